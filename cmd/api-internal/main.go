@@ -16,6 +16,7 @@ import (
 func main() {
 	ctx := context.Background()
 	logger := config.GetLogger()
+	authClient := config.GetAuthClient(logger)
 
 	postgres, sql, err := bunovel.NewClient(ctx, bunovel.Config{
 		Driver:                &bunovel.PGDriver{DSN: config.Postgres.DSN, AppName: config.App.Name},
@@ -32,11 +33,12 @@ func main() {
 
 	improveRequestsDAO := dao.NewImproveRequestRepository(postgres)
 	improveSuggestionDAO := dao.NewImproveSuggestionRepository(postgres)
+	voteDAO := dao.NewVoteRepository(postgres)
 
-	voteImproveRequestService := services.NewVoteImproveRequestService(improveRequestsDAO)
-	voteImproveSuggestionService := services.NewVoteImproveSuggestionService(improveSuggestionDAO)
-	getImproveRequestService := services.NewGetImproveRequestService(improveRequestsDAO)
-	getImproveSuggestionService := services.NewGetImproveSuggestionService(improveSuggestionDAO)
+	voteImproveRequestService := services.NewVoteImproveRequestService(improveRequestsDAO, voteDAO)
+	voteImproveSuggestionService := services.NewVoteImproveSuggestionService(improveSuggestionDAO, voteDAO)
+	getImproveRequestService := services.NewGetImproveRequestService(improveRequestsDAO, authClient)
+	getImproveSuggestionService := services.NewGetImproveSuggestionService(improveSuggestionDAO, authClient)
 
 	voteImproveRequestHandler := handlers.NewVoteImproveRequestHandler(voteImproveRequestService)
 	voteImproveSuggestionHandler := handlers.NewVoteImproveSuggestionHandler(voteImproveSuggestionService)
@@ -8,6 +8,7 @@ import (
 	"github.com/a-novel/forum-service/migrations"
 	"github.com/a-novel/forum-service/pkg/dao"
 	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/ratelimit"
 	"github.com/a-novel/forum-service/pkg/services"
 	"github.com/a-novel/go-apis"
 	"io/fs"
@@ -34,28 +35,89 @@ func main() {
 
 	improveRequestsDAO := dao.NewImproveRequestRepository(postgres)
 	improveSuggestionDAO := dao.NewImproveSuggestionRepository(postgres)
+	commentDAO := dao.NewCommentRepository(postgres)
+	annotationDAO := dao.NewAnnotationRepository(postgres)
+	voteDAO := dao.NewVoteRepository(postgres)
+	notificationDAO := dao.NewNotificationRepository(postgres)
+	subscriptionDAO := dao.NewSubscriptionRepository(postgres)
+	reportDAO := dao.NewReportRepository(postgres)
+	tagDAO := dao.NewTagRepository(postgres)
+	rateLimitDAO := dao.NewRateLimitRepository(postgres)
 
-	createImproveRequestService := services.NewCreateImproveRequestService(improveRequestsDAO, authClient, permissionsClient)
-	createImproveSuggestionService := services.NewCreateImproveSuggestionService(improveSuggestionDAO, improveRequestsDAO, authClient, permissionsClient)
+	limiter := ratelimit.NewPostgresLimiter(rateLimitDAO, ratelimit.Quotas{
+		ratelimit.ActionCreateImproveRequest: {
+			Limit:  config.API.RateLimits.CreateImproveRequest.Limit,
+			Window: config.API.RateLimits.CreateImproveRequest.Window,
+		},
+		ratelimit.ActionCreateImproveSuggestion: {
+			Limit:  config.API.RateLimits.CreateImproveSuggestion.Limit,
+			Window: config.API.RateLimits.CreateImproveSuggestion.Window,
+		},
+		ratelimit.ActionUpdateImproveSuggestion: {
+			Limit:  config.API.RateLimits.UpdateImproveSuggestion.Limit,
+			Window: config.API.RateLimits.UpdateImproveSuggestion.Window,
+		},
+	})
+
+	createImproveRequestService := services.NewCreateImproveRequestService(improveRequestsDAO, authClient, permissionsClient, limiter)
+	createImproveSuggestionService := services.NewCreateImproveSuggestionService(improveSuggestionDAO, improveRequestsDAO, authClient, permissionsClient, limiter)
 	deleteImproveRequestService := services.NewDeleteImproveRequestService(improveRequestsDAO, authClient)
 	deleteImproveRequestRevisionService := services.NewDeleteImproveRequestRevisionService(improveRequestsDAO, authClient)
 	deleteImproveSuggestionService := services.NewDeleteImproveSuggestionService(improveSuggestionDAO, authClient)
-	getImproveRequestService := services.NewGetImproveRequestService(improveRequestsDAO)
-	getImproveRequestRevisionService := services.NewGetImproveRequestRevisionService(improveRequestsDAO)
-	listImproveRequestRevisionsService := services.NewListImproveRequestRevisionsService(improveRequestsDAO)
-	getImproveSuggestionService := services.NewGetImproveSuggestionService(improveSuggestionDAO)
-	listImproveRequestsService := services.NewListImproveRequestsService(improveRequestsDAO)
-	listImproveSuggestionsService := services.NewListImproveSuggestionsService(improveSuggestionDAO)
-	searchImproveRequestsService := services.NewSearchImproveRequestsService(improveRequestsDAO)
-	searchImproveSuggestionsService := services.NewSearchImproveSuggestionsService(improveSuggestionDAO)
-	updateImproveSuggestionService := services.NewUpdateImproveSuggestionService(improveSuggestionDAO, improveRequestsDAO, authClient, permissionsClient)
+	restoreImproveRequestService := services.NewRestoreImproveRequestService(improveRequestsDAO, authClient)
+	restoreImproveRequestRevisionService := services.NewRestoreImproveRequestRevisionService(improveRequestsDAO, authClient)
+	restoreImproveSuggestionService := services.NewRestoreImproveSuggestionService(improveSuggestionDAO, authClient)
+	publishImproveRequestRevisionService := services.NewPublishImproveRequestRevisionService(improveRequestsDAO, authClient)
+	publishImproveSuggestionService := services.NewPublishImproveSuggestionService(improveSuggestionDAO, improveRequestsDAO, authClient)
+	updateImproveRequestStatusService := services.NewUpdateImproveRequestStatusService(improveRequestsDAO, authClient)
+	updateClosePolicyService := services.NewUpdateClosePolicyService(improveRequestsDAO, authClient)
+	getImproveRequestService := services.NewGetImproveRequestService(improveRequestsDAO, authClient)
+	getImproveRequestRevisionService := services.NewGetImproveRequestRevisionService(improveRequestsDAO, authClient)
+	listImproveRequestRevisionsService := services.NewListImproveRequestRevisionsService(improveRequestsDAO, authClient)
+	getImproveSuggestionService := services.NewGetImproveSuggestionService(improveSuggestionDAO, authClient)
+	listImproveRequestsService := services.NewListImproveRequestsService(improveRequestsDAO, authClient)
+	listImproveSuggestionsService := services.NewListImproveSuggestionsService(improveSuggestionDAO, authClient)
+	searchImproveRequestsService := services.NewSearchImproveRequestsService(improveRequestsDAO, authClient)
+	searchImproveSuggestionsService := services.NewSearchImproveSuggestionsService(improveSuggestionDAO, authClient)
+	updateImproveSuggestionService := services.NewUpdateImproveSuggestionService(improveSuggestionDAO, improveRequestsDAO, authClient, permissionsClient, limiter)
 	validateImproveSuggestionService := services.NewValidateImproveSuggestionService(improveSuggestionDAO, improveRequestsDAO, authClient)
+	createCommentService := services.NewCreateCommentService(commentDAO, improveRequestsDAO, improveSuggestionDAO, authClient, permissionsClient)
+	updateCommentService := services.NewUpdateCommentService(commentDAO, authClient, permissionsClient)
+	deleteCommentService := services.NewDeleteCommentService(commentDAO, authClient)
+	listCommentsService := services.NewListCommentsService(commentDAO)
+	applyImproveSuggestionService := services.NewApplyImproveSuggestionService(improveSuggestionDAO, improveRequestsDAO, authClient, permissionsClient)
+	mergeImproveSuggestionsService := services.NewMergeImproveSuggestionsService(improveSuggestionDAO, improveRequestsDAO, authClient, permissionsClient)
+	diffImproveSuggestionService := services.NewDiffImproveSuggestionService(improveSuggestionDAO, improveRequestsDAO, authClient)
+	diffImproveRequestRevisionsService := services.NewDiffImproveRequestRevisionsService(improveRequestsDAO, authClient)
+	createAnnotationService := services.NewCreateAnnotationService(annotationDAO, improveRequestsDAO, authClient, permissionsClient)
+	updateAnnotationService := services.NewUpdateAnnotationService(annotationDAO, authClient, permissionsClient)
+	deleteAnnotationService := services.NewDeleteAnnotationService(annotationDAO, authClient)
+	listAnnotationsService := services.NewListAnnotationsService(annotationDAO)
+	listUserVotesService := services.NewListUserVotesService(voteDAO, authClient)
+	listNotificationsService := services.NewListNotificationsService(notificationDAO, authClient)
+	countUnreadNotificationsService := services.NewCountUnreadNotificationsService(notificationDAO, authClient)
+	markNotificationReadService := services.NewMarkNotificationReadService(notificationDAO, authClient)
+	markAllNotificationsReadService := services.NewMarkAllNotificationsReadService(notificationDAO, authClient)
+	subscribeImproveRequestService := services.NewSubscribeImproveRequestService(subscriptionDAO, improveRequestsDAO, authClient)
+	unsubscribeImproveRequestService := services.NewUnsubscribeImproveRequestService(subscriptionDAO, authClient)
+	createReportService := services.NewCreateReportService(reportDAO, improveRequestsDAO, improveSuggestionDAO, commentDAO, authClient)
+	listReportsService := services.NewListReportsService(reportDAO, authClient, permissionsClient)
+	claimReportService := services.NewClaimReportService(reportDAO, authClient, permissionsClient)
+	resolveReportService := services.NewResolveReportService(reportDAO, authClient, permissionsClient)
+	listPopularTagsService := services.NewListPopularTagsService(tagDAO)
 
 	createImproveRequestHandler := handlers.NewCreateImproveRequestHandler(createImproveRequestService)
 	createImproveSuggestionHandler := handlers.NewCreateImproveSuggestionHandler(createImproveSuggestionService)
 	deleteImproveRequestHandler := handlers.NewDeleteImproveRequestHandler(deleteImproveRequestService)
 	deleteImproveRequestRevisionHandler := handlers.NewDeleteImproveRequestRevisionHandler(deleteImproveRequestRevisionService)
 	deleteImproveSuggestionHandler := handlers.NewDeleteImproveSuggestionHandler(deleteImproveSuggestionService)
+	restoreImproveRequestHandler := handlers.NewRestoreImproveRequestHandler(restoreImproveRequestService)
+	restoreImproveRequestRevisionHandler := handlers.NewRestoreImproveRequestRevisionHandler(restoreImproveRequestRevisionService)
+	restoreImproveSuggestionHandler := handlers.NewRestoreImproveSuggestionHandler(restoreImproveSuggestionService)
+	publishImproveRequestRevisionHandler := handlers.NewPublishImproveRequestRevisionHandler(publishImproveRequestRevisionService)
+	publishImproveSuggestionHandler := handlers.NewPublishImproveSuggestionHandler(publishImproveSuggestionService)
+	updateImproveRequestStatusHandler := handlers.NewUpdateImproveRequestStatusHandler(updateImproveRequestStatusService)
+	updateClosePolicyHandler := handlers.NewUpdateClosePolicyHandler(updateClosePolicyService)
 	getImproveRequestHandler := handlers.NewGetImproveRequestHandler(getImproveRequestService)
 	getImproveRequestRevisionHandler := handlers.NewGetImproveRequestRevisionHandler(getImproveRequestRevisionService)
 	listImproveRequestRevisionsHandler := handlers.NewListImproveRequestRevisionsHandler(listImproveRequestRevisionsService)
@@ -66,6 +128,30 @@ func main() {
 	searchImproveSuggestionsHandler := handlers.NewSearchImproveSuggestionsHandler(searchImproveSuggestionsService)
 	updateImproveSuggestionHandler := handlers.NewUpdateImproveSuggestionHandler(updateImproveSuggestionService)
 	validateImproveSuggestionHandler := handlers.NewValidateImproveSuggestionHandler(validateImproveSuggestionService)
+	createCommentHandler := handlers.NewCreateCommentHandler(createCommentService)
+	updateCommentHandler := handlers.NewUpdateCommentHandler(updateCommentService)
+	deleteCommentHandler := handlers.NewDeleteCommentHandler(deleteCommentService)
+	listCommentsHandler := handlers.NewListCommentsHandler(listCommentsService)
+	applyImproveSuggestionHandler := handlers.NewApplyImproveSuggestionHandler(applyImproveSuggestionService)
+	mergeImproveSuggestionsHandler := handlers.NewMergeImproveSuggestionsHandler(mergeImproveSuggestionsService)
+	diffImproveSuggestionHandler := handlers.NewDiffImproveSuggestionHandler(diffImproveSuggestionService)
+	diffImproveRequestRevisionsHandler := handlers.NewDiffImproveRequestRevisionsHandler(diffImproveRequestRevisionsService)
+	createAnnotationHandler := handlers.NewCreateAnnotationHandler(createAnnotationService)
+	updateAnnotationHandler := handlers.NewUpdateAnnotationHandler(updateAnnotationService)
+	deleteAnnotationHandler := handlers.NewDeleteAnnotationHandler(deleteAnnotationService)
+	listAnnotationsHandler := handlers.NewListAnnotationsHandler(listAnnotationsService)
+	listUserVotesHandler := handlers.NewListUserVotesHandler(listUserVotesService)
+	listNotificationsHandler := handlers.NewListNotificationsHandler(listNotificationsService)
+	countUnreadNotificationsHandler := handlers.NewCountUnreadNotificationsHandler(countUnreadNotificationsService)
+	markNotificationReadHandler := handlers.NewMarkNotificationReadHandler(markNotificationReadService)
+	markAllNotificationsReadHandler := handlers.NewMarkAllNotificationsReadHandler(markAllNotificationsReadService)
+	subscribeImproveRequestHandler := handlers.NewSubscribeImproveRequestHandler(subscribeImproveRequestService)
+	unsubscribeImproveRequestHandler := handlers.NewUnsubscribeImproveRequestHandler(unsubscribeImproveRequestService)
+	createReportHandler := handlers.NewCreateReportHandler(createReportService)
+	listReportsHandler := handlers.NewListReportsHandler(listReportsService)
+	claimReportHandler := handlers.NewClaimReportHandler(claimReportService)
+	resolveReportHandler := handlers.NewResolveReportHandler(resolveReportService)
+	listPopularTagsHandler := handlers.NewListPopularTagsHandler(listPopularTagsService)
 
 	router := apis.GetRouter(apis.RouterConfig{
 		Logger:    logger,
@@ -89,10 +175,20 @@ func main() {
 	router.PUT("/improve-suggestion", createImproveSuggestionHandler.Handle)
 	router.DELETE("/improve-request", deleteImproveRequestHandler.Handle)
 	router.DELETE("/improve-suggestion", deleteImproveSuggestionHandler.Handle)
+	router.POST("/improve-request/restore", restoreImproveRequestHandler.Handle)
+	router.POST("/improve-request/status", updateImproveRequestStatusHandler.Handle)
+	router.PUT("/improve-request/close-policy", updateClosePolicyHandler.Handle)
+	router.POST("/improve-suggestion/restore", restoreImproveSuggestionHandler.Handle)
+	router.POST("/improve-suggestion/publish", publishImproveSuggestionHandler.Handle)
 	router.GET("/improve-request", getImproveRequestHandler.Handle)
 	router.GET("/improve-request/revision", getImproveRequestRevisionHandler.Handle)
 	router.DELETE("/improve-request/revision", deleteImproveRequestRevisionHandler.Handle)
+	router.POST("/improve-request/revision/restore", restoreImproveRequestRevisionHandler.Handle)
+	router.POST("/improve-request/revision/publish", publishImproveRequestRevisionHandler.Handle)
 	router.GET("/improve-request/revisions", listImproveRequestRevisionsHandler.Handle)
+	router.GET("/improve-request/revisions/diff", diffImproveRequestRevisionsHandler.Handle)
+	router.PUT("/improve-request/subscription", subscribeImproveRequestHandler.Handle)
+	router.DELETE("/improve-request/subscription", unsubscribeImproveRequestHandler.Handle)
 	router.GET("/improve-suggestion", getImproveSuggestionHandler.Handle)
 	router.GET("/improve-requests", listImproveRequestsHandler.Handle)
 	router.GET("/improve-suggestions", listImproveSuggestionsHandler.Handle)
@@ -100,6 +196,27 @@ func main() {
 	router.GET("/improve-suggestions/search", searchImproveSuggestionsHandler.Handle)
 	router.PATCH("/improve-suggestion", updateImproveSuggestionHandler.Handle)
 	router.POST("/improve-suggestion/validate", validateImproveSuggestionHandler.Handle)
+	router.POST("/improve-suggestion/apply", applyImproveSuggestionHandler.Handle)
+	router.POST("/improve-suggestions/merge", mergeImproveSuggestionsHandler.Handle)
+	router.GET("/improve-suggestion/diff", diffImproveSuggestionHandler.Handle)
+	router.PUT("/comment", createCommentHandler.Handle)
+	router.PATCH("/comment", updateCommentHandler.Handle)
+	router.DELETE("/comment", deleteCommentHandler.Handle)
+	router.GET("/comments", listCommentsHandler.Handle)
+	router.PUT("/annotation", createAnnotationHandler.Handle)
+	router.PATCH("/annotation", updateAnnotationHandler.Handle)
+	router.DELETE("/annotation", deleteAnnotationHandler.Handle)
+	router.GET("/annotations", listAnnotationsHandler.Handle)
+	router.GET("/votes", listUserVotesHandler.Handle)
+	router.GET("/notifications", listNotificationsHandler.Handle)
+	router.GET("/notifications/unread", countUnreadNotificationsHandler.Handle)
+	router.POST("/notification/read", markNotificationReadHandler.Handle)
+	router.POST("/notifications/read", markAllNotificationsReadHandler.Handle)
+	router.PUT("/report", createReportHandler.Handle)
+	router.GET("/reports", listReportsHandler.Handle)
+	router.POST("/report/claim", claimReportHandler.Handle)
+	router.POST("/report/resolve", resolveReportHandler.Handle)
+	router.GET("/tags/popular", listPopularTagsHandler.Handle)
 
 	if err := router.Run(fmt.Sprintf(":%d", config.API.Port)); err != nil {
 		logger.Fatal().Err(err).Msg("a fatal error occurred while running the API, and the server had to shut down")
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/config"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/services"
+	"io/fs"
+	"time"
+)
+
+func main() {
+	ctx := context.Background()
+	logger := config.GetAutoCloseLogger()
+
+	postgres, sql, err := bunovel.NewClient(ctx, bunovel.Config{
+		Driver:                &bunovel.PGDriver{DSN: config.Postgres.DSN, AppName: config.App.Name},
+		Migrations:            &bunovel.MigrateConfig{Files: []fs.FS{migrations.Migrations}},
+		DiscardUnknownColumns: true,
+	})
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error connecting to postgres")
+	}
+	defer func() {
+		_ = postgres.Close()
+		_ = sql.Close()
+	}()
+
+	improveRequestsDAO := dao.NewImproveRequestRepository(postgres)
+
+	autoCloseImproveRequestsService := services.NewAutoCloseImproveRequestsService(improveRequestsDAO)
+
+	// The job runs once, and is meant to be scheduled externally (cron, scheduler, etc.).
+	updated, err := autoCloseImproveRequestsService.AutoClose(ctx, time.Now())
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to apply close policies")
+	}
+
+	logger.Info().Int("updated", updated).Msg("close policies applied")
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/config"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/forum-service/pkg/sinks"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logger := config.GetDispatcherLogger()
+
+	postgres, sql, err := bunovel.NewClient(ctx, bunovel.Config{
+		Driver:                &bunovel.PGDriver{DSN: config.Postgres.DSN, AppName: config.App.Name},
+		Migrations:            &bunovel.MigrateConfig{Files: []fs.FS{migrations.Migrations}},
+		DiscardUnknownColumns: true,
+	})
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error connecting to postgres")
+	}
+	defer func() {
+		_ = postgres.Close()
+		_ = sql.Close()
+	}()
+
+	eventDAO := dao.NewEventRepository(postgres)
+	notificationDAO := dao.NewNotificationRepository(postgres)
+
+	// The inbox is always fed, so users get notified of events regardless of the external sinks.
+	eventSinks := []sinks.Sink{sinks.NewInboxSink(notificationDAO)}
+	if config.Dispatcher.Sinks.Log {
+		eventSinks = append(eventSinks, sinks.NewLogSink(logger))
+	}
+	if config.Dispatcher.Sinks.Webhook != "" {
+		client := &http.Client{Timeout: config.Dispatcher.Sinks.WebhookTimeout}
+		eventSinks = append(eventSinks, sinks.NewWebhookSink(config.Dispatcher.Sinks.Webhook, client))
+	}
+
+	dispatchEventsService := services.NewDispatchEventsService(eventDAO, eventSinks)
+
+	ticker := time.NewTicker(config.Dispatcher.Interval)
+	defer ticker.Stop()
+
+	for {
+		// Keep dispatching without waiting while full batches are delivered, so a backlog is drained quickly.
+		delivered, err := dispatchEventsService.Dispatch(ctx, time.Now())
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to dispatch events")
+		}
+		if delivered > 0 {
+			logger.Info().Int("delivered", delivered).Msg("events dispatched")
+		}
+
+		if err == nil && delivered == services.DispatchBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info().Msg("dispatcher stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
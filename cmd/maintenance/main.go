@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/config"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/services"
+	"io/fs"
+	"time"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "only count the orphans, without repairing them")
+	flag.Parse()
+
+	ctx := context.Background()
+	logger := config.GetMaintenanceLogger()
+
+	postgres, sql, err := bunovel.NewClient(ctx, bunovel.Config{
+		Driver:                &bunovel.PGDriver{DSN: config.Postgres.DSN, AppName: config.App.Name},
+		Migrations:            &bunovel.MigrateConfig{Files: []fs.FS{migrations.Migrations}},
+		DiscardUnknownColumns: true,
+	})
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error connecting to postgres")
+	}
+	defer func() {
+		_ = postgres.Close()
+		_ = sql.Close()
+	}()
+
+	maintenanceDAO := dao.NewMaintenanceRepository(postgres)
+
+	repairOrphansService := services.NewRepairOrphansService(maintenanceDAO)
+
+	orphans, err := repairOrphansService.Repair(ctx, config.Maintenance.Policy, *dryRun, time.Now())
+	if orphans != nil {
+		logger.Info().
+			Bool("dryRun", *dryRun).
+			Str("policy", config.Maintenance.Policy).
+			Int("revisions", orphans.Revisions).
+			Int("detachedSuggestions", orphans.DetachedSuggestions).
+			Int("orphanedSuggestions", orphans.OrphanedSuggestions).
+			Msg("orphans processed")
+	}
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to repair orphans")
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/config"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/services"
+	"io/fs"
+	"time"
+)
+
+func main() {
+	ctx := context.Background()
+	logger := config.GetPurgeLogger()
+
+	postgres, sql, err := bunovel.NewClient(ctx, bunovel.Config{
+		Driver:                &bunovel.PGDriver{DSN: config.Postgres.DSN, AppName: config.App.Name},
+		Migrations:            &bunovel.MigrateConfig{Files: []fs.FS{migrations.Migrations}},
+		DiscardUnknownColumns: true,
+	})
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error connecting to postgres")
+	}
+	defer func() {
+		_ = postgres.Close()
+		_ = sql.Close()
+	}()
+
+	improveRequestsDAO := dao.NewImproveRequestRepository(postgres)
+	improveSuggestionDAO := dao.NewImproveSuggestionRepository(postgres)
+
+	purgeDeletedService := services.NewPurgeDeletedService(improveRequestsDAO, improveSuggestionDAO)
+
+	// The job runs once, and is meant to be scheduled externally (cron, scheduler, etc.).
+	purged, err := purgeDeletedService.Purge(ctx, config.Purge.Retention, time.Now())
+	if err != nil {
+		logger.Fatal().Err(err).Int("purged", purged).Msg("failed to purge deleted content")
+	}
+
+	logger.Info().Int("purged", purged).Dur("retention", config.Purge.Retention).Msg("deleted content purged")
+}
@@ -3,6 +3,7 @@ package config
 import (
 	_ "embed"
 	"log"
+	"time"
 )
 
 //go:embed api-dev.yml
@@ -11,6 +12,12 @@ var apiDevFile []byte
 //go:embed api-prod.yml
 var apiProdFile []byte
 
+// RateLimit allows a user to perform an action Limit times, per Window.
+type RateLimit struct {
+	Limit  int           `yaml:"limit"`
+	Window time.Duration `yaml:"window"`
+}
+
 type ApiConfig struct {
 	Port         int `yaml:"port"`
 	PortInternal int `yaml:"portInternal"`
@@ -18,6 +25,11 @@ type ApiConfig struct {
 		AuthAPI        string `yaml:"authAPI"`
 		PermissionsAPI string `yaml:"permissionsAPI"`
 	} `yaml:"external"`
+	RateLimits struct {
+		CreateImproveRequest    RateLimit `yaml:"createImproveRequest"`
+		CreateImproveSuggestion RateLimit `yaml:"createImproveSuggestion"`
+		UpdateImproveSuggestion RateLimit `yaml:"updateImproveSuggestion"`
+	} `yaml:"rateLimits"`
 }
 
 var API *ApiConfig
@@ -0,0 +1,41 @@
+package config
+
+import (
+	_ "embed"
+	"log"
+	"time"
+)
+
+//go:embed dispatcher.yml
+var dispatcherFile []byte
+
+//go:embed dispatcher-dev.yml
+var dispatcherDevFile []byte
+
+//go:embed dispatcher-prod.yml
+var dispatcherProdFile []byte
+
+type DispatcherConfig struct {
+	// Interval is the time to wait between two dispatches.
+	Interval time.Duration `yaml:"interval"`
+	Sinks    struct {
+		// Log writes every event to the dispatcher logs.
+		Log bool `yaml:"log"`
+		// Webhook is the URL events are posted to. The webhook sink is disabled when empty.
+		Webhook string `yaml:"webhook"`
+		// WebhookTimeout is the maximum duration of a single webhook call.
+		WebhookTimeout time.Duration `yaml:"webhookTimeout"`
+	} `yaml:"sinks"`
+}
+
+var Dispatcher *DispatcherConfig
+
+func init() {
+	cfg := new(DispatcherConfig)
+
+	if err := loadEnv(EnvLoader{DefaultENV: dispatcherFile, DevENV: dispatcherDevFile, ProdENV: dispatcherProdFile}, cfg); err != nil {
+		log.Fatalf("error loading dispatcher configuration: %v\n", err)
+	}
+
+	Dispatcher = cfg
+}
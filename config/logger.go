@@ -36,3 +36,67 @@ func GetInternalLogger() zerolog.Logger {
 
 	return logger
 }
+
+func GetDispatcherLogger() zerolog.Logger {
+	logger := zerolog.New(os.Stdout).
+		With().
+		Dict("application", zerolog.Dict().Str("name", App.Name+"-dispatcher").Str("env", ENV)).
+		Logger()
+
+	switch ENV {
+	case ProdENV:
+		logger = logger.With().Timestamp().Logger()
+	default:
+		logger = logger.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	}
+
+	return logger
+}
+
+func GetPurgeLogger() zerolog.Logger {
+	logger := zerolog.New(os.Stdout).
+		With().
+		Dict("application", zerolog.Dict().Str("name", App.Name+"-purge").Str("env", ENV)).
+		Logger()
+
+	switch ENV {
+	case ProdENV:
+		logger = logger.With().Timestamp().Logger()
+	default:
+		logger = logger.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	}
+
+	return logger
+}
+
+func GetMaintenanceLogger() zerolog.Logger {
+	logger := zerolog.New(os.Stdout).
+		With().
+		Dict("application", zerolog.Dict().Str("name", App.Name+"-maintenance").Str("env", ENV)).
+		Logger()
+
+	switch ENV {
+	case ProdENV:
+		logger = logger.With().Timestamp().Logger()
+	default:
+		logger = logger.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	}
+
+	return logger
+}
+
+func GetAutoCloseLogger() zerolog.Logger {
+	logger := zerolog.New(os.Stdout).
+		With().
+		Dict("application", zerolog.Dict().Str("name", App.Name+"-autoclose").Str("env", ENV)).
+		Logger()
+
+	switch ENV {
+	case ProdENV:
+		logger = logger.With().Timestamp().Logger()
+	default:
+		logger = logger.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	}
+
+	return logger
+}
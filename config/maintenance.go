@@ -0,0 +1,27 @@
+package config
+
+import (
+	_ "embed"
+	"log"
+)
+
+//go:embed maintenance.yml
+var maintenanceFile []byte
+
+type MaintenanceConfig struct {
+	// Policy decides what happens to the suggestions whose revision is missing or deleted: "reattach" moves them to
+	// the nearest surviving revision, "cascade" deletes them.
+	Policy string `yaml:"policy"`
+}
+
+var Maintenance *MaintenanceConfig
+
+func init() {
+	cfg := new(MaintenanceConfig)
+
+	if err := loadEnv(EnvLoader{DefaultENV: maintenanceFile}, cfg); err != nil {
+		log.Fatalf("error loading maintenance configuration: %v\n", err)
+	}
+
+	Maintenance = cfg
+}
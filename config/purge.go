@@ -0,0 +1,27 @@
+package config
+
+import (
+	_ "embed"
+	"log"
+	"time"
+)
+
+//go:embed purge.yml
+var purgeFile []byte
+
+type PurgeConfig struct {
+	// Retention is how long soft deleted content is kept, and can be restored, before it is permanently deleted.
+	Retention time.Duration `yaml:"retention"`
+}
+
+var Purge *PurgeConfig
+
+func init() {
+	cfg := new(PurgeConfig)
+
+	if err := loadEnv(EnvLoader{DefaultENV: purgeFile}, cfg); err != nil {
+		log.Fatalf("error loading purge configuration: %v\n", err)
+	}
+
+	Purge = cfg
+}
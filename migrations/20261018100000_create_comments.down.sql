@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS comments_target;
+DROP INDEX IF EXISTS comments_parent;
+DROP INDEX IF EXISTS comments_user;
+
+--bun:split
+
+DROP TABLE IF EXISTS comments;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS comments (
+    id uuid PRIMARY KEY NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ,
+
+    user_id uuid NOT NULL,
+    target_type VARCHAR(32) NOT NULL,
+    target_id uuid NOT NULL,
+    parent_id uuid REFERENCES comments (id) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+
+    CONSTRAINT target_type_valid CHECK (
+        target_type IN ('improve_request', 'improve_request_revision', 'improve_suggestion')
+    ),
+    CONSTRAINT content_filled CHECK ( content <> '' ),
+    CONSTRAINT content_length CHECK ( char_length(content) <= 2048 )
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS comments_target ON comments (target_type, target_id, created_at);
+CREATE INDEX IF NOT EXISTS comments_parent ON comments (parent_id, created_at);
+CREATE INDEX IF NOT EXISTS comments_user ON comments (user_id);
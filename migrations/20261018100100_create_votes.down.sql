@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS votes_user;
+
+--bun:split
+
+DROP TABLE IF EXISTS votes;
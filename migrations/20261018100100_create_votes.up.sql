@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS votes (
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ,
+
+    target_type VARCHAR(32) NOT NULL,
+    target_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    vote SMALLINT NOT NULL,
+
+    PRIMARY KEY (target_type, target_id, user_id),
+
+    CONSTRAINT target_type_valid CHECK ( target_type IN ('improve_request', 'improve_suggestion') ),
+    CONSTRAINT vote_valid CHECK ( vote IN (-1, 1) )
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS votes_user ON votes (user_id, target_type);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS annotations_revision;
+DROP INDEX IF EXISTS annotations_source;
+
+--bun:split
+
+DROP TABLE IF EXISTS annotations;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS annotations (
+    id uuid PRIMARY KEY NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ,
+
+    user_id uuid NOT NULL,
+    source_id uuid NOT NULL,
+    revision_id uuid NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    quote TEXT NOT NULL,
+    content TEXT NOT NULL,
+    orphaned BOOLEAN NOT NULL DEFAULT FALSE,
+
+    CONSTRAINT range_valid CHECK ( start_offset >= 0 AND end_offset > start_offset ),
+    CONSTRAINT quote_length CHECK ( char_length(quote) = end_offset - start_offset ),
+    CONSTRAINT content_filled CHECK ( content <> '' ),
+    CONSTRAINT content_length CHECK ( char_length(content) <= 2048 )
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS annotations_revision ON annotations (revision_id, start_offset);
+CREATE INDEX IF NOT EXISTS annotations_source ON annotations (source_id) WHERE orphaned = FALSE;
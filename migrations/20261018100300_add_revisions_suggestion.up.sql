@@ -0,0 +1,26 @@
+ALTER TABLE improve_requests_revisions ADD COLUMN IF NOT EXISTS suggestion_id uuid;
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS improve_requests_suggestion ON improve_requests_revisions (suggestion_id);
+
+--bun:split
+
+CREATE OR REPLACE VIEW improve_requests_revisions_list AS
+    SELECT
+        improve_requests_revisions.id,
+        improve_requests_revisions.created_at,
+        improve_requests_revisions.updated_at,
+        improve_requests_revisions.source_id,
+        suggestions.total AS suggestions_count,
+        accepted_suggestions.total AS accepted_suggestions_count,
+        improve_requests_revisions.suggestion_id
+    FROM improve_requests_revisions
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.request_id = improve_requests_revisions.id
+    ) AS suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.request_id = improve_requests_revisions.id AND improve_suggestions.validated = TRUE
+    ) AS accepted_suggestions ON TRUE;
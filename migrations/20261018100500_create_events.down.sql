@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS events_pending;
+
+--bun:split
+
+DROP TABLE IF EXISTS events;
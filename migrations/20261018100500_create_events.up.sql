@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS events (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    type VARCHAR(64) NOT NULL,
+    user_id uuid NOT NULL,
+    target_id uuid NOT NULL,
+    source_id uuid NOT NULL,
+
+    delivered_at TIMESTAMPTZ,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_attempt_at TIMESTAMPTZ,
+    last_error TEXT,
+    claimed_until TIMESTAMPTZ,
+
+    CONSTRAINT type_valid CHECK (
+        type IN ('request.created', 'request.revised', 'suggestion.created', 'suggestion.validated')
+    )
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS events_pending ON events (id) WHERE delivered_at IS NULL;
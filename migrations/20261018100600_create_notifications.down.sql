@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS notifications_unread;
+DROP INDEX IF EXISTS notifications_user;
+
+--bun:split
+
+DROP TABLE IF EXISTS notifications;
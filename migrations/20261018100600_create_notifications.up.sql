@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS notifications (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ,
+
+    user_id uuid NOT NULL,
+    event_id BIGINT NOT NULL,
+    type VARCHAR(64) NOT NULL,
+    actor_id uuid NOT NULL,
+    target_id uuid NOT NULL,
+    source_id uuid NOT NULL,
+    read_at TIMESTAMPTZ,
+
+    CONSTRAINT notifications_event_user UNIQUE (event_id, user_id)
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS notifications_user ON notifications (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS notifications_unread ON notifications (user_id) WHERE read_at IS NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS subscriptions_source;
+
+--bun:split
+
+DROP TABLE IF EXISTS subscriptions;
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS subscriptions (
+    user_id uuid NOT NULL,
+    source_id uuid NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (user_id, source_id)
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS subscriptions_source ON subscriptions (source_id);
+
+--bun:split
+
+/*
+    Authors of existing requests and suggestions follow them, as if they had been subscribed when posting. The author
+    of a request is the author of its earliest revision: revision IDs are not tied to the ID of their request.
+*/
+INSERT INTO subscriptions (user_id, source_id, created_at)
+SELECT DISTINCT ON (source_id) user_id, source_id, created_at FROM improve_requests_revisions
+ORDER BY source_id, created_at
+ON CONFLICT (user_id, source_id) DO NOTHING;
+
+--bun:split
+
+INSERT INTO subscriptions (user_id, source_id, created_at)
+SELECT user_id, source_id, MIN(created_at) FROM improve_suggestions GROUP BY user_id, source_id
+ON CONFLICT (user_id, source_id) DO NOTHING;
@@ -0,0 +1,74 @@
+DROP VIEW IF EXISTS improve_requests_previews;
+DROP VIEW IF EXISTS improve_requests_latest_revisions;
+DROP VIEW IF EXISTS improve_requests_revisions_list;
+
+--bun:split
+
+ALTER TABLE improve_requests_revisions DROP COLUMN IF EXISTS hidden;
+ALTER TABLE improve_suggestions DROP COLUMN IF EXISTS hidden;
+ALTER TABLE comments DROP COLUMN IF EXISTS hidden;
+
+--bun:split
+
+CREATE VIEW improve_requests_revisions_list AS
+    SELECT
+        improve_requests_revisions.id,
+        improve_requests_revisions.created_at,
+        improve_requests_revisions.updated_at,
+        improve_requests_revisions.source_id,
+        suggestions.total AS suggestions_count,
+        accepted_suggestions.total AS accepted_suggestions_count,
+        improve_requests_revisions.suggestion_ids
+    FROM improve_requests_revisions
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.request_id = improve_requests_revisions.id
+    ) AS suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.request_id = improve_requests_revisions.id AND improve_suggestions.validated = TRUE
+    ) AS accepted_suggestions ON TRUE;
+
+CREATE VIEW improve_requests_latest_revisions AS
+    SELECT DISTINCT ON (source_id) *
+    FROM improve_requests_revisions
+    ORDER BY source_id, created_at DESC NULLS LAST;
+
+CREATE VIEW improve_requests_previews AS
+SELECT
+    improve_requests.id,
+    improve_requests.created_at,
+    improve_requests.updated_at,
+    improve_requests.up_votes,
+    improve_requests.down_votes,
+    improve_requests_latest_revisions.title AS title,
+    improve_requests_latest_revisions.content AS content,
+    improve_requests_latest_revisions.user_id AS user_id,
+    improve_requests_latest_revisions.text_searchable_index_col AS text_searchable_index_col,
+    suggestions.total AS suggestions_count,
+    accepted_suggestions.total AS accepted_suggestions_count,
+    revisions.total AS revisions_count
+FROM improve_requests
+    LEFT JOIN improve_requests_latest_revisions ON improve_requests_latest_revisions.source_id = improve_requests.id
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id
+    ) AS suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.validated = TRUE
+    ) AS accepted_suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(improve_requests_revisions.id) AS total
+        FROM improve_requests_revisions
+        WHERE improve_requests_revisions.source_id = improve_requests.id
+    ) AS revisions ON TRUE;
+
+--bun:split
+
+DROP INDEX IF EXISTS reports_target;
+DROP INDEX IF EXISTS reports_queue;
+
+--bun:split
+
+DROP TABLE IF EXISTS reports;
@@ -0,0 +1,9 @@
+ALTER TABLE improve_suggestions DROP CONSTRAINT IF EXISTS improve_suggestions_request_fk;
+
+--bun:split
+
+ALTER TABLE improve_suggestions DROP CONSTRAINT IF EXISTS improve_suggestions_source_fk;
+
+--bun:split
+
+ALTER TABLE improve_requests_revisions DROP CONSTRAINT IF EXISTS improve_requests_revisions_source_fk;
@@ -0,0 +1,21 @@
+/*
+ * Revisions and suggestions cannot outlive their improvement request, and suggestions cannot outlive the revision
+ * they were made on. The constraints are not validated against existing rows: orphans left by previous versions must
+ * be repaired with the maintenance job first, which then validates them.
+ */
+ALTER TABLE improve_requests_revisions
+    ADD CONSTRAINT improve_requests_revisions_source_fk FOREIGN KEY (source_id)
+        REFERENCES improve_requests (id) ON DELETE CASCADE NOT VALID;
+
+--bun:split
+
+ALTER TABLE improve_suggestions
+    ADD CONSTRAINT improve_suggestions_source_fk FOREIGN KEY (source_id)
+        REFERENCES improve_requests (id) ON DELETE CASCADE NOT VALID;
+
+--bun:split
+
+ALTER TABLE improve_suggestions
+    ADD CONSTRAINT improve_suggestions_request_fk FOREIGN KEY (request_id)
+        REFERENCES improve_requests_revisions (id) ON DELETE CASCADE NOT VALID;
+
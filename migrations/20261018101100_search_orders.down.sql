@@ -0,0 +1,51 @@
+DROP VIEW IF EXISTS improve_requests_previews;
+
+--bun:split
+
+DROP INDEX IF EXISTS comments_target_visible;
+DROP INDEX IF EXISTS improve_suggestions_activity;
+DROP INDEX IF EXISTS improve_suggestions_hotness;
+DROP INDEX IF EXISTS improve_suggestions_controversy;
+DROP INDEX IF EXISTS improve_requests_hotness;
+DROP INDEX IF EXISTS improve_requests_controversy;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS hotness(BIGINT, BIGINT, TIMESTAMPTZ);
+DROP FUNCTION IF EXISTS controversy(BIGINT, BIGINT);
+
+--bun:split
+
+CREATE VIEW improve_requests_previews AS
+SELECT
+    improve_requests.id,
+    improve_requests.created_at,
+    improve_requests.updated_at,
+    improve_requests.up_votes,
+    improve_requests.down_votes,
+    improve_requests_latest_revisions.title AS title,
+    improve_requests_latest_revisions.content AS content,
+    improve_requests_latest_revisions.user_id AS user_id,
+    improve_requests_latest_revisions.text_searchable_index_col AS text_searchable_index_col,
+    suggestions.total AS suggestions_count,
+    accepted_suggestions.total AS accepted_suggestions_count,
+    revisions.total AS revisions_count
+FROM improve_requests
+    LEFT JOIN improve_requests_latest_revisions ON improve_requests_latest_revisions.source_id = improve_requests.id
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.hidden = FALSE
+            AND improve_suggestions.deleted_at IS NULL
+    ) AS suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.validated = TRUE
+            AND improve_suggestions.hidden = FALSE AND improve_suggestions.deleted_at IS NULL
+    ) AS accepted_suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(improve_requests_revisions.id) AS total
+        FROM improve_requests_revisions
+        WHERE improve_requests_revisions.source_id = improve_requests.id AND improve_requests_revisions.hidden = FALSE
+            AND improve_requests_revisions.deleted_at IS NULL
+    ) AS revisions ON TRUE
+WHERE improve_requests.deleted_at IS NULL;
@@ -0,0 +1,96 @@
+/*
+    Sort keys of the search orders. They only depend on the row they are computed from, so they can be indexed.
+
+    controversy is high when a content receives many votes, evenly split between up and down votes.
+    hotness is the score of a content on a log scale, offset by its creation date: a content needs 10 times more
+    score to rank with a content posted 12.5 hours later.
+*/
+CREATE OR REPLACE FUNCTION controversy(up_votes BIGINT, down_votes BIGINT) RETURNS DOUBLE PRECISION AS $$
+    SELECT CASE
+        WHEN up_votes <= 0 OR down_votes <= 0 THEN 0
+        ELSE power(
+            (up_votes + down_votes)::DOUBLE PRECISION,
+            LEAST(up_votes, down_votes)::DOUBLE PRECISION / GREATEST(up_votes, down_votes)
+        )
+    END
+$$ LANGUAGE SQL IMMUTABLE;
+
+CREATE OR REPLACE FUNCTION hotness(up_votes BIGINT, down_votes BIGINT, created_at TIMESTAMPTZ) RETURNS DOUBLE PRECISION AS $$
+    SELECT sign(up_votes - down_votes)::DOUBLE PRECISION * log(GREATEST(abs(up_votes - down_votes), 1)::DOUBLE PRECISION)
+        + EXTRACT(EPOCH FROM created_at)::DOUBLE PRECISION / 45000
+$$ LANGUAGE SQL IMMUTABLE;
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS improve_requests_controversy ON improve_requests (controversy(up_votes, down_votes) DESC)
+    WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS improve_requests_hotness ON improve_requests (hotness(up_votes, down_votes, created_at) DESC)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS improve_suggestions_controversy ON improve_suggestions (controversy(up_votes, down_votes) DESC)
+    WHERE hidden = FALSE AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS improve_suggestions_hotness
+    ON improve_suggestions (hotness(up_votes, down_votes, created_at) DESC)
+    WHERE hidden = FALSE AND deleted_at IS NULL;
+/* Counts and last activity of the suggestions of a request, for the previews. */
+CREATE INDEX IF NOT EXISTS improve_suggestions_activity
+    ON improve_suggestions (source_id, (COALESCE(updated_at, created_at)) DESC)
+    WHERE hidden = FALSE AND deleted_at IS NULL;
+/*
+    Number of comments and latest comment of a suggestion, for the activity and comments orders of the suggestions.
+    Both are computed for every searched suggestion: the partial index answers them without reading the comments.
+*/
+CREATE INDEX IF NOT EXISTS comments_target_visible ON comments (target_type, target_id, created_at DESC)
+    WHERE hidden = FALSE;
+
+--bun:split
+
+DROP VIEW IF EXISTS improve_requests_previews;
+
+--bun:split
+
+/* The activity of a request is its latest revision, or the latest suggestion posted or edited on it. */
+CREATE VIEW improve_requests_previews AS
+SELECT
+    improve_requests.id,
+    improve_requests.created_at,
+    improve_requests.updated_at,
+    improve_requests.up_votes,
+    improve_requests.down_votes,
+    improve_requests_latest_revisions.title AS title,
+    improve_requests_latest_revisions.content AS content,
+    improve_requests_latest_revisions.user_id AS user_id,
+    improve_requests_latest_revisions.text_searchable_index_col AS text_searchable_index_col,
+    suggestions.total AS suggestions_count,
+    accepted_suggestions.total AS accepted_suggestions_count,
+    revisions.total AS revisions_count,
+    GREATEST(
+        improve_requests.created_at, improve_requests_latest_revisions.created_at, suggestions_activity.last
+    ) AS last_activity_at,
+    controversy(improve_requests.up_votes, improve_requests.down_votes) AS controversy,
+    hotness(improve_requests.up_votes, improve_requests.down_votes, improve_requests.created_at) AS hotness
+FROM improve_requests
+    LEFT JOIN improve_requests_latest_revisions ON improve_requests_latest_revisions.source_id = improve_requests.id
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.hidden = FALSE
+            AND improve_suggestions.deleted_at IS NULL
+    ) AS suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.validated = TRUE
+            AND improve_suggestions.hidden = FALSE AND improve_suggestions.deleted_at IS NULL
+    ) AS accepted_suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT MAX(COALESCE(improve_suggestions.updated_at, improve_suggestions.created_at)) AS last
+        FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.hidden = FALSE
+            AND improve_suggestions.deleted_at IS NULL
+    ) AS suggestions_activity ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(improve_requests_revisions.id) AS total
+        FROM improve_requests_revisions
+        WHERE improve_requests_revisions.source_id = improve_requests.id AND improve_requests_revisions.hidden = FALSE
+            AND improve_requests_revisions.deleted_at IS NULL
+    ) AS revisions ON TRUE
+WHERE improve_requests.deleted_at IS NULL;
@@ -0,0 +1,93 @@
+DROP VIEW IF EXISTS improve_requests_previews;
+DROP VIEW IF EXISTS improve_requests_latest_revisions;
+
+--bun:split
+
+DROP TRIGGER IF EXISTS copy_request_language ON improve_suggestions;
+DROP FUNCTION IF EXISTS copy_request_language();
+
+--bun:split
+
+CREATE OR REPLACE FUNCTION format_searchable_content()
+    RETURNS TRIGGER AS $format_searchable_content$
+BEGIN
+    NEW.text_searchable_index_col :=
+        setweight(to_tsvector('french',  unaccent(NEW.title)), 'A') ||
+        setweight(to_tsvector('french', unaccent(NEW.content)), 'B');
+    RETURN NEW;
+END;
+$format_searchable_content$ LANGUAGE plpgsql;
+
+--bun:split
+
+UPDATE improve_requests_revisions SET text_searchable_index_col =
+    setweight(to_tsvector('french',  unaccent(title)), 'A') ||
+    setweight(to_tsvector('french', unaccent(content)), 'B')
+WHERE language <> 'fr';
+
+UPDATE improve_suggestions SET text_searchable_index_col =
+    setweight(to_tsvector('french',  unaccent(title)), 'A') ||
+    setweight(to_tsvector('french', unaccent(content)), 'B')
+WHERE language <> 'fr';
+
+--bun:split
+
+ALTER TABLE improve_suggestions DROP COLUMN IF EXISTS language;
+ALTER TABLE improve_requests_revisions DROP COLUMN IF EXISTS language;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS search_config(VARCHAR);
+
+--bun:split
+
+CREATE VIEW improve_requests_latest_revisions AS
+    SELECT DISTINCT ON (source_id) *
+    FROM improve_requests_revisions
+    WHERE hidden = FALSE AND deleted_at IS NULL
+    ORDER BY source_id, created_at DESC NULLS LAST;
+
+CREATE VIEW improve_requests_previews AS
+SELECT
+    improve_requests.id,
+    improve_requests.created_at,
+    improve_requests.updated_at,
+    improve_requests.up_votes,
+    improve_requests.down_votes,
+    improve_requests_latest_revisions.title AS title,
+    improve_requests_latest_revisions.content AS content,
+    improve_requests_latest_revisions.user_id AS user_id,
+    improve_requests_latest_revisions.text_searchable_index_col AS text_searchable_index_col,
+    suggestions.total AS suggestions_count,
+    accepted_suggestions.total AS accepted_suggestions_count,
+    revisions.total AS revisions_count,
+    GREATEST(
+        improve_requests.created_at, improve_requests_latest_revisions.created_at, suggestions_activity.last
+    ) AS last_activity_at,
+    controversy(improve_requests.up_votes, improve_requests.down_votes) AS controversy,
+    hotness(improve_requests.up_votes, improve_requests.down_votes, improve_requests.created_at) AS hotness
+FROM improve_requests
+    LEFT JOIN improve_requests_latest_revisions ON improve_requests_latest_revisions.source_id = improve_requests.id
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.hidden = FALSE
+            AND improve_suggestions.deleted_at IS NULL
+    ) AS suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.validated = TRUE
+            AND improve_suggestions.hidden = FALSE AND improve_suggestions.deleted_at IS NULL
+    ) AS accepted_suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT MAX(COALESCE(improve_suggestions.updated_at, improve_suggestions.created_at)) AS last
+        FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.hidden = FALSE
+            AND improve_suggestions.deleted_at IS NULL
+    ) AS suggestions_activity ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(improve_requests_revisions.id) AS total
+        FROM improve_requests_revisions
+        WHERE improve_requests_revisions.source_id = improve_requests.id AND improve_requests_revisions.hidden = FALSE
+            AND improve_requests_revisions.deleted_at IS NULL
+    ) AS revisions ON TRUE
+WHERE improve_requests.deleted_at IS NULL;
@@ -0,0 +1,108 @@
+/* Text search configuration of each supported language. */
+CREATE OR REPLACE FUNCTION search_config(language VARCHAR) RETURNS regconfig AS $$
+    SELECT CASE language
+        WHEN 'en' THEN 'english'::regconfig
+        WHEN 'es' THEN 'spanish'::regconfig
+        ELSE 'french'::regconfig
+    END
+$$ LANGUAGE SQL IMMUTABLE;
+
+--bun:split
+
+/* Existing content was indexed in french. */
+ALTER TABLE improve_requests_revisions ADD COLUMN IF NOT EXISTS language VARCHAR(8) NOT NULL DEFAULT 'fr';
+ALTER TABLE improve_requests_revisions ADD CONSTRAINT language_supported CHECK ( language IN ('fr', 'en', 'es') );
+
+ALTER TABLE improve_suggestions ADD COLUMN IF NOT EXISTS language VARCHAR(8) NOT NULL DEFAULT 'fr';
+ALTER TABLE improve_suggestions ADD CONSTRAINT language_supported CHECK ( language IN ('fr', 'en', 'es') );
+
+--bun:split
+
+CREATE OR REPLACE FUNCTION format_searchable_content()
+    RETURNS TRIGGER AS $format_searchable_content$
+BEGIN
+    NEW.text_searchable_index_col :=
+        setweight(to_tsvector(search_config(NEW.language),  unaccent(NEW.title)), 'A') ||
+        setweight(to_tsvector(search_config(NEW.language), unaccent(NEW.content)), 'B');
+    RETURN NEW;
+END;
+$format_searchable_content$ LANGUAGE plpgsql;
+
+--bun:split
+
+/* Suggestions are written in the language of their request. */
+CREATE FUNCTION copy_request_language()
+    RETURNS TRIGGER AS $copy_request_language$
+BEGIN
+    SELECT language INTO NEW.language FROM improve_requests_revisions WHERE id = NEW.request_id;
+    NEW.language := COALESCE(NEW.language, 'fr');
+    RETURN NEW;
+END;
+$copy_request_language$ LANGUAGE plpgsql;
+
+--bun:split
+
+/* Triggers fire in alphabetical order: the language is copied before the searchable content is built from it. */
+CREATE TRIGGER copy_request_language
+    BEFORE INSERT ON improve_suggestions
+    FOR EACH ROW
+EXECUTE FUNCTION copy_request_language();
+
+--bun:split
+
+DROP VIEW IF EXISTS improve_requests_previews;
+DROP VIEW IF EXISTS improve_requests_latest_revisions;
+
+--bun:split
+
+CREATE VIEW improve_requests_latest_revisions AS
+    SELECT DISTINCT ON (source_id) *
+    FROM improve_requests_revisions
+    WHERE hidden = FALSE AND deleted_at IS NULL
+    ORDER BY source_id, created_at DESC NULLS LAST;
+
+CREATE VIEW improve_requests_previews AS
+SELECT
+    improve_requests.id,
+    improve_requests.created_at,
+    improve_requests.updated_at,
+    improve_requests.up_votes,
+    improve_requests.down_votes,
+    improve_requests_latest_revisions.title AS title,
+    improve_requests_latest_revisions.content AS content,
+    improve_requests_latest_revisions.user_id AS user_id,
+    improve_requests_latest_revisions.text_searchable_index_col AS text_searchable_index_col,
+    improve_requests_latest_revisions.language AS language,
+    suggestions.total AS suggestions_count,
+    accepted_suggestions.total AS accepted_suggestions_count,
+    revisions.total AS revisions_count,
+    GREATEST(
+        improve_requests.created_at, improve_requests_latest_revisions.created_at, suggestions_activity.last
+    ) AS last_activity_at,
+    controversy(improve_requests.up_votes, improve_requests.down_votes) AS controversy,
+    hotness(improve_requests.up_votes, improve_requests.down_votes, improve_requests.created_at) AS hotness
+FROM improve_requests
+    LEFT JOIN improve_requests_latest_revisions ON improve_requests_latest_revisions.source_id = improve_requests.id
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.hidden = FALSE
+            AND improve_suggestions.deleted_at IS NULL
+    ) AS suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.validated = TRUE
+            AND improve_suggestions.hidden = FALSE AND improve_suggestions.deleted_at IS NULL
+    ) AS accepted_suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT MAX(COALESCE(improve_suggestions.updated_at, improve_suggestions.created_at)) AS last
+        FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.hidden = FALSE
+            AND improve_suggestions.deleted_at IS NULL
+    ) AS suggestions_activity ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(improve_requests_revisions.id) AS total
+        FROM improve_requests_revisions
+        WHERE improve_requests_revisions.source_id = improve_requests.id AND improve_requests_revisions.hidden = FALSE
+            AND improve_requests_revisions.deleted_at IS NULL
+    ) AS revisions ON TRUE
+WHERE improve_requests.deleted_at IS NULL;
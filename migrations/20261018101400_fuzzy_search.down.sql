@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS improve_suggestions_title_trgm;
+DROP INDEX IF EXISTS improve_requests_title_trgm;
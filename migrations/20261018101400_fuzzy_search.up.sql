@@ -0,0 +1,5 @@
+/* Trigram indexes on the titles, for the fuzzy search and the query suggestions. */
+CREATE INDEX IF NOT EXISTS improve_requests_title_trgm ON improve_requests_revisions USING GIN (title gin_trgm_ops)
+    WHERE hidden = FALSE AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS improve_suggestions_title_trgm ON improve_suggestions USING GIN (title gin_trgm_ops)
+    WHERE hidden = FALSE AND deleted_at IS NULL;
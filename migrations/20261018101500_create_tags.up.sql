@@ -0,0 +1,79 @@
+/* Tags are lowercase words joined by dashes, such as "science-fiction" or "opening-chapter". */
+CREATE TABLE IF NOT EXISTS tags (
+    name VARCHAR(32) PRIMARY KEY NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+
+    CONSTRAINT name_format CHECK ( name = LOWER(name) AND name ~ '^[^[:space:]-]+(-[^[:space:]-]+)*$' )
+);
+
+--bun:split
+
+CREATE TABLE IF NOT EXISTS improve_requests_tags (
+    source_id uuid NOT NULL REFERENCES improve_requests (id) ON DELETE CASCADE,
+    tag VARCHAR(32) NOT NULL REFERENCES tags (name) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (source_id, tag)
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS improve_requests_tags_tag ON improve_requests_tags (tag);
+
+--bun:split
+
+DROP VIEW IF EXISTS improve_requests_previews;
+
+--bun:split
+
+CREATE VIEW improve_requests_previews AS
+SELECT
+    improve_requests.id,
+    improve_requests.created_at,
+    improve_requests.updated_at,
+    improve_requests.up_votes,
+    improve_requests.down_votes,
+    improve_requests_latest_revisions.title AS title,
+    improve_requests_latest_revisions.content AS content,
+    improve_requests_latest_revisions.user_id AS user_id,
+    improve_requests_latest_revisions.text_searchable_index_col AS text_searchable_index_col,
+    improve_requests_latest_revisions.language AS language,
+    tags.names AS tags,
+    suggestions.total AS suggestions_count,
+    accepted_suggestions.total AS accepted_suggestions_count,
+    revisions.total AS revisions_count,
+    GREATEST(
+        improve_requests.created_at, improve_requests_latest_revisions.created_at, suggestions_activity.last
+    ) AS last_activity_at,
+    controversy(improve_requests.up_votes, improve_requests.down_votes) AS controversy,
+    hotness(improve_requests.up_votes, improve_requests.down_votes, improve_requests.created_at) AS hotness
+FROM improve_requests
+    LEFT JOIN improve_requests_latest_revisions ON improve_requests_latest_revisions.source_id = improve_requests.id
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.hidden = FALSE
+            AND improve_suggestions.deleted_at IS NULL
+    ) AS suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.validated = TRUE
+            AND improve_suggestions.hidden = FALSE AND improve_suggestions.deleted_at IS NULL
+    ) AS accepted_suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT MAX(COALESCE(improve_suggestions.updated_at, improve_suggestions.created_at)) AS last
+        FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.hidden = FALSE
+            AND improve_suggestions.deleted_at IS NULL
+    ) AS suggestions_activity ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(improve_requests_revisions.id) AS total
+        FROM improve_requests_revisions
+        WHERE improve_requests_revisions.source_id = improve_requests.id AND improve_requests_revisions.hidden = FALSE
+            AND improve_requests_revisions.deleted_at IS NULL
+    ) AS revisions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT array_agg(improve_requests_tags.tag ORDER BY improve_requests_tags.tag) AS names
+        FROM improve_requests_tags
+        WHERE improve_requests_tags.source_id = improve_requests.id
+    ) AS tags ON TRUE
+WHERE improve_requests.deleted_at IS NULL;
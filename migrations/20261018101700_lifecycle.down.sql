@@ -0,0 +1,69 @@
+DROP VIEW IF EXISTS improve_requests_previews;
+
+--bun:split
+
+ALTER TABLE improve_requests DROP COLUMN IF EXISTS status;
+ALTER TABLE improve_requests DROP COLUMN IF EXISTS status_updated_at;
+ALTER TABLE improve_requests DROP COLUMN IF EXISTS close_after_days;
+ALTER TABLE improve_requests DROP COLUMN IF EXISTS close_after_accepted;
+
+--bun:split
+
+CREATE VIEW improve_requests_previews AS
+SELECT
+    improve_requests.id,
+    improve_requests.created_at,
+    improve_requests.updated_at,
+    improve_requests.up_votes,
+    improve_requests.down_votes,
+    improve_requests_latest_revisions.title AS title,
+    improve_requests_latest_revisions.content AS content,
+    improve_requests_latest_revisions.user_id AS user_id,
+    improve_requests_latest_revisions.text_searchable_index_col AS text_searchable_index_col,
+    improve_requests_latest_revisions.language AS language,
+    tags.names AS tags,
+    COALESCE(improve_requests_latest_revisions.draft, FALSE) AS draft,
+    suggestions.total AS suggestions_count,
+    accepted_suggestions.total AS accepted_suggestions_count,
+    revisions.total AS revisions_count,
+    GREATEST(
+        improve_requests.created_at,
+        improve_requests_latest_revisions.created_at,
+        improve_requests_latest_revisions.published_at,
+        suggestions_activity.last
+    ) AS last_activity_at,
+    controversy(improve_requests.up_votes, improve_requests.down_votes) AS controversy,
+    hotness(improve_requests.up_votes, improve_requests.down_votes, improve_requests.created_at) AS hotness
+FROM improve_requests
+    LEFT JOIN improve_requests_latest_revisions ON improve_requests_latest_revisions.source_id = improve_requests.id
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.hidden = FALSE
+            AND improve_suggestions.deleted_at IS NULL AND improve_suggestions.draft = FALSE
+    ) AS suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.validated = TRUE
+            AND improve_suggestions.hidden = FALSE AND improve_suggestions.deleted_at IS NULL
+            AND improve_suggestions.draft = FALSE
+    ) AS accepted_suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT MAX(GREATEST(
+            improve_suggestions.created_at, improve_suggestions.updated_at, improve_suggestions.published_at
+        )) AS last
+        FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.hidden = FALSE
+            AND improve_suggestions.deleted_at IS NULL AND improve_suggestions.draft = FALSE
+    ) AS suggestions_activity ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(improve_requests_revisions.id) AS total
+        FROM improve_requests_revisions
+        WHERE improve_requests_revisions.source_id = improve_requests.id AND improve_requests_revisions.hidden = FALSE
+            AND improve_requests_revisions.deleted_at IS NULL AND improve_requests_revisions.draft = FALSE
+    ) AS revisions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT array_agg(improve_requests_tags.tag ORDER BY improve_requests_tags.tag) AS names
+        FROM improve_requests_tags
+        WHERE improve_requests_tags.source_id = improve_requests.id
+    ) AS tags ON TRUE
+WHERE improve_requests.deleted_at IS NULL;
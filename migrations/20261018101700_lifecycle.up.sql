@@ -0,0 +1,87 @@
+/*
+    An improve request is open for suggestions until its owner, or its close policy, closes it. Resolved requests
+    reached their goal, and archived requests are kept for reference only. Only open requests accept suggestions.
+*/
+ALTER TABLE improve_requests ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'open';
+ALTER TABLE improve_requests ADD COLUMN IF NOT EXISTS status_updated_at TIMESTAMPTZ;
+ALTER TABLE improve_requests ADD CONSTRAINT status_value CHECK ( status IN ('open', 'closed', 'resolved', 'archived') );
+
+--bun:split
+
+/*
+    The close policy of a request is evaluated by a background worker. A request is closed once it has been open for
+    close_after_days days, or resolved once it has close_after_accepted accepted suggestions.
+*/
+ALTER TABLE improve_requests ADD COLUMN IF NOT EXISTS close_after_days INT;
+ALTER TABLE improve_requests ADD COLUMN IF NOT EXISTS close_after_accepted INT;
+ALTER TABLE improve_requests ADD CONSTRAINT close_after_days_value CHECK ( close_after_days > 0 );
+ALTER TABLE improve_requests ADD CONSTRAINT close_after_accepted_value CHECK ( close_after_accepted > 0 );
+
+--bun:split
+
+DROP VIEW IF EXISTS improve_requests_previews;
+
+--bun:split
+
+CREATE VIEW improve_requests_previews AS
+SELECT
+    improve_requests.id,
+    improve_requests.created_at,
+    improve_requests.updated_at,
+    improve_requests.up_votes,
+    improve_requests.down_votes,
+    improve_requests_latest_revisions.title AS title,
+    improve_requests_latest_revisions.content AS content,
+    improve_requests_latest_revisions.user_id AS user_id,
+    improve_requests_latest_revisions.text_searchable_index_col AS text_searchable_index_col,
+    improve_requests_latest_revisions.language AS language,
+    tags.names AS tags,
+    COALESCE(improve_requests_latest_revisions.draft, FALSE) AS draft,
+    improve_requests.status,
+    improve_requests.status_updated_at,
+    improve_requests.close_after_days,
+    improve_requests.close_after_accepted,
+    suggestions.total AS suggestions_count,
+    accepted_suggestions.total AS accepted_suggestions_count,
+    revisions.total AS revisions_count,
+    GREATEST(
+        improve_requests.created_at,
+        improve_requests_latest_revisions.created_at,
+        improve_requests_latest_revisions.published_at,
+        suggestions_activity.last
+    ) AS last_activity_at,
+    controversy(improve_requests.up_votes, improve_requests.down_votes) AS controversy,
+    hotness(improve_requests.up_votes, improve_requests.down_votes, improve_requests.created_at) AS hotness
+FROM improve_requests
+    LEFT JOIN improve_requests_latest_revisions ON improve_requests_latest_revisions.source_id = improve_requests.id
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.hidden = FALSE
+            AND improve_suggestions.deleted_at IS NULL AND improve_suggestions.draft = FALSE
+    ) AS suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(*) AS total FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.validated = TRUE
+            AND improve_suggestions.hidden = FALSE AND improve_suggestions.deleted_at IS NULL
+            AND improve_suggestions.draft = FALSE
+    ) AS accepted_suggestions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT MAX(GREATEST(
+            improve_suggestions.created_at, improve_suggestions.updated_at, improve_suggestions.published_at
+        )) AS last
+        FROM improve_suggestions
+            WHERE improve_suggestions.source_id = improve_requests.id AND improve_suggestions.hidden = FALSE
+            AND improve_suggestions.deleted_at IS NULL AND improve_suggestions.draft = FALSE
+    ) AS suggestions_activity ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT COUNT(improve_requests_revisions.id) AS total
+        FROM improve_requests_revisions
+        WHERE improve_requests_revisions.source_id = improve_requests.id AND improve_requests_revisions.hidden = FALSE
+            AND improve_requests_revisions.deleted_at IS NULL AND improve_requests_revisions.draft = FALSE
+    ) AS revisions ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT array_agg(improve_requests_tags.tag ORDER BY improve_requests_tags.tag) AS names
+        FROM improve_requests_tags
+        WHERE improve_requests_tags.source_id = improve_requests.id
+    ) AS tags ON TRUE
+WHERE improve_requests.deleted_at IS NULL;
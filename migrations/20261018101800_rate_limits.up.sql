@@ -0,0 +1,12 @@
+/*
+    Counts the actions of a user over fixed time windows, so quotas are shared by every instance of the API. Windows
+    are identified by their start date, and only the current window of each action is kept.
+*/
+CREATE TABLE IF NOT EXISTS rate_limits (
+    user_id uuid NOT NULL,
+    action VARCHAR(64) NOT NULL,
+    window_start TIMESTAMPTZ NOT NULL,
+    hits INT NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (user_id, action, window_start)
+);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS improve_suggestions_user_recent;
+DROP INDEX IF EXISTS improve_requests_user_recent;
+
+--bun:split
+
+DELETE FROM reports WHERE reason = 'duplicate';
+ALTER TABLE reports DROP CONSTRAINT IF EXISTS reason_valid;
+ALTER TABLE reports ADD CONSTRAINT reason_valid CHECK ( reason IN ('spam', 'abuse', 'plagiarism', 'other') );
@@ -0,0 +1,13 @@
+/* Content that resembles a recent post of the same author is flagged with an automatic report. */
+ALTER TABLE reports DROP CONSTRAINT IF EXISTS reason_valid;
+ALTER TABLE reports ADD CONSTRAINT reason_valid CHECK (
+    reason IN ('spam', 'abuse', 'plagiarism', 'other', 'duplicate')
+);
+
+--bun:split
+
+/* The duplicate check compares a new post with the recent posts of its author. */
+CREATE INDEX IF NOT EXISTS improve_requests_user_recent ON improve_requests_revisions (user_id, created_at DESC)
+    WHERE draft = FALSE AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS improve_suggestions_user_recent ON improve_suggestions (user_id, created_at DESC)
+    WHERE draft = FALSE AND deleted_at IS NULL;
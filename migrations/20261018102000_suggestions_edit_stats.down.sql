@@ -0,0 +1,3 @@
+ALTER TABLE improve_suggestions DROP COLUMN IF EXISTS changed_ratio;
+ALTER TABLE improve_suggestions DROP COLUMN IF EXISTS removed_chars;
+ALTER TABLE improve_suggestions DROP COLUMN IF EXISTS added_chars;
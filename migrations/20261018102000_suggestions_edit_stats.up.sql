@@ -0,0 +1,7 @@
+/*
+ Edit statistics of the suggestions, against the content of their revision. They are left empty for older
+ suggestions, rather than showing zeroed stats for edits that were never measured.
+*/
+ALTER TABLE improve_suggestions ADD COLUMN IF NOT EXISTS added_chars INT;
+ALTER TABLE improve_suggestions ADD COLUMN IF NOT EXISTS removed_chars INT;
+ALTER TABLE improve_suggestions ADD COLUMN IF NOT EXISTS changed_ratio DOUBLE PRECISION;
@@ -0,0 +1,8 @@
+DROP FUNCTION IF EXISTS escape_html(TEXT);
+DROP FUNCTION IF EXISTS headline_config(VARCHAR);
+
+--bun:split
+
+DROP TEXT SEARCH CONFIGURATION IF EXISTS spanish_unaccent;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS english_unaccent;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS french_unaccent;
@@ -0,0 +1,36 @@
+/*
+ Headlines are built from the raw text, while the index is built from the unaccented one. These configurations strip
+ the accents of the words before stemming them, so a headline matches the same words as the index, and still shows
+ the original text.
+*/
+CREATE TEXT SEARCH CONFIGURATION french_unaccent ( COPY = french );
+ALTER TEXT SEARCH CONFIGURATION french_unaccent
+    ALTER MAPPING FOR hword, hword_part, word WITH unaccent, french_stem;
+
+CREATE TEXT SEARCH CONFIGURATION english_unaccent ( COPY = english );
+ALTER TEXT SEARCH CONFIGURATION english_unaccent
+    ALTER MAPPING FOR hword, hword_part, word WITH unaccent, english_stem;
+
+CREATE TEXT SEARCH CONFIGURATION spanish_unaccent ( COPY = spanish );
+ALTER TEXT SEARCH CONFIGURATION spanish_unaccent
+    ALTER MAPPING FOR hword, hword_part, word WITH unaccent, spanish_stem;
+
+--bun:split
+
+/* Text search configuration of the headlines of each supported language. */
+CREATE OR REPLACE FUNCTION headline_config(language VARCHAR) RETURNS regconfig AS $$
+    SELECT CASE language
+        WHEN 'en' THEN 'english_unaccent'::regconfig
+        WHEN 'es' THEN 'spanish_unaccent'::regconfig
+        ELSE 'french_unaccent'::regconfig
+    END
+$$ LANGUAGE SQL IMMUTABLE;
+
+--bun:split
+
+/* Content is plain text: it is escaped before the highlight tags are added, so it cannot inject any markup. */
+CREATE OR REPLACE FUNCTION escape_html(content TEXT) RETURNS TEXT AS $$
+    SELECT replace(replace(replace(replace(replace(
+        content, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;'
+    )
+$$ LANGUAGE SQL IMMUTABLE;
@@ -0,0 +1,26 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+func AnnotationToModel(src *dao.AnnotationModel) *models.Annotation {
+	if src == nil {
+		return nil
+	}
+
+	return &models.Annotation{
+		ID:          src.ID,
+		CreatedAt:   src.CreatedAt,
+		UpdatedAt:   src.UpdatedAt,
+		UserID:      src.UserID,
+		SourceID:    src.SourceID,
+		RevisionID:  src.RevisionID,
+		StartOffset: src.StartOffset,
+		EndOffset:   src.EndOffset,
+		Quote:       src.Quote,
+		Content:     src.Content,
+		Orphaned:    src.Orphaned,
+	}
+}
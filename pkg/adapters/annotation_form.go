@@ -0,0 +1,20 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+func AnnotationFormToDAO(src *models.AnnotationForm) *dao.AnnotationModelCore {
+	if src == nil {
+		return nil
+	}
+
+	return &dao.AnnotationModelCore{
+		RevisionID:  src.RevisionID,
+		StartOffset: src.StartOffset,
+		EndOffset:   src.EndOffset,
+		Quote:       src.Quote,
+		Content:     src.Content,
+	}
+}
@@ -0,0 +1,23 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+func CommentToModel(src *dao.CommentModel) *models.Comment {
+	if src == nil {
+		return nil
+	}
+
+	return &models.Comment{
+		ID:         src.ID,
+		CreatedAt:  src.CreatedAt,
+		UpdatedAt:  src.UpdatedAt,
+		UserID:     src.UserID,
+		TargetType: string(src.TargetType),
+		TargetID:   src.TargetID,
+		ParentID:   src.ParentID,
+		Content:    src.Content,
+	}
+}
@@ -0,0 +1,19 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+func CommentFormToDAO(src *models.CommentForm) *dao.CommentModelCore {
+	if src == nil {
+		return nil
+	}
+
+	return &dao.CommentModelCore{
+		TargetType: dao.CommentTarget(src.TargetType),
+		TargetID:   src.TargetID,
+		ParentID:   src.ParentID,
+		Content:    src.Content,
+	}
+}
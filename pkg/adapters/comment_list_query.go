@@ -0,0 +1,21 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func CommentListQueryToDAO(src models.ListCommentsQuery) dao.CommentListQuery {
+	output := dao.CommentListQuery{
+		TargetType: dao.CommentTarget(src.TargetType),
+		TargetID:   src.TargetID.Value(),
+	}
+
+	if src.ParentID.Value() != uuid.Nil {
+		output.ParentID = lo.ToPtr(src.ParentID.Value())
+	}
+
+	return output
+}
@@ -0,0 +1,18 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/diff"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+func DiffChunksToModel(src []diff.Chunk) []*models.DiffChunk {
+	output := make([]*models.DiffChunk, len(src))
+	for i, chunk := range src {
+		output[i] = &models.DiffChunk{
+			Operation: string(chunk.Operation),
+			Text:      chunk.Text,
+		}
+	}
+
+	return output
+}
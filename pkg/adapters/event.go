@@ -0,0 +1,21 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+func EventToModel(src *dao.EventModel) *models.Event {
+	if src == nil {
+		return nil
+	}
+
+	return &models.Event{
+		ID:        src.ID,
+		CreatedAt: src.CreatedAt,
+		Type:      string(src.Type),
+		UserID:    src.UserID,
+		TargetID:  src.TargetID,
+		SourceID:  src.SourceID,
+	}
+}
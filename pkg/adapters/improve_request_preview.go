@@ -21,5 +21,12 @@ func ImproveRequestPreviewToModel(src *dao.ImproveRequestPreview) *models.Improv
 		RevisionCount:            src.RevisionCount,
 		SuggestionsCount:         src.SuggestionsCount,
 		AcceptedSuggestionsCount: src.AcceptedSuggestionsCount,
+		Tags:                     src.Tags,
+		Draft:                    src.Draft,
+		Status:                   string(src.Status),
+		CloseAfterDays:           src.CloseAfterDays,
+		CloseAfterAccepted:       src.CloseAfterAccepted,
+		TitleHighlight:           src.TitleHighlight,
+		ContentHighlight:         src.ContentHighlight,
 	}
 }
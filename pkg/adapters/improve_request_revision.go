@@ -11,11 +11,15 @@ func ImproveRequestRevisionToModel(src *dao.ImproveRequestRevisionModel) *models
 	}
 
 	return &models.ImproveRequestRevision{
-		ID:        src.ID,
-		CreatedAt: src.CreatedAt,
-		SourceID:  src.SourceID,
-		UserID:    src.UserID,
-		Title:     src.Title,
-		Content:   src.Content,
+		ID:            src.ID,
+		CreatedAt:     src.CreatedAt,
+		SourceID:      src.SourceID,
+		UserID:        src.UserID,
+		Title:         src.Title,
+		Content:       src.Content,
+		SuggestionIDs: src.SuggestionIDs,
+		Language:      string(src.Language),
+		Draft:         src.Draft,
+		PublishedAt:   src.PublishedAt,
 	}
 }
@@ -15,5 +15,7 @@ func ImproveRequestRevisionPreviewToModel(src *dao.ImproveRequestRevisionPreview
 		CreatedAt:                src.CreatedAt,
 		SuggestionsCount:         src.SuggestionsCount,
 		AcceptedSuggestionsCount: src.AcceptedSuggestionsCount,
+		SuggestionIDs:            src.SuggestionIDs,
+		Draft:                    src.Draft,
 	}
 }
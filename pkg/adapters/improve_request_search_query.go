@@ -9,15 +9,41 @@ import (
 
 func ImproveRequestSearchQueryToDAO(src models.SearchImproveRequestsQuery) dao.ImproveRequestSearchQuery {
 	output := dao.ImproveRequestSearchQuery{
-		Query: src.Query,
+		Query:     src.Query,
+		Language:  dao.Language(src.Language),
+		Fuzzy:     src.Fuzzy,
+		Highlight: src.Highlight,
+		Followed:  src.Followed,
+		SkipCount: src.SkipTotal,
+		Tags:      src.Tags,
+		AllTags:   src.TagsMatch == models.TagsMatchAll,
+
+		CreatedAfter:           src.CreatedAfter,
+		CreatedBefore:          src.CreatedBefore,
+		MinScore:               src.MinScore,
+		MaxScore:               src.MaxScore,
+		MinSuggestions:         src.MinSuggestions,
+		MaxSuggestions:         src.MaxSuggestions,
+		HasAcceptedSuggestions: src.HasAcceptedSuggestions,
+		MinRevisions:           src.MinRevisions,
+		MaxRevisions:           src.MaxRevisions,
 	}
 
 	if src.UserID.Value() != uuid.Nil {
 		output.UserID = lo.ToPtr(src.UserID.Value())
 	}
 
-	if src.Order == models.OrderScore {
+	switch src.Order {
+	case models.OrderScore:
 		output.Order = &dao.ImproveRequestSearchQueryOrder{Score: true}
+	case models.OrderActivity:
+		output.Order = &dao.ImproveRequestSearchQueryOrder{Activity: true}
+	case models.OrderSuggestions:
+		output.Order = &dao.ImproveRequestSearchQueryOrder{Suggestions: true}
+	case models.OrderControversial:
+		output.Order = &dao.ImproveRequestSearchQueryOrder{Controversial: true}
+	case models.OrderHot:
+		output.Order = &dao.ImproveRequestSearchQueryOrder{Hot: true}
 	}
 
 	return output
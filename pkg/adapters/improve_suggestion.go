@@ -22,5 +22,12 @@ func ImproveSuggestionToModel(src *dao.ImproveSuggestionModel) *models.ImproveSu
 		RequestID: src.RequestID,
 		Title:     src.Title,
 		Content:   src.Content,
+
+		AddedChars:   src.AddedChars,
+		RemovedChars: src.RemovedChars,
+		ChangedRatio: src.ChangedRatio,
+
+		Draft:       src.Draft,
+		PublishedAt: src.PublishedAt,
 	}
 }
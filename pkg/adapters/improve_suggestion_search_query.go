@@ -10,6 +10,10 @@ import (
 func ImproveSuggestionSearchQueryToDAO(src models.SearchImproveSuggestionsQuery) dao.ImproveSuggestionSearchQuery {
 	output := dao.ImproveSuggestionSearchQuery{
 		Validated: src.Validated,
+		Query:     src.Query,
+		Language:  dao.Language(src.Language),
+		Fuzzy:     src.Fuzzy,
+		SkipCount: src.SkipTotal,
 	}
 
 	if src.UserID.Value() != uuid.Nil {
@@ -24,8 +28,17 @@ func ImproveSuggestionSearchQueryToDAO(src models.SearchImproveSuggestionsQuery)
 		output.RequestID = lo.ToPtr(src.RequestID.Value())
 	}
 
-	if src.Order == models.OrderScore {
+	switch src.Order {
+	case models.OrderScore:
 		output.Order = &dao.ImproveSuggestionSearchQueryOrder{Score: true}
+	case models.OrderActivity:
+		output.Order = &dao.ImproveSuggestionSearchQueryOrder{Activity: true}
+	case models.OrderComments:
+		output.Order = &dao.ImproveSuggestionSearchQueryOrder{Comments: true}
+	case models.OrderControversial:
+		output.Order = &dao.ImproveSuggestionSearchQueryOrder{Controversial: true}
+	case models.OrderHot:
+		output.Order = &dao.ImproveSuggestionSearchQueryOrder{Hot: true}
 	}
 
 	return output
@@ -0,0 +1,26 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/diff"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/google/uuid"
+)
+
+// MergeConflictToModel converts a merge conflict. Versions in the conflict hunks are indexes of suggestionIDs.
+func MergeConflictToModel(field string, src diff.Conflict, suggestionIDs []uuid.UUID) *models.MergeConflict {
+	hunks := make([]*models.MergeHunk, len(src.Hunks))
+	for i, hunk := range src.Hunks {
+		hunks[i] = &models.MergeHunk{
+			SuggestionID: suggestionIDs[hunk.Version],
+			Text:         hunk.Text,
+		}
+	}
+
+	return &models.MergeConflict{
+		Field: field,
+		Start: src.Start,
+		End:   src.End,
+		Base:  src.Base,
+		Hunks: hunks,
+	}
+}
@@ -0,0 +1,22 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+func NotificationToModel(src *dao.NotificationModel) *models.Notification {
+	if src == nil {
+		return nil
+	}
+
+	return &models.Notification{
+		ID:        src.ID,
+		CreatedAt: src.CreatedAt,
+		Type:      string(src.Type),
+		ActorID:   src.ActorID,
+		TargetID:  src.TargetID,
+		SourceID:  src.SourceID,
+		ReadAt:    src.ReadAt,
+	}
+}
@@ -0,0 +1,18 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+func OrphansToModel(src *dao.OrphansModel) *models.Orphans {
+	if src == nil {
+		return nil
+	}
+
+	return &models.Orphans{
+		Revisions:           src.Revisions,
+		DetachedSuggestions: src.DetachedSuggestions,
+		OrphanedSuggestions: src.OrphanedSuggestions,
+	}
+}
@@ -0,0 +1,28 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+func ReportToModel(src *dao.ReportModel) *models.Report {
+	if src == nil {
+		return nil
+	}
+
+	return &models.Report{
+		ID:          src.ID,
+		CreatedAt:   src.CreatedAt,
+		UpdatedAt:   src.UpdatedAt,
+		UserID:      src.UserID,
+		TargetType:  string(src.TargetType),
+		TargetID:    src.TargetID,
+		Reason:      string(src.Reason),
+		Content:     src.Content,
+		Status:      string(src.Status),
+		ModeratorID: src.ModeratorID,
+		ClaimedAt:   src.ClaimedAt,
+		ResolvedAt:  src.ResolvedAt,
+		Action:      string(src.Action),
+	}
+}
@@ -0,0 +1,19 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+func ReportFormToDAO(src *models.ReportForm) *dao.ReportModelCore {
+	if src == nil {
+		return nil
+	}
+
+	return &dao.ReportModelCore{
+		TargetType: dao.ReportTarget(src.TargetType),
+		TargetID:   src.TargetID,
+		Reason:     dao.ReportReason(src.Reason),
+		Content:    src.Content,
+	}
+}
@@ -0,0 +1,26 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func ReportListQueryToDAO(src models.ListReportsQuery) dao.ReportListQuery {
+	output := dao.ReportListQuery{}
+
+	if src.Status != "" {
+		output.Status = lo.ToPtr(dao.ReportStatus(src.Status))
+	}
+
+	if src.TargetType != "" {
+		output.TargetType = lo.ToPtr(dao.ReportTarget(src.TargetType))
+	}
+
+	if src.ModeratorID.Value() != uuid.Nil {
+		output.ModeratorID = lo.ToPtr(src.ModeratorID.Value())
+	}
+
+	return output
+}
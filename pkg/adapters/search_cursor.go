@@ -0,0 +1,132 @@
+package adapters
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"time"
+)
+
+// The cursors sent to clients are opaque: they are the base64 encoding of the sort keys of the last result of a page.
+
+type improveRequestSearchCursor struct {
+	Rank        float64    `json:"r,omitempty"`
+	Score       int        `json:"s,omitempty"`
+	Activity    *time.Time `json:"a,omitempty"`
+	Suggestions int        `json:"n,omitempty"`
+	Controversy float64    `json:"v,omitempty"`
+	Hotness     float64    `json:"h,omitempty"`
+	CreatedAt   time.Time  `json:"c"`
+	ID          uuid.UUID  `json:"i"`
+}
+
+type improveSuggestionSearchCursor struct {
+	Rank        float64    `json:"r,omitempty"`
+	Score       int        `json:"s,omitempty"`
+	Activity    *time.Time `json:"a,omitempty"`
+	Comments    int        `json:"n,omitempty"`
+	Controversy float64    `json:"v,omitempty"`
+	Hotness     float64    `json:"h,omitempty"`
+	UpdatedAt   time.Time  `json:"u"`
+	ID          uuid.UUID  `json:"i"`
+}
+
+// optionalTime leaves the dates that were not read from the database out of the cursor.
+func optionalTime(src time.Time) *time.Time {
+	if src.IsZero() {
+		return nil
+	}
+
+	return &src
+}
+
+func encodeSearchCursor(src interface{}) string {
+	// Cursors only hold numbers, dates and IDs, which always marshal.
+	mrsh, _ := json.Marshal(src)
+	return base64.RawURLEncoding.EncodeToString(mrsh)
+}
+
+func decodeSearchCursor(src string, dst interface{}) error {
+	mrsh, err := base64.RawURLEncoding.DecodeString(src)
+	if err != nil {
+		return fmt.Errorf("failed to decode cursor: %w", err)
+	}
+
+	if err := json.Unmarshal(mrsh, dst); err != nil {
+		return fmt.Errorf("failed to parse cursor: %w", err)
+	}
+
+	return nil
+}
+
+// ImproveRequestSearchCursorFromDAO returns the cursor to the page that follows the given result.
+func ImproveRequestSearchCursorFromDAO(src *dao.ImproveRequestPreview) string {
+	return encodeSearchCursor(improveRequestSearchCursor{
+		Rank:        src.SearchRank,
+		Score:       src.UpVotes - src.DownVotes,
+		Activity:    optionalTime(src.LastActivityAt),
+		Suggestions: src.SuggestionsCount,
+		Controversy: src.Controversy,
+		Hotness:     src.Hotness,
+		CreatedAt:   src.CreatedAt,
+		ID:          src.ID,
+	})
+}
+
+func ImproveRequestSearchCursorToDAO(src string) (*dao.ImproveRequestSearchCursor, error) {
+	cursor := new(improveRequestSearchCursor)
+	if err := decodeSearchCursor(src, cursor); err != nil {
+		return nil, err
+	}
+
+	return &dao.ImproveRequestSearchCursor{
+		Rank:        cursor.Rank,
+		Score:       cursor.Score,
+		Activity:    lo.FromPtr(cursor.Activity),
+		Suggestions: cursor.Suggestions,
+		Controversy: cursor.Controversy,
+		Hotness:     cursor.Hotness,
+		CreatedAt:   cursor.CreatedAt,
+		ID:          cursor.ID,
+	}, nil
+}
+
+// ImproveSuggestionSearchCursorFromDAO returns the cursor to the page that follows the given result.
+func ImproveSuggestionSearchCursorFromDAO(src *dao.ImproveSuggestionModel) string {
+	updatedAt := src.CreatedAt
+	if src.UpdatedAt != nil {
+		updatedAt = *src.UpdatedAt
+	}
+
+	return encodeSearchCursor(improveSuggestionSearchCursor{
+		Rank:        src.SearchRank,
+		Score:       src.UpVotes - src.DownVotes,
+		Activity:    optionalTime(src.LastActivityAt),
+		Comments:    src.CommentsCount,
+		Controversy: src.Controversy,
+		Hotness:     src.Hotness,
+		UpdatedAt:   updatedAt,
+		ID:          src.ID,
+	})
+}
+
+func ImproveSuggestionSearchCursorToDAO(src string) (*dao.ImproveSuggestionSearchCursor, error) {
+	cursor := new(improveSuggestionSearchCursor)
+	if err := decodeSearchCursor(src, cursor); err != nil {
+		return nil, err
+	}
+
+	return &dao.ImproveSuggestionSearchCursor{
+		Rank:        cursor.Rank,
+		Score:       cursor.Score,
+		Activity:    lo.FromPtr(cursor.Activity),
+		Comments:    cursor.Comments,
+		Controversy: cursor.Controversy,
+		Hotness:     cursor.Hotness,
+		UpdatedAt:   cursor.UpdatedAt,
+		ID:          cursor.ID,
+	}, nil
+}
@@ -0,0 +1,17 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+func TagUsageToModel(src *dao.TagUsage) *models.Tag {
+	if src == nil {
+		return nil
+	}
+
+	return &models.Tag{
+		Name:  src.Name,
+		Count: src.Count,
+	}
+}
@@ -0,0 +1,20 @@
+package adapters
+
+import (
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+func VoteToModel(src *dao.VoteModel) *models.Vote {
+	if src == nil {
+		return nil
+	}
+
+	return &models.Vote{
+		CreatedAt:  src.CreatedAt,
+		UpdatedAt:  src.UpdatedAt,
+		TargetType: string(src.TargetType),
+		TargetID:   src.TargetID,
+		Vote:       src.Vote,
+	}
+}
@@ -0,0 +1,216 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/diff"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type AnnotationRepository interface {
+	// Get returns the annotation with the given ID.
+	Get(ctx context.Context, id uuid.UUID) (*AnnotationModel, error)
+	// Create creates a new annotation on a given improvement request revision.
+	Create(ctx context.Context, data *AnnotationModelCore, userID, sourceID, id uuid.UUID, now time.Time) (*AnnotationModel, error)
+	// Update updates the content of an existing annotation. The anchor of an annotation cannot be changed.
+	Update(ctx context.Context, content string, id uuid.UUID, now time.Time) (*AnnotationModel, error)
+	// Delete deletes an existing annotation.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// List returns the annotations anchored on a revision, in the order they appear in the text.
+	List(ctx context.Context, query AnnotationListQuery) ([]*AnnotationModel, error)
+}
+
+type AnnotationModel struct {
+	bun.BaseModel `bun:"table:annotations"`
+	bunovel.Metadata
+
+	// UserID is the ID of the user who wrote the annotation.
+	UserID uuid.UUID `bun:"user_id,type:uuid"`
+	// SourceID is the ID of the first revision of the related improvement request. It cannot be changed.
+	SourceID uuid.UUID `bun:"source_id,type:uuid"`
+	// Orphaned is true when the annotation could not be re-anchored on a newer revision of the request. It then
+	// remains anchored on the last revision it could be placed on.
+	Orphaned bool `bun:"orphaned"`
+
+	AnnotationModelCore
+}
+
+type AnnotationModelCore struct {
+	// RevisionID is the ID of the improvement request revision the annotation is anchored on. It moves to the latest
+	// revision every time the request is revised, unless the annotation is orphaned.
+	RevisionID uuid.UUID `bun:"revision_id,type:uuid"`
+	// StartOffset is the position of the first annotated character in the revision content, in characters.
+	StartOffset int `bun:"start_offset"`
+	// EndOffset is the position right after the last annotated character in the revision content, in characters.
+	EndOffset int `bun:"end_offset"`
+	// Quote is a copy of the annotated text. It is used to find the annotated text back when the content changes.
+	Quote string `bun:"quote"`
+	// Content is the text of the annotation.
+	Content string `bun:"content"`
+}
+
+// AnnotationListQuery allows to filter annotations.
+type AnnotationListQuery struct {
+	// RevisionID is the ID of the revision to list annotations for.
+	RevisionID uuid.UUID
+	// Orphaned is an optional parameter, to only list annotations that are (or are not) orphaned.
+	Orphaned *bool
+}
+
+type annotationRepositoryImpl struct {
+	db bun.IDB
+}
+
+func NewAnnotationRepository(db bun.IDB) AnnotationRepository {
+	return &annotationRepositoryImpl{
+		db: db,
+	}
+}
+
+func (repository *annotationRepositoryImpl) Get(ctx context.Context, id uuid.UUID) (*AnnotationModel, error) {
+	annotation := &AnnotationModel{Metadata: bunovel.Metadata{ID: id}}
+	if err := repository.db.NewSelect().Model(annotation).WherePK().Scan(ctx); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return annotation, nil
+}
+
+func (repository *annotationRepositoryImpl) Create(ctx context.Context, data *AnnotationModelCore, userID, sourceID, id uuid.UUID, now time.Time) (*AnnotationModel, error) {
+	annotation := &AnnotationModel{
+		Metadata: bunovel.Metadata{
+			ID:        id,
+			CreatedAt: now,
+		},
+		UserID:              userID,
+		SourceID:            sourceID,
+		AnnotationModelCore: *data,
+	}
+
+	if err := repository.db.NewInsert().Model(annotation).Returning("*").Scan(ctx); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return annotation, nil
+}
+
+func (repository *annotationRepositoryImpl) Update(ctx context.Context, content string, id uuid.UUID, now time.Time) (*AnnotationModel, error) {
+	annotation := &AnnotationModel{
+		Metadata: bunovel.Metadata{
+			ID:        id,
+			UpdatedAt: &now,
+		},
+		AnnotationModelCore: AnnotationModelCore{Content: content},
+	}
+
+	err := repository.db.NewUpdate().Model(annotation).Column("updated_at", "content").WherePK().Returning("*").Scan(ctx)
+	if err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return annotation, nil
+}
+
+func (repository *annotationRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	annotation := &AnnotationModel{Metadata: bunovel.Metadata{ID: id}}
+
+	if _, err := repository.db.NewDelete().Model(annotation).WherePK().Exec(ctx); err != nil {
+		return bunovel.HandlePGError(err)
+	}
+
+	return nil
+}
+
+func (repository *annotationRepositoryImpl) List(ctx context.Context, query AnnotationListQuery) ([]*AnnotationModel, error) {
+	annotations := make([]*AnnotationModel, 0)
+
+	queryBuilder := repository.db.NewSelect().
+		Model(&annotations).
+		Where("revision_id = ?", query.RevisionID).
+		Order("start_offset ASC", "end_offset ASC", "created_at ASC")
+
+	if query.Orphaned != nil {
+		queryBuilder.Where("orphaned = ?", *query.Orphaned)
+	}
+
+	if err := queryBuilder.Scan(ctx); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return annotations, nil
+}
+
+// reanchorAnnotations moves every annotation of a request that is not orphaned onto a new revision. Annotations
+// that cannot be found in the new content are flagged as orphaned instead.
+func reanchorAnnotations(ctx context.Context, tx bun.IDB, sourceID, revisionID uuid.UUID, content string) error {
+	annotations := make([]*AnnotationModel, 0)
+
+	err := tx.NewSelect().
+		Model(&annotations).
+		Where("source_id = ?", sourceID).
+		Where("orphaned = FALSE").
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list annotations: %w", err)
+	}
+
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	anchorers := make(map[uuid.UUID]*diff.Anchorer)
+	for _, annotation := range annotations {
+		if _, ok := anchorers[annotation.RevisionID]; ok {
+			continue
+		}
+
+		revision := &ImproveRequestRevisionModel{Metadata: bunovel.Metadata{ID: annotation.RevisionID}}
+		err := tx.NewSelect().Model(revision).Column("content").WherePK().Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			// The annotated revision was deleted, so there is no text left to compare with.
+			anchorers[annotation.RevisionID] = nil
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get annotated revision: %w", err)
+		}
+
+		anchorers[annotation.RevisionID] = diff.NewAnchorer(revision.Content, content)
+	}
+
+	for _, annotation := range annotations {
+		anchorer := anchorers[annotation.RevisionID]
+		if anchorer == nil {
+			annotation.Orphaned = true
+			continue
+		}
+
+		start, end, ok := anchorer.Anchor(annotation.StartOffset, annotation.EndOffset, annotation.Quote)
+		if !ok {
+			annotation.Orphaned = true
+			continue
+		}
+
+		annotation.RevisionID = revisionID
+		annotation.StartOffset = start
+		annotation.EndOffset = end
+	}
+
+	_, err = tx.NewUpdate().
+		Model(&annotations).
+		Column("revision_id", "start_offset", "end_offset", "orphaned").
+		Bulk().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to re-anchor annotations: %w", err)
+	}
+
+	return nil
+}
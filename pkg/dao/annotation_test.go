@@ -0,0 +1,455 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"io/fs"
+	"testing"
+	"time"
+)
+
+func TestAnnotationRepository_Get(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.AnnotationModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+			UserID:   goframework.NumberUUID(100),
+			SourceID: goframework.NumberUUID(20),
+			AnnotationModelCore: dao.AnnotationModelCore{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "my annotation",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expect    *dao.AnnotationModel
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   goframework.NumberUUID(1),
+			expect: &dao.AnnotationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(20),
+				AnnotationModelCore: dao.AnnotationModelCore{
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 4,
+					EndOffset:   9,
+					Quote:       "quick",
+					Content:     "my annotation",
+				},
+			},
+		},
+		{
+			name:      "Error/NotFound",
+			id:        goframework.NumberUUID(2),
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewAnnotationRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Get(ctx, d.id)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestAnnotationRepository_Create(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.AnnotationModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			SourceID: goframework.NumberUUID(20),
+			AnnotationModelCore: dao.AnnotationModelCore{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "my annotation",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		data     *dao.AnnotationModelCore
+		userID   uuid.UUID
+		sourceID uuid.UUID
+		id       uuid.UUID
+		now      time.Time
+
+		expect    *dao.AnnotationModel
+		expectErr error
+	}{
+		{
+			name: "Success",
+			data: &dao.AnnotationModelCore{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 10,
+				EndOffset:   15,
+				Quote:       "brown",
+				Content:     "my new annotation",
+			},
+			userID:   goframework.NumberUUID(200),
+			sourceID: goframework.NumberUUID(20),
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			expect: &dao.AnnotationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				SourceID: goframework.NumberUUID(20),
+				AnnotationModelCore: dao.AnnotationModelCore{
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 10,
+					EndOffset:   15,
+					Quote:       "brown",
+					Content:     "my new annotation",
+				},
+			},
+		},
+		{
+			name: "Success/OverlappingRange",
+			data: &dao.AnnotationModelCore{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   15,
+				Quote:       "quick brown",
+				Content:     "my new annotation",
+			},
+			userID:   goframework.NumberUUID(200),
+			sourceID: goframework.NumberUUID(20),
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			expect: &dao.AnnotationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				SourceID: goframework.NumberUUID(20),
+				AnnotationModelCore: dao.AnnotationModelCore{
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 4,
+					EndOffset:   15,
+					Quote:       "quick brown",
+					Content:     "my new annotation",
+				},
+			},
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewAnnotationRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Create(ctx, d.data, d.userID, d.sourceID, d.id, d.now)
+				require.Equal(t, d.expect, res)
+				require.ErrorIs(t, err, d.expectErr)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestAnnotationRepository_Update(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.AnnotationModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			SourceID: goframework.NumberUUID(20),
+			AnnotationModelCore: dao.AnnotationModelCore{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "my annotation",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		content string
+		id      uuid.UUID
+		now     time.Time
+
+		expect    *dao.AnnotationModel
+		expectErr error
+	}{
+		{
+			name:    "Success",
+			content: "my updated annotation",
+			id:      goframework.NumberUUID(1),
+			now:     updateTime,
+			expect: &dao.AnnotationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(20),
+				AnnotationModelCore: dao.AnnotationModelCore{
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 4,
+					EndOffset:   9,
+					Quote:       "quick",
+					Content:     "my updated annotation",
+				},
+			},
+		},
+		{
+			name:      "Error/NotFound",
+			content:   "my updated annotation",
+			id:        goframework.NumberUUID(2),
+			now:       updateTime,
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewAnnotationRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Update(ctx, d.content, d.id, d.now)
+				require.Equal(t, d.expect, res)
+				require.ErrorIs(t, err, d.expectErr)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestAnnotationRepository_Delete(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.AnnotationModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			SourceID: goframework.NumberUUID(20),
+			AnnotationModelCore: dao.AnnotationModelCore{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "my annotation",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   goframework.NumberUUID(1),
+		},
+		{
+			name: "Success/NotFound",
+			id:   goframework.NumberUUID(2),
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewAnnotationRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				err := repository.Delete(ctx, d.id)
+				require.ErrorIs(t, err, d.expectErr)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestAnnotationRepository_List(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.AnnotationModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			SourceID: goframework.NumberUUID(20),
+			AnnotationModelCore: dao.AnnotationModelCore{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 10,
+				EndOffset:   15,
+				Quote:       "brown",
+				Content:     "second annotation",
+			},
+		},
+		&dao.AnnotationModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+			UserID:   goframework.NumberUUID(200),
+			SourceID: goframework.NumberUUID(20),
+			AnnotationModelCore: dao.AnnotationModelCore{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "first annotation",
+			},
+		},
+		&dao.AnnotationModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			SourceID: goframework.NumberUUID(20),
+			Orphaned: true,
+			AnnotationModelCore: dao.AnnotationModelCore{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 16,
+				EndOffset:   19,
+				Quote:       "fox",
+				Content:     "orphaned annotation",
+			},
+		},
+		// Anchored on another revision.
+		&dao.AnnotationModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			SourceID: goframework.NumberUUID(20),
+			AnnotationModelCore: dao.AnnotationModelCore{
+				RevisionID:  goframework.NumberUUID(11),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "other annotation",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query dao.AnnotationListQuery
+
+		expect    []*dao.AnnotationModel
+		expectErr error
+	}{
+		{
+			name: "Success",
+			query: dao.AnnotationListQuery{
+				RevisionID: goframework.NumberUUID(10),
+			},
+			expect: []*dao.AnnotationModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+					UserID:   goframework.NumberUUID(200),
+					SourceID: goframework.NumberUUID(20),
+					AnnotationModelCore: dao.AnnotationModelCore{
+						RevisionID:  goframework.NumberUUID(10),
+						StartOffset: 4,
+						EndOffset:   9,
+						Quote:       "quick",
+						Content:     "first annotation",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+					UserID:   goframework.NumberUUID(100),
+					SourceID: goframework.NumberUUID(20),
+					AnnotationModelCore: dao.AnnotationModelCore{
+						RevisionID:  goframework.NumberUUID(10),
+						StartOffset: 10,
+						EndOffset:   15,
+						Quote:       "brown",
+						Content:     "second annotation",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, nil),
+					UserID:   goframework.NumberUUID(100),
+					SourceID: goframework.NumberUUID(20),
+					Orphaned: true,
+					AnnotationModelCore: dao.AnnotationModelCore{
+						RevisionID:  goframework.NumberUUID(10),
+						StartOffset: 16,
+						EndOffset:   19,
+						Quote:       "fox",
+						Content:     "orphaned annotation",
+					},
+				},
+			},
+		},
+		{
+			name: "Success/Orphaned",
+			query: dao.AnnotationListQuery{
+				RevisionID: goframework.NumberUUID(10),
+				Orphaned:   lo.ToPtr(true),
+			},
+			expect: []*dao.AnnotationModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, nil),
+					UserID:   goframework.NumberUUID(100),
+					SourceID: goframework.NumberUUID(20),
+					Orphaned: true,
+					AnnotationModelCore: dao.AnnotationModelCore{
+						RevisionID:  goframework.NumberUUID(10),
+						StartOffset: 16,
+						EndOffset:   19,
+						Quote:       "fox",
+						Content:     "orphaned annotation",
+					},
+				},
+			},
+		},
+		{
+			name: "Success/NoResults",
+			query: dao.AnnotationListQuery{
+				RevisionID: goframework.NumberUUID(12),
+			},
+			expect: []*dao.AnnotationModel{},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewAnnotationRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.List(ctx, d.query)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,159 @@
+package dao
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type CommentRepository interface {
+	// Get returns the comment with the given ID.
+	Get(ctx context.Context, id uuid.UUID) (*CommentModel, error)
+	// Create creates a new comment on a given target.
+	Create(ctx context.Context, data *CommentModelCore, userID, id uuid.UUID, now time.Time) (*CommentModel, error)
+	// Update updates the content of an existing comment.
+	Update(ctx context.Context, content string, id uuid.UUID, now time.Time) (*CommentModel, error)
+	// Delete deletes an existing comment, along with all its replies.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// List returns the comments of a single thread level, in chronological order. Results must be paginated using
+	// the limit and offset parameters.
+	// It also returns the total number of available results, to help with pagination.
+	List(ctx context.Context, query CommentListQuery, limit, offset int) ([]*CommentModel, int, error)
+}
+
+// CommentTarget is the type of content a comment is attached to.
+type CommentTarget string
+
+const (
+	CommentTargetImproveRequest         CommentTarget = "improve_request"
+	CommentTargetImproveRequestRevision CommentTarget = "improve_request_revision"
+	CommentTargetImproveSuggestion      CommentTarget = "improve_suggestion"
+)
+
+type CommentModel struct {
+	bun.BaseModel `bun:"table:comments"`
+	bunovel.Metadata
+
+	// UserID is the ID of the user who wrote the comment.
+	UserID uuid.UUID `bun:"user_id,type:uuid"`
+	// Hidden is true when the comment was hidden by a moderator. Hidden comments are left out of every read.
+	Hidden bool `bun:"hidden"`
+
+	CommentModelCore
+}
+
+type CommentModelCore struct {
+	// TargetType is the type of content the comment is attached to.
+	TargetType CommentTarget `bun:"target_type"`
+	// TargetID is the ID of the content the comment is attached to. For improve requests, it points to the
+	// source ID.
+	TargetID uuid.UUID `bun:"target_id,type:uuid"`
+	// ParentID is set when the comment is a reply to another comment. The parent comment must be attached to the
+	// same target.
+	ParentID *uuid.UUID `bun:"parent_id,type:uuid"`
+	// Content is the text of the comment.
+	Content string `bun:"content"`
+}
+
+// CommentListQuery allows to filter comments.
+type CommentListQuery struct {
+	// TargetType is the type of content to list comments for.
+	TargetType CommentTarget
+	// TargetID is the ID of the content to list comments for.
+	TargetID uuid.UUID
+	// ParentID is an optional parameter, to list the replies of a given comment. When omitted, only top-level
+	// comments are returned.
+	ParentID *uuid.UUID
+}
+
+type commentRepositoryImpl struct {
+	db bun.IDB
+}
+
+func NewCommentRepository(db bun.IDB) CommentRepository {
+	return &commentRepositoryImpl{
+		db: db,
+	}
+}
+
+func (repository *commentRepositoryImpl) Get(ctx context.Context, id uuid.UUID) (*CommentModel, error) {
+	comment := &CommentModel{Metadata: bunovel.Metadata{ID: id}}
+	if err := repository.db.NewSelect().Model(comment).WherePK().Where("hidden = FALSE").Scan(ctx); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return comment, nil
+}
+
+func (repository *commentRepositoryImpl) Create(ctx context.Context, data *CommentModelCore, userID, id uuid.UUID, now time.Time) (*CommentModel, error) {
+	comment := &CommentModel{
+		Metadata: bunovel.Metadata{
+			ID:        id,
+			CreatedAt: now,
+		},
+		UserID:           userID,
+		CommentModelCore: *data,
+	}
+
+	if err := repository.db.NewInsert().Model(comment).Returning("*").Scan(ctx); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return comment, nil
+}
+
+func (repository *commentRepositoryImpl) Update(ctx context.Context, content string, id uuid.UUID, now time.Time) (*CommentModel, error) {
+	comment := &CommentModel{
+		Metadata: bunovel.Metadata{
+			ID:        id,
+			UpdatedAt: &now,
+		},
+		CommentModelCore: CommentModelCore{Content: content},
+	}
+
+	err := repository.db.NewUpdate().Model(comment).Column("updated_at", "content").WherePK().Returning("*").Scan(ctx)
+	if err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return comment, nil
+}
+
+func (repository *commentRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	comment := &CommentModel{Metadata: bunovel.Metadata{ID: id}}
+
+	if _, err := repository.db.NewDelete().Model(comment).WherePK().Exec(ctx); err != nil {
+		return bunovel.HandlePGError(err)
+	}
+
+	return nil
+}
+
+func (repository *commentRepositoryImpl) List(ctx context.Context, query CommentListQuery, limit, offset int) ([]*CommentModel, int, error) {
+	comments := make([]*CommentModel, 0)
+
+	queryBuilder := repository.db.NewSelect().
+		Model(&comments).
+		Where("target_type = ?", query.TargetType).
+		Where("target_id = ?", query.TargetID).
+		Where("hidden = FALSE").
+		Limit(limit).
+		Offset(offset).
+		Order("created_at ASC", "id ASC")
+
+	if query.ParentID != nil {
+		queryBuilder.Where("parent_id = ?", *query.ParentID)
+	} else {
+		queryBuilder.Where("parent_id IS NULL")
+	}
+
+	count, err := queryBuilder.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, bunovel.HandlePGError(err)
+	}
+
+	return comments, count, nil
+}
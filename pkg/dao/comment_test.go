@@ -0,0 +1,450 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"io/fs"
+	"testing"
+	"time"
+)
+
+func TestCommentRepository_Get(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+			UserID:   goframework.NumberUUID(100),
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "my comment",
+			},
+		},
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			Hidden:   true,
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "my hidden comment",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expect    *dao.CommentModel
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   goframework.NumberUUID(1),
+			expect: &dao.CommentModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+				UserID:   goframework.NumberUUID(100),
+				CommentModelCore: dao.CommentModelCore{
+					TargetType: dao.CommentTargetImproveRequest,
+					TargetID:   goframework.NumberUUID(10),
+					Content:    "my comment",
+				},
+			},
+		},
+		{
+			name:      "Error/NotFound",
+			id:        goframework.NumberUUID(2),
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/Hidden",
+			id:        goframework.NumberUUID(3),
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewCommentRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Get(ctx, d.id)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestCommentRepository_Create(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "my comment",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		data   *dao.CommentModelCore
+		userID uuid.UUID
+		id     uuid.UUID
+		now    time.Time
+
+		expect    *dao.CommentModel
+		expectErr error
+	}{
+		{
+			name: "Success",
+			data: &dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "my new comment",
+			},
+			userID: goframework.NumberUUID(200),
+			id:     goframework.NumberUUID(2),
+			now:    baseTime,
+			expect: &dao.CommentModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				CommentModelCore: dao.CommentModelCore{
+					TargetType: dao.CommentTargetImproveSuggestion,
+					TargetID:   goframework.NumberUUID(10),
+					Content:    "my new comment",
+				},
+			},
+		},
+		{
+			name: "Success/Reply",
+			data: &dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				ParentID:   lo.ToPtr(goframework.NumberUUID(1)),
+				Content:    "my reply",
+			},
+			userID: goframework.NumberUUID(200),
+			id:     goframework.NumberUUID(2),
+			now:    baseTime,
+			expect: &dao.CommentModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				CommentModelCore: dao.CommentModelCore{
+					TargetType: dao.CommentTargetImproveSuggestion,
+					TargetID:   goframework.NumberUUID(10),
+					ParentID:   lo.ToPtr(goframework.NumberUUID(1)),
+					Content:    "my reply",
+				},
+			},
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewCommentRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Create(ctx, d.data, d.userID, d.id, d.now)
+				require.Equal(t, d.expect, res)
+				require.ErrorIs(t, err, d.expectErr)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestCommentRepository_Update(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveRequestRevision,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "my comment",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		content string
+		id      uuid.UUID
+		now     time.Time
+
+		expect    *dao.CommentModel
+		expectErr error
+	}{
+		{
+			name:    "Success",
+			content: "my updated comment",
+			id:      goframework.NumberUUID(1),
+			now:     updateTime,
+			expect: &dao.CommentModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+				UserID:   goframework.NumberUUID(100),
+				CommentModelCore: dao.CommentModelCore{
+					TargetType: dao.CommentTargetImproveRequestRevision,
+					TargetID:   goframework.NumberUUID(10),
+					Content:    "my updated comment",
+				},
+			},
+		},
+		{
+			name:      "Error/NotFound",
+			content:   "my updated comment",
+			id:        goframework.NumberUUID(2),
+			now:       updateTime,
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewCommentRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Update(ctx, d.content, d.id, d.now)
+				require.Equal(t, d.expect, res)
+				require.ErrorIs(t, err, d.expectErr)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestCommentRepository_Delete(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "my comment",
+			},
+		},
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			UserID:   goframework.NumberUUID(200),
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				ParentID:   lo.ToPtr(goframework.NumberUUID(1)),
+				Content:    "my reply",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   goframework.NumberUUID(1),
+		},
+		{
+			name: "Success/NotFound",
+			id:   goframework.NumberUUID(3),
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewCommentRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				err := repository.Delete(ctx, d.id)
+				require.ErrorIs(t, err, d.expectErr)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestCommentRepository_List(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "first comment",
+			},
+		},
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+			UserID:   goframework.NumberUUID(200),
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "second comment",
+			},
+		},
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Hour), nil),
+			UserID:   goframework.NumberUUID(200),
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				ParentID:   lo.ToPtr(goframework.NumberUUID(1)),
+				Content:    "first reply",
+			},
+		},
+		// Same target ID, different target type.
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "suggestion comment",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query  dao.CommentListQuery
+		limit  int
+		offset int
+
+		expect      []*dao.CommentModel
+		expectCount int
+		expectErr   error
+	}{
+		{
+			name: "Success/TopLevel",
+			query: dao.CommentListQuery{
+				TargetType: dao.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+			},
+			limit: 10,
+			expect: []*dao.CommentModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+					UserID:   goframework.NumberUUID(100),
+					CommentModelCore: dao.CommentModelCore{
+						TargetType: dao.CommentTargetImproveRequest,
+						TargetID:   goframework.NumberUUID(10),
+						Content:    "first comment",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+					UserID:   goframework.NumberUUID(200),
+					CommentModelCore: dao.CommentModelCore{
+						TargetType: dao.CommentTargetImproveRequest,
+						TargetID:   goframework.NumberUUID(10),
+						Content:    "second comment",
+					},
+				},
+			},
+			expectCount: 2,
+		},
+		{
+			name: "Success/Replies",
+			query: dao.CommentListQuery{
+				TargetType: dao.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				ParentID:   lo.ToPtr(goframework.NumberUUID(1)),
+			},
+			limit: 10,
+			expect: []*dao.CommentModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Hour), nil),
+					UserID:   goframework.NumberUUID(200),
+					CommentModelCore: dao.CommentModelCore{
+						TargetType: dao.CommentTargetImproveRequest,
+						TargetID:   goframework.NumberUUID(10),
+						ParentID:   lo.ToPtr(goframework.NumberUUID(1)),
+						Content:    "first reply",
+					},
+				},
+			},
+			expectCount: 1,
+		},
+		{
+			name: "Success/Paginate",
+			query: dao.CommentListQuery{
+				TargetType: dao.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+			},
+			limit:  1,
+			offset: 1,
+			expect: []*dao.CommentModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+					UserID:   goframework.NumberUUID(200),
+					CommentModelCore: dao.CommentModelCore{
+						TargetType: dao.CommentTargetImproveRequest,
+						TargetID:   goframework.NumberUUID(10),
+						Content:    "second comment",
+					},
+				},
+			},
+			expectCount: 2,
+		},
+		{
+			name: "Success/NoResults",
+			query: dao.CommentListQuery{
+				TargetType: dao.CommentTargetImproveRequestRevision,
+				TargetID:   goframework.NumberUUID(10),
+			},
+			limit:  10,
+			expect: []*dao.CommentModel{},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewCommentRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, count, err := repository.List(ctx, d.query, d.limit, d.offset)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+				require.Equal(t, d.expectCount, count)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,53 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// DuplicateQuery describes a new post, to compare with the recent posts of its author.
+type DuplicateQuery struct {
+	// UserID is the ID of the author of the new post.
+	UserID uuid.UUID
+	// Content is the content of the new post.
+	Content string
+	// Since leaves out the posts created before this date.
+	Since time.Time
+	// MinSimilarity is the trigram similarity, from 0 to 1, above which a post is a duplicate of the new one.
+	MinSimilarity float64
+}
+
+// Duplicate is a recent post that resembles a new one.
+type Duplicate struct {
+	// ID is the ID of the resembling post.
+	ID uuid.UUID `bun:"id,type:uuid"`
+	// Similarity is the trigram similarity of both contents, from 0 to 1.
+	Similarity float64 `bun:"similarity"`
+}
+
+// findDuplicate selects the published post of the model table that resembles the most the new post. Drafts and soft
+// deleted posts are ignored, but hidden posts are not: posting again a content that was moderated is also suspicious.
+func findDuplicate(db bun.IDB, model interface{}, query DuplicateQuery) *bun.SelectQuery {
+	return db.NewSelect().
+		Model(model).
+		Column("id").
+		ColumnExpr("similarity(content, ?) AS similarity", query.Content).
+		Where("user_id = ?", query.UserID).
+		Where("created_at >= ?", query.Since).
+		Where("draft = FALSE").
+		Where("deleted_at IS NULL").
+		Where("similarity(content, ?) >= ?", query.Content, query.MinSimilarity).
+		OrderExpr("similarity DESC, created_at DESC").
+		Limit(1)
+}
+
+func scanDuplicate(ctx context.Context, queryBuilder *bun.SelectQuery) (*Duplicate, error) {
+	duplicate := new(Duplicate)
+	if err := queryBuilder.Scan(ctx, duplicate); err != nil {
+		return nil, err
+	}
+
+	return duplicate, nil
+}
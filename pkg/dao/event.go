@@ -0,0 +1,157 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/bunovel"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"sort"
+	"time"
+)
+
+type EventType string
+
+const (
+	// EventTypeRequestCreated is emitted when the first revision of an improvement request is posted.
+	EventTypeRequestCreated EventType = "request.created"
+	// EventTypeRequestRevised is emitted when a new revision of an existing improvement request is posted.
+	EventTypeRequestRevised EventType = "request.revised"
+	// EventTypeSuggestionCreated is emitted when a suggestion is posted on an improvement request.
+	EventTypeSuggestionCreated EventType = "suggestion.created"
+	// EventTypeSuggestionValidated is emitted when the creator of an improvement request accepts a suggestion.
+	EventTypeSuggestionValidated EventType = "suggestion.validated"
+)
+
+// EventRepository reads the outbox of domain events. Events are written by the other repositories, in the same
+// transaction as the data change they describe.
+type EventRepository interface {
+	// ClaimPending returns the oldest events that have not been delivered yet, in the order they were emitted. Events
+	// that already failed maxAttempts times are skipped. The returned events are claimed until now + lease, so
+	// concurrent dispatchers do not deliver them twice; the claim of an event expires if it is not marked in time.
+	ClaimPending(ctx context.Context, maxAttempts, limit int, now time.Time, lease time.Duration) ([]*EventModel, error)
+	// MarkDelivered flags an event as delivered, so it is not sent again.
+	MarkDelivered(ctx context.Context, id int64, now time.Time) error
+	// MarkFailed records a failed delivery attempt. The event remains pending, and its claim is released.
+	MarkFailed(ctx context.Context, id int64, reason string, now time.Time) error
+}
+
+type EventModel struct {
+	bun.BaseModel `bun:"table:events"`
+
+	// ID is assigned by the database, and increases with every new event.
+	ID        int64     `bun:"id,pk,autoincrement"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+
+	// DeliveredAt is set once the event has been sent to every sink.
+	DeliveredAt *time.Time `bun:"delivered_at"`
+	// Attempts is the number of failed delivery attempts.
+	Attempts      int        `bun:"attempts"`
+	LastAttemptAt *time.Time `bun:"last_attempt_at"`
+	// LastError is the reason of the last failed delivery attempt.
+	LastError string `bun:"last_error,nullzero"`
+	// ClaimedUntil is set while a dispatcher is delivering the event.
+	ClaimedUntil *time.Time `bun:"claimed_until"`
+
+	EventModelCore
+}
+
+type EventModelCore struct {
+	Type EventType `bun:"type"`
+	// UserID is the ID of the user whose action triggered the event.
+	UserID uuid.UUID `bun:"user_id,type:uuid"`
+	// TargetID is the ID of the object the event is about: a revision for request events, a suggestion for
+	// suggestion events.
+	TargetID uuid.UUID `bun:"target_id,type:uuid"`
+	// SourceID is the ID of the improvement request the target belongs to.
+	SourceID uuid.UUID `bun:"source_id,type:uuid"`
+}
+
+type eventRepositoryImpl struct {
+	db bun.IDB
+}
+
+func NewEventRepository(db bun.IDB) EventRepository {
+	return &eventRepositoryImpl{db: db}
+}
+
+func (repository *eventRepositoryImpl) ClaimPending(ctx context.Context, maxAttempts, limit int, now time.Time, lease time.Duration) ([]*EventModel, error) {
+	events := make([]*EventModel, 0)
+
+	// Rows locked by a concurrent claim are skipped, rather than claimed twice once the other claim commits.
+	pending := repository.db.NewSelect().
+		Model((*EventModel)(nil)).
+		Column("id").
+		Where("delivered_at IS NULL").
+		Where("attempts < ?", maxAttempts).
+		Where("(claimed_until IS NULL OR claimed_until <= ?)", now).
+		Order("id ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED")
+
+	err := repository.db.NewUpdate().
+		Model((*EventModel)(nil)).
+		Set("claimed_until = ?", now.Add(lease)).
+		Where("id IN (?)", pending).
+		Returning("*").
+		Scan(ctx, &events)
+	if err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	// UPDATE does not preserve the order of the subquery.
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID < events[j].ID
+	})
+
+	return events, nil
+}
+
+func (repository *eventRepositoryImpl) MarkDelivered(ctx context.Context, id int64, now time.Time) error {
+	event := &EventModel{ID: id, DeliveredAt: &now}
+
+	err := repository.db.NewUpdate().Model(event).Column("delivered_at").WherePK().Returning("*").Scan(ctx)
+	if err != nil {
+		return bunovel.HandlePGError(err)
+	}
+
+	return nil
+}
+
+func (repository *eventRepositoryImpl) MarkFailed(ctx context.Context, id int64, reason string, now time.Time) error {
+	event := &EventModel{ID: id}
+
+	err := repository.db.NewUpdate().
+		Model(event).
+		Set("attempts = attempts + 1").
+		Set("last_attempt_at = ?", now).
+		Set("last_error = ?", reason).
+		Set("claimed_until = NULL").
+		WherePK().
+		Returning("*").
+		Scan(ctx)
+	if err != nil {
+		return bunovel.HandlePGError(err)
+	}
+
+	return nil
+}
+
+// insertEvents writes events to the outbox. It must run in the transaction of the data change the events describe,
+// so an event is only ever emitted for a change that was committed.
+func insertEvents(ctx context.Context, tx bun.IDB, events []*EventModelCore, now time.Time) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	models := make([]*EventModel, len(events))
+	for i, event := range events {
+		models[i] = &EventModel{CreatedAt: now, EventModelCore: *event}
+	}
+
+	if _, err := tx.NewInsert().Model(&models).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to insert events: %w", err)
+	}
+
+	return nil
+}
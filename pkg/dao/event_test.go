@@ -0,0 +1,316 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"io/fs"
+	"testing"
+	"time"
+)
+
+func TestEventRepository_ClaimPending(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	newEvent := func(id int64, deliveredAt *time.Time, attempts int, claimedUntil *time.Time) *dao.EventModel {
+		return &dao.EventModel{
+			ID:           id,
+			CreatedAt:    baseTime,
+			DeliveredAt:  deliveredAt,
+			Attempts:     attempts,
+			ClaimedUntil: claimedUntil,
+			EventModelCore: dao.EventModelCore{
+				Type:     dao.EventTypeSuggestionCreated,
+				UserID:   goframework.NumberUUID(200),
+				TargetID: goframework.NumberUUID(20),
+				SourceID: goframework.NumberUUID(10),
+			},
+		}
+	}
+
+	claimedUntil := lo.ToPtr(updateTime.Add(time.Minute))
+
+	fixtures := []interface{}{
+		newEvent(1, &updateTime, 0, nil),
+		newEvent(2, nil, 0, nil),
+		newEvent(3, nil, 2, nil),
+		newEvent(4, nil, 5, nil),
+		newEvent(5, nil, 0, nil),
+		// Claimed by another dispatcher.
+		newEvent(6, nil, 0, lo.ToPtr(updateTime.Add(time.Second))),
+		// The claim expired.
+		newEvent(7, nil, 0, &baseTime),
+	}
+
+	data := []struct {
+		name string
+
+		maxAttempts int
+		limit       int
+
+		expect    []*dao.EventModel
+		expectErr error
+	}{
+		{
+			name:        "Success",
+			maxAttempts: 5,
+			limit:       10,
+			expect: []*dao.EventModel{
+				newEvent(2, nil, 0, claimedUntil),
+				newEvent(3, nil, 2, claimedUntil),
+				newEvent(5, nil, 0, claimedUntil),
+				newEvent(7, nil, 0, claimedUntil),
+			},
+		},
+		{
+			name:        "Success/Limit",
+			maxAttempts: 5,
+			limit:       2,
+			expect: []*dao.EventModel{
+				newEvent(2, nil, 0, claimedUntil),
+				newEvent(3, nil, 2, claimedUntil),
+			},
+		},
+		{
+			name:        "Success/MaxAttempts",
+			maxAttempts: 1,
+			limit:       10,
+			expect: []*dao.EventModel{
+				newEvent(2, nil, 0, claimedUntil),
+				newEvent(5, nil, 0, claimedUntil),
+				newEvent(7, nil, 0, claimedUntil),
+			},
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewEventRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.ClaimPending(ctx, d.maxAttempts, d.limit, updateTime, time.Minute)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+
+				// Claimed events are not handed to another dispatcher.
+				again, err := repository.ClaimPending(ctx, d.maxAttempts, d.limit, updateTime, time.Minute)
+				require.NoError(t, err)
+				for _, event := range again {
+					require.NotContains(t, d.expect, event)
+				}
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestEventRepository_MarkDelivered(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.EventModel{
+			ID:        1,
+			CreatedAt: baseTime,
+			EventModelCore: dao.EventModelCore{
+				Type:     dao.EventTypeRequestCreated,
+				UserID:   goframework.NumberUUID(100),
+				TargetID: goframework.NumberUUID(1),
+				SourceID: goframework.NumberUUID(10),
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id  int64
+		now time.Time
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   1,
+			now:  updateTime,
+		},
+		{
+			name:      "Error/NotFound",
+			id:        2,
+			now:       updateTime,
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewEventRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				err := repository.MarkDelivered(ctx, d.id, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+
+				if err == nil {
+					pending, err := repository.ClaimPending(ctx, 10, 10, updateTime, time.Minute)
+					require.NoError(t, err)
+					require.Empty(t, pending)
+				}
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestEventRepository_MarkFailed(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.EventModel{
+			ID:        1,
+			CreatedAt: baseTime,
+			Attempts:  1,
+			EventModelCore: dao.EventModelCore{
+				Type:     dao.EventTypeRequestCreated,
+				UserID:   goframework.NumberUUID(100),
+				TargetID: goframework.NumberUUID(1),
+				SourceID: goframework.NumberUUID(10),
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id     int64
+		reason string
+		now    time.Time
+
+		expect    []*dao.EventModel
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			id:     1,
+			reason: "webhook responded with status 500",
+			now:    updateTime,
+			expect: []*dao.EventModel{
+				{
+					ID:            1,
+					CreatedAt:     baseTime,
+					Attempts:      2,
+					LastAttemptAt: lo.ToPtr(updateTime),
+					LastError:     "webhook responded with status 500",
+					ClaimedUntil:  lo.ToPtr(updateTime.Add(time.Minute)),
+					EventModelCore: dao.EventModelCore{
+						Type:     dao.EventTypeRequestCreated,
+						UserID:   goframework.NumberUUID(100),
+						TargetID: goframework.NumberUUID(1),
+						SourceID: goframework.NumberUUID(10),
+					},
+				},
+			},
+		},
+		{
+			name:      "Error/NotFound",
+			id:        2,
+			reason:    "webhook responded with status 500",
+			now:       updateTime,
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewEventRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				err := repository.MarkFailed(ctx, d.id, d.reason, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+
+				if err == nil {
+					pending, err := repository.ClaimPending(ctx, 10, 10, updateTime, time.Minute)
+					require.NoError(t, err)
+					require.Equal(t, d.expect, pending)
+				}
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestEventRepository_Outbox(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewEventRepository(tx)
+		suggestionRepository := dao.NewImproveSuggestionRepository(tx)
+
+		created := &dao.EventModelCore{
+			Type:     dao.EventTypeSuggestionCreated,
+			UserID:   goframework.NumberUUID(200),
+			TargetID: goframework.NumberUUID(20),
+			SourceID: goframework.NumberUUID(10),
+		}
+		validated := &dao.EventModelCore{
+			Type:     dao.EventTypeSuggestionValidated,
+			UserID:   goframework.NumberUUID(100),
+			TargetID: goframework.NumberUUID(20),
+			SourceID: goframework.NumberUUID(10),
+		}
+
+		_, err := suggestionRepository.Create(
+			ctx,
+			&dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my suggested title",
+				Content:   "my suggested content",
+			},
+			false,
+			goframework.NumberUUID(200),
+			goframework.NumberUUID(10),
+			goframework.NumberUUID(20),
+			nil,
+			baseTime,
+			created,
+		)
+		require.NoError(t, err)
+
+		_, err = suggestionRepository.Validate(ctx, true, goframework.NumberUUID(20), updateTime, validated)
+		require.NoError(t, err)
+
+		// A failed change must not leave its events behind.
+		_, err = suggestionRepository.Validate(ctx, true, goframework.NumberUUID(21), updateTime, validated)
+		require.ErrorIs(t, err, bunovel.ErrNotFound)
+
+		pending, err := repository.ClaimPending(ctx, 10, 10, updateTime, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		require.Equal(t, *created, pending[0].EventModelCore)
+		require.Equal(t, *validated, pending[1].EventModelCore)
+		require.Equal(t, baseTime, pending[0].CreatedAt)
+		require.Equal(t, updateTime, pending[1].CreatedAt)
+	})
+	require.NoError(t, err)
+}
@@ -2,24 +2,118 @@ package dao
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/a-novel/bunovel"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
-	"strings"
 	"time"
 )
 
+// ErrSuggestionAlreadyApplied is returned when a suggestion is applied a second time.
+var ErrSuggestionAlreadyApplied = errors.New("the improve suggestion was already applied")
+
 type ImproveRequestRepository interface {
 	GetRevision(ctx context.Context, id uuid.UUID) (*ImproveRequestRevisionModel, error)
 	Get(ctx context.Context, id uuid.UUID) (*ImproveRequestPreview, error)
 	ListRevisions(ctx context.Context, id uuid.UUID) ([]*ImproveRequestRevisionPreview, error)
-	UpdateVotes(ctx context.Context, id uuid.UUID, upVotes, downVotes int) error
-	Create(ctx context.Context, userID uuid.UUID, title, content string, sourceID, id uuid.UUID, now time.Time) (*ImproveRequestPreview, error)
-	DeleteRevision(ctx context.Context, id uuid.UUID) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	// Create creates a new revision of an improvement request, and the request itself if it does not exist yet, in
+	// which case the author is subscribed to it. The suggestions the revision was made from are validated along the
+	// way, or ErrSuggestionAlreadyApplied is returned if one of them already was. The optional report, that flags the
+	// revision for moderation, and the optional events are written in the same transaction. A draft revision is only
+	// visible to its author, until it is published.
+	Create(ctx context.Context, data *ImproveRequestModelCore, draft bool, userID, sourceID, id uuid.UUID, report *ReportModel, now time.Time, events ...*EventModelCore) (*ImproveRequestPreview, error)
+	// Publish makes a draft revision visible to everyone, and stamps its publication date. The optional events are
+	// written to the outbox in the same transaction.
+	Publish(ctx context.Context, id uuid.UUID, now time.Time, events ...*EventModelCore) (*ImproveRequestRevisionModel, error)
+	// ApplySuggestion validates an improvement suggestion, and creates a new revision of the related request from the
+	// suggested title and content. The optional events are written to the outbox in the same transaction. It returns
+	// ErrSuggestionAlreadyApplied if the suggestion is already validated, or already part of a revision.
+	ApplySuggestion(ctx context.Context, userID, suggestionID, id uuid.UUID, now time.Time, events ...*EventModelCore) (*ImproveRequestPreview, error)
+	// DeleteRevision soft deletes a revision. It is left out of every read, until it is restored or purged. The
+	// suggestions made on the revision are handled according to the policy.
+	DeleteRevision(ctx context.Context, id uuid.UUID, policy SuggestionOrphanPolicy, now time.Time) error
+	// Delete soft deletes an improvement request, along with every revision and suggestion that was not deleted yet.
+	// They are left out of every read, until they are restored or purged.
+	Delete(ctx context.Context, id uuid.UUID, now time.Time) error
+	// GetDeletedRevision returns a soft deleted revision.
+	GetDeletedRevision(ctx context.Context, id uuid.UUID) (*ImproveRequestRevisionModel, error)
+	// GetDeleted returns the latest revision of a soft deleted improvement request, as it was when the request was
+	// deleted.
+	GetDeleted(ctx context.Context, id uuid.UUID) (*ImproveRequestRevisionModel, error)
+	// RestoreRevision restores a soft deleted revision, along with the suggestions that were deleted with it. The
+	// revision cannot be restored while its request is deleted.
+	RestoreRevision(ctx context.Context, id uuid.UUID) (*ImproveRequestRevisionModel, error)
+	// Restore restores a soft deleted improvement request, along with the revisions and suggestions that were deleted
+	// with it. Revisions and suggestions that were deleted on their own remain deleted.
+	Restore(ctx context.Context, id uuid.UUID) (*ImproveRequestPreview, error)
+	// Purge permanently deletes the improvement requests and revisions that were soft deleted before the given
+	// date. It returns the number of purged rows.
+	Purge(ctx context.Context, before time.Time) (int, error)
+	// Search returns a list of improvement requests, matching the provided query. Results must be paginated using
+	// the limit parameter, and either the offset parameter or the cursor of the query.
+	// It also returns the total number of available results, to help with pagination, unless the query skips it. The
+	// total ignores the cursor: every page of a search returns the same one.
 	Search(ctx context.Context, query ImproveRequestSearchQuery, limit, offset int) ([]*ImproveRequestPreview, int, error)
+	// SuggestQuery corrects the misspelled words of a search query, using the words of the request titles. It returns
+	// an empty string when no word could be corrected.
+	SuggestQuery(ctx context.Context, query string) (string, error)
 	List(ctx context.Context, ids []uuid.UUID) ([]*ImproveRequestPreview, error)
+	// SetStatus moves an improvement request to a new lifecycle state. Reopening a request clears its close policy,
+	// so it is not closed again right away.
+	SetStatus(ctx context.Context, id uuid.UUID, status RequestStatus, now time.Time) (*ImproveRequestPreview, error)
+	// SetClosePolicy sets the conditions under which an open improvement request is closed automatically. A nil
+	// condition is disabled.
+	SetClosePolicy(ctx context.Context, id uuid.UUID, closeAfterDays, closeAfterAccepted *int) (*ImproveRequestPreview, error)
+	// AutoClose evaluates the close policy of every open improvement request. Requests with enough accepted
+	// suggestions are resolved, and requests that have been open for long enough are closed. It returns the number
+	// of updated requests.
+	AutoClose(ctx context.Context, now time.Time) (int, error)
+	// FindDuplicate returns the recent revision of the user that resembles the most the given content. Revisions of
+	// the excluded request are ignored, since a revision naturally resembles the previous ones. It returns
+	// bunovel.ErrNotFound when no revision is similar enough.
+	FindDuplicate(ctx context.Context, query DuplicateQuery, excludeSourceID uuid.UUID) (*Duplicate, error)
+}
+
+// SuggestionOrphanPolicy decides what happens to the suggestions made on a revision, when this revision is deleted.
+type SuggestionOrphanPolicy string
+
+const (
+	// SuggestionOrphanPolicyReattach moves the suggestions to the nearest surviving revision of the request: the
+	// latest revision created before them, or the oldest one created after them. They are deleted along with the
+	// revision when no other revision survives.
+	SuggestionOrphanPolicyReattach SuggestionOrphanPolicy = "reattach"
+	// SuggestionOrphanPolicyCascade deletes the suggestions along with the revision.
+	SuggestionOrphanPolicyCascade SuggestionOrphanPolicy = "cascade"
+)
+
+// Language is the language an improvement request is written in. It selects the dictionary its content is indexed
+// with, for full-text search. Suggestions share the language of their request.
+type Language string
+
+const (
+	LanguageFrench  Language = "fr"
+	LanguageEnglish Language = "en"
+	LanguageSpanish Language = "es"
+)
+
+// Languages lists the supported languages. A search that does not target a language matches all of them.
+var Languages = []Language{LanguageFrench, LanguageEnglish, LanguageSpanish}
+
+// RequestStatus is the lifecycle state of an improvement request. Only open requests accept new suggestions.
+type RequestStatus string
+
+const (
+	RequestStatusOpen     RequestStatus = "open"
+	RequestStatusClosed   RequestStatus = "closed"
+	RequestStatusResolved RequestStatus = "resolved"
+	RequestStatusArchived RequestStatus = "archived"
+)
+
+// RequestStatuses lists the supported lifecycle states.
+var RequestStatuses = []RequestStatus{
+	RequestStatusOpen, RequestStatusClosed, RequestStatusResolved, RequestStatusArchived,
 }
 
 type ImproveRequestModel struct {
@@ -32,6 +126,30 @@ type ImproveRequestModel struct {
 	// DownVotes is the number of down votes the request has received. This value is indirectly updated from the
 	// votes table.
 	DownVotes int `bun:"down_votes"`
+	// Status is the lifecycle state of the request. New requests are open.
+	Status RequestStatus `bun:"status,nullzero"`
+	// StatusUpdatedAt is set every time the status of the request changes.
+	StatusUpdatedAt *time.Time `bun:"status_updated_at"`
+	// CloseAfterDays closes the request once it has been open for this many days.
+	CloseAfterDays *int `bun:"close_after_days"`
+	// CloseAfterAccepted resolves the request once this many of its suggestions have been accepted.
+	CloseAfterAccepted *int `bun:"close_after_accepted"`
+	// DeletedAt is set when the request is soft deleted.
+	DeletedAt *time.Time `bun:"deleted_at"`
+}
+
+// ImproveRequestModelCore holds the data of a new improvement request revision.
+type ImproveRequestModelCore struct {
+	// Title is a quick summary of the Content, and the goal it tries to achieve.
+	Title string
+	// Content is a novel scene that the user wants to improve.
+	Content string
+	// Language is only used for new requests, and defaults to French: revisions keep the language of their request.
+	Language Language
+	// Tags replace the tags of the request, unless they are nil.
+	Tags []string
+	// SuggestionIDs are the IDs of the suggestions the revision was made from, if any.
+	SuggestionIDs []uuid.UUID
 }
 
 type ImproveRequestRevisionModel struct {
@@ -48,14 +166,34 @@ type ImproveRequestRevisionModel struct {
 	Title string `bun:"title"`
 	// Content is a novel scene that the user wants to improve.
 	Content string `bun:"content"`
+	// SuggestionIDs are the IDs of the suggestions this revision was created from, if any.
+	SuggestionIDs []uuid.UUID `bun:"suggestion_ids,type:uuid[],array"`
+	// Language is the language of the request. It is set when the request is created, and copied to every revision.
+	Language Language `bun:"language,nullzero"`
+	// Draft is true while the revision is only visible to its author.
+	Draft bool `bun:"draft"`
+	// PublishedAt is set when a draft revision is published. Revisions that were never drafts do not have one.
+	PublishedAt *time.Time `bun:"published_at"`
+	// Hidden is true when the revision was hidden by a moderator. Hidden revisions are left out of every read.
+	Hidden bool `bun:"hidden"`
+	// DeletedAt is set when the revision is soft deleted, either on its own or with its request.
+	DeletedAt *time.Time `bun:"deleted_at"`
 }
 
 type ImproveRequestRevisionPreview struct {
 	bun.BaseModel `bun:"table:improve_requests_revisions_list"`
 	bunovel.Metadata
 
+	// UserID is the ID of the user who created the revision.
+	UserID uuid.UUID `bun:"user_id,type:uuid"`
+	// Draft is true while the revision is only visible to its author.
+	Draft bool `bun:"draft"`
+
 	SuggestionsCount         int `bun:"suggestions_count"`
 	AcceptedSuggestionsCount int `bun:"accepted_suggestions_count"`
+
+	// SuggestionIDs are the IDs of the suggestions this revision was created from, if any.
+	SuggestionIDs []uuid.UUID `bun:"suggestion_ids,type:uuid[],array"`
 }
 
 type ImproveRequestPreview struct {
@@ -83,10 +221,64 @@ type ImproveRequestPreview struct {
 	// AcceptedSuggestionsCount returns the total number of accepted suggestions, associated with the request and all
 	// its revisions.
 	AcceptedSuggestionsCount int `bun:"accepted_suggestions_count"`
+
+	// Tags are the labels of the request, in alphabetical order.
+	Tags []string `bun:"tags,array"`
+	// Draft is true when the request has no published revision yet. The preview then shows its latest draft, which
+	// is only visible to its author.
+	Draft bool `bun:"draft"`
+
+	// Status is the lifecycle state of the request. Only open requests accept new suggestions.
+	Status RequestStatus `bun:"status"`
+	// StatusUpdatedAt is the date of the latest status change, if any.
+	StatusUpdatedAt *time.Time `bun:"status_updated_at"`
+	// CloseAfterDays and CloseAfterAccepted are the close policy of the request, if any.
+	CloseAfterDays     *int `bun:"close_after_days"`
+	CloseAfterAccepted *int `bun:"close_after_accepted"`
+
+	// The following sort keys are only set by Search, when the results are sorted on them.
+
+	// LastActivityAt is the creation date of the latest revision of the request, or the date the latest suggestion
+	// on the request was posted or edited.
+	LastActivityAt time.Time `bun:"last_activity_at,scanonly"`
+	// Controversy is high when the request received many votes, evenly split between up and down votes.
+	Controversy float64 `bun:"controversy,scanonly"`
+	// Hotness is the score of the request on a log scale, offset by its creation date.
+	Hotness float64 `bun:"hotness,scanonly"`
+	// SearchRank is the relevance of the request for a text search. It is set when a query is provided.
+	SearchRank float64 `bun:"search_rank,scanonly"`
+	// TitleHighlight and ContentHighlight are set by Search, when highlights are requested along with a query. The
+	// words matching the query are wrapped in highlight markers, and the content is reduced to its best fragments.
+	// The text around the markers is HTML escaped.
+	TitleHighlight   string `bun:"title_highlight,scanonly"`
+	ContentHighlight string `bun:"content_highlight,scanonly"`
 }
 
 type ImproveRequestSearchQueryOrder struct {
+	// Score sorts the requests by score (up votes minus down votes).
 	Score bool
+	// Activity sorts the requests by last activity: their latest revision, or the latest suggestion posted or edited
+	// on them.
+	Activity bool
+	// Suggestions sorts the requests by number of suggestions.
+	Suggestions bool
+	// Controversial sorts the requests by controversy: many votes, evenly split between up and down votes.
+	Controversial bool
+	// Hot sorts the requests by score, on a log scale, offset by their creation date.
+	Hot bool
+}
+
+// ImproveRequestSearchCursor holds the sort keys of the last result of a page. The next page starts right after it.
+// Keys that are not part of the search order are ignored.
+type ImproveRequestSearchCursor struct {
+	Rank        float64
+	Score       int
+	Activity    time.Time
+	Suggestions int
+	Controversy float64
+	Hotness     float64
+	CreatedAt   time.Time
+	ID          uuid.UUID
 }
 
 // ImproveRequestSearchQuery allows to filter improve requests.
@@ -95,8 +287,48 @@ type ImproveRequestSearchQuery struct {
 	UserID *uuid.UUID
 	// Query is an optional parameter, to filter requests based on their title or content.
 	Query string
+	// Fuzzy also matches the requests whose title contains a word close to the Query, so misspelled words are
+	// tolerated. The similarity of the title is added to the rank of the results.
+	Fuzzy bool
+	// Highlight returns the title and content of the results with the words matching the Query highlighted. It is
+	// ignored without a Query.
+	Highlight bool
+	// Followed only targets the requests the viewer is subscribed to. It matches nothing without a ViewerID.
+	Followed bool
+	// ViewerID is the user running the search. Requests that were never published are only returned to their author.
+	ViewerID *uuid.UUID
+	// Language is an optional parameter, to only target requests written in a specific language. The Query is then
+	// only parsed with the dictionary of this language.
+	Language Language
+	// Tags is an optional parameter, to only target requests labeled with any of the given tags, or with all of them
+	// when AllTags is set.
+	Tags    []string
+	AllTags bool
+	// CreatedAfter and CreatedBefore are optional parameters, to only target requests created within a given period.
+	// Both bounds are inclusive.
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// MinScore and MaxScore are optional parameters, to only target requests whose score (up votes minus down votes)
+	// is within a given range. Both bounds are inclusive.
+	MinScore *int
+	MaxScore *int
+	// MinSuggestions and MaxSuggestions are optional parameters, to only target requests whose number of suggestions
+	// is within a given range. Both bounds are inclusive: a MaxSuggestions of 0 targets unanswered requests.
+	MinSuggestions *int
+	MaxSuggestions *int
+	// HasAcceptedSuggestions is an optional parameter, to only target requests with (or without) at least one
+	// accepted suggestion.
+	HasAcceptedSuggestions *bool
+	// MinRevisions and MaxRevisions are optional parameters, to only target requests whose number of revisions is
+	// within a given range. Both bounds are inclusive.
+	MinRevisions *int
+	MaxRevisions *int
 	// Order specifies custom ordering for the search results.
 	Order *ImproveRequestSearchQueryOrder
+	// Cursor is an optional parameter, to only return the results that come after it.
+	Cursor *ImproveRequestSearchCursor
+	// SkipCount prevents the total number of results from being computed. The returned total is then always 0.
+	SkipCount bool
 }
 
 type improveRequestRepositoryImpl struct {
@@ -112,7 +344,13 @@ func (repository *improveRequestRepositoryImpl) GetRevision(ctx context.Context,
 		Metadata: bunovel.Metadata{ID: id},
 	}
 
-	if err := repository.db.NewSelect().Model(model).WherePK().Scan(ctx); err != nil {
+	err := repository.db.NewSelect().
+		Model(model).
+		WherePK().
+		Where("hidden = FALSE").
+		Where("deleted_at IS NULL").
+		Scan(ctx)
+	if err != nil {
 		return nil, bunovel.HandlePGError(err)
 	}
 
@@ -145,59 +383,126 @@ func (repository *improveRequestRepositoryImpl) ListRevisions(ctx context.Contex
 	return models, nil
 }
 
-func (repository *improveRequestRepositoryImpl) UpdateVotes(ctx context.Context, id uuid.UUID, upVotes, downVotes int) error {
-	model := &ImproveRequestModel{Metadata: bunovel.Metadata{ID: id}, UpVotes: upVotes, DownVotes: downVotes}
-
-	rows, err := repository.db.NewUpdate().
-		Model(model).
-		Column("up_votes", "down_votes").
-		WherePK().
-		Exec(ctx)
-	if err != nil {
-		return bunovel.HandlePGError(err)
-	}
-
-	if err := bunovel.ForceRowsUpdate(rows); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (repository *improveRequestRepositoryImpl) Create(ctx context.Context, userID uuid.UUID, title, content string, sourceID, id uuid.UUID, now time.Time) (*ImproveRequestPreview, error) {
+func (repository *improveRequestRepositoryImpl) Create(ctx context.Context, data *ImproveRequestModelCore, draft bool, userID, sourceID, id uuid.UUID, report *ReportModel, now time.Time, events ...*EventModelCore) (*ImproveRequestPreview, error) {
 	output := new(ImproveRequestPreview)
+	language := data.Language
 
 	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		model := &ImproveRequestModel{
 			Metadata: bunovel.NewMetadata(sourceID, now, nil),
 		}
 
-		exists, err := tx.NewSelect().Model(model).WherePK().Exists(ctx)
-		if err != nil {
+		existing := &ImproveRequestModel{Metadata: bunovel.Metadata{ID: sourceID}}
+		err := tx.NewSelect().Model(existing).Column("deleted_at").WherePK().Scan(ctx)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
 			return fmt.Errorf("failed to check if improve request exists: %w", err)
 		}
 
+		exists := err == nil
+		// A deleted request cannot be revised until it is restored.
+		if exists && existing.DeletedAt != nil {
+			return fmt.Errorf("improve request is deleted: %w", sql.ErrNoRows)
+		}
+
 		if !exists {
 			if err := tx.NewInsert().Model(model).Scan(ctx); err != nil {
 				return fmt.Errorf("failed to create improve request: %w", err)
 			}
+
+			if err := subscribe(ctx, tx, userID, sourceID, now); err != nil {
+				return fmt.Errorf("failed to subscribe author: %w", err)
+			}
 		} else {
-			revisionModel := &ImproveRequestRevisionModel{
-				Metadata: bunovel.NewMetadata(id, now, nil),
-				SourceID: sourceID,
-				UserID:   userID,
-				Title:    title,
-				Content:  content,
+			language, err = requestLanguage(ctx, tx, sourceID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if data.Tags != nil {
+			if err := setTags(ctx, tx, sourceID, data.Tags, now); err != nil {
+				return err
+			}
+		}
+
+		if len(data.SuggestionIDs) > 0 {
+			// The lock holds concurrent applications of the same suggestions until this revision is committed, so they
+			// see them validated. Rows are locked in a fixed order, so overlapping merges cannot deadlock.
+			suggestions := make([]*ImproveSuggestionModel, 0, len(data.SuggestionIDs))
+			err := tx.NewSelect().
+				Model(&suggestions).
+				Where("id IN (?)", bun.In(data.SuggestionIDs)).
+				Order("id").
+				For("UPDATE").
+				Scan(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get improve suggestions: %w", err)
+			}
+			for _, suggestion := range suggestions {
+				if suggestion.Validated {
+					return ErrSuggestionAlreadyApplied
+				}
+			}
+
+			// Revisions also keep track of the suggestions they were built from.
+			merged, err := tx.NewSelect().
+				Model((*ImproveRequestRevisionModel)(nil)).
+				Where("source_id = ?", sourceID).
+				Where("suggestion_ids && ARRAY[?]::uuid[]", bun.In(data.SuggestionIDs)).
+				Exists(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to check improve request revisions: %w", err)
+			}
+			if merged {
+				return ErrSuggestionAlreadyApplied
+			}
+
+			_, err = tx.NewUpdate().
+				Model((*ImproveSuggestionModel)(nil)).
+				Set("validated = TRUE").
+				Set("updated_at = ?", now).
+				Where("id IN (?)", bun.In(data.SuggestionIDs)).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to validate improve suggestions: %w", err)
 			}
+		}
+
+		revisionModel := &ImproveRequestRevisionModel{
+			Metadata:      bunovel.NewMetadata(id, now, nil),
+			SourceID:      sourceID,
+			UserID:        userID,
+			Title:         data.Title,
+			Content:       data.Content,
+			SuggestionIDs: data.SuggestionIDs,
+			Language:      language,
+			Draft:         draft,
+		}
+
+		if err := tx.NewInsert().Model(revisionModel).Scan(ctx); err != nil {
+			return fmt.Errorf("failed to create improve request revision: %w", err)
+		}
 
-			if err := tx.NewInsert().Model(revisionModel).Scan(ctx); err != nil {
-				return fmt.Errorf("failed to create improve request revision: %w", err)
+		// Annotations stay on the published revisions: they are moved to a draft when it is published.
+		if exists && !draft {
+			if err := reanchorAnnotations(ctx, tx, sourceID, id, data.Content); err != nil {
+				return err
 			}
 		}
 
+		if err := insertReport(ctx, tx, report); err != nil {
+			return err
+		}
+
+		if err := insertEvents(ctx, tx, events, now); err != nil {
+			return err
+		}
+
 		output.UserID = userID
-		output.Title = title
-		output.Content = content
+		output.Title = data.Title
+		output.Content = data.Content
+		output.Tags = data.Tags
+		output.Draft = draft
 		output.Metadata = bunovel.Metadata{ID: sourceID, CreatedAt: now}
 
 		return nil
@@ -208,27 +513,189 @@ func (repository *improveRequestRepositoryImpl) Create(ctx context.Context, user
 	return output, nil
 }
 
-func (repository *improveRequestRepositoryImpl) DeleteRevision(ctx context.Context, id uuid.UUID) error {
+func (repository *improveRequestRepositoryImpl) Publish(ctx context.Context, id uuid.UUID, now time.Time, events ...*EventModelCore) (*ImproveRequestRevisionModel, error) {
 	model := &ImproveRequestRevisionModel{Metadata: bunovel.Metadata{ID: id}}
-	if _, err := repository.db.NewDelete().Model(model).WherePK().Exec(ctx); err != nil {
+
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewUpdate().
+			Model(model).
+			Set("draft = FALSE").
+			Set("published_at = ?", now).
+			WherePK().
+			Where("draft = TRUE").
+			Where("hidden = FALSE").
+			Where("deleted_at IS NULL").
+			Returning("*").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := reanchorAnnotations(ctx, tx, model.SourceID, id, model.Content); err != nil {
+			return err
+		}
+
+		return insertEvents(ctx, tx, events, now)
+	}); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *improveRequestRepositoryImpl) ApplySuggestion(ctx context.Context, userID, suggestionID, id uuid.UUID, now time.Time, events ...*EventModelCore) (*ImproveRequestPreview, error) {
+	output := new(ImproveRequestPreview)
+
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		suggestion := &ImproveSuggestionModel{Metadata: bunovel.Metadata{ID: suggestionID}}
+		// The lock holds concurrent applications of the same suggestion until this one is committed, so they see it
+		// validated.
+		if err := tx.NewSelect().Model(suggestion).WherePK().For("UPDATE").Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get improve suggestion: %w", err)
+		}
+		if suggestion.Validated {
+			return ErrSuggestionAlreadyApplied
+		}
+
+		// Revisions also keep track of the suggestions they were built from.
+		merged, err := tx.NewSelect().
+			Model((*ImproveRequestRevisionModel)(nil)).
+			Where("source_id = ?", suggestion.SourceID).
+			Where("? = ANY(suggestion_ids)", suggestionID).
+			Exists(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check improve request revisions: %w", err)
+		}
+		if merged {
+			return ErrSuggestionAlreadyApplied
+		}
+
+		suggestion.Validated = true
+		suggestion.UpdatedAt = &now
+		if _, err := tx.NewUpdate().Model(suggestion).Column("validated", "updated_at").WherePK().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to validate improve suggestion: %w", err)
+		}
+
+		language, err := requestLanguage(ctx, tx, suggestion.SourceID)
+		if err != nil {
+			return err
+		}
+
+		revisionModel := &ImproveRequestRevisionModel{
+			Metadata:      bunovel.NewMetadata(id, now, nil),
+			SourceID:      suggestion.SourceID,
+			UserID:        userID,
+			Title:         suggestion.Title,
+			Content:       suggestion.Content,
+			SuggestionIDs: []uuid.UUID{suggestionID},
+			Language:      language,
+		}
+
+		if err := tx.NewInsert().Model(revisionModel).Scan(ctx); err != nil {
+			return fmt.Errorf("failed to create improve request revision: %w", err)
+		}
+
+		if err := reanchorAnnotations(ctx, tx, suggestion.SourceID, id, suggestion.Content); err != nil {
+			return err
+		}
+
+		if err := insertEvents(ctx, tx, events, now); err != nil {
+			return err
+		}
+
+		output.UserID = userID
+		output.Title = suggestion.Title
+		output.Content = suggestion.Content
+		output.Metadata = bunovel.Metadata{ID: suggestion.SourceID, CreatedAt: now}
+
+		return nil
+	}); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return output, nil
+}
+
+func (repository *improveRequestRepositoryImpl) DeleteRevision(ctx context.Context, id uuid.UUID, policy SuggestionOrphanPolicy, now time.Time) error {
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		revision := &ImproveRequestRevisionModel{Metadata: bunovel.Metadata{ID: id}}
+		err := tx.NewSelect().Model(revision).WherePK().Where("deleted_at IS NULL").For("UPDATE").Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			// Nothing left to delete.
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get improve request revision: %w", err)
+		}
+
+		_, err = tx.NewUpdate().Model(revision).Set("deleted_at = ?", now).WherePK().Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete improve request revision: %w", err)
+		}
+
+		if policy == SuggestionOrphanPolicyReattach {
+			reattached, err := reattachSuggestions(ctx, tx, revision)
+			if err != nil {
+				return err
+			}
+			if reattached {
+				return nil
+			}
+		}
+
+		// Suggestions share the deletion date of their revision, so they are restored along with it.
+		_, err = tx.NewUpdate().
+			Model((*ImproveSuggestionModel)(nil)).
+			Set("deleted_at = ?", now).
+			Where("request_id = ?", id).
+			Where("deleted_at IS NULL").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete improve suggestions: %w", err)
+		}
+
+		return nil
+	}); err != nil {
 		return bunovel.HandlePGError(err)
 	}
 
 	return nil
 }
 
-func (repository *improveRequestRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+func (repository *improveRequestRepositoryImpl) Delete(ctx context.Context, id uuid.UUID, now time.Time) error {
 	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		model := &ImproveRequestModel{Metadata: bunovel.Metadata{ID: id}}
-		if _, err := tx.NewDelete().Model(model).WherePK().Exec(ctx); err != nil {
+		_, err := tx.NewUpdate().
+			Model((*ImproveRequestModel)(nil)).
+			Set("deleted_at = ?", now).
+			Where("id = ?", id).
+			Where("deleted_at IS NULL").
+			Exec(ctx)
+		if err != nil {
 			return fmt.Errorf("failed to delete improve request: %w", err)
 		}
 
-		revisionModel := new(ImproveRequestRevisionModel)
-		if _, err := tx.NewDelete().Model(revisionModel).Where("source_id = ?", id).Exec(ctx); err != nil {
+		// Revisions and suggestions share the deletion date of their request, so they can be told apart from the
+		// ones that were deleted on their own when the request is restored.
+		_, err = tx.NewUpdate().
+			Model((*ImproveRequestRevisionModel)(nil)).
+			Set("deleted_at = ?", now).
+			Where("source_id = ?", id).
+			Where("deleted_at IS NULL").
+			Exec(ctx)
+		if err != nil {
 			return fmt.Errorf("failed to delete improve request revisions: %w", err)
 		}
 
+		_, err = tx.NewUpdate().
+			Model((*ImproveSuggestionModel)(nil)).
+			Set("deleted_at = ?", now).
+			Where("source_id = ?", id).
+			Where("deleted_at IS NULL").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete improve suggestions: %w", err)
+		}
+
 		return nil
 	}); err != nil {
 		return bunovel.HandlePGError(err)
@@ -237,47 +704,387 @@ func (repository *improveRequestRepositoryImpl) Delete(ctx context.Context, id u
 	return nil
 }
 
+func (repository *improveRequestRepositoryImpl) GetDeletedRevision(ctx context.Context, id uuid.UUID) (*ImproveRequestRevisionModel, error) {
+	model := &ImproveRequestRevisionModel{Metadata: bunovel.Metadata{ID: id}}
+
+	if err := repository.db.NewSelect().Model(model).WherePK().Where("deleted_at IS NOT NULL").Scan(ctx); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *improveRequestRepositoryImpl) GetDeleted(ctx context.Context, id uuid.UUID) (*ImproveRequestRevisionModel, error) {
+	model := new(ImproveRequestRevisionModel)
+
+	deletedAt := repository.db.NewSelect().
+		Model((*ImproveRequestModel)(nil)).
+		Column("deleted_at").
+		Where("id = ?", id).
+		Where("deleted_at IS NOT NULL")
+
+	err := repository.db.NewSelect().
+		Model(model).
+		Where("source_id = ?", id).
+		Where("deleted_at = (?)", deletedAt).
+		Order("created_at DESC").
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *improveRequestRepositoryImpl) RestoreRevision(ctx context.Context, id uuid.UUID) (*ImproveRequestRevisionModel, error) {
+	model := &ImproveRequestRevisionModel{Metadata: bunovel.Metadata{ID: id}}
+
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		activeRequests := tx.NewSelect().
+			Model((*ImproveRequestModel)(nil)).
+			Column("id").
+			Where("deleted_at IS NULL")
+
+		err := tx.NewSelect().
+			Model(model).
+			WherePK().
+			Where("deleted_at IS NOT NULL").
+			Where("source_id IN (?)", activeRequests).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewUpdate().
+			Model((*ImproveSuggestionModel)(nil)).
+			Set("deleted_at = NULL").
+			Where("request_id = ?", id).
+			Where("deleted_at = ?", model.DeletedAt).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to restore improve suggestions: %w", err)
+		}
+
+		err = tx.NewUpdate().Model(model).Set("deleted_at = NULL").WherePK().Returning("*").Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to restore improve request revision: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *improveRequestRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) (*ImproveRequestPreview, error) {
+	output := &ImproveRequestPreview{Metadata: bunovel.Metadata{ID: id}}
+
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		model := &ImproveRequestModel{Metadata: bunovel.Metadata{ID: id}}
+		err := tx.NewSelect().Model(model).WherePK().Where("deleted_at IS NOT NULL").For("UPDATE").Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewUpdate().
+			Model((*ImproveRequestRevisionModel)(nil)).
+			Set("deleted_at = NULL").
+			Where("source_id = ?", id).
+			Where("deleted_at = ?", model.DeletedAt).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to restore improve request revisions: %w", err)
+		}
+
+		_, err = tx.NewUpdate().
+			Model((*ImproveSuggestionModel)(nil)).
+			Set("deleted_at = NULL").
+			Where("source_id = ?", id).
+			Where("deleted_at = ?", model.DeletedAt).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to restore improve suggestions: %w", err)
+		}
+
+		_, err = tx.NewUpdate().Model(model).Set("deleted_at = NULL").WherePK().Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to restore improve request: %w", err)
+		}
+
+		if err := tx.NewSelect().Model(output).WherePK().Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get restored improve request: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return output, nil
+}
+
+func (repository *improveRequestRepositoryImpl) Purge(ctx context.Context, before time.Time) (int, error) {
+	var purged int64
+
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Live suggestions can still point to a purged revision, when they were posted on it while it was being
+		// deleted. They follow the reattach policy, instead of being removed with the revision by the foreign keys.
+		liveSuggestions := tx.NewSelect().
+			Model((*ImproveSuggestionModel)(nil)).
+			Column("request_id").
+			Where("deleted_at IS NULL")
+
+		orphaned := make([]*ImproveRequestRevisionModel, 0)
+		err := tx.NewSelect().
+			Model(&orphaned).
+			Where("deleted_at < ?", before).
+			Where("id IN (?)", liveSuggestions).
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list purged improve request revisions with suggestions: %w", err)
+		}
+
+		for _, revision := range orphaned {
+			if _, err := reattachSuggestions(ctx, tx, revision); err != nil {
+				return err
+			}
+		}
+
+		// Revisions deleted with their request share its deletion date, so they are purged along with it. The
+		// suggestions made on purged revisions and requests are removed by the foreign keys.
+		res, err := tx.NewDelete().
+			Model((*ImproveRequestRevisionModel)(nil)).
+			Where("deleted_at < ?", before).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to purge improve request revisions: %w", err)
+		}
+
+		revisions, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to count purged improve request revisions: %w", err)
+		}
+
+		requests := tx.NewSelect().
+			Model((*ImproveRequestModel)(nil)).
+			Column("id").
+			Where("deleted_at < ?", before)
+
+		// Subscriptions are kept while the request can be restored, and only removed with it.
+		_, err = tx.NewDelete().
+			Model((*SubscriptionModel)(nil)).
+			Where("source_id IN (?)", requests).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to purge improve request subscriptions: %w", err)
+		}
+
+		res, err = tx.NewDelete().
+			Model((*ImproveRequestModel)(nil)).
+			Where("deleted_at < ?", before).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to purge improve requests: %w", err)
+		}
+
+		deleted, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to count purged improve requests: %w", err)
+		}
+
+		purged = revisions + deleted
+
+		return nil
+	}); err != nil {
+		return 0, bunovel.HandlePGError(err)
+	}
+
+	return int(purged), nil
+}
+
 func (repository *improveRequestRepositoryImpl) Search(ctx context.Context, query ImproveRequestSearchQuery, limit, offset int) ([]*ImproveRequestPreview, int, error) {
 	model := make([]*ImproveRequestPreview, 0)
 
-	queryBuilder := repository.db.NewSelect().Model(&model).Limit(limit).Offset(offset)
+	// Sort keys are only selected when they are used, so the default columns are listed explicitly.
+	queryBuilder := repository.db.NewSelect().Model(&model).ColumnExpr("?TableColumns").Limit(limit).Offset(offset)
 
 	if query.UserID != nil {
 		queryBuilder.Where("user_id = ?", query.UserID)
 	}
 
-	var orderBy []string
+	if query.Followed {
+		followed := repository.db.NewSelect().
+			Model((*SubscriptionModel)(nil)).
+			Column("source_id").
+			Where("user_id = ?", query.ViewerID)
+
+		queryBuilder.Where("id IN (?)", followed)
+	}
+
+	if query.ViewerID != nil {
+		queryBuilder.Where("(draft = FALSE OR user_id = ?)", *query.ViewerID)
+	} else {
+		queryBuilder.Where("draft = FALSE")
+	}
+
+	if query.Language != "" {
+		queryBuilder.Where("?TableAlias.language = ?", query.Language)
+	}
+
+	if len(query.Tags) > 0 {
+		tagged := repository.db.NewSelect().
+			Model((*ImproveRequestTagModel)(nil)).
+			Column("source_id").
+			Where("tag IN (?)", bun.In(query.Tags))
+
+		if query.AllTags {
+			tagged.Group("source_id").Having("COUNT(*) = ?", countUnique(query.Tags))
+		}
+
+		queryBuilder.Where("id IN (?)", tagged)
+	}
+
+	if query.CreatedAfter != nil {
+		queryBuilder.Where("created_at >= ?", *query.CreatedAfter)
+	}
+
+	if query.CreatedBefore != nil {
+		queryBuilder.Where("created_at <= ?", *query.CreatedBefore)
+	}
+
+	if query.MinScore != nil {
+		queryBuilder.Where("up_votes - down_votes >= ?", *query.MinScore)
+	}
+
+	if query.MaxScore != nil {
+		queryBuilder.Where("up_votes - down_votes <= ?", *query.MaxScore)
+	}
+
+	if query.MinSuggestions != nil {
+		queryBuilder.Where("suggestions_count >= ?", *query.MinSuggestions)
+	}
+
+	if query.MaxSuggestions != nil {
+		queryBuilder.Where("suggestions_count <= ?", *query.MaxSuggestions)
+	}
+
+	if query.HasAcceptedSuggestions != nil {
+		if *query.HasAcceptedSuggestions {
+			queryBuilder.Where("accepted_suggestions_count > 0")
+		} else {
+			queryBuilder.Where("accepted_suggestions_count = 0")
+		}
+	}
+
+	if query.MinRevisions != nil {
+		queryBuilder.Where("revisions_count >= ?", *query.MinRevisions)
+	}
+
+	if query.MaxRevisions != nil {
+		queryBuilder.Where("revisions_count <= ?", *query.MaxRevisions)
+	}
+
+	cursor := query.Cursor
+	if cursor == nil {
+		cursor = new(ImproveRequestSearchCursor)
+	}
+
+	var sortKeys []searchSortKey
 
 	if query.Query != "" {
-		queryFullText := repository.db.NewSelect().
-			ColumnExpr("to_tsquery('french', string_agg(lexeme || ':*', ' & ' order by positions)) AS query").
-			TableExpr("unnest(to_tsvector('french', unaccent(?)))", query.Query)
+		rank := "ts_rank_cd(text_searchable_index_col, search.query)"
+		match := "text_searchable_index_col @@ search.query"
+		if query.Fuzzy {
+			// A misspelled word shares no lexeme with the content, but most of its trigrams with a word of the title.
+			rank += " + word_similarity(search.text, title)"
+			match = "(" + match + " OR search.text <% title)"
+		}
 
 		queryBuilder = queryBuilder.
-			TableExpr("(?) AS search", queryFullText).
-			Where("text_searchable_index_col @@ search.query")
+			ColumnExpr(rank+" AS search_rank").
+			TableExpr("(?) AS search", textSearchQuery(repository.db, query.Query, query.Language)).
+			Where("?TableAlias.language = search.language").
+			Where(match)
 
-		orderBy = append(orderBy, "ts_rank_cd(text_searchable_index_col, search.query) DESC")
+		if query.Highlight {
+			// The text is escaped first, so the highlight tags are the only markup of the headline.
+			queryBuilder.
+				ColumnExpr(
+					"ts_headline(headline_config(?TableAlias.language), escape_html(title), search.query, ?) AS title_highlight",
+					titleHeadlineOptions,
+				).
+				ColumnExpr(
+					"ts_headline(headline_config(?TableAlias.language), escape_html(content), search.query, ?) AS content_highlight",
+					contentHeadlineOptions,
+				)
+		}
+
+		sortKeys = append(sortKeys, searchSortKey{
+			expr: rank,
+			// The rank is a real: the cursor value is cast back, so it compares equal to the rank it was read from.
+			placeholder: "?::real",
+			value:       cursor.Rank,
+		})
 	}
 
 	if query.Order != nil {
 		if query.Order.Score {
-			orderBy = append(orderBy, "up_votes - down_votes DESC")
+			sortKeys = append(sortKeys, searchSortKey{expr: "up_votes - down_votes", value: cursor.Score})
+		}
+		if query.Order.Activity {
+			queryBuilder.ColumnExpr("last_activity_at")
+			sortKeys = append(sortKeys, searchSortKey{expr: "last_activity_at", value: cursor.Activity})
+		}
+		if query.Order.Suggestions {
+			sortKeys = append(sortKeys, searchSortKey{expr: "suggestions_count", value: cursor.Suggestions})
+		}
+		if query.Order.Controversial {
+			queryBuilder.ColumnExpr("controversy")
+			sortKeys = append(sortKeys, searchSortKey{expr: "controversy", value: cursor.Controversy})
+		}
+		if query.Order.Hot {
+			queryBuilder.ColumnExpr("hotness")
+			sortKeys = append(sortKeys, searchSortKey{expr: "hotness", value: cursor.Hotness})
 		}
 	}
 
-	orderBy = append(orderBy, "created_at DESC")
+	sortKeys = append(
+		sortKeys,
+		searchSortKey{expr: "created_at", value: cursor.CreatedAt},
+		searchSortKey{expr: "id", value: cursor.ID},
+	)
 
-	queryBuilder = queryBuilder.OrderExpr(strings.Join(orderBy, ", "))
+	// The total is counted before the keyset condition of the cursor is added, so it stays the same on every page.
+	var count int
+	if !query.SkipCount {
+		var err error
+		if count, err = queryBuilder.Count(ctx); err != nil {
+			return nil, 0, bunovel.HandlePGError(err)
+		}
+	}
 
-	count, err := queryBuilder.ScanAndCount(ctx)
-	if err != nil {
+	queryBuilder = sortSearch(queryBuilder, sortKeys, query.Cursor != nil)
+
+	if err := queryBuilder.Scan(ctx); err != nil {
 		return nil, 0, bunovel.HandlePGError(err)
 	}
 
 	return model, count, nil
 }
 
+func (repository *improveRequestRepositoryImpl) SuggestQuery(ctx context.Context, query string) (string, error) {
+	suggestion, err := suggestSearchQuery(ctx, repository.db, (*ImproveRequestRevisionModel)(nil), query)
+	if err != nil {
+		return "", bunovel.HandlePGError(err)
+	}
+
+	return suggestion, nil
+}
+
 func (repository *improveRequestRepositoryImpl) List(ctx context.Context, ids []uuid.UUID) ([]*ImproveRequestPreview, error) {
 	model := make([]*ImproveRequestPreview, 0)
 
@@ -287,3 +1094,209 @@ func (repository *improveRequestRepositoryImpl) List(ctx context.Context, ids []
 
 	return model, nil
 }
+
+func (repository *improveRequestRepositoryImpl) SetStatus(ctx context.Context, id uuid.UUID, status RequestStatus, now time.Time) (*ImproveRequestPreview, error) {
+	output := &ImproveRequestPreview{Metadata: bunovel.Metadata{ID: id}}
+
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		model := &ImproveRequestModel{Metadata: bunovel.Metadata{ID: id}}
+
+		queryBuilder := tx.NewUpdate().
+			Model(model).
+			Set("status = ?", status).
+			Set("status_updated_at = ?", now).
+			WherePK().
+			Where("deleted_at IS NULL").
+			Returning("*")
+
+		if status == RequestStatusOpen {
+			queryBuilder.Set("close_after_days = NULL").Set("close_after_accepted = NULL")
+		}
+
+		if err := queryBuilder.Scan(ctx); err != nil {
+			return err
+		}
+
+		if err := tx.NewSelect().Model(output).WherePK().Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get updated improve request: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return output, nil
+}
+
+func (repository *improveRequestRepositoryImpl) SetClosePolicy(ctx context.Context, id uuid.UUID, closeAfterDays, closeAfterAccepted *int) (*ImproveRequestPreview, error) {
+	output := &ImproveRequestPreview{Metadata: bunovel.Metadata{ID: id}}
+
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		model := &ImproveRequestModel{Metadata: bunovel.Metadata{ID: id}}
+
+		err := tx.NewUpdate().
+			Model(model).
+			Set("close_after_days = ?", closeAfterDays).
+			Set("close_after_accepted = ?", closeAfterAccepted).
+			WherePK().
+			Where("deleted_at IS NULL").
+			Returning("*").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.NewSelect().Model(output).WherePK().Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get updated improve request: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return output, nil
+}
+
+func (repository *improveRequestRepositoryImpl) AutoClose(ctx context.Context, now time.Time) (int, error) {
+	var updated int64
+
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Resolution comes first: a request that reached its goal is resolved, even if it is also overdue.
+		accepted := tx.NewSelect().
+			Model((*ImproveRequestPreview)(nil)).
+			Column("id").
+			Where("status = ?", RequestStatusOpen).
+			Where("close_after_accepted IS NOT NULL").
+			Where("accepted_suggestions_count >= close_after_accepted")
+
+		res, err := tx.NewUpdate().
+			Model((*ImproveRequestModel)(nil)).
+			Set("status = ?", RequestStatusResolved).
+			Set("status_updated_at = ?", now).
+			Where("id IN (?)", accepted).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to resolve improve requests: %w", err)
+		}
+
+		resolved, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to count resolved improve requests: %w", err)
+		}
+
+		// The delay starts when the request was created, or reopened.
+		res, err = tx.NewUpdate().
+			Model((*ImproveRequestModel)(nil)).
+			Set("status = ?", RequestStatusClosed).
+			Set("status_updated_at = ?", now).
+			Where("status = ?", RequestStatusOpen).
+			Where("deleted_at IS NULL").
+			Where("close_after_days IS NOT NULL").
+			Where("COALESCE(status_updated_at, created_at) + close_after_days * INTERVAL '1 day' <= ?", now).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to close improve requests: %w", err)
+		}
+
+		closed, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to count closed improve requests: %w", err)
+		}
+
+		updated = resolved + closed
+
+		return nil
+	}); err != nil {
+		return 0, bunovel.HandlePGError(err)
+	}
+
+	return int(updated), nil
+}
+
+// requestLanguage returns the language of an improvement request, from any of its revisions.
+func requestLanguage(ctx context.Context, tx bun.IDB, sourceID uuid.UUID) (Language, error) {
+	var language Language
+
+	err := tx.NewSelect().
+		Model((*ImproveRequestRevisionModel)(nil)).
+		Column("language").
+		Where("source_id = ?", sourceID).
+		Limit(1).
+		Scan(ctx, &language)
+	if err != nil {
+		return "", fmt.Errorf("failed to get improve request language: %w", err)
+	}
+
+	return language, nil
+}
+
+// reattachSuggestions moves the live suggestions of a deleted revision to the nearest surviving revision of its
+// request. It returns false, and leaves the suggestions untouched, when no other revision survives.
+func reattachSuggestions(ctx context.Context, tx bun.IDB, revision *ImproveRequestRevisionModel) (bool, error) {
+	nearest, err := nearestRevision(ctx, tx, revision.SourceID, revision.CreatedAt)
+	if err != nil || nearest == nil {
+		return false, err
+	}
+
+	_, err = tx.NewUpdate().
+		Model((*ImproveSuggestionModel)(nil)).
+		Set("request_id = ?", nearest.ID).
+		Where("request_id = ?", revision.ID).
+		Where("deleted_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to reattach improve suggestions: %w", err)
+	}
+
+	return true, nil
+}
+
+// nearestRevision returns the surviving, published revision of a request that is the closest to a given date: the
+// latest revision created before it, or the oldest one created after it. It returns nil when no revision survives.
+// Drafts are skipped, so content moved to the nearest revision never ends up hidden from its readers.
+func nearestRevision(ctx context.Context, tx bun.IDB, sourceID uuid.UUID, date time.Time) (*ImproveRequestRevisionModel, error) {
+	revision := new(ImproveRequestRevisionModel)
+
+	err := tx.NewSelect().
+		Model(revision).
+		Where("source_id = ?", sourceID).
+		Where("deleted_at IS NULL").
+		Where("hidden = FALSE").
+		Where("draft = FALSE").
+		OrderExpr("created_at > ? ASC", date).
+		OrderExpr("ABS(EXTRACT(EPOCH FROM created_at - ?)) ASC", date).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find nearest revision: %w", err)
+	}
+
+	return revision, nil
+}
+
+// countUnique returns the number of distinct values in a list.
+func countUnique(values []string) int {
+	unique := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		unique[value] = struct{}{}
+	}
+
+	return len(unique)
+}
+
+func (repository *improveRequestRepositoryImpl) FindDuplicate(ctx context.Context, query DuplicateQuery, excludeSourceID uuid.UUID) (*Duplicate, error) {
+	queryBuilder := findDuplicate(repository.db, (*ImproveRequestRevisionModel)(nil), query).
+		Where("source_id != ?", excludeSourceID)
+
+	duplicate, err := scanDuplicate(ctx, queryBuilder)
+	if err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return duplicate, nil
+}
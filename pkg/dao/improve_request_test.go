@@ -60,6 +60,7 @@ func TestImproveRequestRepository_GetRevision(t *testing.T) {
 				UserID:   goframework.NumberUUID(100),
 				Title:    "my title with robots",
 				Content:  "my content with mechanics",
+				Language: dao.LanguageFrench,
 			},
 		},
 		{
@@ -144,6 +145,44 @@ func TestImproveRequestRepository_Get(t *testing.T) {
 			},
 		},
 
+		// The latest revision of each of these requests was moderated or deleted, so their previews show the previous one.
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(11), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+			SourceID: goframework.NumberUUID(11),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my visible title",
+			Content:  "my visible content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(11),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my hidden title",
+			Content:  "my hidden content",
+			Hidden:   true,
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(12), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(22), baseTime, nil),
+			SourceID: goframework.NumberUUID(12),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my live title",
+			Content:  "my live content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(23), baseTime.Add(time.Hour), nil),
+			SourceID:  goframework.NumberUUID(12),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my deleted title",
+			Content:   "my deleted content",
+			DeletedAt: &updateTime,
+		},
+
 		// Suggestions for goframework.NumberUUID(2).
 		&dao.ImproveSuggestionModel{
 			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, &updateTime),
@@ -189,6 +228,31 @@ func TestImproveRequestRepository_Get(t *testing.T) {
 				RevisionCount:            2,
 				SuggestionsCount:         5,
 				AcceptedSuggestionsCount: 3,
+				Status:                   dao.RequestStatusOpen,
+			},
+		},
+		{
+			name: "Success/LatestRevisionHidden",
+			id:   goframework.NumberUUID(11),
+			expect: &dao.ImproveRequestPreview{
+				Metadata:      bunovel.NewMetadata(goframework.NumberUUID(11), baseTime, nil),
+				UserID:        goframework.NumberUUID(100),
+				Title:         "my visible title",
+				Content:       "my visible content",
+				RevisionCount: 1,
+				Status:        dao.RequestStatusOpen,
+			},
+		},
+		{
+			name: "Success/LatestRevisionDeleted",
+			id:   goframework.NumberUUID(12),
+			expect: &dao.ImproveRequestPreview{
+				Metadata:      bunovel.NewMetadata(goframework.NumberUUID(12), baseTime, nil),
+				UserID:        goframework.NumberUUID(100),
+				Title:         "my live title",
+				Content:       "my live content",
+				RevisionCount: 1,
+				Status:        dao.RequestStatusOpen,
 			},
 		},
 		{
@@ -311,11 +375,13 @@ func TestImproveRequestRepository_ListRevisions(t *testing.T) {
 			expect: []*dao.ImproveRequestRevisionPreview{
 				{
 					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), &updateTime),
+					UserID:                   goframework.NumberUUID(100),
 					SuggestionsCount:         2,
 					AcceptedSuggestionsCount: 1,
 				},
 				{
 					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+					UserID:                   goframework.NumberUUID(100),
 					SuggestionsCount:         3,
 					AcceptedSuggestionsCount: 2,
 				},
@@ -342,7 +408,7 @@ func TestImproveRequestRepository_ListRevisions(t *testing.T) {
 	require.NoError(t, err)
 }
 
-func TestImproveRequestRepository_UpdateVotes(t *testing.T) {
+func TestImproveRequestRepository_Create(t *testing.T) {
 	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
 	defer db.Close()
 	defer sqlDB.Close()
@@ -360,83 +426,149 @@ func TestImproveRequestRepository_UpdateVotes(t *testing.T) {
 			Title:    "my title with robots",
 			Content:  "my content with mechanics",
 		},
-	}
-
-	data := []struct {
-		name string
-
-		id        uuid.UUID
-		upVotes   int
-		downVotes int
-
-		expectErr error
-	}{
-		{
-			name:      "Success",
-			id:        goframework.NumberUUID(10),
-			upVotes:   256,
-			downVotes: 512,
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(30), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(300),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my content with robots",
+			},
 		},
-		{
-			name:      "Error/NotFound",
-			id:        goframework.NumberUUID(3),
-			expectErr: bunovel.ErrNotFound,
-			upVotes:   256,
-			downVotes: 512,
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(31), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(300),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title with robots",
+				Content:   "my content",
+			},
 		},
-	}
-
-	for _, d := range data {
-		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
-			repository := dao.NewImproveRequestRepository(tx)
-
-			t.Run(d.name, func(st *testing.T) {
-				err := repository.UpdateVotes(ctx, d.id, d.upVotes, d.downVotes)
-				require.ErrorIs(t, err, d.expectErr)
-			})
-		})
-		require.NoError(t, err)
-	}
-}
-
-func TestImproveRequestRepository_Create(t *testing.T) {
-	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
-	defer db.Close()
-	defer sqlDB.Close()
-
-	fixtures := []interface{}{
-		&dao.ImproveRequestModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
-			UpVotes:   160,
-			DownVotes: 80,
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(32), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(300),
+			Validated: true,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my validated content",
+			},
 		},
-		&dao.ImproveRequestRevisionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+		// Merged into a revision, without being marked as validated.
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(33), baseTime, nil),
 			SourceID: goframework.NumberUUID(10),
-			UserID:   goframework.NumberUUID(100),
-			Title:    "my title with robots",
-			Content:  "my content with mechanics",
+			UserID:   goframework.NumberUUID(300),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my merged content",
+			},
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata:      bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(time.Minute), nil),
+			SourceID:      goframework.NumberUUID(10),
+			UserID:        goframework.NumberUUID(100),
+			Title:         "my title",
+			Content:       "my merged content",
+			SuggestionIDs: []uuid.UUID{goframework.NumberUUID(33)},
+		},
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(40), baseTime, nil),
+			DeletedAt: &updateTime,
 		},
+		&dao.TagModel{Name: "horror", CreatedAt: baseTime},
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(10), Tag: "horror", CreatedAt: baseTime},
 	}
 
 	data := []struct {
 		name string
 
+		data     *dao.ImproveRequestModelCore
+		draft    bool
 		userID   uuid.UUID
-		title    string
-		content  string
 		sourceID uuid.UUID
 		id       uuid.UUID
 		now      time.Time
 
-		expect    *dao.ImproveRequestPreview
-		expectErr error
+		expect           *dao.ImproveRequestPreview
+		expectLanguage   dao.Language
+		expectTags       []string
+		expectSubscribed bool
+		expectErr        error
 	}{
 		{
-			name:     "Success",
-			userID:   goframework.NumberUUID(200),
-			title:    "my title",
-			content:  "my content",
+			name:   "Success",
+			userID: goframework.NumberUUID(200),
+			data: &dao.ImproveRequestModelCore{
+				Title:   "my title",
+				Content: "my content",
+			},
+			sourceID: goframework.NumberUUID(20),
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			expect: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				Title:    "my title",
+				Content:  "my content",
+			},
+			expectLanguage:   dao.LanguageFrench,
+			expectSubscribed: true,
+		},
+		{
+			name:   "Success/Language",
+			userID: goframework.NumberUUID(200),
+			data: &dao.ImproveRequestModelCore{
+				Title:    "my title",
+				Content:  "my content",
+				Language: dao.LanguageEnglish,
+			},
+			sourceID: goframework.NumberUUID(20),
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			expect: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				Title:    "my title",
+				Content:  "my content",
+			},
+			expectLanguage:   dao.LanguageEnglish,
+			expectSubscribed: true,
+		},
+		{
+			name:   "Success/Revision",
+			userID: goframework.NumberUUID(200),
+			data: &dao.ImproveRequestModelCore{
+				Title:    "my title",
+				Content:  "my content",
+				Language: dao.LanguageEnglish,
+			},
+			// Revisions keep the language of their request.
+			sourceID: goframework.NumberUUID(10),
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			expect: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				Title:    "my title",
+				Content:  "my content",
+			},
+			expectLanguage: dao.LanguageFrench,
+			// Tags are left unchanged when omitted.
+			expectTags: []string{"horror"},
+		},
+		{
+			name:   "Success/Tags",
+			userID: goframework.NumberUUID(200),
+			data: &dao.ImproveRequestModelCore{
+				Title:   "my title",
+				Content: "my content",
+				Tags:    []string{"horror", "dialogue"},
+			},
 			sourceID: goframework.NumberUUID(20),
 			id:       goframework.NumberUUID(2),
 			now:      baseTime,
@@ -445,13 +577,41 @@ func TestImproveRequestRepository_Create(t *testing.T) {
 				UserID:   goframework.NumberUUID(200),
 				Title:    "my title",
 				Content:  "my content",
+				Tags:     []string{"horror", "dialogue"},
+			},
+			expectLanguage:   dao.LanguageFrench,
+			expectTags:       []string{"dialogue", "horror"},
+			expectSubscribed: true,
+		},
+		{
+			name:   "Success/RevisionReplacesTags",
+			userID: goframework.NumberUUID(200),
+			data: &dao.ImproveRequestModelCore{
+				Title:   "my title",
+				Content: "my content",
+				Tags:    []string{"opening-chapter"},
+			},
+			sourceID: goframework.NumberUUID(10),
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			expect: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				Title:    "my title",
+				Content:  "my content",
+				Tags:     []string{"opening-chapter"},
 			},
+			expectLanguage: dao.LanguageFrench,
+			expectTags:     []string{"opening-chapter"},
 		},
 		{
-			name:     "Success/Revision",
-			userID:   goframework.NumberUUID(200),
-			title:    "my title",
-			content:  "my content",
+			name:   "Success/RevisionClearsTags",
+			userID: goframework.NumberUUID(200),
+			data: &dao.ImproveRequestModelCore{
+				Title:   "my title",
+				Content: "my content",
+				Tags:    []string{},
+			},
 			sourceID: goframework.NumberUUID(10),
 			id:       goframework.NumberUUID(2),
 			now:      baseTime,
@@ -460,7 +620,88 @@ func TestImproveRequestRepository_Create(t *testing.T) {
 				UserID:   goframework.NumberUUID(200),
 				Title:    "my title",
 				Content:  "my content",
+				Tags:     []string{},
+			},
+			expectLanguage: dao.LanguageFrench,
+		},
+		{
+			name:   "Success/Draft",
+			userID: goframework.NumberUUID(200),
+			data: &dao.ImproveRequestModelCore{
+				Title:   "my title",
+				Content: "my content",
+			},
+			draft:    true,
+			sourceID: goframework.NumberUUID(20),
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			expect: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				Title:    "my title",
+				Content:  "my content",
+				Draft:    true,
+			},
+			expectLanguage:   dao.LanguageFrench,
+			expectSubscribed: true,
+		},
+		{
+			name:   "Success/FromSuggestions",
+			userID: goframework.NumberUUID(100),
+			data: &dao.ImproveRequestModelCore{
+				Title:         "my title",
+				Content:       "my content",
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(30), goframework.NumberUUID(31)},
+			},
+			sourceID: goframework.NumberUUID(10),
+			id:       goframework.NumberUUID(2),
+			now:      updateTime,
+			expect: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), updateTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "my content",
+			},
+			expectLanguage: dao.LanguageFrench,
+			expectTags:     []string{"horror"},
+		},
+		{
+			name:   "Error/SuggestionAlreadyValidated",
+			userID: goframework.NumberUUID(100),
+			data: &dao.ImproveRequestModelCore{
+				Title:         "my title",
+				Content:       "my content",
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(30), goframework.NumberUUID(32)},
+			},
+			sourceID:  goframework.NumberUUID(10),
+			id:        goframework.NumberUUID(2),
+			now:       updateTime,
+			expectErr: dao.ErrSuggestionAlreadyApplied,
+		},
+		{
+			name:   "Error/SuggestionAlreadyMerged",
+			userID: goframework.NumberUUID(100),
+			data: &dao.ImproveRequestModelCore{
+				Title:         "my title",
+				Content:       "my content",
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(30), goframework.NumberUUID(33)},
 			},
+			sourceID:  goframework.NumberUUID(10),
+			id:        goframework.NumberUUID(2),
+			now:       updateTime,
+			expectErr: dao.ErrSuggestionAlreadyApplied,
+		},
+		{
+			name:   "Error/RequestDeleted",
+			userID: goframework.NumberUUID(200),
+			data: &dao.ImproveRequestModelCore{
+				Title:   "my title",
+				Content: "my content",
+			},
+			sourceID:  goframework.NumberUUID(40),
+			id:        goframework.NumberUUID(2),
+			now:       updateTime,
+			expectErr: bunovel.ErrNotFound,
 		},
 	}
 
@@ -468,671 +709,3363 @@ func TestImproveRequestRepository_Create(t *testing.T) {
 		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
 			repository := dao.NewImproveRequestRepository(tx)
 			t.Run(d.name, func(st *testing.T) {
-				res, err := repository.Create(ctx, d.userID, d.title, d.content, d.sourceID, d.id, d.now)
+				res, err := repository.Create(
+					ctx, d.data, d.draft, d.userID, d.sourceID, d.id, nil, d.now,
+				)
 				require.Equal(t, d.expect, res)
 				require.ErrorIs(t, err, d.expectErr)
+
+				if err != nil {
+					return
+				}
+
+				revision, err := repository.GetRevision(ctx, d.id)
+				require.NoError(t, err)
+				require.Equal(t, d.data.SuggestionIDs, revision.SuggestionIDs)
+				require.Equal(t, d.expectLanguage, revision.Language)
+				require.Equal(t, d.draft, revision.Draft)
+
+				request, err := repository.Get(ctx, d.sourceID)
+				require.NoError(t, err)
+				require.Equal(t, d.expectTags, request.Tags)
+
+				// Suggestions the revision was made from are accepted along the way.
+				for _, suggestionID := range d.data.SuggestionIDs {
+					suggestion, err := dao.NewImproveSuggestionRepository(tx).Get(ctx, suggestionID)
+					require.NoError(t, err)
+					require.True(t, suggestion.Validated)
+				}
+
+				// The author of a new request follows it.
+				subscribed, err := tx.NewSelect().
+					Model(&dao.SubscriptionModel{UserID: d.userID, SourceID: d.sourceID}).
+					WherePK().
+					Exists(ctx)
+				require.NoError(t, err)
+				require.Equal(t, d.expectSubscribed, subscribed)
 			})
 		})
 		require.NoError(t, err)
 	}
 }
 
-func TestImproveRequestRepository_Delete(t *testing.T) {
+// A new request used to be created without its first revision, which left it without a title nor a content.
+func TestImproveRequestRepository_Create_FirstRevision(t *testing.T) {
 	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
 	defer db.Close()
 	defer sqlDB.Close()
 
-	fixtures := []interface{}{
-		&dao.ImproveRequestModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
-			UpVotes:   160,
-			DownVotes: 80,
-		},
-		&dao.ImproveRequestRevisionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
-			SourceID: goframework.NumberUUID(10),
-			UserID:   goframework.NumberUUID(100),
-			Title:    "my title with robots",
-			Content:  "my content with mechanics",
-		},
-	}
-
-	data := []struct {
-		name string
+	err := bunovel.RunTransactionalTest(db, nil, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveRequestRepository(tx)
 
-		id uuid.UUID
+		_, err := repository.Create(
+			ctx,
+			&dao.ImproveRequestModelCore{Title: "my title", Content: "my content", Language: dao.LanguageFrench},
+			false, goframework.NumberUUID(100), goframework.NumberUUID(10), goframework.NumberUUID(1), nil, baseTime,
+		)
+		require.NoError(t, err)
 
-		expectErr error
-	}{
-		{
-			name: "Success",
-			id:   goframework.NumberUUID(10),
-		},
-		{
-			name: "Success/NotFound",
-			id:   goframework.NumberUUID(20),
-		},
-	}
+		revision, err := repository.GetRevision(ctx, goframework.NumberUUID(1))
+		require.NoError(t, err)
+		require.Equal(t, goframework.NumberUUID(10), revision.SourceID)
+		require.Equal(t, "my title", revision.Title)
+		require.Equal(t, "my content", revision.Content)
 
-	for _, d := range data {
-		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
-			repository := dao.NewImproveRequestRepository(tx)
-			t.Run(d.name, func(st *testing.T) {
-				err := repository.Delete(ctx, d.id)
-				require.ErrorIs(t, err, d.expectErr)
-			})
-		})
+		revisions, err := repository.ListRevisions(ctx, goframework.NumberUUID(10))
 		require.NoError(t, err)
-	}
+		require.Len(t, revisions, 1)
+	})
+	require.NoError(t, err)
 }
 
-func TestImproveRequestRepository_DeleteRevision(t *testing.T) {
+func TestImproveRequestRepository_CreateReanchorsAnnotations(t *testing.T) {
 	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
 	defer db.Close()
 	defer sqlDB.Close()
 
 	fixtures := []interface{}{
 		&dao.ImproveRequestModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
-			UpVotes:   160,
-			DownVotes: 80,
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
 		},
 		&dao.ImproveRequestRevisionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
 			SourceID: goframework.NumberUUID(10),
 			UserID:   goframework.NumberUUID(100),
-			Title:    "my title with robots",
-			Content:  "my content with mechanics",
+			Title:    "my title",
+			Content:  "The quick brown fox jumps.",
+		},
+		// The annotated text is removed by the new revision.
+		&dao.AnnotationModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1001), baseTime, nil),
+			UserID:   goframework.NumberUUID(200),
+			SourceID: goframework.NumberUUID(10),
+			AnnotationModelCore: dao.AnnotationModelCore{
+				RevisionID:  goframework.NumberUUID(1),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "first annotation",
+			},
+		},
+		// The annotated text moves in the new revision.
+		&dao.AnnotationModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1002), baseTime, nil),
+			UserID:   goframework.NumberUUID(200),
+			SourceID: goframework.NumberUUID(10),
+			AnnotationModelCore: dao.AnnotationModelCore{
+				RevisionID:  goframework.NumberUUID(1),
+				StartOffset: 16,
+				EndOffset:   19,
+				Quote:       "fox",
+				Content:     "second annotation",
+			},
+		},
+		// The annotated revision no longer exists.
+		&dao.AnnotationModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1003), baseTime, nil),
+			UserID:   goframework.NumberUUID(200),
+			SourceID: goframework.NumberUUID(10),
+			AnnotationModelCore: dao.AnnotationModelCore{
+				RevisionID:  goframework.NumberUUID(3),
+				StartOffset: 0,
+				EndOffset:   3,
+				Quote:       "The",
+				Content:     "third annotation",
+			},
 		},
 	}
 
-	data := []struct {
-		name string
-
-		id uuid.UUID
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveRequestRepository(tx)
+		annotationRepository := dao.NewAnnotationRepository(tx)
+
+		_, err := repository.Create(
+			ctx,
+			&dao.ImproveRequestModelCore{Title: "my title", Content: "The slow brown fox jumps."},
+			false,
+			goframework.NumberUUID(100),
+			goframework.NumberUUID(10),
+			goframework.NumberUUID(2),
+			nil,
+			updateTime,
+		)
+		require.NoError(t, err)
 
-		expectErr error
-	}{
-		{
-			name: "Success",
-			id:   goframework.NumberUUID(1),
-		},
-		{
-			name: "Success/NotFound",
-			id:   goframework.NumberUUID(2),
-		},
-	}
+		moved, err := annotationRepository.List(ctx, dao.AnnotationListQuery{RevisionID: goframework.NumberUUID(2)})
+		require.NoError(t, err)
+		require.Equal(t, []*dao.AnnotationModel{
+			{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1002), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				SourceID: goframework.NumberUUID(10),
+				AnnotationModelCore: dao.AnnotationModelCore{
+					RevisionID:  goframework.NumberUUID(2),
+					StartOffset: 15,
+					EndOffset:   18,
+					Quote:       "fox",
+					Content:     "second annotation",
+				},
+			},
+		}, moved)
 
-	for _, d := range data {
-		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
-			repository := dao.NewImproveRequestRepository(tx)
-			t.Run(d.name, func(st *testing.T) {
-				err := repository.DeleteRevision(ctx, d.id)
-				require.ErrorIs(t, err, d.expectErr)
-			})
+		orphaned, err := annotationRepository.List(ctx, dao.AnnotationListQuery{
+			RevisionID: goframework.NumberUUID(1),
+			Orphaned:   lo.ToPtr(true),
 		})
 		require.NoError(t, err)
-	}
+		require.Equal(t, []*dao.AnnotationModel{
+			{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1001), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				SourceID: goframework.NumberUUID(10),
+				Orphaned: true,
+				AnnotationModelCore: dao.AnnotationModelCore{
+					RevisionID:  goframework.NumberUUID(1),
+					StartOffset: 4,
+					EndOffset:   9,
+					Quote:       "quick",
+					Content:     "first annotation",
+				},
+			},
+		}, orphaned)
+
+		deleted, err := annotationRepository.Get(ctx, goframework.NumberUUID(1003))
+		require.NoError(t, err)
+		require.True(t, deleted.Orphaned)
+		require.Equal(t, goframework.NumberUUID(3), deleted.RevisionID)
+	})
+	require.NoError(t, err)
 }
 
-func TestImproveRequestRepository_Search(t *testing.T) {
+func TestImproveRequestRepository_Publish(t *testing.T) {
 	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
 	defer db.Close()
 	defer sqlDB.Close()
 
 	fixtures := []interface{}{
 		&dao.ImproveRequestModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
-			UpVotes:   160,
-			DownVotes: 80,
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
 		},
-
 		&dao.ImproveRequestRevisionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
 			SourceID: goframework.NumberUUID(10),
 			UserID:   goframework.NumberUUID(100),
-			Title:    "my title with robots",
-			Content:  "my content with mechanics",
+			Title:    "my title",
+			Content:  "my content",
 		},
 		&dao.ImproveRequestRevisionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), &updateTime),
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Minute), nil),
 			SourceID: goframework.NumberUUID(10),
 			UserID:   goframework.NumberUUID(100),
-			Title:    "my title with spaceships",
-			Content:  "my content with thrusters",
-		},
-
-		&dao.ImproveRequestModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
-			UpVotes:   128,
-			DownVotes: 64,
+			Title:    "my draft title",
+			Content:  "my draft content",
+			Draft:    true,
 		},
-
 		&dao.ImproveRequestRevisionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Hour), &updateTime),
-			SourceID: goframework.NumberUUID(20),
-			UserID:   goframework.NumberUUID(200),
-			Title:    "my title with thrusters",
-			Content:  "my content with spaceships",
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Minute), nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my deleted title",
+			Content:   "my deleted content",
+			Draft:     true,
+			DeletedAt: &baseTime,
 		},
+	}
 
-		&dao.ImproveRequestModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
-			UpVotes:   128,
-			DownVotes: 64,
-		},
+	data := []struct {
+		name string
 
-		&dao.ImproveRequestRevisionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime.Add(3*time.Hour), &updateTime),
-			SourceID: goframework.NumberUUID(30),
-			UserID:   goframework.NumberUUID(300),
-			Title:    "my title with super thrusters",
-			Content:  "my content with super spaceships",
+		id  uuid.UUID
+		now time.Time
+
+		expectTitle string
+		expectErr   error
+	}{
+		{
+			name:        "Success",
+			id:          goframework.NumberUUID(2),
+			now:         updateTime,
+			expectTitle: "my draft title",
+		},
+		{
+			name:      "Error/AlreadyPublished",
+			id:        goframework.NumberUUID(1),
+			now:       updateTime,
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/Deleted",
+			id:        goframework.NumberUUID(3),
+			now:       updateTime,
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/NotFound",
+			id:        goframework.NumberUUID(4),
+			now:       updateTime,
+			expectErr: bunovel.ErrNotFound,
 		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveRequestRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Publish(ctx, d.id, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+
+				if err != nil {
+					return
+				}
+
+				require.False(t, res.Draft)
+				require.Equal(t, &d.now, res.PublishedAt)
+
+				// The published revision becomes the latest one.
+				request, err := repository.Get(ctx, res.SourceID)
+				require.NoError(t, err)
+				require.False(t, request.Draft)
+				require.Equal(t, d.expectTitle, request.Title)
+				require.Equal(t, 2, request.RevisionCount)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
 
+func TestImproveRequestRepository_ApplySuggestion(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
 		&dao.ImproveRequestModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(40), baseTime.Add(4*time.Hour), &updateTime),
-			UpVotes:   128,
-			DownVotes: 64,
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
 		},
-
 		&dao.ImproveRequestRevisionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(5), baseTime.Add(4*time.Hour), &updateTime),
-			SourceID: goframework.NumberUUID(40),
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
 			UserID:   goframework.NumberUUID(100),
-			Title:    "my title with tomatoes",
-			Content:  "my content with super chips",
+			Title:    "my title",
+			Content:  "my content",
 		},
-
-		// Suggestions for goframework.NumberUUID(1).
 		&dao.ImproveSuggestionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
 			SourceID: goframework.NumberUUID(10),
-			UserID:   uuid.Nil,
+			UserID:   goframework.NumberUUID(200),
 			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
 				RequestID: goframework.NumberUUID(1),
-				Title:     "suggestion title",
-				Content:   "suggestion content",
+				Title:     "my suggested title",
+				Content:   "my suggested content",
 			},
 		},
 		&dao.ImproveSuggestionModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &updateTime),
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(21), baseTime, nil),
 			SourceID:  goframework.NumberUUID(10),
-			UserID:    uuid.Nil,
+			UserID:    goframework.NumberUUID(200),
 			Validated: true,
 			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
 				RequestID: goframework.NumberUUID(1),
-				Title:     "suggestion title",
-				Content:   "suggestion content",
+				Title:     "my suggested title",
+				Content:   "my validated content",
 			},
 		},
+		// Merged into a revision, without being marked as validated.
 		&dao.ImproveSuggestionModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, &updateTime),
-			SourceID:  goframework.NumberUUID(10),
-			UserID:    uuid.Nil,
-			Validated: true,
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(22), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
 			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
 				RequestID: goframework.NumberUUID(1),
-				Title:     "suggestion title",
-				Content:   "suggestion content",
+				Title:     "my suggested title",
+				Content:   "my merged content",
 			},
 		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata:      bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(time.Hour), nil),
+			SourceID:      goframework.NumberUUID(10),
+			UserID:        goframework.NumberUUID(100),
+			Title:         "my suggested title",
+			Content:       "my merged content",
+			SuggestionIDs: []uuid.UUID{goframework.NumberUUID(22)},
+		},
+	}
 
-		// Suggestions for goframework.NumberUUID(2).
+	data := []struct {
+		name string
+
+		userID       uuid.UUID
+		suggestionID uuid.UUID
+		id           uuid.UUID
+		now          time.Time
+
+		expect         *dao.ImproveRequestPreview
+		expectRevision *dao.ImproveRequestRevisionModel
+		expectErr      error
+	}{
+		{
+			name:         "Success",
+			userID:       goframework.NumberUUID(100),
+			suggestionID: goframework.NumberUUID(20),
+			id:           goframework.NumberUUID(2),
+			now:          updateTime,
+			expect: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), updateTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my suggested title",
+				Content:  "my suggested content",
+			},
+			expectRevision: &dao.ImproveRequestRevisionModel{
+				Metadata:      bunovel.NewMetadata(goframework.NumberUUID(2), updateTime, nil),
+				SourceID:      goframework.NumberUUID(10),
+				UserID:        goframework.NumberUUID(100),
+				Title:         "my suggested title",
+				Content:       "my suggested content",
+				Language:      dao.LanguageFrench,
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20)},
+			},
+		},
+		{
+			name:         "Error/AlreadyValidated",
+			userID:       goframework.NumberUUID(100),
+			suggestionID: goframework.NumberUUID(21),
+			id:           goframework.NumberUUID(2),
+			now:          updateTime,
+			expectErr:    dao.ErrSuggestionAlreadyApplied,
+		},
+		{
+			name:         "Error/AlreadyMerged",
+			userID:       goframework.NumberUUID(100),
+			suggestionID: goframework.NumberUUID(22),
+			id:           goframework.NumberUUID(2),
+			now:          updateTime,
+			expectErr:    dao.ErrSuggestionAlreadyApplied,
+		},
+		{
+			name:         "Error/NotFound",
+			userID:       goframework.NumberUUID(100),
+			suggestionID: goframework.NumberUUID(23),
+			id:           goframework.NumberUUID(2),
+			now:          updateTime,
+			expectErr:    bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveRequestRepository(tx)
+			suggestionRepository := dao.NewImproveSuggestionRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.ApplySuggestion(ctx, d.userID, d.suggestionID, d.id, d.now)
+				require.Equal(t, d.expect, res)
+				require.ErrorIs(t, err, d.expectErr)
+
+				if d.expectRevision != nil {
+					revision, err := repository.GetRevision(ctx, d.id)
+					require.NoError(t, err)
+					require.Equal(t, d.expectRevision, revision)
+
+					suggestion, err := suggestionRepository.Get(ctx, d.suggestionID)
+					require.NoError(t, err)
+					require.True(t, suggestion.Validated)
+				}
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestImproveRequestRepository_Delete(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
+			UpVotes:   160,
+			DownVotes: 80,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title with robots",
+			Content:  "my content with mechanics",
+		},
+		// This revision was deleted on its own, before the request.
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &updateTime),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my title with robots",
+			Content:   "my content with androids",
+			DeletedAt: &baseTime,
+		},
 		&dao.ImproveSuggestionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, &updateTime),
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(30), baseTime, nil),
 			SourceID: goframework.NumberUUID(10),
-			UserID:   uuid.Nil,
+			UserID:   goframework.NumberUUID(200),
 			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
-				RequestID: goframework.NumberUUID(2),
-				Title:     "suggestion title",
-				Content:   "suggestion content",
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my suggested content",
 			},
 		},
-		&dao.ImproveSuggestionModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(5), baseTime, &updateTime),
+		&dao.SubscriptionModel{
+			UserID:    goframework.NumberUUID(100),
 			SourceID:  goframework.NumberUUID(10),
-			UserID:    uuid.Nil,
-			Validated: true,
-			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
-				RequestID: goframework.NumberUUID(2),
-				Title:     "suggestion title",
-				Content:   "suggestion content",
-			},
+			CreatedAt: baseTime,
 		},
 	}
 
 	data := []struct {
 		name string
 
-		query  dao.ImproveRequestSearchQuery
-		limit  int
-		offset int
+		id  uuid.UUID
+		now time.Time
 
-		expect      []*dao.ImproveRequestPreview
-		expectCount int
-		expectErr   error
+		expectRevisionsDeletedAt   map[uuid.UUID]time.Time
+		expectSuggestionsDeletedAt map[uuid.UUID]time.Time
+		expectErr                  error
 	}{
 		{
 			name: "Success",
-			expect: []*dao.ImproveRequestPreview{
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(40), baseTime.Add(4*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(100),
-					Title:         "my title with tomatoes",
-					Content:       "my content with super chips",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(300),
-					Title:         "my title with super thrusters",
-					Content:       "my content with super spaceships",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(200),
-					Title:         "my title with thrusters",
-					Content:       "my content with spaceships",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
-				{
-					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
-					UserID:                   goframework.NumberUUID(100),
-					Title:                    "my title with spaceships",
-					Content:                  "my content with thrusters",
-					UpVotes:                  160,
-					DownVotes:                80,
-					RevisionCount:            2,
-					SuggestionsCount:         5,
-					AcceptedSuggestionsCount: 3,
-				},
+			id:   goframework.NumberUUID(10),
+			now:  updateTime,
+			expectRevisionsDeletedAt: map[uuid.UUID]time.Time{
+				goframework.NumberUUID(1): updateTime,
+				goframework.NumberUUID(2): baseTime,
+			},
+			expectSuggestionsDeletedAt: map[uuid.UUID]time.Time{
+				goframework.NumberUUID(30): updateTime,
 			},
-			expectCount: 4,
 		},
 		{
-			name: "Success/WithQuery",
-			query: dao.ImproveRequestSearchQuery{
-				Query: "spaceships",
-			},
-			expect: []*dao.ImproveRequestPreview{
-				// Most relevant, presence in title has more value.
-				{
-					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
-					UserID:                   goframework.NumberUUID(100),
-					Title:                    "my title with spaceships",
-					Content:                  "my content with thrusters",
-					UpVotes:                  160,
-					DownVotes:                80,
-					RevisionCount:            2,
-					SuggestionsCount:         5,
-					AcceptedSuggestionsCount: 3,
-				},
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(300),
-					Title:         "my title with super thrusters",
-					Content:       "my content with super spaceships",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(200),
-					Title:         "my title with thrusters",
-					Content:       "my content with spaceships",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
+			name: "Success/NotFound",
+			id:   goframework.NumberUUID(20),
+			now:  updateTime,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveRequestRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				err := repository.Delete(ctx, d.id, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+
+				if d.expectRevisionsDeletedAt == nil {
+					return
+				}
+
+				_, err = repository.Get(ctx, d.id)
+				require.ErrorIs(t, err, bunovel.ErrNotFound)
+
+				for id, deletedAt := range d.expectRevisionsDeletedAt {
+					revision, err := repository.GetDeletedRevision(ctx, id)
+					require.NoError(t, err)
+					require.Equal(t, deletedAt, *revision.DeletedAt)
+				}
+
+				for id, deletedAt := range d.expectSuggestionsDeletedAt {
+					suggestion, err := dao.NewImproveSuggestionRepository(tx).GetDeleted(ctx, id)
+					require.NoError(t, err)
+					require.Equal(t, deletedAt, *suggestion.DeletedAt)
+				}
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestImproveRequestRepository_DeleteRevision(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Minute), nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Minute), nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(30), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(2),
+				Title:     "my title",
+				Content:   "my suggested content",
 			},
-			expectCount: 3,
 		},
+		// This suggestion was deleted on its own, before the revision.
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(31), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(200),
+			DeletedAt: &baseTime,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(2),
+				Title:     "my title",
+				Content:   "my suggested content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(32), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my suggested content",
+			},
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(33), baseTime, nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(4),
+				Title:     "my title",
+				Content:   "my suggested content",
+			},
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(40), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(7), baseTime, nil),
+			SourceID: goframework.NumberUUID(40),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(8), baseTime.Add(2*time.Minute), nil),
+			SourceID: goframework.NumberUUID(40),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my draft content",
+			Draft:    true,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(9), baseTime.Add(3*time.Minute), nil),
+			SourceID: goframework.NumberUUID(40),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(34), baseTime, nil),
+			SourceID: goframework.NumberUUID(40),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(9),
+				Title:     "my title",
+				Content:   "my suggested content",
+			},
+		},
+	}
+
+	type suggestionState struct {
+		requestID uuid.UUID
+		deletedAt *time.Time
+	}
+
+	data := []struct {
+		name string
+
+		id     uuid.UUID
+		policy dao.SuggestionOrphanPolicy
+		now    time.Time
+
+		expectDeleted     bool
+		expectSuggestions map[uuid.UUID]suggestionState
+		expectErr         error
+	}{
 		{
-			name: "Success/WithUserID",
-			query: dao.ImproveRequestSearchQuery{
-				UserID: lo.ToPtr(goframework.NumberUUID(100)),
+			name:          "Success/Reattach",
+			id:            goframework.NumberUUID(2),
+			policy:        dao.SuggestionOrphanPolicyReattach,
+			now:           updateTime,
+			expectDeleted: true,
+			expectSuggestions: map[uuid.UUID]suggestionState{
+				// The previous revision is preferred over the next one.
+				goframework.NumberUUID(30): {requestID: goframework.NumberUUID(1)},
+				goframework.NumberUUID(31): {requestID: goframework.NumberUUID(2), deletedAt: &baseTime},
 			},
-			expect: []*dao.ImproveRequestPreview{
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(40), baseTime.Add(4*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(100),
-					Title:         "my title with tomatoes",
-					Content:       "my content with super chips",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
-				{
-					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
-					UserID:                   goframework.NumberUUID(100),
-					Title:                    "my title with spaceships",
-					Content:                  "my content with thrusters",
-					UpVotes:                  160,
-					DownVotes:                80,
-					RevisionCount:            2,
-					SuggestionsCount:         5,
-					AcceptedSuggestionsCount: 3,
-				},
+		},
+		{
+			name:          "Success/ReattachToNextRevision",
+			id:            goframework.NumberUUID(1),
+			policy:        dao.SuggestionOrphanPolicyReattach,
+			now:           updateTime,
+			expectDeleted: true,
+			expectSuggestions: map[uuid.UUID]suggestionState{
+				goframework.NumberUUID(32): {requestID: goframework.NumberUUID(2)},
 			},
-			expectCount: 2,
 		},
 		{
-			name: "Success/WithOrderByScore",
-			query: dao.ImproveRequestSearchQuery{
-				Order: &dao.ImproveRequestSearchQueryOrder{
-					Score: true,
-				},
+			name:          "Success/ReattachWithoutSurvivingRevision",
+			id:            goframework.NumberUUID(4),
+			policy:        dao.SuggestionOrphanPolicyReattach,
+			now:           updateTime,
+			expectDeleted: true,
+			expectSuggestions: map[uuid.UUID]suggestionState{
+				goframework.NumberUUID(33): {requestID: goframework.NumberUUID(4), deletedAt: &updateTime},
 			},
-			expect: []*dao.ImproveRequestPreview{
-				{
-					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
-					UserID:                   goframework.NumberUUID(100),
-					Title:                    "my title with spaceships",
-					Content:                  "my content with thrusters",
-					UpVotes:                  160,
-					DownVotes:                80,
-					RevisionCount:            2,
-					SuggestionsCount:         5,
-					AcceptedSuggestionsCount: 3,
-				},
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(40), baseTime.Add(4*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(100),
-					Title:         "my title with tomatoes",
-					Content:       "my content with super chips",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(300),
-					Title:         "my title with super thrusters",
-					Content:       "my content with super spaceships",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(200),
-					Title:         "my title with thrusters",
-					Content:       "my content with spaceships",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
+		},
+		{
+			name:          "Success/ReattachSkipsDrafts",
+			id:            goframework.NumberUUID(9),
+			policy:        dao.SuggestionOrphanPolicyReattach,
+			now:           updateTime,
+			expectDeleted: true,
+			expectSuggestions: map[uuid.UUID]suggestionState{
+				// The draft is nearer, but the suggestion must stay visible to everyone.
+				goframework.NumberUUID(34): {requestID: goframework.NumberUUID(7)},
 			},
-			expectCount: 4,
 		},
 		{
-			name:  "Success/Limit",
-			limit: 2,
-			expect: []*dao.ImproveRequestPreview{
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(40), baseTime.Add(4*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(100),
-					Title:         "my title with tomatoes",
-					Content:       "my content with super chips",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(300),
-					Title:         "my title with super thrusters",
-					Content:       "my content with super spaceships",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
+			name:          "Success/Cascade",
+			id:            goframework.NumberUUID(2),
+			policy:        dao.SuggestionOrphanPolicyCascade,
+			now:           updateTime,
+			expectDeleted: true,
+			expectSuggestions: map[uuid.UUID]suggestionState{
+				goframework.NumberUUID(30): {requestID: goframework.NumberUUID(2), deletedAt: &updateTime},
+				goframework.NumberUUID(31): {requestID: goframework.NumberUUID(2), deletedAt: &baseTime},
 			},
-			expectCount: 4,
 		},
 		{
-			name:   "Success/Offset",
-			offset: 1,
-			limit:  2,
-			expect: []*dao.ImproveRequestPreview{
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(300),
-					Title:         "my title with super thrusters",
-					Content:       "my content with super spaceships",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(200),
-					Title:         "my title with thrusters",
-					Content:       "my content with spaceships",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
+			name:   "Success/NotFound",
+			id:     goframework.NumberUUID(5),
+			policy: dao.SuggestionOrphanPolicyReattach,
+			now:    updateTime,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveRequestRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				err := repository.DeleteRevision(ctx, d.id, d.policy, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+
+				if !d.expectDeleted {
+					return
+				}
+
+				_, err = repository.GetRevision(ctx, d.id)
+				require.ErrorIs(t, err, bunovel.ErrNotFound)
+
+				revision, err := repository.GetDeletedRevision(ctx, d.id)
+				require.NoError(t, err)
+				require.Equal(t, d.now, *revision.DeletedAt)
+
+				for id, state := range d.expectSuggestions {
+					suggestion := &dao.ImproveSuggestionModel{Metadata: bunovel.Metadata{ID: id}}
+					require.NoError(t, tx.NewSelect().Model(suggestion).WherePK().Scan(ctx))
+					require.Equal(t, state.requestID, suggestion.RequestID)
+					require.Equal(t, state.deletedAt, suggestion.DeletedAt)
+				}
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestImproveRequestRepository_GetDeletedRevision(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my title",
+			Content:   "my content",
+			DeletedAt: &updateTime,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expect    *dao.ImproveRequestRevisionModel
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   goframework.NumberUUID(1),
+			expect: &dao.ImproveRequestRevisionModel{
+				Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID:  goframework.NumberUUID(10),
+				UserID:    goframework.NumberUUID(100),
+				Title:     "my title",
+				Content:   "my content",
+				Language:  dao.LanguageFrench,
+				DeletedAt: &updateTime,
+			},
+		},
+		{
+			name:      "Error/NotDeleted",
+			id:        goframework.NumberUUID(2),
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/NotFound",
+			id:        goframework.NumberUUID(3),
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveRequestRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.GetDeletedRevision(ctx, d.id)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_GetDeleted(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+			DeletedAt: &updateTime,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my title",
+			Content:   "my content",
+			DeletedAt: &updateTime,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Minute), nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my title",
+			Content:   "my updated content",
+			DeletedAt: &updateTime,
+		},
+		// This revision was deleted on its own, before the request.
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Minute), nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my title",
+			Content:   "my broken content",
+			DeletedAt: &baseTime,
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expect    *dao.ImproveRequestRevisionModel
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   goframework.NumberUUID(10),
+			expect: &dao.ImproveRequestRevisionModel{
+				Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Minute), nil),
+				SourceID:  goframework.NumberUUID(10),
+				UserID:    goframework.NumberUUID(100),
+				Title:     "my title",
+				Content:   "my updated content",
+				Language:  dao.LanguageFrench,
+				DeletedAt: &updateTime,
+			},
+		},
+		{
+			name:      "Error/NotDeleted",
+			id:        goframework.NumberUUID(20),
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/NotFound",
+			id:        goframework.NumberUUID(30),
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveRequestRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.GetDeleted(ctx, d.id)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_RestoreRevision(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my title",
+			Content:   "my content",
+			DeletedAt: &updateTime,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(30), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(200),
+			DeletedAt: &updateTime,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my suggested content",
+			},
+		},
+		// This suggestion was deleted on its own, before the revision.
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(31), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(200),
+			DeletedAt: &baseTime,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my suggested content",
+			},
+		},
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+			DeletedAt: &updateTime,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, nil),
+			SourceID:  goframework.NumberUUID(20),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my title",
+			Content:   "my content",
+			DeletedAt: &updateTime,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expect                    *dao.ImproveRequestRevisionModel
+		expectRestoredSuggestions []uuid.UUID
+		expectDeletedSuggestions  []uuid.UUID
+		expectErr                 error
+	}{
+		{
+			name: "Success",
+			id:   goframework.NumberUUID(1),
+			expect: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "my content",
+				Language: dao.LanguageFrench,
+			},
+			expectRestoredSuggestions: []uuid.UUID{goframework.NumberUUID(30)},
+			expectDeletedSuggestions:  []uuid.UUID{goframework.NumberUUID(31)},
+		},
+		{
+			name:      "Error/NotDeleted",
+			id:        goframework.NumberUUID(2),
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/RequestDeleted",
+			id:        goframework.NumberUUID(3),
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/NotFound",
+			id:        goframework.NumberUUID(4),
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveRequestRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.RestoreRevision(ctx, d.id)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+
+				if err != nil {
+					return
+				}
+
+				suggestionRepository := dao.NewImproveSuggestionRepository(tx)
+				for _, id := range d.expectRestoredSuggestions {
+					_, err := suggestionRepository.Get(ctx, id)
+					require.NoError(t, err)
+				}
+				for _, id := range d.expectDeletedSuggestions {
+					_, err := suggestionRepository.GetDeleted(ctx, id)
+					require.NoError(t, err)
+				}
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestImproveRequestRepository_Restore(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+			DeletedAt: &updateTime,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my title",
+			Content:   "my content",
+			DeletedAt: &updateTime,
+		},
+		// This revision was deleted on its own, before the request.
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Minute), nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my title",
+			Content:   "my broken content",
+			DeletedAt: &baseTime,
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(30), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(200),
+			DeletedAt: &updateTime,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my suggested content",
+			},
+		},
+		// This suggestion was deleted on its own, before the request.
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(31), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(200),
+			DeletedAt: &baseTime,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my suggested content",
+			},
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expect                    *dao.ImproveRequestPreview
+		expectRestoredSuggestions []uuid.UUID
+		expectDeletedSuggestions  []uuid.UUID
+		expectErr                 error
+	}{
+		{
+			name: "Success",
+			id:   goframework.NumberUUID(10),
+			expect: &dao.ImproveRequestPreview{
+				Metadata:      bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				UserID:        goframework.NumberUUID(100),
+				Title:         "my title",
+				Content:       "my content",
+				RevisionCount: 1,
+				Status:        dao.RequestStatusOpen,
+			},
+			expectRestoredSuggestions: []uuid.UUID{goframework.NumberUUID(30)},
+			expectDeletedSuggestions:  []uuid.UUID{goframework.NumberUUID(31)},
+		},
+		{
+			name:      "Error/NotDeleted",
+			id:        goframework.NumberUUID(20),
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/NotFound",
+			id:        goframework.NumberUUID(30),
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveRequestRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Restore(ctx, d.id)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+
+				if err != nil {
+					return
+				}
+
+				suggestionRepository := dao.NewImproveSuggestionRepository(tx)
+				for _, id := range d.expectRestoredSuggestions {
+					_, err := suggestionRepository.Get(ctx, id)
+					require.NoError(t, err)
+				}
+				for _, id := range d.expectDeletedSuggestions {
+					_, err := suggestionRepository.GetDeleted(ctx, id)
+					require.NoError(t, err)
+				}
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestImproveRequestRepository_Purge(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+			DeletedAt: &baseTime,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my title",
+			Content:   "my content",
+			DeletedAt: &baseTime,
+		},
+		&dao.SubscriptionModel{
+			UserID:    goframework.NumberUUID(100),
+			SourceID:  goframework.NumberUUID(10),
+			CreatedAt: baseTime,
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			SourceID:  goframework.NumberUUID(20),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my title",
+			Content:   "my content",
+			DeletedAt: &baseTime,
+		},
+		// Deleted recently, so it is kept.
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, nil),
+			SourceID:  goframework.NumberUUID(20),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my title",
+			Content:   "my content",
+			DeletedAt: &updateTime,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		// Still live on a purged revision, so it is reattached instead of being removed with it.
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(2),
+				Title:     "suggestion title",
+				Content:   "suggestion content",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		before time.Time
+
+		expect                  int
+		expectRemains           []uuid.UUID
+		expectSuggestionRequest uuid.UUID
+		expectErr               error
+	}{
+		{
+			name:   "Success",
+			before: updateTime,
+			expect: 3,
+			expectRemains: []uuid.UUID{
+				goframework.NumberUUID(3),
+				goframework.NumberUUID(4),
+			},
+			expectSuggestionRequest: goframework.NumberUUID(4),
+		},
+		{
+			name:   "Success/NothingToPurge",
+			before: baseTime,
+			expectRemains: []uuid.UUID{
+				goframework.NumberUUID(1),
+				goframework.NumberUUID(2),
+				goframework.NumberUUID(3),
+				goframework.NumberUUID(4),
+			},
+			expectSuggestionRequest: goframework.NumberUUID(2),
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveRequestRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Purge(ctx, d.before)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+
+				remains := make([]uuid.UUID, 0)
+				err = tx.NewSelect().
+					Model((*dao.ImproveRequestRevisionModel)(nil)).
+					Column("id").
+					Order("id ASC").
+					Scan(ctx, &remains)
+				require.NoError(t, err)
+				require.Equal(t, d.expectRemains, remains)
+
+				suggestion := &dao.ImproveSuggestionModel{Metadata: bunovel.Metadata{ID: goframework.NumberUUID(1)}}
+				require.NoError(t, tx.NewSelect().Model(suggestion).WherePK().Scan(ctx))
+				require.Equal(t, d.expectSuggestionRequest, suggestion.RequestID)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestImproveRequestRepository_Search(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
+			UpVotes:   160,
+			DownVotes: 80,
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title with robots",
+			Content:  "my content with mechanics",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), &updateTime),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title with spaceships",
+			Content:  "my content with thrusters",
+		},
+
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+			UpVotes:   128,
+			DownVotes: 64,
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Hour), &updateTime),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(200),
+			Title:    "my title with thrusters",
+			Content:  "my content with spaceships",
+		},
+
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
+			UpVotes:   128,
+			DownVotes: 64,
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime.Add(3*time.Hour), &updateTime),
+			SourceID: goframework.NumberUUID(30),
+			UserID:   goframework.NumberUUID(300),
+			Title:    "my title with super thrusters",
+			Content:  "my content with super spaceships",
+		},
+
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(40), baseTime.Add(4*time.Hour), &updateTime),
+			UpVotes:   128,
+			DownVotes: 64,
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(5), baseTime.Add(4*time.Hour), &updateTime),
+			SourceID: goframework.NumberUUID(40),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title with tomatoes",
+			Content:  "my content with super chips",
+		},
+
+		// Suggestions for goframework.NumberUUID(1).
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   uuid.Nil,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "suggestion title",
+				Content:   "suggestion content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &updateTime),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    uuid.Nil,
+			Validated: true,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "suggestion title",
+				Content:   "suggestion content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, &updateTime),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    uuid.Nil,
+			Validated: true,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "suggestion title",
+				Content:   "suggestion content",
+			},
+		},
+
+		// Suggestions for goframework.NumberUUID(2).
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, &updateTime),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   uuid.Nil,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(2),
+				Title:     "suggestion title",
+				Content:   "suggestion content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(5), baseTime, &updateTime),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    uuid.Nil,
+			Validated: true,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(2),
+				Title:     "suggestion title",
+				Content:   "suggestion content",
+			},
+		},
+
+		&dao.SubscriptionModel{UserID: goframework.NumberUUID(500), SourceID: goframework.NumberUUID(20), CreatedAt: baseTime},
+		&dao.SubscriptionModel{UserID: goframework.NumberUUID(500), SourceID: goframework.NumberUUID(40), CreatedAt: baseTime},
+		&dao.SubscriptionModel{UserID: goframework.NumberUUID(600), SourceID: goframework.NumberUUID(30), CreatedAt: baseTime},
+	}
+
+	data := []struct {
+		name string
+
+		query  dao.ImproveRequestSearchQuery
+		limit  int
+		offset int
+
+		expect      []*dao.ImproveRequestPreview
+		expectCount int
+		expectErr   error
+	}{
+		{
+			name: "Success",
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(40), baseTime.Add(4*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(100),
+					Title:         "my title with tomatoes",
+					Content:       "my content with super chips",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(300),
+					Title:         "my title with super thrusters",
+					Content:       "my content with super spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(200),
+					Title:         "my title with thrusters",
+					Content:       "my content with spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
+					UserID:                   goframework.NumberUUID(100),
+					Title:                    "my title with spaceships",
+					Content:                  "my content with thrusters",
+					UpVotes:                  160,
+					DownVotes:                80,
+					RevisionCount:            2,
+					SuggestionsCount:         5,
+					AcceptedSuggestionsCount: 3,
+					Status:                   dao.RequestStatusOpen,
+				},
+			},
+			expectCount: 4,
+		},
+		{
+			name: "Success/WithQuery",
+			query: dao.ImproveRequestSearchQuery{
+				Query: "spaceships",
+			},
+			expect: []*dao.ImproveRequestPreview{
+				// Most relevant, presence in title has more value.
+				{
+					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
+					UserID:                   goframework.NumberUUID(100),
+					Title:                    "my title with spaceships",
+					Content:                  "my content with thrusters",
+					UpVotes:                  160,
+					DownVotes:                80,
+					RevisionCount:            2,
+					SuggestionsCount:         5,
+					AcceptedSuggestionsCount: 3,
+					Status:                   dao.RequestStatusOpen,
+				},
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(300),
+					Title:         "my title with super thrusters",
+					Content:       "my content with super spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(200),
+					Title:         "my title with thrusters",
+					Content:       "my content with spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+			},
+			expectCount: 3,
+		},
+		{
+			name: "Success/WithUserID",
+			query: dao.ImproveRequestSearchQuery{
+				UserID: lo.ToPtr(goframework.NumberUUID(100)),
+			},
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(40), baseTime.Add(4*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(100),
+					Title:         "my title with tomatoes",
+					Content:       "my content with super chips",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
+					UserID:                   goframework.NumberUUID(100),
+					Title:                    "my title with spaceships",
+					Content:                  "my content with thrusters",
+					UpVotes:                  160,
+					DownVotes:                80,
+					RevisionCount:            2,
+					SuggestionsCount:         5,
+					AcceptedSuggestionsCount: 3,
+					Status:                   dao.RequestStatusOpen,
+				},
+			},
+			expectCount: 2,
+		},
+		{
+			name: "Success/Followed",
+			query: dao.ImproveRequestSearchQuery{
+				Followed: true,
+				ViewerID: lo.ToPtr(goframework.NumberUUID(500)),
+			},
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(40), baseTime.Add(4*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(100),
+					Title:         "my title with tomatoes",
+					Content:       "my content with super chips",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(200),
+					Title:         "my title with thrusters",
+					Content:       "my content with spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+			},
+			expectCount: 2,
+		},
+		{
+			name: "Success/WithOrderByScore",
+			query: dao.ImproveRequestSearchQuery{
+				Order: &dao.ImproveRequestSearchQueryOrder{
+					Score: true,
+				},
+			},
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
+					UserID:                   goframework.NumberUUID(100),
+					Title:                    "my title with spaceships",
+					Content:                  "my content with thrusters",
+					UpVotes:                  160,
+					DownVotes:                80,
+					RevisionCount:            2,
+					SuggestionsCount:         5,
+					AcceptedSuggestionsCount: 3,
+					Status:                   dao.RequestStatusOpen,
+				},
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(40), baseTime.Add(4*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(100),
+					Title:         "my title with tomatoes",
+					Content:       "my content with super chips",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(300),
+					Title:         "my title with super thrusters",
+					Content:       "my content with super spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(200),
+					Title:         "my title with thrusters",
+					Content:       "my content with spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+			},
+			expectCount: 4,
+		},
+		{
+			name: "Success/WithCreatedRange",
+			query: dao.ImproveRequestSearchQuery{
+				CreatedAfter:  lo.ToPtr(baseTime.Add(2 * time.Hour)),
+				CreatedBefore: lo.ToPtr(baseTime.Add(3 * time.Hour)),
+			},
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(300),
+					Title:         "my title with super thrusters",
+					Content:       "my content with super spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(200),
+					Title:         "my title with thrusters",
+					Content:       "my content with spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+			},
+			expectCount: 2,
+		},
+		{
+			name: "Success/WithScoreRange",
+			query: dao.ImproveRequestSearchQuery{
+				MinScore: lo.ToPtr(65),
+				MaxScore: lo.ToPtr(80),
+			},
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
+					UserID:                   goframework.NumberUUID(100),
+					Title:                    "my title with spaceships",
+					Content:                  "my content with thrusters",
+					UpVotes:                  160,
+					DownVotes:                80,
+					RevisionCount:            2,
+					SuggestionsCount:         5,
+					AcceptedSuggestionsCount: 3,
+					Status:                   dao.RequestStatusOpen,
+				},
+			},
+			expectCount: 1,
+		},
+		{
+			name: "Success/Unanswered",
+			query: dao.ImproveRequestSearchQuery{
+				MaxSuggestions: lo.ToPtr(0),
+				CreatedBefore:  lo.ToPtr(baseTime.Add(2 * time.Hour)),
+			},
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(200),
+					Title:         "my title with thrusters",
+					Content:       "my content with spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+			},
+			expectCount: 1,
+		},
+		{
+			name: "Success/WithAcceptedSuggestions",
+			query: dao.ImproveRequestSearchQuery{
+				HasAcceptedSuggestions: lo.ToPtr(true),
+				MinSuggestions:         lo.ToPtr(1),
+				MinRevisions:           lo.ToPtr(2),
+				MaxRevisions:           lo.ToPtr(2),
+			},
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
+					UserID:                   goframework.NumberUUID(100),
+					Title:                    "my title with spaceships",
+					Content:                  "my content with thrusters",
+					UpVotes:                  160,
+					DownVotes:                80,
+					RevisionCount:            2,
+					SuggestionsCount:         5,
+					AcceptedSuggestionsCount: 3,
+					Status:                   dao.RequestStatusOpen,
+				},
+			},
+			expectCount: 1,
+		},
+		{
+			name: "Success/WithoutAcceptedSuggestions",
+			query: dao.ImproveRequestSearchQuery{
+				HasAcceptedSuggestions: lo.ToPtr(false),
+				CreatedBefore:          lo.ToPtr(baseTime.Add(2 * time.Hour)),
+			},
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(200),
+					Title:         "my title with thrusters",
+					Content:       "my content with spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+			},
+			expectCount: 1,
+		},
+		{
+			name:  "Success/Limit",
+			limit: 2,
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(40), baseTime.Add(4*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(100),
+					Title:         "my title with tomatoes",
+					Content:       "my content with super chips",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(300),
+					Title:         "my title with super thrusters",
+					Content:       "my content with super spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+			},
+			expectCount: 4,
+		},
+		{
+			name:   "Success/Offset",
+			offset: 1,
+			limit:  2,
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(300),
+					Title:         "my title with super thrusters",
+					Content:       "my content with super spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(200),
+					Title:         "my title with thrusters",
+					Content:       "my content with spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+			},
+			expectCount: 4,
+		},
+		{
+			name: "Success/Cursor",
+			query: dao.ImproveRequestSearchQuery{
+				Cursor: &dao.ImproveRequestSearchCursor{
+					CreatedAt: baseTime.Add(3 * time.Hour),
+					ID:        goframework.NumberUUID(30),
+				},
+				SkipCount: true,
+			},
+			limit: 2,
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(200),
+					Title:         "my title with thrusters",
+					Content:       "my content with spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
+					UserID:                   goframework.NumberUUID(100),
+					Title:                    "my title with spaceships",
+					Content:                  "my content with thrusters",
+					UpVotes:                  160,
+					DownVotes:                80,
+					RevisionCount:            2,
+					SuggestionsCount:         5,
+					AcceptedSuggestionsCount: 3,
+					Status:                   dao.RequestStatusOpen,
+				},
+			},
+		},
+		{
+			name: "Success/CursorKeepsTotal",
+			query: dao.ImproveRequestSearchQuery{
+				Cursor: &dao.ImproveRequestSearchCursor{
+					CreatedAt: baseTime.Add(3 * time.Hour),
+					ID:        goframework.NumberUUID(30),
+				},
+			},
+			limit: 2,
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(200),
+					Title:         "my title with thrusters",
+					Content:       "my content with spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
+					UserID:                   goframework.NumberUUID(100),
+					Title:                    "my title with spaceships",
+					Content:                  "my content with thrusters",
+					UpVotes:                  160,
+					DownVotes:                80,
+					RevisionCount:            2,
+					SuggestionsCount:         5,
+					AcceptedSuggestionsCount: 3,
+					Status:                   dao.RequestStatusOpen,
+				},
+			},
+			// The total counts every result, not only the ones after the cursor.
+			expectCount: 4,
+		},
+		{
+			name: "Success/CursorWithOrderByScore",
+			query: dao.ImproveRequestSearchQuery{
+				Order: &dao.ImproveRequestSearchQueryOrder{
+					Score: true,
+				},
+				Cursor: &dao.ImproveRequestSearchCursor{
+					Score:     64,
+					CreatedAt: baseTime.Add(4 * time.Hour),
+					ID:        goframework.NumberUUID(40),
+				},
+			},
+			limit: 2,
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(300),
+					Title:         "my title with super thrusters",
+					Content:       "my content with super spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(200),
+					Title:         "my title with thrusters",
+					Content:       "my content with spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+			},
+			expectCount: 4,
+		},
+		{
+			name:        "Success/OffsetTooHigh",
+			offset:      10,
+			expect:      []*dao.ImproveRequestPreview{},
+			expectCount: 4,
+		},
+		{
+			name: "Success/NoResult",
+			query: dao.ImproveRequestSearchQuery{
+				Query: "foo bar qux",
+			},
+			expect: []*dao.ImproveRequestPreview{},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveRequestRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, count, err := repository.Search(ctx, d.query, d.limit, d.offset)
+				require.ErrorIs(t, err, d.expectErr)
+
+				// The rank depends on the text search configuration, so it is only checked to be set.
+				for _, preview := range res {
+					require.Equal(t, d.query.Query != "", preview.SearchRank > 0)
+					preview.SearchRank = 0
+				}
+
+				require.Equal(t, d.expect, res)
+				require.Equal(t, d.expectCount, count)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_List(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
+			UpVotes:   160,
+			DownVotes: 80,
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title with robots",
+			Content:  "my content with mechanics",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), &updateTime),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title with spaceships",
+			Content:  "my content with thrusters",
+		},
+
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+			UpVotes:   128,
+			DownVotes: 64,
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Hour), &updateTime),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(200),
+			Title:    "my title with thrusters",
+			Content:  "my content with spaceships",
+		},
+
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
+			UpVotes:   128,
+			DownVotes: 64,
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime.Add(3*time.Hour), &updateTime),
+			SourceID: goframework.NumberUUID(30),
+			UserID:   goframework.NumberUUID(300),
+			Title:    "my title with super thrusters",
+			Content:  "my content with super spaceships",
+		},
+
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(40), baseTime.Add(4*time.Hour), &updateTime),
+			UpVotes:   128,
+			DownVotes: 64,
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(5), baseTime.Add(4*time.Hour), &updateTime),
+			SourceID: goframework.NumberUUID(40),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title with tomatoes",
+			Content:  "my content with super chips",
+		},
+
+		// Suggestions for goframework.NumberUUID(1).
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   uuid.Nil,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "suggestion title",
+				Content:   "suggestion content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &updateTime),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    uuid.Nil,
+			Validated: true,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "suggestion title",
+				Content:   "suggestion content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, &updateTime),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    uuid.Nil,
+			Validated: true,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "suggestion title",
+				Content:   "suggestion content",
+			},
+		},
+
+		// Suggestions for goframework.NumberUUID(2).
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, &updateTime),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   uuid.Nil,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(2),
+				Title:     "suggestion title",
+				Content:   "suggestion content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(5), baseTime, &updateTime),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    uuid.Nil,
+			Validated: true,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(2),
+				Title:     "suggestion title",
+				Content:   "suggestion content",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		ids []uuid.UUID
+
+		expect    []*dao.ImproveRequestPreview
+		expectErr error
+	}{
+		{
+			name: "Success",
+			ids:  []uuid.UUID{goframework.NumberUUID(10), goframework.NumberUUID(20), goframework.NumberUUID(60)},
+			expect: []*dao.ImproveRequestPreview{
+				{
+					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
+					UserID:                   goframework.NumberUUID(100),
+					Title:                    "my title with spaceships",
+					Content:                  "my content with thrusters",
+					UpVotes:                  160,
+					DownVotes:                80,
+					RevisionCount:            2,
+					SuggestionsCount:         5,
+					AcceptedSuggestionsCount: 3,
+					Status:                   dao.RequestStatusOpen,
+				},
+				{
+					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
+					UserID:        goframework.NumberUUID(200),
+					Title:         "my title with thrusters",
+					Content:       "my content with spaceships",
+					RevisionCount: 1,
+					UpVotes:       128,
+					DownVotes:     64,
+					Status:        dao.RequestStatusOpen,
+				},
+			},
+		},
+		{
+			name:   "Success/NoResults",
+			ids:    []uuid.UUID{},
+			expect: []*dao.ImproveRequestPreview{},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveRequestRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.List(ctx, d.ids)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_SearchOrders(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		// Balanced votes, most suggestions, most recent suggestion.
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+			UpVotes:   10,
+			DownVotes: 10,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, lo.ToPtr(baseTime.Add(5*time.Hour))),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+
+		// Highest score, no suggestion.
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(time.Hour), nil),
+			UpVotes:  100,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+
+		// Slightly controversial, revised later.
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(2*time.Hour), nil),
+			UpVotes:   3,
+			DownVotes: 1,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Hour), nil),
+			SourceID: goframework.NumberUUID(30),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime.Add(3*time.Hour), nil),
+			SourceID: goframework.NumberUUID(30),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Hour), nil),
+			SourceID: goframework.NumberUUID(30),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(3),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query dao.ImproveRequestSearchQuery
+		limit int
+
+		expect    []uuid.UUID
+		expectErr error
+	}{
+		{
+			name:  "Success/Activity",
+			query: dao.ImproveRequestSearchQuery{Order: &dao.ImproveRequestSearchQueryOrder{Activity: true}},
+			expect: []uuid.UUID{
+				goframework.NumberUUID(10),
+				goframework.NumberUUID(30),
+				goframework.NumberUUID(20),
+			},
+		},
+		{
+			name: "Success/ActivityWithCursor",
+			query: dao.ImproveRequestSearchQuery{
+				Order: &dao.ImproveRequestSearchQueryOrder{Activity: true},
+				Cursor: &dao.ImproveRequestSearchCursor{
+					Activity:  baseTime.Add(5 * time.Hour),
+					CreatedAt: baseTime,
+					ID:        goframework.NumberUUID(10),
+				},
+			},
+			expect: []uuid.UUID{
+				goframework.NumberUUID(30),
+				goframework.NumberUUID(20),
+			},
+		},
+		{
+			name:  "Success/Suggestions",
+			query: dao.ImproveRequestSearchQuery{Order: &dao.ImproveRequestSearchQueryOrder{Suggestions: true}},
+			expect: []uuid.UUID{
+				goframework.NumberUUID(10),
+				goframework.NumberUUID(30),
+				goframework.NumberUUID(20),
+			},
+		},
+		{
+			name: "Success/SuggestionsWithCursor",
+			query: dao.ImproveRequestSearchQuery{
+				Order: &dao.ImproveRequestSearchQueryOrder{Suggestions: true},
+				Cursor: &dao.ImproveRequestSearchCursor{
+					Suggestions: 1,
+					CreatedAt:   baseTime.Add(2 * time.Hour),
+					ID:          goframework.NumberUUID(30),
+				},
+			},
+			expect: []uuid.UUID{
+				goframework.NumberUUID(20),
+			},
+		},
+		{
+			name:  "Success/Controversial",
+			query: dao.ImproveRequestSearchQuery{Order: &dao.ImproveRequestSearchQueryOrder{Controversial: true}},
+			expect: []uuid.UUID{
+				goframework.NumberUUID(10),
+				goframework.NumberUUID(30),
+				goframework.NumberUUID(20),
+			},
+		},
+		{
+			name:  "Success/Hot",
+			query: dao.ImproveRequestSearchQuery{Order: &dao.ImproveRequestSearchQueryOrder{Hot: true}},
+			expect: []uuid.UUID{
+				goframework.NumberUUID(20),
+				goframework.NumberUUID(30),
+				goframework.NumberUUID(10),
+			},
+		},
+		{
+			name:  "Success/HotLimit",
+			query: dao.ImproveRequestSearchQuery{Order: &dao.ImproveRequestSearchQueryOrder{Hot: true}},
+			limit: 1,
+			expect: []uuid.UUID{
+				goframework.NumberUUID(20),
+			},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveRequestRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, _, err := repository.Search(ctx, d.query, d.limit, 0)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, lo.Map(res, func(item *dao.ImproveRequestPreview, _ int) uuid.UUID {
+					return item.ID
+				}))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_SearchLanguages(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(time.Hour), nil),
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(2*time.Hour), nil),
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "mes chevaux",
+			Content:  "des chevaux qui galopent dans les champs",
+			Language: dao.LanguageFrench,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my horses",
+			Content:  "horses running in the fields",
+			Language: dao.LanguageEnglish,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Hour), nil),
+			SourceID: goframework.NumberUUID(30),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "mis caballos",
+			Content:  "caballos corriendo en el campo",
+			Language: dao.LanguageSpanish,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query dao.ImproveRequestSearchQuery
+
+		expect    []uuid.UUID
+		expectErr error
+	}{
+		{
+			name:   "Success/English",
+			query:  dao.ImproveRequestSearchQuery{Query: "horse"},
+			expect: []uuid.UUID{goframework.NumberUUID(20)},
+		},
+		{
+			// Each request is stemmed with its own dictionary: "run" matches "running" in english only.
+			name:   "Success/Stemming",
+			query:  dao.ImproveRequestSearchQuery{Query: "runs", Language: dao.LanguageEnglish},
+			expect: []uuid.UUID{goframework.NumberUUID(20)},
+		},
+		{
+			name:   "Success/Spanish",
+			query:  dao.ImproveRequestSearchQuery{Query: "caballo"},
+			expect: []uuid.UUID{goframework.NumberUUID(30)},
+		},
+		{
+			name:   "Success/French",
+			query:  dao.ImproveRequestSearchQuery{Query: "cheval", Language: dao.LanguageFrench},
+			expect: []uuid.UUID{goframework.NumberUUID(10)},
+		},
+		{
+			name:   "Success/OtherLanguage",
+			query:  dao.ImproveRequestSearchQuery{Query: "horse", Language: dao.LanguageFrench},
+			expect: []uuid.UUID{},
+		},
+		{
+			name:   "Success/LanguageOnly",
+			query:  dao.ImproveRequestSearchQuery{Language: dao.LanguageSpanish},
+			expect: []uuid.UUID{goframework.NumberUUID(30)},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveRequestRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, _, err := repository.Search(ctx, d.query, 10, 0)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, lo.Map(res, func(item *dao.ImproveRequestPreview, _ int) uuid.UUID {
+					return item.ID
+				}))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_SearchTags(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(time.Hour), nil),
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(2*time.Hour), nil),
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Hour), nil),
+			SourceID: goframework.NumberUUID(30),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+
+		&dao.TagModel{Name: "dialogue", CreatedAt: baseTime},
+		&dao.TagModel{Name: "horror", CreatedAt: baseTime},
+		&dao.TagModel{Name: "science-fiction", CreatedAt: baseTime},
+
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(10), Tag: "horror", CreatedAt: baseTime},
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(10), Tag: "dialogue", CreatedAt: baseTime},
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(20), Tag: "dialogue", CreatedAt: baseTime},
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(20), Tag: "science-fiction", CreatedAt: baseTime},
+	}
+
+	data := []struct {
+		name string
+
+		query dao.ImproveRequestSearchQuery
+
+		expect     []uuid.UUID
+		expectTags [][]string
+		expectErr  error
+	}{
+		{
+			name:       "Success/Any",
+			query:      dao.ImproveRequestSearchQuery{Tags: []string{"horror", "science-fiction"}},
+			expect:     []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(10)},
+			expectTags: [][]string{{"dialogue", "science-fiction"}, {"dialogue", "horror"}},
+		},
+		{
+			name:       "Success/All",
+			query:      dao.ImproveRequestSearchQuery{Tags: []string{"horror", "dialogue"}, AllTags: true},
+			expect:     []uuid.UUID{goframework.NumberUUID(10)},
+			expectTags: [][]string{{"dialogue", "horror"}},
+		},
+		{
+			name:       "Success/AllWithDuplicates",
+			query:      dao.ImproveRequestSearchQuery{Tags: []string{"dialogue", "dialogue"}, AllTags: true},
+			expect:     []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(10)},
+			expectTags: [][]string{{"dialogue", "science-fiction"}, {"dialogue", "horror"}},
+		},
+		{
+			name:       "Success/UnknownTag",
+			query:      dao.ImproveRequestSearchQuery{Tags: []string{"romance"}},
+			expect:     []uuid.UUID{},
+			expectTags: [][]string{},
+		},
+		{
+			name:   "Success/NoTags",
+			query:  dao.ImproveRequestSearchQuery{},
+			expect: []uuid.UUID{goframework.NumberUUID(30), goframework.NumberUUID(20), goframework.NumberUUID(10)},
+			expectTags: [][]string{
+				nil,
+				{"dialogue", "science-fiction"},
+				{"dialogue", "horror"},
+			},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveRequestRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, _, err := repository.Search(ctx, d.query, 10, 0)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, lo.Map(res, func(item *dao.ImproveRequestPreview, _ int) uuid.UUID {
+					return item.ID
+				}))
+				require.Equal(t, d.expectTags, lo.Map(res, func(item *dao.ImproveRequestPreview, _ int) []string {
+					return item.Tags
+				}))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_SearchDrafts(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(time.Hour), nil),
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		// A draft revision of a published request does not replace the published one.
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Minute), nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my draft title",
+			Content:  "my draft content",
+			Draft:    true,
+		},
+		// This request was never published.
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(200),
+			Title:    "my secret title",
+			Content:  "my secret content",
+			Draft:    true,
+		},
+
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(30), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(300),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my suggested content",
+			},
+		},
+		// Draft suggestions are not counted.
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(31), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(300),
+			Draft:    true,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my drafted content",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query dao.ImproveRequestSearchQuery
+
+		expect            []uuid.UUID
+		expectTitles      []string
+		expectSuggestions []int
+		expectRevisions   []int
+		expectErr         error
+	}{
+		{
+			name:              "Success/Anonymous",
+			query:             dao.ImproveRequestSearchQuery{},
+			expect:            []uuid.UUID{goframework.NumberUUID(10)},
+			expectTitles:      []string{"my title"},
+			expectSuggestions: []int{1},
+			expectRevisions:   []int{1},
+		},
+		{
+			name:              "Success/OtherUser",
+			query:             dao.ImproveRequestSearchQuery{ViewerID: lo.ToPtr(goframework.NumberUUID(100))},
+			expect:            []uuid.UUID{goframework.NumberUUID(10)},
+			expectTitles:      []string{"my title"},
+			expectSuggestions: []int{1},
+			expectRevisions:   []int{1},
+		},
+		{
+			name:              "Success/Author",
+			query:             dao.ImproveRequestSearchQuery{ViewerID: lo.ToPtr(goframework.NumberUUID(200))},
+			expect:            []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(10)},
+			expectTitles:      []string{"my secret title", "my title"},
+			expectSuggestions: []int{0, 1},
+			expectRevisions:   []int{0, 1},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveRequestRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, _, err := repository.Search(ctx, d.query, 10, 0)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, lo.Map(res, func(item *dao.ImproveRequestPreview, _ int) uuid.UUID {
+					return item.ID
+				}))
+				require.Equal(t, d.expectTitles, lo.Map(res, func(item *dao.ImproveRequestPreview, _ int) string {
+					return item.Title
+				}))
+				require.Equal(t, d.expectSuggestions, lo.Map(res, func(item *dao.ImproveRequestPreview, _ int) int {
+					return item.SuggestionsCount
+				}))
+				require.Equal(t, d.expectRevisions, lo.Map(res, func(item *dao.ImproveRequestPreview, _ int) int {
+					return item.RevisionCount
+				}))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_SearchFuzzy(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(time.Hour), nil),
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "Gandalf the grey returns",
+			Content:  "the wizard comes back",
+			Language: dao.LanguageEnglish,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "Frodo and the ring",
+			Content:  "the hobbit leaves the shire",
+			Language: dao.LanguageEnglish,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query dao.ImproveRequestSearchQuery
+
+		expect    []uuid.UUID
+		expectErr error
+	}{
+		{
+			name:   "Success/Misspelled",
+			query:  dao.ImproveRequestSearchQuery{Query: "gandalv", Fuzzy: true},
+			expect: []uuid.UUID{goframework.NumberUUID(10)},
+		},
+		{
+			name:   "Success/NotFuzzy",
+			query:  dao.ImproveRequestSearchQuery{Query: "gandalv"},
+			expect: []uuid.UUID{},
+		},
+		{
+			name:   "Success/ExactMatch",
+			query:  dao.ImproveRequestSearchQuery{Query: "frodo", Fuzzy: true},
+			expect: []uuid.UUID{goframework.NumberUUID(20)},
+		},
+		{
+			name:   "Success/NoResults",
+			query:  dao.ImproveRequestSearchQuery{Query: "qwerty", Fuzzy: true},
+			expect: []uuid.UUID{},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveRequestRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, _, err := repository.Search(ctx, d.query, 10, 0)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, lo.Map(res, func(item *dao.ImproveRequestPreview, _ int) uuid.UUID {
+					require.Greater(t, item.SearchRank, 0.0)
+					return item.ID
+				}))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_SuggestQuery(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "Gandalf the grey returns",
+			Content:  "the wizard comes back",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "Frodo and the ring",
+			Content:  "the hobbit leaves the shire",
+		},
+		// Hidden revisions are not used for suggestions.
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "Saruman the white",
+			Content:  "the hobbit leaves the shire",
+			Hidden:   true,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query string
+
+		expect    string
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			query:  "Gandalv ring",
+			expect: "gandalf ring",
+		},
+		{
+			name:  "Success/NothingToCorrect",
+			query: "frodo",
+		},
+		{
+			name:  "Success/NoCloseWord",
+			query: "qwerty",
+		},
+		{
+			name:  "Success/Hidden",
+			query: "sarumam",
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveRequestRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.SuggestQuery(ctx, d.query)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_SearchHighlight(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my horses",
+			Content:  "horses running in the fields",
+			Language: dao.LanguageEnglish,
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "L'étoile filante",
+			Content:  "<script>alert('étoile')</script>",
+			Language: dao.LanguageFrench,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query dao.ImproveRequestSearchQuery
+
+		expectTitle   string
+		expectContent string
+		// expectRawTitle and expectRawContent are the original title and content, that are still returned.
+		expectRawTitle   string
+		expectRawContent string
+		expectErr        error
+	}{
+		{
+			name:             "Success",
+			query:            dao.ImproveRequestSearchQuery{Query: "horse", Highlight: true},
+			expectTitle:      "my <mark>horses</mark>",
+			expectContent:    "<mark>horses</mark> running in the fields",
+			expectRawTitle:   "my horses",
+			expectRawContent: "horses running in the fields",
+		},
+		{
+			// The index ignores accents, so do the highlights. The original text is highlighted, with its accents,
+			// and escaped, so it cannot inject any markup.
+			name:             "Success/AccentsAndMarkup",
+			query:            dao.ImproveRequestSearchQuery{Query: "etoile", Highlight: true},
+			expectTitle:      "L&#39;<mark>étoile</mark> filante",
+			expectContent:    "&lt;script&gt;alert(&#39;<mark>étoile</mark>&#39;)&lt;/script&gt;",
+			expectRawTitle:   "L'étoile filante",
+			expectRawContent: "<script>alert('étoile')</script>",
+		},
+		{
+			name:             "Success/NoHighlight",
+			query:            dao.ImproveRequestSearchQuery{Query: "horse"},
+			expectRawTitle:   "my horses",
+			expectRawContent: "horses running in the fields",
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveRequestRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, _, err := repository.Search(ctx, d.query, 10, 0)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Len(t, res, 1)
+				require.Equal(t, d.expectTitle, res[0].TitleHighlight)
+				require.Equal(t, d.expectContent, res[0].ContentHighlight)
+				require.Equal(t, d.expectRawTitle, res[0].Title)
+				require.Equal(t, d.expectRawContent, res[0].Content)
+			})
+		}
+
+		// There is nothing to highlight without a query.
+		t.Run("Success/NoQuery", func(st *testing.T) {
+			res, _, err := repository.Search(ctx, dao.ImproveRequestSearchQuery{Highlight: true}, 10, 0)
+			require.NoError(t, err)
+			require.Len(t, res, 2)
+			for _, item := range res {
+				require.Empty(t, item.TitleHighlight)
+				require.Empty(t, item.ContentHighlight)
+			}
+		})
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_SetStatus(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata:           bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+			Status:             dao.RequestStatusClosed,
+			StatusUpdatedAt:    &baseTime,
+			CloseAfterDays:     lo.ToPtr(7),
+			CloseAfterAccepted: lo.ToPtr(3),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+			DeletedAt: &baseTime,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id     uuid.UUID
+		status dao.RequestStatus
+
+		expect    *dao.ImproveRequestPreview
+		expectErr error
+	}{
+		{
+			name:   "Success/Reopen",
+			id:     goframework.NumberUUID(10),
+			status: dao.RequestStatusOpen,
+			expect: &dao.ImproveRequestPreview{
+				Metadata:        bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				UserID:          goframework.NumberUUID(100),
+				Title:           "my title",
+				Content:         "my content",
+				RevisionCount:   1,
+				Status:          dao.RequestStatusOpen,
+				StatusUpdatedAt: &updateTime,
+			},
+		},
+		{
+			name:   "Success/KeepsPolicy",
+			id:     goframework.NumberUUID(10),
+			status: dao.RequestStatusArchived,
+			expect: &dao.ImproveRequestPreview{
+				Metadata:           bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				UserID:             goframework.NumberUUID(100),
+				Title:              "my title",
+				Content:            "my content",
+				RevisionCount:      1,
+				Status:             dao.RequestStatusArchived,
+				StatusUpdatedAt:    &updateTime,
+				CloseAfterDays:     lo.ToPtr(7),
+				CloseAfterAccepted: lo.ToPtr(3),
+			},
+		},
+		{
+			name:      "Error/Deleted",
+			id:        goframework.NumberUUID(20),
+			status:    dao.RequestStatusClosed,
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/NotFound",
+			id:        goframework.NumberUUID(30),
+			status:    dao.RequestStatusClosed,
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveRequestRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.SetStatus(ctx, d.id, d.status, updateTime)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestImproveRequestRepository_SetClosePolicy(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata:       bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+			CloseAfterDays: lo.ToPtr(7),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+			DeletedAt: &baseTime,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id                 uuid.UUID
+		closeAfterDays     *int
+		closeAfterAccepted *int
+
+		expect    *dao.ImproveRequestPreview
+		expectErr error
+	}{
+		{
+			name:               "Success",
+			id:                 goframework.NumberUUID(10),
+			closeAfterAccepted: lo.ToPtr(3),
+			expect: &dao.ImproveRequestPreview{
+				Metadata:           bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				UserID:             goframework.NumberUUID(100),
+				Title:              "my title",
+				Content:            "my content",
+				RevisionCount:      1,
+				Status:             dao.RequestStatusOpen,
+				CloseAfterAccepted: lo.ToPtr(3),
 			},
-			expectCount: 4,
 		},
 		{
-			name:        "Success/OffsetTooHigh",
-			offset:      10,
-			expect:      []*dao.ImproveRequestPreview{},
-			expectCount: 4,
+			name:      "Error/Deleted",
+			id:        goframework.NumberUUID(20),
+			expectErr: bunovel.ErrNotFound,
 		},
 		{
-			name: "Success/NoResult",
-			query: dao.ImproveRequestSearchQuery{
-				Query: "foo bar qux",
-			},
-			expect: []*dao.ImproveRequestPreview{},
+			name:      "Error/NotFound",
+			id:        goframework.NumberUUID(30),
+			expectErr: bunovel.ErrNotFound,
 		},
 	}
 
-	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
-		repository := dao.NewImproveRequestRepository(tx)
-
-		for _, d := range data {
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveRequestRepository(tx)
 			t.Run(d.name, func(st *testing.T) {
-				res, count, err := repository.Search(ctx, d.query, d.limit, d.offset)
+				res, err := repository.SetClosePolicy(ctx, d.id, d.closeAfterDays, d.closeAfterAccepted)
 				require.ErrorIs(t, err, d.expectErr)
 				require.Equal(t, d.expect, res)
-				require.Equal(t, d.expectCount, count)
 			})
-		}
-	})
-	require.NoError(t, err)
+		})
+		require.NoError(t, err)
+	}
 }
 
-func TestImproveRequestRepository_List(t *testing.T) {
+func TestImproveRequestRepository_AutoClose(t *testing.T) {
 	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
 	defer db.Close()
 	defer sqlDB.Close()
 
+	reopenedAt := baseTime.Add(48 * time.Hour)
+
 	fixtures := []interface{}{
+		// Open for more than 3 days.
 		&dao.ImproveRequestModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
-			UpVotes:   160,
-			DownVotes: 80,
+			Metadata:       bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+			CloseAfterDays: lo.ToPtr(3),
 		},
-
-		&dao.ImproveRequestRevisionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
-			SourceID: goframework.NumberUUID(10),
-			UserID:   goframework.NumberUUID(100),
-			Title:    "my title with robots",
-			Content:  "my content with mechanics",
+		// Reopened 2 days after its creation, so the delay is not over yet.
+		&dao.ImproveRequestModel{
+			Metadata:        bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+			StatusUpdatedAt: &reopenedAt,
+			CloseAfterDays:  lo.ToPtr(3),
 		},
-		&dao.ImproveRequestRevisionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), &updateTime),
-			SourceID: goframework.NumberUUID(10),
-			UserID:   goframework.NumberUUID(100),
-			Title:    "my title with spaceships",
-			Content:  "my content with thrusters",
+		// Has enough accepted suggestions, and is also overdue.
+		&dao.ImproveRequestModel{
+			Metadata:           bunovel.NewMetadata(goframework.NumberUUID(30), baseTime, nil),
+			CloseAfterDays:     lo.ToPtr(3),
+			CloseAfterAccepted: lo.ToPtr(1),
 		},
-
+		// Not enough accepted suggestions.
 		&dao.ImproveRequestModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
-			UpVotes:   128,
-			DownVotes: 64,
+			Metadata:           bunovel.NewMetadata(goframework.NumberUUID(40), baseTime, nil),
+			CloseAfterAccepted: lo.ToPtr(2),
 		},
-
-		&dao.ImproveRequestRevisionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Hour), &updateTime),
-			SourceID: goframework.NumberUUID(20),
-			UserID:   goframework.NumberUUID(200),
-			Title:    "my title with thrusters",
-			Content:  "my content with spaceships",
+		// No policy.
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(50), baseTime, nil),
 		},
-
+		// Already archived.
 		&dao.ImproveRequestModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(30), baseTime.Add(3*time.Hour), &updateTime),
-			UpVotes:   128,
-			DownVotes: 64,
+			Metadata:       bunovel.NewMetadata(goframework.NumberUUID(60), baseTime, nil),
+			Status:         dao.RequestStatusArchived,
+			CloseAfterDays: lo.ToPtr(3),
 		},
-
 		&dao.ImproveRequestRevisionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime.Add(3*time.Hour), &updateTime),
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, nil),
 			SourceID: goframework.NumberUUID(30),
-			UserID:   goframework.NumberUUID(300),
-			Title:    "my title with super thrusters",
-			Content:  "my content with super spaceships",
-		},
-
-		&dao.ImproveRequestModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(40), baseTime.Add(4*time.Hour), &updateTime),
-			UpVotes:   128,
-			DownVotes: 64,
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
 		},
-
 		&dao.ImproveRequestRevisionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(5), baseTime.Add(4*time.Hour), &updateTime),
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, nil),
 			SourceID: goframework.NumberUUID(40),
 			UserID:   goframework.NumberUUID(100),
-			Title:    "my title with tomatoes",
-			Content:  "my content with super chips",
+			Title:    "my title",
+			Content:  "my content",
 		},
-
-		// Suggestions for goframework.NumberUUID(1).
 		&dao.ImproveSuggestionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
-			SourceID: goframework.NumberUUID(10),
-			UserID:   uuid.Nil,
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(31), baseTime, nil),
+			SourceID:  goframework.NumberUUID(30),
+			UserID:    goframework.NumberUUID(200),
+			Validated: true,
 			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
-				RequestID: goframework.NumberUUID(1),
-				Title:     "suggestion title",
-				Content:   "suggestion content",
+				RequestID: goframework.NumberUUID(3),
+				Title:     "my title",
+				Content:   "my suggested content",
 			},
 		},
 		&dao.ImproveSuggestionModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &updateTime),
-			SourceID:  goframework.NumberUUID(10),
-			UserID:    uuid.Nil,
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(41), baseTime, nil),
+			SourceID:  goframework.NumberUUID(40),
+			UserID:    goframework.NumberUUID(200),
 			Validated: true,
 			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
-				RequestID: goframework.NumberUUID(1),
-				Title:     "suggestion title",
-				Content:   "suggestion content",
+				RequestID: goframework.NumberUUID(4),
+				Title:     "my title",
+				Content:   "my suggested content",
 			},
 		},
+		// Drafts do not count.
 		&dao.ImproveSuggestionModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, &updateTime),
-			SourceID:  goframework.NumberUUID(10),
-			UserID:    uuid.Nil,
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(42), baseTime, nil),
+			SourceID:  goframework.NumberUUID(40),
+			UserID:    goframework.NumberUUID(200),
+			Draft:     true,
 			Validated: true,
 			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
-				RequestID: goframework.NumberUUID(1),
-				Title:     "suggestion title",
-				Content:   "suggestion content",
+				RequestID: goframework.NumberUUID(4),
+				Title:     "my title",
+				Content:   "my suggested content",
 			},
 		},
+	}
 
-		// Suggestions for goframework.NumberUUID(2).
-		&dao.ImproveSuggestionModel{
-			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, &updateTime),
-			SourceID: goframework.NumberUUID(10),
-			UserID:   uuid.Nil,
-			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
-				RequestID: goframework.NumberUUID(2),
-				Title:     "suggestion title",
-				Content:   "suggestion content",
+	data := []struct {
+		name string
+
+		now time.Time
+
+		expect         int
+		expectStatuses map[uuid.UUID]dao.RequestStatus
+		expectErr      error
+	}{
+		{
+			name:   "Success",
+			now:    baseTime.Add(96 * time.Hour),
+			expect: 2,
+			expectStatuses: map[uuid.UUID]dao.RequestStatus{
+				goframework.NumberUUID(10): dao.RequestStatusClosed,
+				goframework.NumberUUID(20): dao.RequestStatusOpen,
+				goframework.NumberUUID(30): dao.RequestStatusResolved,
+				goframework.NumberUUID(40): dao.RequestStatusOpen,
+				goframework.NumberUUID(50): dao.RequestStatusOpen,
+				goframework.NumberUUID(60): dao.RequestStatusArchived,
 			},
 		},
-		&dao.ImproveSuggestionModel{
-			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(5), baseTime, &updateTime),
-			SourceID:  goframework.NumberUUID(10),
-			UserID:    uuid.Nil,
-			Validated: true,
-			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
-				RequestID: goframework.NumberUUID(2),
-				Title:     "suggestion title",
-				Content:   "suggestion content",
+		{
+			name:   "Success/NotOverdue",
+			now:    baseTime.Add(time.Hour),
+			expect: 1,
+			expectStatuses: map[uuid.UUID]dao.RequestStatus{
+				goframework.NumberUUID(10): dao.RequestStatusOpen,
+				goframework.NumberUUID(20): dao.RequestStatusOpen,
+				goframework.NumberUUID(30): dao.RequestStatusResolved,
+				goframework.NumberUUID(40): dao.RequestStatusOpen,
+				goframework.NumberUUID(50): dao.RequestStatusOpen,
+				goframework.NumberUUID(60): dao.RequestStatusArchived,
 			},
 		},
 	}
 
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveRequestRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.AutoClose(ctx, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+
+				requests := make([]*dao.ImproveRequestModel, 0)
+				require.NoError(t, tx.NewSelect().Model(&requests).Scan(ctx))
+				require.Equal(t, d.expectStatuses, lo.SliceToMap(requests, func(item *dao.ImproveRequestModel) (uuid.UUID, dao.RequestStatus) {
+					return item.ID, item.Status
+				}))
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestImproveRequestRepository_FindDuplicate(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	content := "The rain had not stopped for three days, and the river was already licking the doorsteps."
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  content,
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+		},
+		// Drafts are ignored.
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), updateTime, nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  content,
+			Draft:    true,
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(30), baseTime, nil),
+		},
+		// Deleted revisions are ignored.
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), updateTime, nil),
+			SourceID:  goframework.NumberUUID(30),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "my title",
+			Content:   content,
+			DeletedAt: &updateTime,
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(40), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, nil),
+			SourceID: goframework.NumberUUID(40),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "A completely different scene, with nothing in common.",
+		},
+	}
+
 	data := []struct {
 		name string
 
-		ids []uuid.UUID
+		query           dao.DuplicateQuery
+		excludeSourceID uuid.UUID
 
-		expect    []*dao.ImproveRequestPreview
+		expect    *dao.Duplicate
 		expectErr error
 	}{
 		{
 			name: "Success",
-			ids:  []uuid.UUID{goframework.NumberUUID(10), goframework.NumberUUID(20), goframework.NumberUUID(60)},
-			expect: []*dao.ImproveRequestPreview{
-				{
-					Metadata:                 bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
-					UserID:                   goframework.NumberUUID(100),
-					Title:                    "my title with spaceships",
-					Content:                  "my content with thrusters",
-					UpVotes:                  160,
-					DownVotes:                80,
-					RevisionCount:            2,
-					SuggestionsCount:         5,
-					AcceptedSuggestionsCount: 3,
-				},
-				{
-					Metadata:      bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), &updateTime),
-					UserID:        goframework.NumberUUID(200),
-					Title:         "my title with thrusters",
-					Content:       "my content with spaceships",
-					RevisionCount: 1,
-					UpVotes:       128,
-					DownVotes:     64,
-				},
+			query: dao.DuplicateQuery{
+				UserID:        goframework.NumberUUID(100),
+				Content:       content,
+				Since:         baseTime,
+				MinSimilarity: 0.8,
+			},
+			excludeSourceID: goframework.NumberUUID(50),
+			expect: &dao.Duplicate{
+				ID:         goframework.NumberUUID(1),
+				Similarity: 1,
 			},
 		},
 		{
-			name:   "Success/NoResults",
-			ids:    []uuid.UUID{},
-			expect: []*dao.ImproveRequestPreview{},
+			name: "Error/ExcludedSource",
+			query: dao.DuplicateQuery{
+				UserID:        goframework.NumberUUID(100),
+				Content:       content,
+				Since:         baseTime,
+				MinSimilarity: 0.8,
+			},
+			excludeSourceID: goframework.NumberUUID(10),
+			expectErr:       bunovel.ErrNotFound,
+		},
+		{
+			name: "Error/OtherUser",
+			query: dao.DuplicateQuery{
+				UserID:        goframework.NumberUUID(200),
+				Content:       content,
+				Since:         baseTime,
+				MinSimilarity: 0.8,
+			},
+			excludeSourceID: goframework.NumberUUID(50),
+			expectErr:       bunovel.ErrNotFound,
+		},
+		{
+			name: "Error/TooOld",
+			query: dao.DuplicateQuery{
+				UserID:        goframework.NumberUUID(100),
+				Content:       content,
+				Since:         updateTime,
+				MinSimilarity: 0.8,
+			},
+			excludeSourceID: goframework.NumberUUID(50),
+			expectErr:       bunovel.ErrNotFound,
+		},
+		{
+			name: "Error/NotSimilar",
+			query: dao.DuplicateQuery{
+				UserID:        goframework.NumberUUID(100),
+				Content:       "Nobody had seen the lighthouse keeper since the storm.",
+				Since:         baseTime,
+				MinSimilarity: 0.8,
+			},
+			excludeSourceID: goframework.NumberUUID(50),
+			expectErr:       bunovel.ErrNotFound,
 		},
 	}
 
-	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
-		repository := dao.NewImproveRequestRepository(tx)
-
-		for _, d := range data {
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveRequestRepository(tx)
 			t.Run(d.name, func(st *testing.T) {
-				res, err := repository.List(ctx, d.ids)
+				res, err := repository.FindDuplicate(ctx, d.query, d.excludeSourceID)
 				require.ErrorIs(t, err, d.expectErr)
 				require.Equal(t, d.expect, res)
 			})
-		}
-	})
-	require.NoError(t, err)
+		})
+		require.NoError(t, err)
+	}
 }
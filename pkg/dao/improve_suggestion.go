@@ -5,30 +5,49 @@ import (
 	"github.com/a-novel/bunovel"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
-	"strings"
 	"time"
 )
 
 type ImproveSuggestionRepository interface {
 	// Get returns the improvement suggestion with the given ID.
 	Get(ctx context.Context, id uuid.UUID) (*ImproveSuggestionModel, error)
-	// Create creates a new improvement suggestion for a given improvement request revision.
-	Create(ctx context.Context, data *ImproveSuggestionModelCore, userID, sourceID, id uuid.UUID, now time.Time) (*ImproveSuggestionModel, error)
+	// Create creates a new improvement suggestion for a given improvement request revision, and subscribes its author
+	// to the request. The optional report, that flags the suggestion for moderation, and the optional events are
+	// written in the same transaction. A draft suggestion is only visible to its author, until it is published.
+	Create(ctx context.Context, data *ImproveSuggestionModelCore, draft bool, userID, sourceID, id uuid.UUID, report *ReportModel, now time.Time, events ...*EventModelCore) (*ImproveSuggestionModel, error)
+	// Publish makes a draft suggestion visible to everyone, and stamps its publication date. The optional events are
+	// written to the outbox in the same transaction.
+	Publish(ctx context.Context, id uuid.UUID, now time.Time, events ...*EventModelCore) (*ImproveSuggestionModel, error)
 	// Update updates an existing improvement suggestion.
 	Update(ctx context.Context, data *ImproveSuggestionModelCore, id uuid.UUID, now time.Time) (*ImproveSuggestionModel, error)
-	// Delete deletes an existing improvement suggestion.
-	Delete(ctx context.Context, id uuid.UUID) error
-
-	// Validate validates an existing improvement suggestion.
-	Validate(ctx context.Context, validated bool, id uuid.UUID) (*ImproveSuggestionModel, error)
-	// UpdateVotes updates the number of up and down votes of a suggestion.
-	UpdateVotes(ctx context.Context, id uuid.UUID, upVotes, downVotes int) error
+	// Delete soft deletes an existing improvement suggestion. It is left out of every read, until it is restored or
+	// purged.
+	Delete(ctx context.Context, id uuid.UUID, now time.Time) error
+	// GetDeleted returns a soft deleted improvement suggestion.
+	GetDeleted(ctx context.Context, id uuid.UUID) (*ImproveSuggestionModel, error)
+	// Restore restores a soft deleted improvement suggestion. The suggestion cannot be restored while its revision is
+	// deleted.
+	Restore(ctx context.Context, id uuid.UUID) (*ImproveSuggestionModel, error)
+	// Purge permanently deletes the improvement suggestions that were soft deleted before the given date. It returns
+	// the number of purged suggestions.
+	Purge(ctx context.Context, before time.Time) (int, error)
+
+	// Validate validates an existing improvement suggestion. The optional events are written to the outbox in the
+	// same transaction.
+	Validate(ctx context.Context, validated bool, id uuid.UUID, now time.Time, events ...*EventModelCore) (*ImproveSuggestionModel, error)
 
 	// Search returns a list of improvement suggestions, matching the provided query. Results must be paginated using
-	// the limit and offset parameters.
-	// It also returns the total number of available results, to help with pagination.
+	// the limit parameter, and either the offset parameter or the cursor of the query.
+	// It also returns the total number of available results, to help with pagination, unless the query skips it. The
+	// total ignores the cursor: every page of a search returns the same one.
 	Search(ctx context.Context, query ImproveSuggestionSearchQuery, limit, offset int) ([]*ImproveSuggestionModel, int, error)
+	// SuggestQuery corrects the misspelled words of a search query, using the words of the suggestion titles. It
+	// returns an empty string when no word could be corrected.
+	SuggestQuery(ctx context.Context, query string) (string, error)
 	List(ctx context.Context, ids []uuid.UUID) ([]*ImproveSuggestionModel, error)
+	// FindDuplicate returns the recent suggestion of the user that resembles the most the given content. It returns
+	// bunovel.ErrNotFound when no suggestion is similar enough.
+	FindDuplicate(ctx context.Context, query DuplicateQuery) (*Duplicate, error)
 }
 
 type ImproveSuggestionModel struct {
@@ -48,6 +67,27 @@ type ImproveSuggestionModel struct {
 	// DownVotes is the number of down votes the suggestion has received. This value is indirectly updated from the
 	// votes table.
 	DownVotes int `bun:"down_votes"`
+	// Draft is true while the suggestion is only visible to its author.
+	Draft bool `bun:"draft"`
+	// PublishedAt is set when a draft suggestion is published. Suggestions that were never drafts do not have one.
+	PublishedAt *time.Time `bun:"published_at"`
+	// Hidden is true when the suggestion was hidden by a moderator. Hidden suggestions are left out of every read.
+	Hidden bool `bun:"hidden"`
+	// DeletedAt is set when the suggestion is soft deleted.
+	DeletedAt *time.Time `bun:"deleted_at"`
+
+	// The following sort keys are only set by Search, when the results are sorted on them.
+
+	// LastActivityAt is the date of the latest edit of the suggestion, or of the latest comment posted on it.
+	LastActivityAt time.Time `bun:"last_activity_at,scanonly"`
+	// CommentsCount is the number of comments posted on the suggestion.
+	CommentsCount int `bun:"comments_count,scanonly"`
+	// Controversy is high when the suggestion received many votes, evenly split between up and down votes.
+	Controversy float64 `bun:"controversy,scanonly"`
+	// Hotness is the score of the suggestion on a log scale, offset by its creation date.
+	Hotness float64 `bun:"hotness,scanonly"`
+	// SearchRank is the relevance of the suggestion for a text search. It is set when a query is provided.
+	SearchRank float64 `bun:"search_rank,scanonly"`
 
 	ImproveSuggestionModelCore
 }
@@ -60,10 +100,52 @@ type ImproveSuggestionModelCore struct {
 	Title string `bun:"title"`
 	// Content contains the updated content of the source request.
 	Content string `bun:"content"`
+
+	// AddedChars and RemovedChars count the characters the suggestion inserts in, and deletes from, the content of
+	// its revision. Edit stats are nil for the suggestions created before they were measured.
+	AddedChars   *int `bun:"added_chars"`
+	RemovedChars *int `bun:"removed_chars"`
+	// ChangedRatio is the share of the content that was added or removed, from 0 to 1.
+	ChangedRatio *float64 `bun:"changed_ratio"`
 }
 
 type ImproveSuggestionSearchQueryOrder struct {
+	// Score sorts the suggestions by score (up votes minus down votes).
 	Score bool
+	// Activity sorts the suggestions by last activity: their latest edit, or the latest comment posted on them.
+	Activity bool
+	// Comments sorts the suggestions by number of comments.
+	Comments bool
+	// Controversial sorts the suggestions by controversy: many votes, evenly split between up and down votes.
+	Controversial bool
+	// Hot sorts the suggestions by score, on a log scale, offset by their creation date.
+	Hot bool
+}
+
+// Sort keys of the suggestions that are not stored in the table. Comments are looked up from the comments_target
+// index, and the controversy and hotness have their own indexes.
+const (
+	improveSuggestionCommentsCount = "(SELECT COUNT(*) FROM comments WHERE comments.target_type = 'improve_suggestion' " +
+		"AND comments.target_id = ?TableAlias.id AND comments.hidden = FALSE)"
+	improveSuggestionLastActivity = "GREATEST(COALESCE(?TableAlias.updated_at, ?TableAlias.created_at), " +
+		"(SELECT MAX(comments.created_at) FROM comments WHERE comments.target_type = 'improve_suggestion' " +
+		"AND comments.target_id = ?TableAlias.id AND comments.hidden = FALSE))"
+	improveSuggestionControversy = "controversy(?TableAlias.up_votes, ?TableAlias.down_votes)"
+	improveSuggestionHotness     = "hotness(?TableAlias.up_votes, ?TableAlias.down_votes, ?TableAlias.created_at)"
+)
+
+// ImproveSuggestionSearchCursor holds the sort keys of the last result of a page. The next page starts right after
+// it. Keys that are not part of the search order are ignored.
+type ImproveSuggestionSearchCursor struct {
+	Rank        float64
+	Score       int
+	Activity    time.Time
+	Comments    int
+	Controversy float64
+	Hotness     float64
+	// UpdatedAt is the date of the last update of the suggestion, or its creation date if it was never updated.
+	UpdatedAt time.Time
+	ID        uuid.UUID
 }
 
 type ImproveSuggestionSearchQuery struct {
@@ -78,8 +160,22 @@ type ImproveSuggestionSearchQuery struct {
 	// Validated is an optional parameter, to only target suggestions that have been validated by the improvement
 	// request creator.
 	Validated *bool
+	// ViewerID is the user running the search. Draft suggestions are only returned to their author.
+	ViewerID *uuid.UUID
+	// Query is an optional parameter, to filter suggestions based on their title or content.
+	Query string
+	// Fuzzy also matches the suggestions whose title contains a word close to the Query, so misspelled words are
+	// tolerated. The similarity of the title is added to the rank of the results.
+	Fuzzy bool
+	// Language is an optional parameter, to only target suggestions made on requests written in a specific language.
+	// The Query is then only parsed with the dictionary of this language.
+	Language Language
 	// Order specifies custom ordering for the search results.
 	Order *ImproveSuggestionSearchQueryOrder
+	// Cursor is an optional parameter, to only return the results that come after it.
+	Cursor *ImproveSuggestionSearchCursor
+	// SkipCount prevents the total number of results from being computed. The returned total is then always 0.
+	SkipCount bool
 }
 
 type improveSuggestionRepositoryImpl struct {
@@ -94,14 +190,20 @@ func NewImproveSuggestionRepository(db bun.IDB) ImproveSuggestionRepository {
 
 func (repository *improveSuggestionRepositoryImpl) Get(ctx context.Context, id uuid.UUID) (*ImproveSuggestionModel, error) {
 	suggestion := &ImproveSuggestionModel{Metadata: bunovel.Metadata{ID: id}}
-	if err := repository.db.NewSelect().Model(suggestion).WherePK().Scan(ctx); err != nil {
+	err := repository.db.NewSelect().
+		Model(suggestion).
+		WherePK().
+		Where("hidden = FALSE").
+		Where("deleted_at IS NULL").
+		Scan(ctx)
+	if err != nil {
 		return nil, bunovel.HandlePGError(err)
 	}
 
 	return suggestion, nil
 }
 
-func (repository *improveSuggestionRepositoryImpl) Create(ctx context.Context, data *ImproveSuggestionModelCore, userID, sourceID, id uuid.UUID, now time.Time) (*ImproveSuggestionModel, error) {
+func (repository *improveSuggestionRepositoryImpl) Create(ctx context.Context, data *ImproveSuggestionModelCore, draft bool, userID, sourceID, id uuid.UUID, report *ReportModel, now time.Time, events ...*EventModelCore) (*ImproveSuggestionModel, error) {
 	suggestion := &ImproveSuggestionModel{
 		Metadata: bunovel.Metadata{
 			ID:        id,
@@ -109,11 +211,51 @@ func (repository *improveSuggestionRepositoryImpl) Create(ctx context.Context, d
 		},
 		SourceID:                   sourceID,
 		UserID:                     userID,
+		Draft:                      draft,
 		ImproveSuggestionModelCore: *data,
 	}
 
-	err := repository.db.NewInsert().Model(suggestion).Returning("*").Scan(ctx)
-	if err != nil {
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := tx.NewInsert().Model(suggestion).Returning("*").Scan(ctx); err != nil {
+			return err
+		}
+
+		if err := subscribe(ctx, tx, userID, sourceID, now); err != nil {
+			return err
+		}
+
+		if err := insertReport(ctx, tx, report); err != nil {
+			return err
+		}
+
+		return insertEvents(ctx, tx, events, now)
+	}); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return suggestion, nil
+}
+
+func (repository *improveSuggestionRepositoryImpl) Publish(ctx context.Context, id uuid.UUID, now time.Time, events ...*EventModelCore) (*ImproveSuggestionModel, error) {
+	suggestion := &ImproveSuggestionModel{Metadata: bunovel.Metadata{ID: id}}
+
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewUpdate().
+			Model(suggestion).
+			Set("draft = FALSE").
+			Set("published_at = ?", now).
+			WherePK().
+			Where("draft = TRUE").
+			Where("hidden = FALSE").
+			Where("deleted_at IS NULL").
+			Returning("*").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		return insertEvents(ctx, tx, events, now)
+	}); err != nil {
 		return nil, bunovel.HandlePGError(err)
 	}
 
@@ -129,7 +271,7 @@ func (repository *improveSuggestionRepositoryImpl) Update(ctx context.Context, d
 		ImproveSuggestionModelCore: *data,
 	}
 
-	err := repository.db.NewUpdate().Model(suggestion).Column("updated_at", "request_id", "title", "content").WherePK().Returning("*").Scan(ctx)
+	err := repository.db.NewUpdate().Model(suggestion).Column("updated_at", "request_id", "title", "content", "added_chars", "removed_chars", "changed_ratio").WherePK().Returning("*").Scan(ctx)
 	if err != nil {
 		return nil, bunovel.HandlePGError(err)
 	}
@@ -137,10 +279,13 @@ func (repository *improveSuggestionRepositoryImpl) Update(ctx context.Context, d
 	return suggestion, nil
 }
 
-func (repository *improveSuggestionRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	suggestion := &ImproveSuggestionModel{Metadata: bunovel.Metadata{ID: id}}
-
-	_, err := repository.db.NewDelete().Model(suggestion).WherePK().Exec(ctx)
+func (repository *improveSuggestionRepositoryImpl) Delete(ctx context.Context, id uuid.UUID, now time.Time) error {
+	_, err := repository.db.NewUpdate().
+		Model((*ImproveSuggestionModel)(nil)).
+		Set("deleted_at = ?", now).
+		Where("id = ?", id).
+		Where("deleted_at IS NULL").
+		Exec(ctx)
 	if err != nil {
 		return bunovel.HandlePGError(err)
 	}
@@ -148,13 +293,31 @@ func (repository *improveSuggestionRepositoryImpl) Delete(ctx context.Context, i
 	return nil
 }
 
-func (repository *improveSuggestionRepositoryImpl) Validate(ctx context.Context, validated bool, id uuid.UUID) (*ImproveSuggestionModel, error) {
-	suggestion := &ImproveSuggestionModel{
-		Metadata:  bunovel.Metadata{ID: id},
-		Validated: validated,
+func (repository *improveSuggestionRepositoryImpl) GetDeleted(ctx context.Context, id uuid.UUID) (*ImproveSuggestionModel, error) {
+	suggestion := &ImproveSuggestionModel{Metadata: bunovel.Metadata{ID: id}}
+	if err := repository.db.NewSelect().Model(suggestion).WherePK().Where("deleted_at IS NOT NULL").Scan(ctx); err != nil {
+		return nil, bunovel.HandlePGError(err)
 	}
 
-	err := repository.db.NewUpdate().Model(suggestion).Column("validated").WherePK().Returning("*").Scan(ctx)
+	return suggestion, nil
+}
+
+func (repository *improveSuggestionRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) (*ImproveSuggestionModel, error) {
+	suggestion := &ImproveSuggestionModel{Metadata: bunovel.Metadata{ID: id}}
+
+	activeRevisions := repository.db.NewSelect().
+		Model((*ImproveRequestRevisionModel)(nil)).
+		Column("id").
+		Where("deleted_at IS NULL")
+
+	err := repository.db.NewUpdate().
+		Model(suggestion).
+		Set("deleted_at = NULL").
+		WherePK().
+		Where("deleted_at IS NOT NULL").
+		Where("request_id IN (?)", activeRevisions).
+		Returning("*").
+		Scan(ctx)
 	if err != nil {
 		return nil, bunovel.HandlePGError(err)
 	}
@@ -162,34 +325,64 @@ func (repository *improveSuggestionRepositoryImpl) Validate(ctx context.Context,
 	return suggestion, nil
 }
 
-func (repository *improveSuggestionRepositoryImpl) UpdateVotes(ctx context.Context, id uuid.UUID, upVotes, downVotes int) error {
-	suggestion := &ImproveSuggestionModel{
-		Metadata:  bunovel.Metadata{ID: id},
-		UpVotes:   upVotes,
-		DownVotes: downVotes,
+func (repository *improveSuggestionRepositoryImpl) Purge(ctx context.Context, before time.Time) (int, error) {
+	res, err := repository.db.NewDelete().
+		Model((*ImproveSuggestionModel)(nil)).
+		Where("deleted_at < ?", before).
+		Exec(ctx)
+	if err != nil {
+		return 0, bunovel.HandlePGError(err)
 	}
 
-	rows, err := repository.db.NewUpdate().Model(suggestion).Column("up_votes", "down_votes").WherePK().Exec(ctx)
+	purged, err := res.RowsAffected()
 	if err != nil {
-		return bunovel.HandlePGError(err)
+		return 0, bunovel.HandlePGError(err)
 	}
 
-	if err := bunovel.ForceRowsUpdate(rows); err != nil {
-		return err
+	return int(purged), nil
+}
+
+func (repository *improveSuggestionRepositoryImpl) Validate(ctx context.Context, validated bool, id uuid.UUID, now time.Time, events ...*EventModelCore) (*ImproveSuggestionModel, error) {
+	suggestion := &ImproveSuggestionModel{
+		Metadata:  bunovel.Metadata{ID: id},
+		Validated: validated,
 	}
 
-	return nil
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := tx.NewUpdate().Model(suggestion).Column("validated").WherePK().Returning("*").Scan(ctx); err != nil {
+			return err
+		}
+
+		return insertEvents(ctx, tx, events, now)
+	}); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return suggestion, nil
 }
 
 func (repository *improveSuggestionRepositoryImpl) Search(ctx context.Context, query ImproveSuggestionSearchQuery, limit, offset int) ([]*ImproveSuggestionModel, int, error) {
 	suggestions := make([]*ImproveSuggestionModel, 0)
 
-	queryBuilder := repository.db.NewSelect().Model(&suggestions).Limit(limit).Offset(offset)
+	// Sort keys are only selected when they are used, so the default columns are listed explicitly.
+	queryBuilder := repository.db.NewSelect().
+		Model(&suggestions).
+		ColumnExpr("?TableColumns").
+		Where("hidden = FALSE").
+		Where("deleted_at IS NULL").
+		Limit(limit).
+		Offset(offset)
 
 	if query.UserID != nil {
 		queryBuilder.Where("user_id = ?", *query.UserID)
 	}
 
+	if query.ViewerID != nil {
+		queryBuilder.Where("(draft = FALSE OR user_id = ?)", *query.ViewerID)
+	} else {
+		queryBuilder.Where("draft = FALSE")
+	}
+
 	if query.SourceID != nil {
 		queryBuilder.Where("source_id = ?", *query.SourceID)
 	}
@@ -202,33 +395,117 @@ func (repository *improveSuggestionRepositoryImpl) Search(ctx context.Context, q
 		queryBuilder.Where("validated = ?", *query.Validated)
 	}
 
-	var orderBy []string
+	if query.Language != "" {
+		queryBuilder.Where("?TableAlias.language = ?", query.Language)
+	}
+
+	cursor := query.Cursor
+	if cursor == nil {
+		cursor = new(ImproveSuggestionSearchCursor)
+	}
+
+	var sortKeys []searchSortKey
+
+	if query.Query != "" {
+		rank := "ts_rank_cd(?TableAlias.text_searchable_index_col, search.query)"
+		match := "?TableAlias.text_searchable_index_col @@ search.query"
+		if query.Fuzzy {
+			// A misspelled word shares no lexeme with the content, but most of its trigrams with a word of the title.
+			rank += " + word_similarity(search.text, ?TableAlias.title)"
+			match = "(" + match + " OR search.text <% ?TableAlias.title)"
+		}
+
+		queryBuilder = queryBuilder.
+			ColumnExpr(rank+" AS search_rank").
+			TableExpr("(?) AS search", textSearchQuery(repository.db, query.Query, query.Language)).
+			Where("?TableAlias.language = search.language").
+			Where(match)
+
+		sortKeys = append(sortKeys, searchSortKey{
+			expr: rank,
+			// The rank is a real: the cursor value is cast back, so it compares equal to the rank it was read from.
+			placeholder: "?::real",
+			value:       cursor.Rank,
+		})
+	}
 
 	if query.Order != nil {
 		if query.Order.Score {
-			orderBy = append(orderBy, "up_votes - down_votes DESC")
+			sortKeys = append(sortKeys, searchSortKey{expr: "up_votes - down_votes", value: cursor.Score})
+		}
+		if query.Order.Activity {
+			queryBuilder.ColumnExpr(improveSuggestionLastActivity + " AS last_activity_at")
+			sortKeys = append(sortKeys, searchSortKey{expr: improveSuggestionLastActivity, value: cursor.Activity})
+		}
+		if query.Order.Comments {
+			queryBuilder.ColumnExpr(improveSuggestionCommentsCount + " AS comments_count")
+			sortKeys = append(sortKeys, searchSortKey{expr: improveSuggestionCommentsCount, value: cursor.Comments})
+		}
+		if query.Order.Controversial {
+			queryBuilder.ColumnExpr(improveSuggestionControversy + " AS controversy")
+			sortKeys = append(sortKeys, searchSortKey{expr: improveSuggestionControversy, value: cursor.Controversy})
+		}
+		if query.Order.Hot {
+			queryBuilder.ColumnExpr(improveSuggestionHotness + " AS hotness")
+			sortKeys = append(sortKeys, searchSortKey{expr: improveSuggestionHotness, value: cursor.Hotness})
 		}
 	}
 
-	orderBy = append(orderBy, "updated_at DESC")
+	// Suggestions that were never updated have no update date, and would not compare with a cursor.
+	sortKeys = append(
+		sortKeys,
+		searchSortKey{expr: "COALESCE(?TableAlias.updated_at, ?TableAlias.created_at)", value: cursor.UpdatedAt},
+		searchSortKey{expr: "?TableAlias.id", value: cursor.ID},
+	)
+
+	// The total is counted before the keyset condition of the cursor is added, so it stays the same on every page.
+	var count int
+	if !query.SkipCount {
+		var err error
+		if count, err = queryBuilder.Count(ctx); err != nil {
+			return nil, 0, bunovel.HandlePGError(err)
+		}
+	}
 
-	queryBuilder = queryBuilder.OrderExpr(strings.Join(orderBy, ", "))
+	queryBuilder = sortSearch(queryBuilder, sortKeys, query.Cursor != nil)
 
-	count, err := queryBuilder.ScanAndCount(ctx)
-	if err != nil {
+	if err := queryBuilder.Scan(ctx); err != nil {
 		return nil, 0, bunovel.HandlePGError(err)
 	}
 
 	return suggestions, count, nil
 }
 
+func (repository *improveSuggestionRepositoryImpl) SuggestQuery(ctx context.Context, query string) (string, error) {
+	suggestion, err := suggestSearchQuery(ctx, repository.db, (*ImproveSuggestionModel)(nil), query)
+	if err != nil {
+		return "", bunovel.HandlePGError(err)
+	}
+
+	return suggestion, nil
+}
+
 func (repository *improveSuggestionRepositoryImpl) List(ctx context.Context, ids []uuid.UUID) ([]*ImproveSuggestionModel, error) {
 	suggestions := make([]*ImproveSuggestionModel, 0)
 
-	err := repository.db.NewSelect().Model(&suggestions).Where("id IN (?)", bun.In(ids)).Scan(ctx)
+	err := repository.db.NewSelect().
+		Model(&suggestions).
+		Where("id IN (?)", bun.In(ids)).
+		Where("hidden = FALSE").
+		Where("deleted_at IS NULL").
+		Scan(ctx)
 	if err != nil {
 		return nil, bunovel.HandlePGError(err)
 	}
 
 	return suggestions, nil
 }
+
+func (repository *improveSuggestionRepositoryImpl) FindDuplicate(ctx context.Context, query DuplicateQuery) (*Duplicate, error) {
+	duplicate, err := scanDuplicate(ctx, findDuplicate(repository.db, (*ImproveSuggestionModel)(nil), query))
+	if err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return duplicate, nil
+}
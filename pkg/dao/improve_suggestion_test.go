@@ -21,6 +21,16 @@ func TestImproveSuggestionRepository_Get(t *testing.T) {
 	defer sqlDB.Close()
 
 	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
 		&dao.ImproveSuggestionModel{
 			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
 			SourceID:  goframework.NumberUUID(10),
@@ -88,6 +98,26 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 	defer sqlDB.Close()
 
 	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
 		&dao.ImproveSuggestionModel{
 			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
 			SourceID:  goframework.NumberUUID(10),
@@ -107,9 +137,11 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 		name string
 
 		data     *dao.ImproveSuggestionModelCore
+		draft    bool
 		userID   uuid.UUID
 		sourceID uuid.UUID
 		id       uuid.UUID
+		report   *dao.ReportModel
 		now      time.Time
 
 		expect    *dao.ImproveSuggestionModel
@@ -137,6 +169,39 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Success/Reported",
+			data: &dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(2),
+				Title:     "my title",
+				Content:   "my content",
+			},
+			userID:   goframework.NumberUUID(200),
+			sourceID: goframework.NumberUUID(20),
+			id:       goframework.NumberUUID(2),
+			report: &dao.ReportModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, nil),
+				UserID:   dao.ReportSystemUserID,
+				Status:   dao.ReportStatusOpen,
+				ReportModelCore: dao.ReportModelCore{
+					TargetType: dao.ReportTargetImproveSuggestion,
+					TargetID:   goframework.NumberUUID(2),
+					Reason:     dao.ReportReasonDuplicate,
+					Content:    "my report",
+				},
+			},
+			now: baseTime,
+			expect: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+				SourceID: goframework.NumberUUID(20),
+				UserID:   goframework.NumberUUID(200),
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(2),
+					Title:     "my title",
+					Content:   "my content",
+				},
+			},
+		},
 		{
 			name: "Success/OnRevisionWithOtherSuggestions",
 			data: &dao.ImproveSuggestionModelCore{
@@ -159,15 +224,168 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Success/Draft",
+			data: &dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(2),
+				Title:     "my title",
+				Content:   "my content",
+			},
+			draft:    true,
+			userID:   goframework.NumberUUID(200),
+			sourceID: goframework.NumberUUID(20),
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			expect: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+				SourceID: goframework.NumberUUID(20),
+				UserID:   goframework.NumberUUID(200),
+				Draft:    true,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(2),
+					Title:     "my title",
+					Content:   "my content",
+				},
+			},
+		},
 	}
 
 	for _, d := range data {
 		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
 			repository := dao.NewImproveSuggestionRepository(tx)
+			reportRepository := dao.NewReportRepository(tx)
 			t.Run(d.name, func(st *testing.T) {
-				res, err := repository.Create(ctx, d.data, d.userID, d.sourceID, d.id, d.now)
+				res, err := repository.Create(ctx, d.data, d.draft, d.userID, d.sourceID, d.id, d.report, d.now)
 				require.Equal(t, d.expect, res)
 				require.ErrorIs(t, err, d.expectErr)
+
+				if err != nil {
+					return
+				}
+
+				// The author of a suggestion follows the request.
+				subscribed, err := tx.NewSelect().
+					Model(&dao.SubscriptionModel{UserID: d.userID, SourceID: d.sourceID}).
+					WherePK().
+					Exists(ctx)
+				require.NoError(t, err)
+				require.True(t, subscribed)
+
+				// The report is filed along with the suggestion.
+				if d.report != nil {
+					report, err := reportRepository.Get(ctx, d.report.ID)
+					require.NoError(t, err)
+					require.Equal(t, d.report, report)
+				}
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestImproveSuggestionRepository_Publish(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my suggested content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			Draft:    true,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my drafted content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(200),
+			Draft:     true,
+			DeletedAt: &baseTime,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my deleted content",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id  uuid.UUID
+		now time.Time
+
+		expect    *dao.ImproveSuggestionModel
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   goframework.NumberUUID(2),
+			now:  updateTime,
+			expect: &dao.ImproveSuggestionModel{
+				Metadata:    bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+				SourceID:    goframework.NumberUUID(10),
+				UserID:      goframework.NumberUUID(200),
+				PublishedAt: &updateTime,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(1),
+					Title:     "my title",
+					Content:   "my drafted content",
+				},
+			},
+		},
+		{
+			name:      "Error/AlreadyPublished",
+			id:        goframework.NumberUUID(1),
+			now:       updateTime,
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/Deleted",
+			id:        goframework.NumberUUID(3),
+			now:       updateTime,
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/NotFound",
+			id:        goframework.NumberUUID(4),
+			now:       updateTime,
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveSuggestionRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Publish(ctx, d.id, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
 			})
 		})
 		require.NoError(t, err)
@@ -180,6 +398,23 @@ func TestImproveSuggestionRepository_Update(t *testing.T) {
 	defer sqlDB.Close()
 
 	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
 		&dao.ImproveSuggestionModel{
 			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &baseTime),
 			SourceID:  goframework.NumberUUID(10),
@@ -228,6 +463,35 @@ func TestImproveSuggestionRepository_Update(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Success/EditStats",
+			data: &dao.ImproveSuggestionModelCore{
+				RequestID:    goframework.NumberUUID(2),
+				Title:        "new title",
+				Content:      "new content",
+				AddedChars:   lo.ToPtr(4),
+				RemovedChars: lo.ToPtr(0),
+				ChangedRatio: lo.ToPtr(0.22),
+			},
+			id:  goframework.NumberUUID(1),
+			now: updateTime,
+			expect: &dao.ImproveSuggestionModel{
+				Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+				SourceID:  goframework.NumberUUID(10),
+				UserID:    goframework.NumberUUID(100),
+				UpVotes:   128,
+				DownVotes: 64,
+				Validated: true,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID:    goframework.NumberUUID(2),
+					Title:        "new title",
+					Content:      "new content",
+					AddedChars:   lo.ToPtr(4),
+					RemovedChars: lo.ToPtr(0),
+					ChangedRatio: lo.ToPtr(0.22),
+				},
+			},
+		},
 		{
 			name: "Error/NotFound",
 			data: &dao.ImproveSuggestionModelCore{
@@ -260,6 +524,16 @@ func TestImproveSuggestionRepository_Delete(t *testing.T) {
 	defer sqlDB.Close()
 
 	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
 		&dao.ImproveSuggestionModel{
 			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &baseTime),
 			SourceID:  goframework.NumberUUID(10),
@@ -278,17 +552,22 @@ func TestImproveSuggestionRepository_Delete(t *testing.T) {
 	data := []struct {
 		name string
 
-		id uuid.UUID
+		id  uuid.UUID
+		now time.Time
 
-		expectErr error
+		expectDeleted bool
+		expectErr     error
 	}{
 		{
-			name: "Success",
-			id:   goframework.NumberUUID(1),
+			name:          "Success",
+			id:            goframework.NumberUUID(1),
+			now:           updateTime,
+			expectDeleted: true,
 		},
 		{
 			name: "Success/NotFound",
 			id:   goframework.NumberUUID(2),
+			now:  updateTime,
 		},
 	}
 
@@ -296,20 +575,41 @@ func TestImproveSuggestionRepository_Delete(t *testing.T) {
 		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
 			repository := dao.NewImproveSuggestionRepository(tx)
 			t.Run(d.name, func(st *testing.T) {
-				err := repository.Delete(ctx, d.id)
+				err := repository.Delete(ctx, d.id, d.now)
 				require.ErrorIs(t, err, d.expectErr)
+
+				if !d.expectDeleted {
+					return
+				}
+
+				_, err = repository.Get(ctx, d.id)
+				require.ErrorIs(t, err, bunovel.ErrNotFound)
+
+				suggestion, err := repository.GetDeleted(ctx, d.id)
+				require.NoError(t, err)
+				require.Equal(t, d.now, *suggestion.DeletedAt)
 			})
 		})
 		require.NoError(t, err)
 	}
 }
 
-func TestImproveSuggestionRepository_Validate(t *testing.T) {
+func TestImproveSuggestionRepository_GetDeleted(t *testing.T) {
 	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
 	defer db.Close()
 	defer sqlDB.Close()
 
 	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
 		&dao.ImproveSuggestionModel{
 			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &baseTime),
 			SourceID:  goframework.NumberUUID(10),
@@ -317,6 +617,7 @@ func TestImproveSuggestionRepository_Validate(t *testing.T) {
 			UpVotes:   128,
 			DownVotes: 64,
 			Validated: true,
+			DeletedAt: &updateTime,
 			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
 				RequestID: goframework.NumberUUID(1),
 				Title:     "title",
@@ -327,8 +628,9 @@ func TestImproveSuggestionRepository_Validate(t *testing.T) {
 			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &baseTime),
 			SourceID:  goframework.NumberUUID(10),
 			UserID:    goframework.NumberUUID(100),
-			UpVotes:   32,
-			DownVotes: 16,
+			UpVotes:   128,
+			DownVotes: 64,
+			Validated: true,
 			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
 				RequestID: goframework.NumberUUID(1),
 				Title:     "title",
@@ -340,14 +642,13 @@ func TestImproveSuggestionRepository_Validate(t *testing.T) {
 	data := []struct {
 		name string
 
-		validated bool
-		id        uuid.UUID
+		id uuid.UUID
 
 		expect    *dao.ImproveSuggestionModel
 		expectErr error
 	}{
 		{
-			name: "Success/UnValidate",
+			name: "Success",
 			id:   goframework.NumberUUID(1),
 			expect: &dao.ImproveSuggestionModel{
 				Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &baseTime),
@@ -355,6 +656,8 @@ func TestImproveSuggestionRepository_Validate(t *testing.T) {
 				UserID:    goframework.NumberUUID(100),
 				UpVotes:   128,
 				DownVotes: 64,
+				Validated: true,
+				DeletedAt: &updateTime,
 				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
 					RequestID: goframework.NumberUUID(1),
 					Title:     "title",
@@ -363,22 +666,9 @@ func TestImproveSuggestionRepository_Validate(t *testing.T) {
 			},
 		},
 		{
-			name:      "Success/Validate",
-			validated: true,
+			name:      "Error/NotDeleted",
 			id:        goframework.NumberUUID(2),
-			expect: &dao.ImproveSuggestionModel{
-				Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &baseTime),
-				SourceID:  goframework.NumberUUID(10),
-				UserID:    goframework.NumberUUID(100),
-				UpVotes:   32,
-				DownVotes: 16,
-				Validated: true,
-				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
-					RequestID: goframework.NumberUUID(1),
-					Title:     "title",
-					Content:   "content",
-				},
-			},
+			expectErr: bunovel.ErrNotFound,
 		},
 		{
 			name:      "Error/NotFound",
@@ -387,25 +677,36 @@ func TestImproveSuggestionRepository_Validate(t *testing.T) {
 		},
 	}
 
-	for _, d := range data {
-		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
-			repository := dao.NewImproveSuggestionRepository(tx)
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveSuggestionRepository(tx)
+
+		for _, d := range data {
 			t.Run(d.name, func(st *testing.T) {
-				res, err := repository.Validate(ctx, d.validated, d.id)
-				require.Equal(t, d.expect, res)
+				res, err := repository.GetDeleted(ctx, d.id)
 				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
 			})
-		})
-		require.NoError(t, err)
-	}
+		}
+	})
+	require.NoError(t, err)
 }
 
-func TestImproveSuggestionRepository_UpdateVotes(t *testing.T) {
+func TestImproveSuggestionRepository_Restore(t *testing.T) {
 	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
 	defer db.Close()
 	defer sqlDB.Close()
 
 	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
 		&dao.ImproveSuggestionModel{
 			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &baseTime),
 			SourceID:  goframework.NumberUUID(10),
@@ -413,34 +714,85 @@ func TestImproveSuggestionRepository_UpdateVotes(t *testing.T) {
 			UpVotes:   128,
 			DownVotes: 64,
 			Validated: true,
+			DeletedAt: &updateTime,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &baseTime),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			UpVotes:   128,
+			DownVotes: 64,
+			Validated: true,
 			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
 				RequestID: goframework.NumberUUID(1),
 				Title:     "title",
 				Content:   "content",
 			},
 		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			Title:     "title",
+			Content:   "content",
+			DeletedAt: &updateTime,
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			DeletedAt: &updateTime,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(2),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
 	}
 
 	data := []struct {
 		name string
 
-		id        uuid.UUID
-		upVotes   int
-		downVotes int
+		id uuid.UUID
 
+		expect    *dao.ImproveSuggestionModel
 		expectErr error
 	}{
 		{
-			name:      "Success",
-			id:        goframework.NumberUUID(1),
-			upVotes:   256,
-			downVotes: 128,
+			name: "Success",
+			id:   goframework.NumberUUID(1),
+			expect: &dao.ImproveSuggestionModel{
+				Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &baseTime),
+				SourceID:  goframework.NumberUUID(10),
+				UserID:    goframework.NumberUUID(100),
+				UpVotes:   128,
+				DownVotes: 64,
+				Validated: true,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(1),
+					Title:     "title",
+					Content:   "content",
+				},
+			},
 		},
 		{
-			name:      "Error/NotFound",
+			name:      "Error/NotDeleted",
 			id:        goframework.NumberUUID(2),
-			upVotes:   256,
-			downVotes: 128,
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/RevisionDeleted",
+			id:        goframework.NumberUUID(3),
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/NotFound",
+			id:        goframework.NumberUUID(4),
 			expectErr: bunovel.ErrNotFound,
 		},
 	}
@@ -449,7 +801,224 @@ func TestImproveSuggestionRepository_UpdateVotes(t *testing.T) {
 		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
 			repository := dao.NewImproveSuggestionRepository(tx)
 			t.Run(d.name, func(st *testing.T) {
-				err := repository.UpdateVotes(ctx, d.id, d.upVotes, d.downVotes)
+				res, err := repository.Restore(ctx, d.id)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestImproveSuggestionRepository_Purge(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &baseTime),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			UpVotes:   128,
+			DownVotes: 64,
+			Validated: true,
+			DeletedAt: &baseTime,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &baseTime),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			UpVotes:   128,
+			DownVotes: 64,
+			Validated: true,
+			DeletedAt: &updateTime,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, &baseTime),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			UpVotes:   128,
+			DownVotes: 64,
+			Validated: true,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		before time.Time
+
+		expect        int
+		expectRemains []uuid.UUID
+		expectErr     error
+	}{
+		{
+			name:   "Success",
+			before: updateTime,
+			expect: 1,
+			expectRemains: []uuid.UUID{
+				goframework.NumberUUID(2),
+				goframework.NumberUUID(3),
+			},
+		},
+		{
+			name:   "Success/NothingToPurge",
+			before: baseTime,
+			expectRemains: []uuid.UUID{
+				goframework.NumberUUID(1),
+				goframework.NumberUUID(2),
+				goframework.NumberUUID(3),
+			},
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveSuggestionRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Purge(ctx, d.before)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+
+				remains := make([]uuid.UUID, 0)
+				err = tx.NewSelect().
+					Model((*dao.ImproveSuggestionModel)(nil)).
+					Column("id").
+					Order("id ASC").
+					Scan(ctx, &remains)
+				require.NoError(t, err)
+				require.Equal(t, d.expectRemains, remains)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestImproveSuggestionRepository_Validate(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &baseTime),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			UpVotes:   128,
+			DownVotes: 64,
+			Validated: true,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &baseTime),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			UpVotes:   32,
+			DownVotes: 16,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		validated bool
+		id        uuid.UUID
+
+		expect    *dao.ImproveSuggestionModel
+		expectErr error
+	}{
+		{
+			name: "Success/UnValidate",
+			id:   goframework.NumberUUID(1),
+			expect: &dao.ImproveSuggestionModel{
+				Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &baseTime),
+				SourceID:  goframework.NumberUUID(10),
+				UserID:    goframework.NumberUUID(100),
+				UpVotes:   128,
+				DownVotes: 64,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(1),
+					Title:     "title",
+					Content:   "content",
+				},
+			},
+		},
+		{
+			name:      "Success/Validate",
+			validated: true,
+			id:        goframework.NumberUUID(2),
+			expect: &dao.ImproveSuggestionModel{
+				Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &baseTime),
+				SourceID:  goframework.NumberUUID(10),
+				UserID:    goframework.NumberUUID(100),
+				UpVotes:   32,
+				DownVotes: 16,
+				Validated: true,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(1),
+					Title:     "title",
+					Content:   "content",
+				},
+			},
+		},
+		{
+			name:      "Error/NotFound",
+			id:        goframework.NumberUUID(3),
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveSuggestionRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Validate(ctx, d.validated, d.id, updateTime)
+				require.Equal(t, d.expect, res)
 				require.ErrorIs(t, err, d.expectErr)
 			})
 		})
@@ -463,6 +1032,26 @@ func TestImproveSuggestionRepository_Search(t *testing.T) {
 	defer sqlDB.Close()
 
 	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
 		&dao.ImproveSuggestionModel{
 			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, lo.ToPtr(baseTime.Add(3*time.Hour))),
 			SourceID:  goframework.NumberUUID(10),
@@ -902,6 +1491,83 @@ func TestImproveSuggestionRepository_Search(t *testing.T) {
 			},
 			expectCount: 4,
 		},
+		{
+			name: "Success/Cursor",
+			query: dao.ImproveSuggestionSearchQuery{
+				Cursor: &dao.ImproveSuggestionSearchCursor{
+					UpdatedAt: baseTime.Add(2 * time.Hour),
+					ID:        goframework.NumberUUID(2),
+				},
+				SkipCount: true,
+			},
+			limit: 2,
+			expect: []*dao.ImproveSuggestionModel{
+				{
+					Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, lo.ToPtr(baseTime.Add(time.Hour))),
+					SourceID:  goframework.NumberUUID(10),
+					UserID:    goframework.NumberUUID(100),
+					UpVotes:   64,
+					DownVotes: 32,
+					Validated: true,
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(2),
+						Title:     "title",
+						Content:   "content",
+					},
+				},
+				{
+					Metadata:  bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, &baseTime),
+					SourceID:  goframework.NumberUUID(10),
+					UserID:    goframework.NumberUUID(100),
+					UpVotes:   128,
+					DownVotes: 64,
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "title",
+						Content:   "content",
+					},
+				},
+			},
+		},
+		{
+			name: "Success/CursorKeepsTotal",
+			query: dao.ImproveSuggestionSearchQuery{
+				Cursor: &dao.ImproveSuggestionSearchCursor{
+					UpdatedAt: baseTime.Add(2 * time.Hour),
+					ID:        goframework.NumberUUID(2),
+				},
+			},
+			limit: 2,
+			expect: []*dao.ImproveSuggestionModel{
+				{
+					Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, lo.ToPtr(baseTime.Add(time.Hour))),
+					SourceID:  goframework.NumberUUID(10),
+					UserID:    goframework.NumberUUID(100),
+					UpVotes:   64,
+					DownVotes: 32,
+					Validated: true,
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(2),
+						Title:     "title",
+						Content:   "content",
+					},
+				},
+				{
+					Metadata:  bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, &baseTime),
+					SourceID:  goframework.NumberUUID(10),
+					UserID:    goframework.NumberUUID(100),
+					UpVotes:   128,
+					DownVotes: 64,
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "title",
+						Content:   "content",
+					},
+				},
+			},
+			// The total counts every result, not only the ones after the cursor.
+			expectCount: 4,
+		},
 		{
 			name:        "Success/OffsetTooLarge",
 			offset:      5,
@@ -938,6 +1604,26 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 	defer sqlDB.Close()
 
 	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
 		&dao.ImproveSuggestionModel{
 			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, lo.ToPtr(baseTime.Add(3*time.Hour))),
 			SourceID:  goframework.NumberUUID(10),
@@ -1051,3 +1737,764 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestImproveSuggestionRepository_SearchOrders(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+
+		// Balanced votes, most comments, most recent comment.
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(200),
+			UpVotes:   10,
+			DownVotes: 10,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(300),
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(1),
+				Content:    "comment",
+			},
+		},
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(6*time.Hour), nil),
+			UserID:   goframework.NumberUUID(300),
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(1),
+				Content:    "comment",
+			},
+		},
+		// Hidden comments are not counted.
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(7*time.Hour), nil),
+			UserID:   goframework.NumberUUID(300),
+			Hidden:   true,
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(2),
+				Content:    "comment",
+			},
+		},
+
+		// Highest score, edited, no comment.
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), lo.ToPtr(baseTime.Add(2*time.Hour))),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			UpVotes:  100,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+
+		// Slightly controversial, commented later.
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Hour), nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(200),
+			UpVotes:   3,
+			DownVotes: 1,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime.Add(3*time.Hour), nil),
+			UserID:   goframework.NumberUUID(300),
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(3),
+				Content:    "comment",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query dao.ImproveSuggestionSearchQuery
+		limit int
+
+		expect    []uuid.UUID
+		expectErr error
+	}{
+		{
+			name:  "Success/Activity",
+			query: dao.ImproveSuggestionSearchQuery{Order: &dao.ImproveSuggestionSearchQueryOrder{Activity: true}},
+			expect: []uuid.UUID{
+				goframework.NumberUUID(1),
+				goframework.NumberUUID(3),
+				goframework.NumberUUID(2),
+			},
+		},
+		{
+			name: "Success/ActivityWithCursor",
+			query: dao.ImproveSuggestionSearchQuery{
+				Order: &dao.ImproveSuggestionSearchQueryOrder{Activity: true},
+				Cursor: &dao.ImproveSuggestionSearchCursor{
+					Activity:  baseTime.Add(6 * time.Hour),
+					UpdatedAt: baseTime,
+					ID:        goframework.NumberUUID(1),
+				},
+			},
+			expect: []uuid.UUID{
+				goframework.NumberUUID(3),
+				goframework.NumberUUID(2),
+			},
+		},
+		{
+			name:  "Success/Comments",
+			query: dao.ImproveSuggestionSearchQuery{Order: &dao.ImproveSuggestionSearchQueryOrder{Comments: true}},
+			expect: []uuid.UUID{
+				goframework.NumberUUID(1),
+				goframework.NumberUUID(3),
+				goframework.NumberUUID(2),
+			},
+		},
+		{
+			name:  "Success/Controversial",
+			query: dao.ImproveSuggestionSearchQuery{Order: &dao.ImproveSuggestionSearchQueryOrder{Controversial: true}},
+			expect: []uuid.UUID{
+				goframework.NumberUUID(1),
+				goframework.NumberUUID(3),
+				goframework.NumberUUID(2),
+			},
+		},
+		{
+			name:  "Success/Hot",
+			query: dao.ImproveSuggestionSearchQuery{Order: &dao.ImproveSuggestionSearchQueryOrder{Hot: true}},
+			expect: []uuid.UUID{
+				goframework.NumberUUID(2),
+				goframework.NumberUUID(3),
+				goframework.NumberUUID(1),
+			},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveSuggestionRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, _, err := repository.Search(ctx, d.query, d.limit, 0)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, lo.Map(res, func(item *dao.ImproveSuggestionModel, _ int) uuid.UUID {
+					return item.ID
+				}))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_SearchText(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title with robots",
+				Content:   "my content with robots and mechanics",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title with spaceships",
+				Content:   "my content with thrusters and robots",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Hour), nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title with tomatoes",
+				Content:   "my content with chips",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query dao.ImproveSuggestionSearchQuery
+
+		expect      []uuid.UUID
+		expectCount int
+		expectErr   error
+	}{
+		{
+			name:  "Success",
+			query: dao.ImproveSuggestionSearchQuery{Query: "robot"},
+			// The first suggestion mentions robots in its title, which weighs more than its content.
+			expect: []uuid.UUID{
+				goframework.NumberUUID(1),
+				goframework.NumberUUID(2),
+			},
+			expectCount: 2,
+		},
+		{
+			name:        "Success/EveryWordMustMatch",
+			query:       dao.ImproveSuggestionSearchQuery{Query: "robots thrusters"},
+			expect:      []uuid.UUID{goframework.NumberUUID(2)},
+			expectCount: 1,
+		},
+		{
+			name:   "Success/NoResults",
+			query:  dao.ImproveSuggestionSearchQuery{Query: "foo bar qux"},
+			expect: []uuid.UUID{},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveSuggestionRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, count, err := repository.Search(ctx, d.query, 0, 0)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expectCount, count)
+				require.Equal(t, d.expect, lo.Map(res, func(item *dao.ImproveSuggestionModel, _ int) uuid.UUID {
+					require.Greater(t, item.SearchRank, 0.0)
+					return item.ID
+				}))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_UpdateRefreshesSearch(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title with robots",
+				Content:   "my content with mechanics",
+			},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveSuggestionRepository(tx)
+
+		_, err := repository.Update(ctx, &dao.ImproveSuggestionModelCore{
+			RequestID: goframework.NumberUUID(1),
+			Title:     "my title with spaceships",
+			Content:   "my content with thrusters",
+		}, goframework.NumberUUID(1), updateTime)
+		require.NoError(t, err)
+
+		res, _, err := repository.Search(ctx, dao.ImproveSuggestionSearchQuery{Query: "robots"}, 10, 0)
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		res, _, err = repository.Search(ctx, dao.ImproveSuggestionSearchQuery{Query: "spaceships"}, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_SearchLanguages(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "mes chevaux",
+			Content:  "des chevaux qui galopent",
+			Language: dao.LanguageFrench,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my horses",
+			Content:  "horses running",
+			Language: dao.LanguageEnglish,
+		},
+		// Suggestions are indexed in the language of their request.
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "mes chevaux",
+				Content:   "des chevaux qui galopent vite",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(2),
+				Title:     "my horses",
+				Content:   "horses running fast",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query dao.ImproveSuggestionSearchQuery
+
+		expect    []uuid.UUID
+		expectErr error
+	}{
+		{
+			name:   "Success/English",
+			query:  dao.ImproveSuggestionSearchQuery{Query: "runs"},
+			expect: []uuid.UUID{goframework.NumberUUID(2)},
+		},
+		{
+			name:   "Success/French",
+			query:  dao.ImproveSuggestionSearchQuery{Query: "cheval"},
+			expect: []uuid.UUID{goframework.NumberUUID(1)},
+		},
+		{
+			name:   "Success/OtherLanguage",
+			query:  dao.ImproveSuggestionSearchQuery{Query: "horse", Language: dao.LanguageFrench},
+			expect: []uuid.UUID{},
+		},
+		{
+			name:   "Success/LanguageOnly",
+			query:  dao.ImproveSuggestionSearchQuery{Language: dao.LanguageEnglish},
+			expect: []uuid.UUID{goframework.NumberUUID(2)},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveSuggestionRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, _, err := repository.Search(ctx, d.query, 10, 0)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, lo.Map(res, func(item *dao.ImproveSuggestionModel, _ int) uuid.UUID {
+					return item.ID
+				}))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_SearchDrafts(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my suggested content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			Draft:    true,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my title",
+				Content:   "my drafted content",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query dao.ImproveSuggestionSearchQuery
+
+		expect    []uuid.UUID
+		expectErr error
+	}{
+		{
+			name:   "Success/Anonymous",
+			query:  dao.ImproveSuggestionSearchQuery{},
+			expect: []uuid.UUID{goframework.NumberUUID(1)},
+		},
+		{
+			name:   "Success/OtherUser",
+			query:  dao.ImproveSuggestionSearchQuery{ViewerID: lo.ToPtr(goframework.NumberUUID(100))},
+			expect: []uuid.UUID{goframework.NumberUUID(1)},
+		},
+		{
+			name:   "Success/Author",
+			query:  dao.ImproveSuggestionSearchQuery{ViewerID: lo.ToPtr(goframework.NumberUUID(200))},
+			expect: []uuid.UUID{goframework.NumberUUID(2), goframework.NumberUUID(1)},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveSuggestionRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, _, err := repository.Search(ctx, d.query, 10, 0)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, lo.Map(res, func(item *dao.ImproveSuggestionModel, _ int) uuid.UUID {
+					return item.ID
+				}))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_SearchFuzzy(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+			Language: dao.LanguageEnglish,
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "Gandalf the grey returns",
+				Content:   "the wizard comes back",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "Frodo and the ring",
+				Content:   "the hobbit leaves the shire",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query dao.ImproveSuggestionSearchQuery
+
+		expect    []uuid.UUID
+		expectErr error
+	}{
+		{
+			name:   "Success/Misspelled",
+			query:  dao.ImproveSuggestionSearchQuery{Query: "gandalv", Fuzzy: true},
+			expect: []uuid.UUID{goframework.NumberUUID(1)},
+		},
+		{
+			name:   "Success/NotFuzzy",
+			query:  dao.ImproveSuggestionSearchQuery{Query: "gandalv"},
+			expect: []uuid.UUID{},
+		},
+		{
+			name:   "Success/NoResults",
+			query:  dao.ImproveSuggestionSearchQuery{Query: "qwerty", Fuzzy: true},
+			expect: []uuid.UUID{},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveSuggestionRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, _, err := repository.Search(ctx, d.query, 10, 0)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, lo.Map(res, func(item *dao.ImproveSuggestionModel, _ int) uuid.UUID {
+					require.Greater(t, item.SearchRank, 0.0)
+					return item.ID
+				}))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_SuggestQuery(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "Gandalf the grey returns",
+				Content:   "the wizard comes back",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query string
+
+		expect    string
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			query:  "gandalv returns",
+			expect: "gandalf returns",
+		},
+		{
+			name:  "Success/NoCloseWord",
+			query: "qwerty",
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewImproveSuggestionRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.SuggestQuery(ctx, d.query)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_FindDuplicate(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	content := "The rain had not stopped for three days, and the river was already licking the doorsteps."
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   content,
+			},
+		},
+		// Drafts are ignored.
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), updateTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Draft:    true,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   content,
+			},
+		},
+		// Deleted suggestions are ignored.
+		&dao.ImproveSuggestionModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(3), updateTime, nil),
+			SourceID:  goframework.NumberUUID(10),
+			UserID:    goframework.NumberUUID(100),
+			DeletedAt: &updateTime,
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   content,
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query dao.DuplicateQuery
+
+		expect    *dao.Duplicate
+		expectErr error
+	}{
+		{
+			name: "Success",
+			query: dao.DuplicateQuery{
+				UserID:        goframework.NumberUUID(100),
+				Content:       content,
+				Since:         baseTime,
+				MinSimilarity: 0.8,
+			},
+			expect: &dao.Duplicate{
+				ID:         goframework.NumberUUID(1),
+				Similarity: 1,
+			},
+		},
+		{
+			name: "Error/OtherUser",
+			query: dao.DuplicateQuery{
+				UserID:        goframework.NumberUUID(200),
+				Content:       content,
+				Since:         baseTime,
+				MinSimilarity: 0.8,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name: "Error/TooOld",
+			query: dao.DuplicateQuery{
+				UserID:        goframework.NumberUUID(100),
+				Content:       content,
+				Since:         updateTime,
+				MinSimilarity: 0.8,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name: "Error/NotSimilar",
+			query: dao.DuplicateQuery{
+				UserID:        goframework.NumberUUID(100),
+				Content:       "Nobody had seen the lighthouse keeper since the storm.",
+				Since:         baseTime,
+				MinSimilarity: 0.8,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewImproveSuggestionRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.FindDuplicate(ctx, d.query)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
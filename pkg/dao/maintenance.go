@@ -0,0 +1,217 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/bunovel"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type MaintenanceRepository interface {
+	// FindOrphans counts the revisions and suggestions that point to a parent that no longer exists, or to a
+	// revision that was deleted without them.
+	FindOrphans(ctx context.Context) (*OrphansModel, error)
+	// RepairOrphans fixes the orphans reported by FindOrphans, and returns what was repaired. Revisions and
+	// suggestions whose request no longer exists are permanently deleted. Suggestions whose revision is missing or
+	// deleted are handled according to the policy.
+	RepairOrphans(ctx context.Context, policy SuggestionOrphanPolicy, now time.Time) (*OrphansModel, error)
+	// ValidateConstraints checks the foreign keys of the improvement tables against existing rows. It fails as long as
+	// orphans remain.
+	ValidateConstraints(ctx context.Context) error
+}
+
+// OrphansModel counts the rows that break, or would break, the foreign keys of the improvement tables.
+type OrphansModel struct {
+	// Revisions is the number of revisions whose improvement request no longer exists.
+	Revisions int `bun:"revisions"`
+	// DetachedSuggestions is the number of suggestions whose improvement request no longer exists.
+	DetachedSuggestions int `bun:"detached_suggestions"`
+	// OrphanedSuggestions is the number of active suggestions whose revision no longer exists, or was deleted
+	// without them.
+	OrphanedSuggestions int `bun:"orphaned_suggestions"`
+}
+
+// improveForeignKeys lists the foreign keys of the improvement tables, that were created without being validated.
+var improveForeignKeys = []struct {
+	table      string
+	constraint string
+}{
+	{"improve_requests_revisions", "improve_requests_revisions_source_fk"},
+	{"improve_suggestions", "improve_suggestions_source_fk"},
+	{"improve_suggestions", "improve_suggestions_request_fk"},
+}
+
+type orphanedSuggestion struct {
+	ID                uuid.UUID  `bun:"id,type:uuid"`
+	SourceID          uuid.UUID  `bun:"source_id,type:uuid"`
+	CreatedAt         time.Time  `bun:"created_at"`
+	RevisionExists    bool       `bun:"revision_exists"`
+	RevisionDeletedAt *time.Time `bun:"revision_deleted_at"`
+}
+
+type maintenanceRepositoryImpl struct {
+	db bun.IDB
+}
+
+func NewMaintenanceRepository(db bun.IDB) MaintenanceRepository {
+	return &maintenanceRepositoryImpl{db: db}
+}
+
+func (repository *maintenanceRepositoryImpl) FindOrphans(ctx context.Context) (*OrphansModel, error) {
+	output := new(OrphansModel)
+
+	err := repository.db.NewSelect().
+		ColumnExpr("(?) AS revisions", repository.db.NewSelect().
+			Model((*ImproveRequestRevisionModel)(nil)).
+			ColumnExpr("COUNT(*)").
+			Where("source_id NOT IN (?)", repository.db.NewSelect().Model((*ImproveRequestModel)(nil)).Column("id"))).
+		ColumnExpr("(?) AS detached_suggestions", repository.db.NewSelect().
+			Model((*ImproveSuggestionModel)(nil)).
+			ColumnExpr("COUNT(*)").
+			Where("source_id NOT IN (?)", repository.db.NewSelect().Model((*ImproveRequestModel)(nil)).Column("id"))).
+		ColumnExpr("(?) AS orphaned_suggestions", repository.db.NewSelect().
+			Model((*ImproveSuggestionModel)(nil)).
+			ColumnExpr("COUNT(*)").
+			Where("deleted_at IS NULL").
+			Where("source_id IN (?)", repository.db.NewSelect().Model((*ImproveRequestModel)(nil)).Column("id")).
+			Where("request_id NOT IN (?)", repository.db.NewSelect().
+				Model((*ImproveRequestRevisionModel)(nil)).
+				Column("id").
+				Where("deleted_at IS NULL"))).
+		Scan(ctx, output)
+	if err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return output, nil
+}
+
+func (repository *maintenanceRepositoryImpl) RepairOrphans(ctx context.Context, policy SuggestionOrphanPolicy, now time.Time) (*OrphansModel, error) {
+	output := new(OrphansModel)
+
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		requests := tx.NewSelect().Model((*ImproveRequestModel)(nil)).Column("id")
+
+		// There is no request left to attach those rows to, so they are gone for good.
+		res, err := tx.NewDelete().
+			Model((*ImproveSuggestionModel)(nil)).
+			Where("source_id NOT IN (?)", requests).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete detached suggestions: %w", err)
+		}
+
+		detached, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to count detached suggestions: %w", err)
+		}
+
+		res, err = tx.NewDelete().
+			Model((*ImproveRequestRevisionModel)(nil)).
+			Where("source_id NOT IN (?)", requests).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete orphaned revisions: %w", err)
+		}
+
+		revisions, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to count orphaned revisions: %w", err)
+		}
+
+		orphans := make([]*orphanedSuggestion, 0)
+		err = tx.NewSelect().
+			TableExpr("improve_suggestions AS suggestion").
+			ColumnExpr("suggestion.id, suggestion.source_id, suggestion.created_at").
+			ColumnExpr("revision.id IS NOT NULL AS revision_exists").
+			ColumnExpr("revision.deleted_at AS revision_deleted_at").
+			Join("LEFT JOIN improve_requests_revisions AS revision ON revision.id = suggestion.request_id").
+			Where("suggestion.deleted_at IS NULL").
+			Where("revision.id IS NULL OR revision.deleted_at IS NOT NULL").
+			For("UPDATE OF suggestion").
+			Scan(ctx, &orphans)
+		if err != nil {
+			return fmt.Errorf("failed to list orphaned suggestions: %w", err)
+		}
+
+		for _, orphan := range orphans {
+			if err := repairOrphanedSuggestion(ctx, tx, orphan, policy, now); err != nil {
+				return err
+			}
+		}
+
+		output.Revisions = int(revisions)
+		output.DetachedSuggestions = int(detached)
+		output.OrphanedSuggestions = len(orphans)
+
+		return nil
+	}); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return output, nil
+}
+
+func (repository *maintenanceRepositoryImpl) ValidateConstraints(ctx context.Context) error {
+	for _, fk := range improveForeignKeys {
+		_, err := repository.db.ExecContext(
+			ctx, "ALTER TABLE ? VALIDATE CONSTRAINT ?", bun.Ident(fk.table), bun.Ident(fk.constraint),
+		)
+		if err != nil {
+			return bunovel.HandlePGError(fmt.Errorf("failed to validate %s: %w", fk.constraint, err))
+		}
+	}
+
+	return nil
+}
+
+// repairOrphanedSuggestion moves a suggestion to the nearest surviving revision of its request, or deletes it. A
+// suggestion on a deleted revision shares the deletion date of the revision, so they can be restored together. A
+// suggestion on a missing revision is permanently deleted, since it has nothing left to be restored with.
+func repairOrphanedSuggestion(ctx context.Context, tx bun.IDB, orphan *orphanedSuggestion, policy SuggestionOrphanPolicy, now time.Time) error {
+	if policy == SuggestionOrphanPolicyReattach {
+		nearest, err := nearestRevision(ctx, tx, orphan.SourceID, orphan.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		if nearest != nil {
+			_, err = tx.NewUpdate().
+				Model((*ImproveSuggestionModel)(nil)).
+				Set("request_id = ?", nearest.ID).
+				Set("updated_at = ?", now).
+				Where("id = ?", orphan.ID).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to reattach suggestion %s: %w", orphan.ID, err)
+			}
+
+			return nil
+		}
+	}
+
+	if orphan.RevisionExists {
+		_, err := tx.NewUpdate().
+			Model((*ImproveSuggestionModel)(nil)).
+			Set("deleted_at = ?", orphan.RevisionDeletedAt).
+			Where("id = ?", orphan.ID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete suggestion %s: %w", orphan.ID, err)
+		}
+
+		return nil
+	}
+
+	_, err := tx.NewDelete().
+		Model((*ImproveSuggestionModel)(nil)).
+		Where("id = ?", orphan.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete suggestion %s: %w", orphan.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"io/fs"
+	"testing"
+	"time"
+)
+
+// maintenanceFixtures holds a request with one live and one deleted revision. Orphans are inserted separately, with
+// insertOrphans, since the foreign keys reject them.
+var maintenanceFixtures = []interface{}{
+	&dao.ImproveRequestModel{
+		Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+	},
+	&dao.ImproveRequestRevisionModel{
+		Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+		SourceID: goframework.NumberUUID(10),
+		UserID:   goframework.NumberUUID(100),
+		Title:    "title",
+		Content:  "content",
+	},
+	&dao.ImproveRequestRevisionModel{
+		Metadata:  bunovel.NewMetadata(goframework.NumberUUID(2), updateTime, nil),
+		SourceID:  goframework.NumberUUID(10),
+		UserID:    goframework.NumberUUID(100),
+		Title:     "title",
+		Content:   "content",
+		DeletedAt: &updateTime,
+	},
+}
+
+// maintenanceOrphans breaks every foreign key of the improvement tables.
+func maintenanceOrphans() []interface{} {
+	return []interface{}{
+		// Active suggestion on a deleted revision.
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), updateTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(2),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		// Suggestion on a missing revision.
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(3),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		// Revision of a missing request.
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		// Suggestion of a missing request.
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(4),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+	}
+}
+
+// insertOrphans inserts rows without checking the foreign keys, as a database that predates them would hold.
+func insertOrphans(ctx context.Context, t *testing.T, tx bun.Tx, rows []interface{}) {
+	_, err := tx.ExecContext(ctx, "SET LOCAL session_replication_role = replica")
+	require.NoError(t, err)
+
+	for _, row := range rows {
+		_, err = tx.NewInsert().Model(row).Exec(ctx)
+		require.NoError(t, err)
+	}
+
+	_, err = tx.ExecContext(ctx, "SET LOCAL session_replication_role = DEFAULT")
+	require.NoError(t, err)
+}
+
+func TestMaintenanceRepository_FindOrphans(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		orphans []interface{}
+
+		expect    *dao.OrphansModel
+		expectErr error
+	}{
+		{
+			name:    "Success",
+			orphans: maintenanceOrphans(),
+			expect: &dao.OrphansModel{
+				Revisions:           1,
+				DetachedSuggestions: 1,
+				OrphanedSuggestions: 2,
+			},
+		},
+		{
+			name:   "Success/NoOrphans",
+			expect: &dao.OrphansModel{},
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, maintenanceFixtures, func(ctx context.Context, tx bun.Tx) {
+			insertOrphans(ctx, t, tx, d.orphans)
+
+			repository := dao.NewMaintenanceRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.FindOrphans(ctx)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestMaintenanceRepository_RepairOrphans(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	type suggestionState struct {
+		requestID uuid.UUID
+		deletedAt *time.Time
+	}
+
+	data := []struct {
+		name string
+
+		policy dao.SuggestionOrphanPolicy
+		now    time.Time
+
+		expect            *dao.OrphansModel
+		expectSuggestions map[uuid.UUID]suggestionState
+		expectErr         error
+	}{
+		{
+			name:   "Success/Reattach",
+			policy: dao.SuggestionOrphanPolicyReattach,
+			now:    updateTime.Add(time.Hour),
+			expect: &dao.OrphansModel{
+				Revisions:           1,
+				DetachedSuggestions: 1,
+				OrphanedSuggestions: 2,
+			},
+			expectSuggestions: map[uuid.UUID]suggestionState{
+				goframework.NumberUUID(1): {requestID: goframework.NumberUUID(1)},
+				goframework.NumberUUID(2): {requestID: goframework.NumberUUID(1)},
+			},
+		},
+		{
+			name:   "Success/Cascade",
+			policy: dao.SuggestionOrphanPolicyCascade,
+			now:    updateTime.Add(time.Hour),
+			expect: &dao.OrphansModel{
+				Revisions:           1,
+				DetachedSuggestions: 1,
+				OrphanedSuggestions: 2,
+			},
+			// The suggestion on the missing revision is permanently deleted.
+			expectSuggestions: map[uuid.UUID]suggestionState{
+				goframework.NumberUUID(1): {requestID: goframework.NumberUUID(2), deletedAt: &updateTime},
+			},
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, maintenanceFixtures, func(ctx context.Context, tx bun.Tx) {
+			insertOrphans(ctx, t, tx, maintenanceOrphans())
+
+			repository := dao.NewMaintenanceRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.RepairOrphans(ctx, d.policy, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+
+				suggestions := make([]*dao.ImproveSuggestionModel, 0)
+				require.NoError(t, tx.NewSelect().Model(&suggestions).Scan(ctx))
+
+				states := make(map[uuid.UUID]suggestionState, len(suggestions))
+				for _, suggestion := range suggestions {
+					states[suggestion.ID] = suggestionState{
+						requestID: suggestion.RequestID,
+						deletedAt: suggestion.DeletedAt,
+					}
+				}
+				require.Equal(t, d.expectSuggestions, states)
+
+				// Nothing is left to break the foreign keys.
+				require.NoError(t, repository.ValidateConstraints(ctx))
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestMaintenanceRepository_ValidateConstraints(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		orphans []interface{}
+
+		expectErr bool
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:      "Error/Orphans",
+			orphans:   maintenanceOrphans(),
+			expectErr: true,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, maintenanceFixtures, func(ctx context.Context, tx bun.Tx) {
+			insertOrphans(ctx, t, tx, d.orphans)
+
+			repository := dao.NewMaintenanceRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				err := repository.ValidateConstraints(ctx)
+				if d.expectErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+			})
+		})
+		require.NoError(t, err)
+	}
+}
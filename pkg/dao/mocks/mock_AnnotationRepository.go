@@ -0,0 +1,310 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/a-novel/forum-service/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// AnnotationRepository is an autogenerated mock type for the AnnotationRepository type
+type AnnotationRepository struct {
+	mock.Mock
+}
+
+type AnnotationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AnnotationRepository) EXPECT() *AnnotationRepository_Expecter {
+	return &AnnotationRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, data, userID, sourceID, id, now
+func (_m *AnnotationRepository) Create(ctx context.Context, data *dao.AnnotationModelCore, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, now time.Time) (*dao.AnnotationModel, error) {
+	ret := _m.Called(ctx, data, userID, sourceID, id, now)
+
+	var r0 *dao.AnnotationModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.AnnotationModelCore, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*dao.AnnotationModel, error)); ok {
+		return rf(ctx, data, userID, sourceID, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.AnnotationModelCore, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) *dao.AnnotationModel); ok {
+		r0 = rf(ctx, data, userID, sourceID, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AnnotationModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.AnnotationModelCore, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, data, userID, sourceID, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnnotationRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AnnotationRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *dao.AnnotationModelCore
+//   - userID uuid.UUID
+//   - sourceID uuid.UUID
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *AnnotationRepository_Expecter) Create(ctx interface{}, data interface{}, userID interface{}, sourceID interface{}, id interface{}, now interface{}) *AnnotationRepository_Create_Call {
+	return &AnnotationRepository_Create_Call{Call: _e.mock.On("Create", ctx, data, userID, sourceID, id, now)}
+}
+
+func (_c *AnnotationRepository_Create_Call) Run(run func(ctx context.Context, data *dao.AnnotationModelCore, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, now time.Time)) *AnnotationRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.AnnotationModelCore), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(time.Time))
+	})
+	return _c
+}
+
+func (_c *AnnotationRepository_Create_Call) Return(_a0 *dao.AnnotationModel, _a1 error) *AnnotationRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnnotationRepository_Create_Call) RunAndReturn(run func(context.Context, *dao.AnnotationModelCore, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*dao.AnnotationModel, error)) *AnnotationRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *AnnotationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AnnotationRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type AnnotationRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *AnnotationRepository_Expecter) Delete(ctx interface{}, id interface{}) *AnnotationRepository_Delete_Call {
+	return &AnnotationRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *AnnotationRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *AnnotationRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AnnotationRepository_Delete_Call) Return(_a0 error) *AnnotationRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AnnotationRepository_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *AnnotationRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *AnnotationRepository) Get(ctx context.Context, id uuid.UUID) (*dao.AnnotationModel, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *dao.AnnotationModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.AnnotationModel, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.AnnotationModel); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AnnotationModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnnotationRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type AnnotationRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *AnnotationRepository_Expecter) Get(ctx interface{}, id interface{}) *AnnotationRepository_Get_Call {
+	return &AnnotationRepository_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *AnnotationRepository_Get_Call) Run(run func(ctx context.Context, id uuid.UUID)) *AnnotationRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AnnotationRepository_Get_Call) Return(_a0 *dao.AnnotationModel, _a1 error) *AnnotationRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnnotationRepository_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dao.AnnotationModel, error)) *AnnotationRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, query
+func (_m *AnnotationRepository) List(ctx context.Context, query dao.AnnotationListQuery) ([]*dao.AnnotationModel, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []*dao.AnnotationModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dao.AnnotationListQuery) ([]*dao.AnnotationModel, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dao.AnnotationListQuery) []*dao.AnnotationModel); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.AnnotationModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dao.AnnotationListQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnnotationRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type AnnotationRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query dao.AnnotationListQuery
+func (_e *AnnotationRepository_Expecter) List(ctx interface{}, query interface{}) *AnnotationRepository_List_Call {
+	return &AnnotationRepository_List_Call{Call: _e.mock.On("List", ctx, query)}
+}
+
+func (_c *AnnotationRepository_List_Call) Run(run func(ctx context.Context, query dao.AnnotationListQuery)) *AnnotationRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dao.AnnotationListQuery))
+	})
+	return _c
+}
+
+func (_c *AnnotationRepository_List_Call) Return(_a0 []*dao.AnnotationModel, _a1 error) *AnnotationRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnnotationRepository_List_Call) RunAndReturn(run func(context.Context, dao.AnnotationListQuery) ([]*dao.AnnotationModel, error)) *AnnotationRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, content, id, now
+func (_m *AnnotationRepository) Update(ctx context.Context, content string, id uuid.UUID, now time.Time) (*dao.AnnotationModel, error) {
+	ret := _m.Called(ctx, content, id, now)
+
+	var r0 *dao.AnnotationModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) (*dao.AnnotationModel, error)); ok {
+		return rf(ctx, content, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) *dao.AnnotationModel); ok {
+		r0 = rf(ctx, content, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AnnotationModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, content, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnnotationRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type AnnotationRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - content string
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *AnnotationRepository_Expecter) Update(ctx interface{}, content interface{}, id interface{}, now interface{}) *AnnotationRepository_Update_Call {
+	return &AnnotationRepository_Update_Call{Call: _e.mock.On("Update", ctx, content, id, now)}
+}
+
+func (_c *AnnotationRepository_Update_Call) Run(run func(ctx context.Context, content string, id uuid.UUID, now time.Time)) *AnnotationRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *AnnotationRepository_Update_Call) Return(_a0 *dao.AnnotationModel, _a1 error) *AnnotationRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnnotationRepository_Update_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, time.Time) (*dao.AnnotationModel, error)) *AnnotationRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAnnotationRepository creates a new instance of AnnotationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnnotationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnnotationRepository {
+	mock := &AnnotationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
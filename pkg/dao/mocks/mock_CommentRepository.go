@@ -0,0 +1,318 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/a-novel/forum-service/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// CommentRepository is an autogenerated mock type for the CommentRepository type
+type CommentRepository struct {
+	mock.Mock
+}
+
+type CommentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CommentRepository) EXPECT() *CommentRepository_Expecter {
+	return &CommentRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, data, userID, id, now
+func (_m *CommentRepository) Create(ctx context.Context, data *dao.CommentModelCore, userID uuid.UUID, id uuid.UUID, now time.Time) (*dao.CommentModel, error) {
+	ret := _m.Called(ctx, data, userID, id, now)
+
+	var r0 *dao.CommentModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CommentModelCore, uuid.UUID, uuid.UUID, time.Time) (*dao.CommentModel, error)); ok {
+		return rf(ctx, data, userID, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CommentModelCore, uuid.UUID, uuid.UUID, time.Time) *dao.CommentModel); ok {
+		r0 = rf(ctx, data, userID, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.CommentModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.CommentModelCore, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, data, userID, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type CommentRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *dao.CommentModelCore
+//   - userID uuid.UUID
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *CommentRepository_Expecter) Create(ctx interface{}, data interface{}, userID interface{}, id interface{}, now interface{}) *CommentRepository_Create_Call {
+	return &CommentRepository_Create_Call{Call: _e.mock.On("Create", ctx, data, userID, id, now)}
+}
+
+func (_c *CommentRepository_Create_Call) Run(run func(ctx context.Context, data *dao.CommentModelCore, userID uuid.UUID, id uuid.UUID, now time.Time)) *CommentRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.CommentModelCore), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_Create_Call) Return(_a0 *dao.CommentModel, _a1 error) *CommentRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentRepository_Create_Call) RunAndReturn(run func(context.Context, *dao.CommentModelCore, uuid.UUID, uuid.UUID, time.Time) (*dao.CommentModel, error)) *CommentRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *CommentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommentRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type CommentRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *CommentRepository_Expecter) Delete(ctx interface{}, id interface{}) *CommentRepository_Delete_Call {
+	return &CommentRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *CommentRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *CommentRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_Delete_Call) Return(_a0 error) *CommentRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CommentRepository_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *CommentRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *CommentRepository) Get(ctx context.Context, id uuid.UUID) (*dao.CommentModel, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *dao.CommentModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.CommentModel, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.CommentModel); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.CommentModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type CommentRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *CommentRepository_Expecter) Get(ctx interface{}, id interface{}) *CommentRepository_Get_Call {
+	return &CommentRepository_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *CommentRepository_Get_Call) Run(run func(ctx context.Context, id uuid.UUID)) *CommentRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_Get_Call) Return(_a0 *dao.CommentModel, _a1 error) *CommentRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentRepository_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dao.CommentModel, error)) *CommentRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, query, limit, offset
+func (_m *CommentRepository) List(ctx context.Context, query dao.CommentListQuery, limit int, offset int) ([]*dao.CommentModel, int, error) {
+	ret := _m.Called(ctx, query, limit, offset)
+
+	var r0 []*dao.CommentModel
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, dao.CommentListQuery, int, int) ([]*dao.CommentModel, int, error)); ok {
+		return rf(ctx, query, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dao.CommentListQuery, int, int) []*dao.CommentModel); ok {
+		r0 = rf(ctx, query, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.CommentModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dao.CommentListQuery, int, int) int); ok {
+		r1 = rf(ctx, query, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, dao.CommentListQuery, int, int) error); ok {
+		r2 = rf(ctx, query, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CommentRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type CommentRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query dao.CommentListQuery
+//   - limit int
+//   - offset int
+func (_e *CommentRepository_Expecter) List(ctx interface{}, query interface{}, limit interface{}, offset interface{}) *CommentRepository_List_Call {
+	return &CommentRepository_List_Call{Call: _e.mock.On("List", ctx, query, limit, offset)}
+}
+
+func (_c *CommentRepository_List_Call) Run(run func(ctx context.Context, query dao.CommentListQuery, limit int, offset int)) *CommentRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dao.CommentListQuery), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_List_Call) Return(_a0 []*dao.CommentModel, _a1 int, _a2 error) *CommentRepository_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *CommentRepository_List_Call) RunAndReturn(run func(context.Context, dao.CommentListQuery, int, int) ([]*dao.CommentModel, int, error)) *CommentRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, content, id, now
+func (_m *CommentRepository) Update(ctx context.Context, content string, id uuid.UUID, now time.Time) (*dao.CommentModel, error) {
+	ret := _m.Called(ctx, content, id, now)
+
+	var r0 *dao.CommentModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) (*dao.CommentModel, error)); ok {
+		return rf(ctx, content, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) *dao.CommentModel); ok {
+		r0 = rf(ctx, content, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.CommentModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, content, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type CommentRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - content string
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *CommentRepository_Expecter) Update(ctx interface{}, content interface{}, id interface{}, now interface{}) *CommentRepository_Update_Call {
+	return &CommentRepository_Update_Call{Call: _e.mock.On("Update", ctx, content, id, now)}
+}
+
+func (_c *CommentRepository_Update_Call) Run(run func(ctx context.Context, content string, id uuid.UUID, now time.Time)) *CommentRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_Update_Call) Return(_a0 *dao.CommentModel, _a1 error) *CommentRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentRepository_Update_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, time.Time) (*dao.CommentModel, error)) *CommentRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCommentRepository creates a new instance of CommentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommentRepository {
+	mock := &CommentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
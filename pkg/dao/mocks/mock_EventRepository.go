@@ -0,0 +1,186 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/a-novel/forum-service/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// EventRepository is an autogenerated mock type for the EventRepository type
+type EventRepository struct {
+	mock.Mock
+}
+
+type EventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EventRepository) EXPECT() *EventRepository_Expecter {
+	return &EventRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimPending provides a mock function with given fields: ctx, maxAttempts, limit, now, lease
+func (_m *EventRepository) ClaimPending(ctx context.Context, maxAttempts int, limit int, now time.Time, lease time.Duration) ([]*dao.EventModel, error) {
+	ret := _m.Called(ctx, maxAttempts, limit, now, lease)
+
+	var r0 []*dao.EventModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, time.Time, time.Duration) ([]*dao.EventModel, error)); ok {
+		return rf(ctx, maxAttempts, limit, now, lease)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, time.Time, time.Duration) []*dao.EventModel); ok {
+		r0 = rf(ctx, maxAttempts, limit, now, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.EventModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, time.Time, time.Duration) error); ok {
+		r1 = rf(ctx, maxAttempts, limit, now, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EventRepository_ClaimPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimPending'
+type EventRepository_ClaimPending_Call struct {
+	*mock.Call
+}
+
+// ClaimPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - maxAttempts int
+//   - limit int
+//   - now time.Time
+//   - lease time.Duration
+func (_e *EventRepository_Expecter) ClaimPending(ctx interface{}, maxAttempts interface{}, limit interface{}, now interface{}, lease interface{}) *EventRepository_ClaimPending_Call {
+	return &EventRepository_ClaimPending_Call{Call: _e.mock.On("ClaimPending", ctx, maxAttempts, limit, now, lease)}
+}
+
+func (_c *EventRepository_ClaimPending_Call) Run(run func(ctx context.Context, maxAttempts int, limit int, now time.Time, lease time.Duration)) *EventRepository_ClaimPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(time.Time), args[4].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *EventRepository_ClaimPending_Call) Return(_a0 []*dao.EventModel, _a1 error) *EventRepository_ClaimPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EventRepository_ClaimPending_Call) RunAndReturn(run func(context.Context, int, int, time.Time, time.Duration) ([]*dao.EventModel, error)) *EventRepository_ClaimPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkDelivered provides a mock function with given fields: ctx, id, now
+func (_m *EventRepository) MarkDelivered(ctx context.Context, id int64, now time.Time) error {
+	ret := _m.Called(ctx, id, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = rf(ctx, id, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EventRepository_MarkDelivered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDelivered'
+type EventRepository_MarkDelivered_Call struct {
+	*mock.Call
+}
+
+// MarkDelivered is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - now time.Time
+func (_e *EventRepository_Expecter) MarkDelivered(ctx interface{}, id interface{}, now interface{}) *EventRepository_MarkDelivered_Call {
+	return &EventRepository_MarkDelivered_Call{Call: _e.mock.On("MarkDelivered", ctx, id, now)}
+}
+
+func (_c *EventRepository_MarkDelivered_Call) Run(run func(ctx context.Context, id int64, now time.Time)) *EventRepository_MarkDelivered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *EventRepository_MarkDelivered_Call) Return(_a0 error) *EventRepository_MarkDelivered_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EventRepository_MarkDelivered_Call) RunAndReturn(run func(context.Context, int64, time.Time) error) *EventRepository_MarkDelivered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkFailed provides a mock function with given fields: ctx, id, reason, now
+func (_m *EventRepository) MarkFailed(ctx context.Context, id int64, reason string, now time.Time) error {
+	ret := _m.Called(ctx, id, reason, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Time) error); ok {
+		r0 = rf(ctx, id, reason, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EventRepository_MarkFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFailed'
+type EventRepository_MarkFailed_Call struct {
+	*mock.Call
+}
+
+// MarkFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - reason string
+//   - now time.Time
+func (_e *EventRepository_Expecter) MarkFailed(ctx interface{}, id interface{}, reason interface{}, now interface{}) *EventRepository_MarkFailed_Call {
+	return &EventRepository_MarkFailed_Call{Call: _e.mock.On("MarkFailed", ctx, id, reason, now)}
+}
+
+func (_c *EventRepository_MarkFailed_Call) Run(run func(ctx context.Context, id int64, reason string, now time.Time)) *EventRepository_MarkFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *EventRepository_MarkFailed_Call) Return(_a0 error) *EventRepository_MarkFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EventRepository_MarkFailed_Call) RunAndReturn(run func(context.Context, int64, string, time.Time) error) *EventRepository_MarkFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEventRepository creates a new instance of EventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventRepository {
+	mock := &EventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
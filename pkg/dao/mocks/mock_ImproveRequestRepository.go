@@ -26,25 +26,158 @@ func (_m *ImproveRequestRepository) EXPECT() *ImproveRequestRepository_Expecter
 	return &ImproveRequestRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, userID, title, content, sourceID, id, now
-func (_m *ImproveRequestRepository) Create(ctx context.Context, userID uuid.UUID, title string, content string, sourceID uuid.UUID, id uuid.UUID, now time.Time) (*dao.ImproveRequestPreview, error) {
-	ret := _m.Called(ctx, userID, title, content, sourceID, id, now)
+// ApplySuggestion provides a mock function with given fields: ctx, userID, suggestionID, id, now, events
+func (_m *ImproveRequestRepository) ApplySuggestion(ctx context.Context, userID uuid.UUID, suggestionID uuid.UUID, id uuid.UUID, now time.Time, events ...*dao.EventModelCore) (*dao.ImproveRequestPreview, error) {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, userID, suggestionID, id, now)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dao.ImproveRequestPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time, ...*dao.EventModelCore) (*dao.ImproveRequestPreview, error)); ok {
+		return rf(ctx, userID, suggestionID, id, now, events...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time, ...*dao.EventModelCore) *dao.ImproveRequestPreview); ok {
+		r0 = rf(ctx, userID, suggestionID, id, now, events...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ImproveRequestPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time, ...*dao.EventModelCore) error); ok {
+		r1 = rf(ctx, userID, suggestionID, id, now, events...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveRequestRepository_ApplySuggestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplySuggestion'
+type ImproveRequestRepository_ApplySuggestion_Call struct {
+	*mock.Call
+}
+
+// ApplySuggestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - suggestionID uuid.UUID
+//   - id uuid.UUID
+//   - now time.Time
+//   - events ...*dao.EventModelCore
+func (_e *ImproveRequestRepository_Expecter) ApplySuggestion(ctx interface{}, userID interface{}, suggestionID interface{}, id interface{}, now interface{}, events ...interface{}) *ImproveRequestRepository_ApplySuggestion_Call {
+	return &ImproveRequestRepository_ApplySuggestion_Call{Call: _e.mock.On("ApplySuggestion",
+		append([]interface{}{ctx, userID, suggestionID, id, now}, events...)...)}
+}
+
+func (_c *ImproveRequestRepository_ApplySuggestion_Call) Run(run func(ctx context.Context, userID uuid.UUID, suggestionID uuid.UUID, id uuid.UUID, now time.Time, events ...*dao.EventModelCore)) *ImproveRequestRepository_ApplySuggestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*dao.EventModelCore, len(args)-5)
+		for i, a := range args[5:] {
+			if a != nil {
+				variadicArgs[i] = a.(*dao.EventModelCore)
+			}
+		}
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ImproveRequestRepository_ApplySuggestion_Call) Return(_a0 *dao.ImproveRequestPreview, _a1 error) *ImproveRequestRepository_ApplySuggestion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveRequestRepository_ApplySuggestion_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time, ...*dao.EventModelCore) (*dao.ImproveRequestPreview, error)) *ImproveRequestRepository_ApplySuggestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AutoClose provides a mock function with given fields: ctx, now
+func (_m *ImproveRequestRepository) AutoClose(ctx context.Context, now time.Time) (int, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveRequestRepository_AutoClose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoClose'
+type ImproveRequestRepository_AutoClose_Call struct {
+	*mock.Call
+}
+
+// AutoClose is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *ImproveRequestRepository_Expecter) AutoClose(ctx interface{}, now interface{}) *ImproveRequestRepository_AutoClose_Call {
+	return &ImproveRequestRepository_AutoClose_Call{Call: _e.mock.On("AutoClose", ctx, now)}
+}
+
+func (_c *ImproveRequestRepository_AutoClose_Call) Run(run func(ctx context.Context, now time.Time)) *ImproveRequestRepository_AutoClose_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ImproveRequestRepository_AutoClose_Call) Return(_a0 int, _a1 error) *ImproveRequestRepository_AutoClose_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveRequestRepository_AutoClose_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *ImproveRequestRepository_AutoClose_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, data, draft, userID, sourceID, id, report, now, events
+func (_m *ImproveRequestRepository) Create(ctx context.Context, data *dao.ImproveRequestModelCore, draft bool, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, report *dao.ReportModel, now time.Time, events ...*dao.EventModelCore) (*dao.ImproveRequestPreview, error) {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, data, draft, userID, sourceID, id, report, now)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 *dao.ImproveRequestPreview
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, uuid.UUID, uuid.UUID, time.Time) (*dao.ImproveRequestPreview, error)); ok {
-		return rf(ctx, userID, title, content, sourceID, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ImproveRequestModelCore, bool, uuid.UUID, uuid.UUID, uuid.UUID, *dao.ReportModel, time.Time, ...*dao.EventModelCore) (*dao.ImproveRequestPreview, error)); ok {
+		return rf(ctx, data, draft, userID, sourceID, id, report, now, events...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, uuid.UUID, uuid.UUID, time.Time) *dao.ImproveRequestPreview); ok {
-		r0 = rf(ctx, userID, title, content, sourceID, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ImproveRequestModelCore, bool, uuid.UUID, uuid.UUID, uuid.UUID, *dao.ReportModel, time.Time, ...*dao.EventModelCore) *dao.ImproveRequestPreview); ok {
+		r0 = rf(ctx, data, draft, userID, sourceID, id, report, now, events...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dao.ImproveRequestPreview)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, uuid.UUID, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, userID, title, content, sourceID, id, now)
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.ImproveRequestModelCore, bool, uuid.UUID, uuid.UUID, uuid.UUID, *dao.ReportModel, time.Time, ...*dao.EventModelCore) error); ok {
+		r1 = rf(ctx, data, draft, userID, sourceID, id, report, now, events...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,19 +192,28 @@ type ImproveRequestRepository_Create_Call struct {
 
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
+//   - data *dao.ImproveRequestModelCore
+//   - draft bool
 //   - userID uuid.UUID
-//   - title string
-//   - content string
 //   - sourceID uuid.UUID
 //   - id uuid.UUID
+//   - report *dao.ReportModel
 //   - now time.Time
-func (_e *ImproveRequestRepository_Expecter) Create(ctx interface{}, userID interface{}, title interface{}, content interface{}, sourceID interface{}, id interface{}, now interface{}) *ImproveRequestRepository_Create_Call {
-	return &ImproveRequestRepository_Create_Call{Call: _e.mock.On("Create", ctx, userID, title, content, sourceID, id, now)}
+//   - events ...*dao.EventModelCore
+func (_e *ImproveRequestRepository_Expecter) Create(ctx interface{}, data interface{}, draft interface{}, userID interface{}, sourceID interface{}, id interface{}, report interface{}, now interface{}, events ...interface{}) *ImproveRequestRepository_Create_Call {
+	return &ImproveRequestRepository_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, data, draft, userID, sourceID, id, report, now}, events...)...)}
 }
 
-func (_c *ImproveRequestRepository_Create_Call) Run(run func(ctx context.Context, userID uuid.UUID, title string, content string, sourceID uuid.UUID, id uuid.UUID, now time.Time)) *ImproveRequestRepository_Create_Call {
+func (_c *ImproveRequestRepository_Create_Call) Run(run func(ctx context.Context, data *dao.ImproveRequestModelCore, draft bool, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, report *dao.ReportModel, now time.Time, events ...*dao.EventModelCore)) *ImproveRequestRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(uuid.UUID), args[5].(uuid.UUID), args[6].(time.Time))
+		variadicArgs := make([]*dao.EventModelCore, len(args)-8)
+		for i, a := range args[8:] {
+			if a != nil {
+				variadicArgs[i] = a.(*dao.EventModelCore)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*dao.ImproveRequestModelCore), args[2].(bool), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(uuid.UUID), args[6].(*dao.ReportModel), args[7].(time.Time), variadicArgs...)
 	})
 	return _c
 }
@@ -81,18 +223,18 @@ func (_c *ImproveRequestRepository_Create_Call) Return(_a0 *dao.ImproveRequestPr
 	return _c
 }
 
-func (_c *ImproveRequestRepository_Create_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, uuid.UUID, uuid.UUID, time.Time) (*dao.ImproveRequestPreview, error)) *ImproveRequestRepository_Create_Call {
+func (_c *ImproveRequestRepository_Create_Call) RunAndReturn(run func(context.Context, *dao.ImproveRequestModelCore, bool, uuid.UUID, uuid.UUID, uuid.UUID, *dao.ReportModel, time.Time, ...*dao.EventModelCore) (*dao.ImproveRequestPreview, error)) *ImproveRequestRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *ImproveRequestRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// Delete provides a mock function with given fields: ctx, id, now
+func (_m *ImproveRequestRepository) Delete(ctx context.Context, id uuid.UUID, now time.Time) error {
+	ret := _m.Called(ctx, id, now)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, id, now)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -108,13 +250,14 @@ type ImproveRequestRepository_Delete_Call struct {
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *ImproveRequestRepository_Expecter) Delete(ctx interface{}, id interface{}) *ImproveRequestRepository_Delete_Call {
-	return &ImproveRequestRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - now time.Time
+func (_e *ImproveRequestRepository_Expecter) Delete(ctx interface{}, id interface{}, now interface{}) *ImproveRequestRepository_Delete_Call {
+	return &ImproveRequestRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, now)}
 }
 
-func (_c *ImproveRequestRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ImproveRequestRepository_Delete_Call {
+func (_c *ImproveRequestRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID, now time.Time)) *ImproveRequestRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
 	})
 	return _c
 }
@@ -124,18 +267,18 @@ func (_c *ImproveRequestRepository_Delete_Call) Return(_a0 error) *ImproveReques
 	return _c
 }
 
-func (_c *ImproveRequestRepository_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ImproveRequestRepository_Delete_Call {
+func (_c *ImproveRequestRepository_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *ImproveRequestRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteRevision provides a mock function with given fields: ctx, id
-func (_m *ImproveRequestRepository) DeleteRevision(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// DeleteRevision provides a mock function with given fields: ctx, id, policy, now
+func (_m *ImproveRequestRepository) DeleteRevision(ctx context.Context, id uuid.UUID, policy dao.SuggestionOrphanPolicy, now time.Time) error {
+	ret := _m.Called(ctx, id, policy, now)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dao.SuggestionOrphanPolicy, time.Time) error); ok {
+		r0 = rf(ctx, id, policy, now)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -151,13 +294,15 @@ type ImproveRequestRepository_DeleteRevision_Call struct {
 // DeleteRevision is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *ImproveRequestRepository_Expecter) DeleteRevision(ctx interface{}, id interface{}) *ImproveRequestRepository_DeleteRevision_Call {
-	return &ImproveRequestRepository_DeleteRevision_Call{Call: _e.mock.On("DeleteRevision", ctx, id)}
+//   - policy dao.SuggestionOrphanPolicy
+//   - now time.Time
+func (_e *ImproveRequestRepository_Expecter) DeleteRevision(ctx interface{}, id interface{}, policy interface{}, now interface{}) *ImproveRequestRepository_DeleteRevision_Call {
+	return &ImproveRequestRepository_DeleteRevision_Call{Call: _e.mock.On("DeleteRevision", ctx, id, policy, now)}
 }
 
-func (_c *ImproveRequestRepository_DeleteRevision_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ImproveRequestRepository_DeleteRevision_Call {
+func (_c *ImproveRequestRepository_DeleteRevision_Call) Run(run func(ctx context.Context, id uuid.UUID, policy dao.SuggestionOrphanPolicy, now time.Time)) *ImproveRequestRepository_DeleteRevision_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dao.SuggestionOrphanPolicy), args[3].(time.Time))
 	})
 	return _c
 }
@@ -167,7 +312,63 @@ func (_c *ImproveRequestRepository_DeleteRevision_Call) Return(_a0 error) *Impro
 	return _c
 }
 
-func (_c *ImproveRequestRepository_DeleteRevision_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ImproveRequestRepository_DeleteRevision_Call {
+func (_c *ImproveRequestRepository_DeleteRevision_Call) RunAndReturn(run func(context.Context, uuid.UUID, dao.SuggestionOrphanPolicy, time.Time) error) *ImproveRequestRepository_DeleteRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDuplicate provides a mock function with given fields: ctx, query, excludeSourceID
+func (_m *ImproveRequestRepository) FindDuplicate(ctx context.Context, query dao.DuplicateQuery, excludeSourceID uuid.UUID) (*dao.Duplicate, error) {
+	ret := _m.Called(ctx, query, excludeSourceID)
+
+	var r0 *dao.Duplicate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dao.DuplicateQuery, uuid.UUID) (*dao.Duplicate, error)); ok {
+		return rf(ctx, query, excludeSourceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dao.DuplicateQuery, uuid.UUID) *dao.Duplicate); ok {
+		r0 = rf(ctx, query, excludeSourceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Duplicate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dao.DuplicateQuery, uuid.UUID) error); ok {
+		r1 = rf(ctx, query, excludeSourceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveRequestRepository_FindDuplicate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDuplicate'
+type ImproveRequestRepository_FindDuplicate_Call struct {
+	*mock.Call
+}
+
+// FindDuplicate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query dao.DuplicateQuery
+//   - excludeSourceID uuid.UUID
+func (_e *ImproveRequestRepository_Expecter) FindDuplicate(ctx interface{}, query interface{}, excludeSourceID interface{}) *ImproveRequestRepository_FindDuplicate_Call {
+	return &ImproveRequestRepository_FindDuplicate_Call{Call: _e.mock.On("FindDuplicate", ctx, query, excludeSourceID)}
+}
+
+func (_c *ImproveRequestRepository_FindDuplicate_Call) Run(run func(ctx context.Context, query dao.DuplicateQuery, excludeSourceID uuid.UUID)) *ImproveRequestRepository_FindDuplicate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dao.DuplicateQuery), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ImproveRequestRepository_FindDuplicate_Call) Return(_a0 *dao.Duplicate, _a1 error) *ImproveRequestRepository_FindDuplicate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveRequestRepository_FindDuplicate_Call) RunAndReturn(run func(context.Context, dao.DuplicateQuery, uuid.UUID) (*dao.Duplicate, error)) *ImproveRequestRepository_FindDuplicate_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -227,6 +428,116 @@ func (_c *ImproveRequestRepository_Get_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetDeleted provides a mock function with given fields: ctx, id
+func (_m *ImproveRequestRepository) GetDeleted(ctx context.Context, id uuid.UUID) (*dao.ImproveRequestRevisionModel, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *dao.ImproveRequestRevisionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.ImproveRequestRevisionModel, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.ImproveRequestRevisionModel); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ImproveRequestRevisionModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveRequestRepository_GetDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeleted'
+type ImproveRequestRepository_GetDeleted_Call struct {
+	*mock.Call
+}
+
+// GetDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ImproveRequestRepository_Expecter) GetDeleted(ctx interface{}, id interface{}) *ImproveRequestRepository_GetDeleted_Call {
+	return &ImproveRequestRepository_GetDeleted_Call{Call: _e.mock.On("GetDeleted", ctx, id)}
+}
+
+func (_c *ImproveRequestRepository_GetDeleted_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ImproveRequestRepository_GetDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ImproveRequestRepository_GetDeleted_Call) Return(_a0 *dao.ImproveRequestRevisionModel, _a1 error) *ImproveRequestRepository_GetDeleted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveRequestRepository_GetDeleted_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dao.ImproveRequestRevisionModel, error)) *ImproveRequestRepository_GetDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedRevision provides a mock function with given fields: ctx, id
+func (_m *ImproveRequestRepository) GetDeletedRevision(ctx context.Context, id uuid.UUID) (*dao.ImproveRequestRevisionModel, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *dao.ImproveRequestRevisionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.ImproveRequestRevisionModel, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.ImproveRequestRevisionModel); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ImproveRequestRevisionModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveRequestRepository_GetDeletedRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedRevision'
+type ImproveRequestRepository_GetDeletedRevision_Call struct {
+	*mock.Call
+}
+
+// GetDeletedRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ImproveRequestRepository_Expecter) GetDeletedRevision(ctx interface{}, id interface{}) *ImproveRequestRepository_GetDeletedRevision_Call {
+	return &ImproveRequestRepository_GetDeletedRevision_Call{Call: _e.mock.On("GetDeletedRevision", ctx, id)}
+}
+
+func (_c *ImproveRequestRepository_GetDeletedRevision_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ImproveRequestRepository_GetDeletedRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ImproveRequestRepository_GetDeletedRevision_Call) Return(_a0 *dao.ImproveRequestRevisionModel, _a1 error) *ImproveRequestRepository_GetDeletedRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveRequestRepository_GetDeletedRevision_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dao.ImproveRequestRevisionModel, error)) *ImproveRequestRepository_GetDeletedRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRevision provides a mock function with given fields: ctx, id
 func (_m *ImproveRequestRepository) GetRevision(ctx context.Context, id uuid.UUID) (*dao.ImproveRequestRevisionModel, error) {
 	ret := _m.Called(ctx, id)
@@ -392,6 +703,240 @@ func (_c *ImproveRequestRepository_ListRevisions_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// Publish provides a mock function with given fields: ctx, id, now, events
+func (_m *ImproveRequestRepository) Publish(ctx context.Context, id uuid.UUID, now time.Time, events ...*dao.EventModelCore) (*dao.ImproveRequestRevisionModel, error) {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, id, now)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dao.ImproveRequestRevisionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, ...*dao.EventModelCore) (*dao.ImproveRequestRevisionModel, error)); ok {
+		return rf(ctx, id, now, events...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, ...*dao.EventModelCore) *dao.ImproveRequestRevisionModel); ok {
+		r0 = rf(ctx, id, now, events...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ImproveRequestRevisionModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, ...*dao.EventModelCore) error); ok {
+		r1 = rf(ctx, id, now, events...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveRequestRepository_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type ImproveRequestRepository_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - now time.Time
+//   - events ...*dao.EventModelCore
+func (_e *ImproveRequestRepository_Expecter) Publish(ctx interface{}, id interface{}, now interface{}, events ...interface{}) *ImproveRequestRepository_Publish_Call {
+	return &ImproveRequestRepository_Publish_Call{Call: _e.mock.On("Publish",
+		append([]interface{}{ctx, id, now}, events...)...)}
+}
+
+func (_c *ImproveRequestRepository_Publish_Call) Run(run func(ctx context.Context, id uuid.UUID, now time.Time, events ...*dao.EventModelCore)) *ImproveRequestRepository_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*dao.EventModelCore, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(*dao.EventModelCore)
+			}
+		}
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ImproveRequestRepository_Publish_Call) Return(_a0 *dao.ImproveRequestRevisionModel, _a1 error) *ImproveRequestRepository_Publish_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveRequestRepository_Publish_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, ...*dao.EventModelCore) (*dao.ImproveRequestRevisionModel, error)) *ImproveRequestRepository_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, before
+func (_m *ImproveRequestRepository) Purge(ctx context.Context, before time.Time) (int, error) {
+	ret := _m.Called(ctx, before)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveRequestRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type ImproveRequestRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *ImproveRequestRepository_Expecter) Purge(ctx interface{}, before interface{}) *ImproveRequestRepository_Purge_Call {
+	return &ImproveRequestRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, before)}
+}
+
+func (_c *ImproveRequestRepository_Purge_Call) Run(run func(ctx context.Context, before time.Time)) *ImproveRequestRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ImproveRequestRepository_Purge_Call) Return(_a0 int, _a1 error) *ImproveRequestRepository_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveRequestRepository_Purge_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *ImproveRequestRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *ImproveRequestRepository) Restore(ctx context.Context, id uuid.UUID) (*dao.ImproveRequestPreview, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *dao.ImproveRequestPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.ImproveRequestPreview, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.ImproveRequestPreview); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ImproveRequestPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveRequestRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type ImproveRequestRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ImproveRequestRepository_Expecter) Restore(ctx interface{}, id interface{}) *ImproveRequestRepository_Restore_Call {
+	return &ImproveRequestRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *ImproveRequestRepository_Restore_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ImproveRequestRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ImproveRequestRepository_Restore_Call) Return(_a0 *dao.ImproveRequestPreview, _a1 error) *ImproveRequestRepository_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveRequestRepository_Restore_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dao.ImproveRequestPreview, error)) *ImproveRequestRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreRevision provides a mock function with given fields: ctx, id
+func (_m *ImproveRequestRepository) RestoreRevision(ctx context.Context, id uuid.UUID) (*dao.ImproveRequestRevisionModel, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *dao.ImproveRequestRevisionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.ImproveRequestRevisionModel, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.ImproveRequestRevisionModel); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ImproveRequestRevisionModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveRequestRepository_RestoreRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreRevision'
+type ImproveRequestRepository_RestoreRevision_Call struct {
+	*mock.Call
+}
+
+// RestoreRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ImproveRequestRepository_Expecter) RestoreRevision(ctx interface{}, id interface{}) *ImproveRequestRepository_RestoreRevision_Call {
+	return &ImproveRequestRepository_RestoreRevision_Call{Call: _e.mock.On("RestoreRevision", ctx, id)}
+}
+
+func (_c *ImproveRequestRepository_RestoreRevision_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ImproveRequestRepository_RestoreRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ImproveRequestRepository_RestoreRevision_Call) Return(_a0 *dao.ImproveRequestRevisionModel, _a1 error) *ImproveRequestRepository_RestoreRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveRequestRepository_RestoreRevision_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dao.ImproveRequestRevisionModel, error)) *ImproveRequestRepository_RestoreRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function with given fields: ctx, query, limit, offset
 func (_m *ImproveRequestRepository) Search(ctx context.Context, query dao.ImproveRequestSearchQuery, limit int, offset int) ([]*dao.ImproveRequestPreview, int, error) {
 	ret := _m.Called(ctx, query, limit, offset)
@@ -456,47 +1001,169 @@ func (_c *ImproveRequestRepository_Search_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
-// UpdateVotes provides a mock function with given fields: ctx, id, upVotes, downVotes
-func (_m *ImproveRequestRepository) UpdateVotes(ctx context.Context, id uuid.UUID, upVotes int, downVotes int) error {
-	ret := _m.Called(ctx, id, upVotes, downVotes)
+// SetClosePolicy provides a mock function with given fields: ctx, id, closeAfterDays, closeAfterAccepted
+func (_m *ImproveRequestRepository) SetClosePolicy(ctx context.Context, id uuid.UUID, closeAfterDays *int, closeAfterAccepted *int) (*dao.ImproveRequestPreview, error) {
+	ret := _m.Called(ctx, id, closeAfterDays, closeAfterAccepted)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r0 = rf(ctx, id, upVotes, downVotes)
+	var r0 *dao.ImproveRequestPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *int, *int) (*dao.ImproveRequestPreview, error)); ok {
+		return rf(ctx, id, closeAfterDays, closeAfterAccepted)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *int, *int) *dao.ImproveRequestPreview); ok {
+		r0 = rf(ctx, id, closeAfterDays, closeAfterAccepted)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ImproveRequestPreview)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *int, *int) error); ok {
+		r1 = rf(ctx, id, closeAfterDays, closeAfterAccepted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ImproveRequestRepository_UpdateVotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateVotes'
-type ImproveRequestRepository_UpdateVotes_Call struct {
+// ImproveRequestRepository_SetClosePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetClosePolicy'
+type ImproveRequestRepository_SetClosePolicy_Call struct {
 	*mock.Call
 }
 
-// UpdateVotes is a helper method to define mock.On call
+// SetClosePolicy is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-//   - upVotes int
-//   - downVotes int
-func (_e *ImproveRequestRepository_Expecter) UpdateVotes(ctx interface{}, id interface{}, upVotes interface{}, downVotes interface{}) *ImproveRequestRepository_UpdateVotes_Call {
-	return &ImproveRequestRepository_UpdateVotes_Call{Call: _e.mock.On("UpdateVotes", ctx, id, upVotes, downVotes)}
+//   - closeAfterDays *int
+//   - closeAfterAccepted *int
+func (_e *ImproveRequestRepository_Expecter) SetClosePolicy(ctx interface{}, id interface{}, closeAfterDays interface{}, closeAfterAccepted interface{}) *ImproveRequestRepository_SetClosePolicy_Call {
+	return &ImproveRequestRepository_SetClosePolicy_Call{Call: _e.mock.On("SetClosePolicy", ctx, id, closeAfterDays, closeAfterAccepted)}
 }
 
-func (_c *ImproveRequestRepository_UpdateVotes_Call) Run(run func(ctx context.Context, id uuid.UUID, upVotes int, downVotes int)) *ImproveRequestRepository_UpdateVotes_Call {
+func (_c *ImproveRequestRepository_SetClosePolicy_Call) Run(run func(ctx context.Context, id uuid.UUID, closeAfterDays *int, closeAfterAccepted *int)) *ImproveRequestRepository_SetClosePolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*int), args[3].(*int))
 	})
 	return _c
 }
 
-func (_c *ImproveRequestRepository_UpdateVotes_Call) Return(_a0 error) *ImproveRequestRepository_UpdateVotes_Call {
-	_c.Call.Return(_a0)
+func (_c *ImproveRequestRepository_SetClosePolicy_Call) Return(_a0 *dao.ImproveRequestPreview, _a1 error) *ImproveRequestRepository_SetClosePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveRequestRepository_SetClosePolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, *int, *int) (*dao.ImproveRequestPreview, error)) *ImproveRequestRepository_SetClosePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetStatus provides a mock function with given fields: ctx, id, status, now
+func (_m *ImproveRequestRepository) SetStatus(ctx context.Context, id uuid.UUID, status dao.RequestStatus, now time.Time) (*dao.ImproveRequestPreview, error) {
+	ret := _m.Called(ctx, id, status, now)
+
+	var r0 *dao.ImproveRequestPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dao.RequestStatus, time.Time) (*dao.ImproveRequestPreview, error)); ok {
+		return rf(ctx, id, status, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dao.RequestStatus, time.Time) *dao.ImproveRequestPreview); ok {
+		r0 = rf(ctx, id, status, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ImproveRequestPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dao.RequestStatus, time.Time) error); ok {
+		r1 = rf(ctx, id, status, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveRequestRepository_SetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStatus'
+type ImproveRequestRepository_SetStatus_Call struct {
+	*mock.Call
+}
+
+// SetStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - status dao.RequestStatus
+//   - now time.Time
+func (_e *ImproveRequestRepository_Expecter) SetStatus(ctx interface{}, id interface{}, status interface{}, now interface{}) *ImproveRequestRepository_SetStatus_Call {
+	return &ImproveRequestRepository_SetStatus_Call{Call: _e.mock.On("SetStatus", ctx, id, status, now)}
+}
+
+func (_c *ImproveRequestRepository_SetStatus_Call) Run(run func(ctx context.Context, id uuid.UUID, status dao.RequestStatus, now time.Time)) *ImproveRequestRepository_SetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dao.RequestStatus), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ImproveRequestRepository_SetStatus_Call) Return(_a0 *dao.ImproveRequestPreview, _a1 error) *ImproveRequestRepository_SetStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveRequestRepository_SetStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, dao.RequestStatus, time.Time) (*dao.ImproveRequestPreview, error)) *ImproveRequestRepository_SetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SuggestQuery provides a mock function with given fields: ctx, query
+func (_m *ImproveRequestRepository) SuggestQuery(ctx context.Context, query string) (string, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, query)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveRequestRepository_SuggestQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuggestQuery'
+type ImproveRequestRepository_SuggestQuery_Call struct {
+	*mock.Call
+}
+
+// SuggestQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+func (_e *ImproveRequestRepository_Expecter) SuggestQuery(ctx interface{}, query interface{}) *ImproveRequestRepository_SuggestQuery_Call {
+	return &ImproveRequestRepository_SuggestQuery_Call{Call: _e.mock.On("SuggestQuery", ctx, query)}
+}
+
+func (_c *ImproveRequestRepository_SuggestQuery_Call) Run(run func(ctx context.Context, query string)) *ImproveRequestRepository_SuggestQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ImproveRequestRepository_SuggestQuery_Call) Return(_a0 string, _a1 error) *ImproveRequestRepository_SuggestQuery_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ImproveRequestRepository_UpdateVotes_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int) error) *ImproveRequestRepository_UpdateVotes_Call {
+func (_c *ImproveRequestRepository_SuggestQuery_Call) RunAndReturn(run func(context.Context, string) (string, error)) *ImproveRequestRepository_SuggestQuery_Call {
 	_c.Call.Return(run)
 	return _c
 }
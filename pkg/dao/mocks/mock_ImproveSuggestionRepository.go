@@ -26,25 +26,32 @@ func (_m *ImproveSuggestionRepository) EXPECT() *ImproveSuggestionRepository_Exp
 	return &ImproveSuggestionRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, data, userID, sourceID, id, now
-func (_m *ImproveSuggestionRepository) Create(ctx context.Context, data *dao.ImproveSuggestionModelCore, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, now time.Time) (*dao.ImproveSuggestionModel, error) {
-	ret := _m.Called(ctx, data, userID, sourceID, id, now)
+// Create provides a mock function with given fields: ctx, data, draft, userID, sourceID, id, report, now, events
+func (_m *ImproveSuggestionRepository) Create(ctx context.Context, data *dao.ImproveSuggestionModelCore, draft bool, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, report *dao.ReportModel, now time.Time, events ...*dao.EventModelCore) (*dao.ImproveSuggestionModel, error) {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, data, draft, userID, sourceID, id, report, now)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 *dao.ImproveSuggestionModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dao.ImproveSuggestionModelCore, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*dao.ImproveSuggestionModel, error)); ok {
-		return rf(ctx, data, userID, sourceID, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ImproveSuggestionModelCore, bool, uuid.UUID, uuid.UUID, uuid.UUID, *dao.ReportModel, time.Time, ...*dao.EventModelCore) (*dao.ImproveSuggestionModel, error)); ok {
+		return rf(ctx, data, draft, userID, sourceID, id, report, now, events...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *dao.ImproveSuggestionModelCore, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) *dao.ImproveSuggestionModel); ok {
-		r0 = rf(ctx, data, userID, sourceID, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ImproveSuggestionModelCore, bool, uuid.UUID, uuid.UUID, uuid.UUID, *dao.ReportModel, time.Time, ...*dao.EventModelCore) *dao.ImproveSuggestionModel); ok {
+		r0 = rf(ctx, data, draft, userID, sourceID, id, report, now, events...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dao.ImproveSuggestionModel)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *dao.ImproveSuggestionModelCore, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, data, userID, sourceID, id, now)
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.ImproveSuggestionModelCore, bool, uuid.UUID, uuid.UUID, uuid.UUID, *dao.ReportModel, time.Time, ...*dao.EventModelCore) error); ok {
+		r1 = rf(ctx, data, draft, userID, sourceID, id, report, now, events...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,17 +67,27 @@ type ImproveSuggestionRepository_Create_Call struct {
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
 //   - data *dao.ImproveSuggestionModelCore
+//   - draft bool
 //   - userID uuid.UUID
 //   - sourceID uuid.UUID
 //   - id uuid.UUID
+//   - report *dao.ReportModel
 //   - now time.Time
-func (_e *ImproveSuggestionRepository_Expecter) Create(ctx interface{}, data interface{}, userID interface{}, sourceID interface{}, id interface{}, now interface{}) *ImproveSuggestionRepository_Create_Call {
-	return &ImproveSuggestionRepository_Create_Call{Call: _e.mock.On("Create", ctx, data, userID, sourceID, id, now)}
+//   - events ...*dao.EventModelCore
+func (_e *ImproveSuggestionRepository_Expecter) Create(ctx interface{}, data interface{}, draft interface{}, userID interface{}, sourceID interface{}, id interface{}, report interface{}, now interface{}, events ...interface{}) *ImproveSuggestionRepository_Create_Call {
+	return &ImproveSuggestionRepository_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, data, draft, userID, sourceID, id, report, now}, events...)...)}
 }
 
-func (_c *ImproveSuggestionRepository_Create_Call) Run(run func(ctx context.Context, data *dao.ImproveSuggestionModelCore, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, now time.Time)) *ImproveSuggestionRepository_Create_Call {
+func (_c *ImproveSuggestionRepository_Create_Call) Run(run func(ctx context.Context, data *dao.ImproveSuggestionModelCore, draft bool, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, report *dao.ReportModel, now time.Time, events ...*dao.EventModelCore)) *ImproveSuggestionRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*dao.ImproveSuggestionModelCore), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(time.Time))
+		variadicArgs := make([]*dao.EventModelCore, len(args)-8)
+		for i, a := range args[8:] {
+			if a != nil {
+				variadicArgs[i] = a.(*dao.EventModelCore)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*dao.ImproveSuggestionModelCore), args[2].(bool), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(uuid.UUID), args[6].(*dao.ReportModel), args[7].(time.Time), variadicArgs...)
 	})
 	return _c
 }
@@ -80,18 +97,18 @@ func (_c *ImproveSuggestionRepository_Create_Call) Return(_a0 *dao.ImproveSugges
 	return _c
 }
 
-func (_c *ImproveSuggestionRepository_Create_Call) RunAndReturn(run func(context.Context, *dao.ImproveSuggestionModelCore, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*dao.ImproveSuggestionModel, error)) *ImproveSuggestionRepository_Create_Call {
+func (_c *ImproveSuggestionRepository_Create_Call) RunAndReturn(run func(context.Context, *dao.ImproveSuggestionModelCore, bool, uuid.UUID, uuid.UUID, uuid.UUID, *dao.ReportModel, time.Time, ...*dao.EventModelCore) (*dao.ImproveSuggestionModel, error)) *ImproveSuggestionRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *ImproveSuggestionRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// Delete provides a mock function with given fields: ctx, id, now
+func (_m *ImproveSuggestionRepository) Delete(ctx context.Context, id uuid.UUID, now time.Time) error {
+	ret := _m.Called(ctx, id, now)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, id, now)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -107,13 +124,14 @@ type ImproveSuggestionRepository_Delete_Call struct {
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *ImproveSuggestionRepository_Expecter) Delete(ctx interface{}, id interface{}) *ImproveSuggestionRepository_Delete_Call {
-	return &ImproveSuggestionRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - now time.Time
+func (_e *ImproveSuggestionRepository_Expecter) Delete(ctx interface{}, id interface{}, now interface{}) *ImproveSuggestionRepository_Delete_Call {
+	return &ImproveSuggestionRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, now)}
 }
 
-func (_c *ImproveSuggestionRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ImproveSuggestionRepository_Delete_Call {
+func (_c *ImproveSuggestionRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID, now time.Time)) *ImproveSuggestionRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
 	})
 	return _c
 }
@@ -123,7 +141,62 @@ func (_c *ImproveSuggestionRepository_Delete_Call) Return(_a0 error) *ImproveSug
 	return _c
 }
 
-func (_c *ImproveSuggestionRepository_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ImproveSuggestionRepository_Delete_Call {
+func (_c *ImproveSuggestionRepository_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *ImproveSuggestionRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDuplicate provides a mock function with given fields: ctx, query
+func (_m *ImproveSuggestionRepository) FindDuplicate(ctx context.Context, query dao.DuplicateQuery) (*dao.Duplicate, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 *dao.Duplicate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dao.DuplicateQuery) (*dao.Duplicate, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dao.DuplicateQuery) *dao.Duplicate); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Duplicate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dao.DuplicateQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveSuggestionRepository_FindDuplicate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDuplicate'
+type ImproveSuggestionRepository_FindDuplicate_Call struct {
+	*mock.Call
+}
+
+// FindDuplicate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query dao.DuplicateQuery
+func (_e *ImproveSuggestionRepository_Expecter) FindDuplicate(ctx interface{}, query interface{}) *ImproveSuggestionRepository_FindDuplicate_Call {
+	return &ImproveSuggestionRepository_FindDuplicate_Call{Call: _e.mock.On("FindDuplicate", ctx, query)}
+}
+
+func (_c *ImproveSuggestionRepository_FindDuplicate_Call) Run(run func(ctx context.Context, query dao.DuplicateQuery)) *ImproveSuggestionRepository_FindDuplicate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dao.DuplicateQuery))
+	})
+	return _c
+}
+
+func (_c *ImproveSuggestionRepository_FindDuplicate_Call) Return(_a0 *dao.Duplicate, _a1 error) *ImproveSuggestionRepository_FindDuplicate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveSuggestionRepository_FindDuplicate_Call) RunAndReturn(run func(context.Context, dao.DuplicateQuery) (*dao.Duplicate, error)) *ImproveSuggestionRepository_FindDuplicate_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -183,6 +256,61 @@ func (_c *ImproveSuggestionRepository_Get_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// GetDeleted provides a mock function with given fields: ctx, id
+func (_m *ImproveSuggestionRepository) GetDeleted(ctx context.Context, id uuid.UUID) (*dao.ImproveSuggestionModel, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *dao.ImproveSuggestionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.ImproveSuggestionModel, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.ImproveSuggestionModel); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ImproveSuggestionModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveSuggestionRepository_GetDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeleted'
+type ImproveSuggestionRepository_GetDeleted_Call struct {
+	*mock.Call
+}
+
+// GetDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ImproveSuggestionRepository_Expecter) GetDeleted(ctx interface{}, id interface{}) *ImproveSuggestionRepository_GetDeleted_Call {
+	return &ImproveSuggestionRepository_GetDeleted_Call{Call: _e.mock.On("GetDeleted", ctx, id)}
+}
+
+func (_c *ImproveSuggestionRepository_GetDeleted_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ImproveSuggestionRepository_GetDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ImproveSuggestionRepository_GetDeleted_Call) Return(_a0 *dao.ImproveSuggestionModel, _a1 error) *ImproveSuggestionRepository_GetDeleted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveSuggestionRepository_GetDeleted_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dao.ImproveSuggestionModel, error)) *ImproveSuggestionRepository_GetDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: ctx, ids
 func (_m *ImproveSuggestionRepository) List(ctx context.Context, ids []uuid.UUID) ([]*dao.ImproveSuggestionModel, error) {
 	ret := _m.Called(ctx, ids)
@@ -238,6 +366,185 @@ func (_c *ImproveSuggestionRepository_List_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// Publish provides a mock function with given fields: ctx, id, now, events
+func (_m *ImproveSuggestionRepository) Publish(ctx context.Context, id uuid.UUID, now time.Time, events ...*dao.EventModelCore) (*dao.ImproveSuggestionModel, error) {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, id, now)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dao.ImproveSuggestionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, ...*dao.EventModelCore) (*dao.ImproveSuggestionModel, error)); ok {
+		return rf(ctx, id, now, events...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, ...*dao.EventModelCore) *dao.ImproveSuggestionModel); ok {
+		r0 = rf(ctx, id, now, events...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ImproveSuggestionModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, ...*dao.EventModelCore) error); ok {
+		r1 = rf(ctx, id, now, events...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveSuggestionRepository_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type ImproveSuggestionRepository_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - now time.Time
+//   - events ...*dao.EventModelCore
+func (_e *ImproveSuggestionRepository_Expecter) Publish(ctx interface{}, id interface{}, now interface{}, events ...interface{}) *ImproveSuggestionRepository_Publish_Call {
+	return &ImproveSuggestionRepository_Publish_Call{Call: _e.mock.On("Publish",
+		append([]interface{}{ctx, id, now}, events...)...)}
+}
+
+func (_c *ImproveSuggestionRepository_Publish_Call) Run(run func(ctx context.Context, id uuid.UUID, now time.Time, events ...*dao.EventModelCore)) *ImproveSuggestionRepository_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*dao.EventModelCore, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(*dao.EventModelCore)
+			}
+		}
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ImproveSuggestionRepository_Publish_Call) Return(_a0 *dao.ImproveSuggestionModel, _a1 error) *ImproveSuggestionRepository_Publish_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveSuggestionRepository_Publish_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, ...*dao.EventModelCore) (*dao.ImproveSuggestionModel, error)) *ImproveSuggestionRepository_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, before
+func (_m *ImproveSuggestionRepository) Purge(ctx context.Context, before time.Time) (int, error) {
+	ret := _m.Called(ctx, before)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveSuggestionRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type ImproveSuggestionRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *ImproveSuggestionRepository_Expecter) Purge(ctx interface{}, before interface{}) *ImproveSuggestionRepository_Purge_Call {
+	return &ImproveSuggestionRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, before)}
+}
+
+func (_c *ImproveSuggestionRepository_Purge_Call) Run(run func(ctx context.Context, before time.Time)) *ImproveSuggestionRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ImproveSuggestionRepository_Purge_Call) Return(_a0 int, _a1 error) *ImproveSuggestionRepository_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveSuggestionRepository_Purge_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *ImproveSuggestionRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *ImproveSuggestionRepository) Restore(ctx context.Context, id uuid.UUID) (*dao.ImproveSuggestionModel, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *dao.ImproveSuggestionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.ImproveSuggestionModel, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.ImproveSuggestionModel); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ImproveSuggestionModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveSuggestionRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type ImproveSuggestionRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ImproveSuggestionRepository_Expecter) Restore(ctx interface{}, id interface{}) *ImproveSuggestionRepository_Restore_Call {
+	return &ImproveSuggestionRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *ImproveSuggestionRepository_Restore_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ImproveSuggestionRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ImproveSuggestionRepository_Restore_Call) Return(_a0 *dao.ImproveSuggestionModel, _a1 error) *ImproveSuggestionRepository_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveSuggestionRepository_Restore_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dao.ImproveSuggestionModel, error)) *ImproveSuggestionRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function with given fields: ctx, query, limit, offset
 func (_m *ImproveSuggestionRepository) Search(ctx context.Context, query dao.ImproveSuggestionSearchQuery, limit int, offset int) ([]*dao.ImproveSuggestionModel, int, error) {
 	ret := _m.Called(ctx, query, limit, offset)
@@ -302,6 +609,59 @@ func (_c *ImproveSuggestionRepository_Search_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// SuggestQuery provides a mock function with given fields: ctx, query
+func (_m *ImproveSuggestionRepository) SuggestQuery(ctx context.Context, query string) (string, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, query)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImproveSuggestionRepository_SuggestQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuggestQuery'
+type ImproveSuggestionRepository_SuggestQuery_Call struct {
+	*mock.Call
+}
+
+// SuggestQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+func (_e *ImproveSuggestionRepository_Expecter) SuggestQuery(ctx interface{}, query interface{}) *ImproveSuggestionRepository_SuggestQuery_Call {
+	return &ImproveSuggestionRepository_SuggestQuery_Call{Call: _e.mock.On("SuggestQuery", ctx, query)}
+}
+
+func (_c *ImproveSuggestionRepository_SuggestQuery_Call) Run(run func(ctx context.Context, query string)) *ImproveSuggestionRepository_SuggestQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ImproveSuggestionRepository_SuggestQuery_Call) Return(_a0 string, _a1 error) *ImproveSuggestionRepository_SuggestQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImproveSuggestionRepository_SuggestQuery_Call) RunAndReturn(run func(context.Context, string) (string, error)) *ImproveSuggestionRepository_SuggestQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, data, id, now
 func (_m *ImproveSuggestionRepository) Update(ctx context.Context, data *dao.ImproveSuggestionModelCore, id uuid.UUID, now time.Time) (*dao.ImproveSuggestionModel, error) {
 	ret := _m.Called(ctx, data, id, now)
@@ -359,70 +719,32 @@ func (_c *ImproveSuggestionRepository_Update_Call) RunAndReturn(run func(context
 	return _c
 }
 
-// UpdateVotes provides a mock function with given fields: ctx, id, upVotes, downVotes
-func (_m *ImproveSuggestionRepository) UpdateVotes(ctx context.Context, id uuid.UUID, upVotes int, downVotes int) error {
-	ret := _m.Called(ctx, id, upVotes, downVotes)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r0 = rf(ctx, id, upVotes, downVotes)
-	} else {
-		r0 = ret.Error(0)
+// Validate provides a mock function with given fields: ctx, validated, id, now, events
+func (_m *ImproveSuggestionRepository) Validate(ctx context.Context, validated bool, id uuid.UUID, now time.Time, events ...*dao.EventModelCore) (*dao.ImproveSuggestionModel, error) {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
 	}
-
-	return r0
-}
-
-// ImproveSuggestionRepository_UpdateVotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateVotes'
-type ImproveSuggestionRepository_UpdateVotes_Call struct {
-	*mock.Call
-}
-
-// UpdateVotes is a helper method to define mock.On call
-//   - ctx context.Context
-//   - id uuid.UUID
-//   - upVotes int
-//   - downVotes int
-func (_e *ImproveSuggestionRepository_Expecter) UpdateVotes(ctx interface{}, id interface{}, upVotes interface{}, downVotes interface{}) *ImproveSuggestionRepository_UpdateVotes_Call {
-	return &ImproveSuggestionRepository_UpdateVotes_Call{Call: _e.mock.On("UpdateVotes", ctx, id, upVotes, downVotes)}
-}
-
-func (_c *ImproveSuggestionRepository_UpdateVotes_Call) Run(run func(ctx context.Context, id uuid.UUID, upVotes int, downVotes int)) *ImproveSuggestionRepository_UpdateVotes_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
-	})
-	return _c
-}
-
-func (_c *ImproveSuggestionRepository_UpdateVotes_Call) Return(_a0 error) *ImproveSuggestionRepository_UpdateVotes_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *ImproveSuggestionRepository_UpdateVotes_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int) error) *ImproveSuggestionRepository_UpdateVotes_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// Validate provides a mock function with given fields: ctx, validated, id
-func (_m *ImproveSuggestionRepository) Validate(ctx context.Context, validated bool, id uuid.UUID) (*dao.ImproveSuggestionModel, error) {
-	ret := _m.Called(ctx, validated, id)
+	var _ca []interface{}
+	_ca = append(_ca, ctx, validated, id, now)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 *dao.ImproveSuggestionModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, bool, uuid.UUID) (*dao.ImproveSuggestionModel, error)); ok {
-		return rf(ctx, validated, id)
+	if rf, ok := ret.Get(0).(func(context.Context, bool, uuid.UUID, time.Time, ...*dao.EventModelCore) (*dao.ImproveSuggestionModel, error)); ok {
+		return rf(ctx, validated, id, now, events...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, bool, uuid.UUID) *dao.ImproveSuggestionModel); ok {
-		r0 = rf(ctx, validated, id)
+	if rf, ok := ret.Get(0).(func(context.Context, bool, uuid.UUID, time.Time, ...*dao.EventModelCore) *dao.ImproveSuggestionModel); ok {
+		r0 = rf(ctx, validated, id, now, events...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dao.ImproveSuggestionModel)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, bool, uuid.UUID) error); ok {
-		r1 = rf(ctx, validated, id)
+	if rf, ok := ret.Get(1).(func(context.Context, bool, uuid.UUID, time.Time, ...*dao.EventModelCore) error); ok {
+		r1 = rf(ctx, validated, id, now, events...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -439,13 +761,22 @@ type ImproveSuggestionRepository_Validate_Call struct {
 //   - ctx context.Context
 //   - validated bool
 //   - id uuid.UUID
-func (_e *ImproveSuggestionRepository_Expecter) Validate(ctx interface{}, validated interface{}, id interface{}) *ImproveSuggestionRepository_Validate_Call {
-	return &ImproveSuggestionRepository_Validate_Call{Call: _e.mock.On("Validate", ctx, validated, id)}
+//   - now time.Time
+//   - events ...*dao.EventModelCore
+func (_e *ImproveSuggestionRepository_Expecter) Validate(ctx interface{}, validated interface{}, id interface{}, now interface{}, events ...interface{}) *ImproveSuggestionRepository_Validate_Call {
+	return &ImproveSuggestionRepository_Validate_Call{Call: _e.mock.On("Validate",
+		append([]interface{}{ctx, validated, id, now}, events...)...)}
 }
 
-func (_c *ImproveSuggestionRepository_Validate_Call) Run(run func(ctx context.Context, validated bool, id uuid.UUID)) *ImproveSuggestionRepository_Validate_Call {
+func (_c *ImproveSuggestionRepository_Validate_Call) Run(run func(ctx context.Context, validated bool, id uuid.UUID, now time.Time, events ...*dao.EventModelCore)) *ImproveSuggestionRepository_Validate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(bool), args[2].(uuid.UUID))
+		variadicArgs := make([]*dao.EventModelCore, len(args)-4)
+		for i, a := range args[4:] {
+			if a != nil {
+				variadicArgs[i] = a.(*dao.EventModelCore)
+			}
+		}
+		run(args[0].(context.Context), args[1].(bool), args[2].(uuid.UUID), args[3].(time.Time), variadicArgs...)
 	})
 	return _c
 }
@@ -455,7 +786,7 @@ func (_c *ImproveSuggestionRepository_Validate_Call) Return(_a0 *dao.ImproveSugg
 	return _c
 }
 
-func (_c *ImproveSuggestionRepository_Validate_Call) RunAndReturn(run func(context.Context, bool, uuid.UUID) (*dao.ImproveSuggestionModel, error)) *ImproveSuggestionRepository_Validate_Call {
+func (_c *ImproveSuggestionRepository_Validate_Call) RunAndReturn(run func(context.Context, bool, uuid.UUID, time.Time, ...*dao.EventModelCore) (*dao.ImproveSuggestionModel, error)) *ImproveSuggestionRepository_Validate_Call {
 	_c.Call.Return(run)
 	return _c
 }
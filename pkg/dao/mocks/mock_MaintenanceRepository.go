@@ -0,0 +1,191 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/a-novel/forum-service/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MaintenanceRepository is an autogenerated mock type for the MaintenanceRepository type
+type MaintenanceRepository struct {
+	mock.Mock
+}
+
+type MaintenanceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MaintenanceRepository) EXPECT() *MaintenanceRepository_Expecter {
+	return &MaintenanceRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindOrphans provides a mock function with given fields: ctx
+func (_m *MaintenanceRepository) FindOrphans(ctx context.Context) (*dao.OrphansModel, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dao.OrphansModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dao.OrphansModel, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dao.OrphansModel); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.OrphansModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MaintenanceRepository_FindOrphans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindOrphans'
+type MaintenanceRepository_FindOrphans_Call struct {
+	*mock.Call
+}
+
+// FindOrphans is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MaintenanceRepository_Expecter) FindOrphans(ctx interface{}) *MaintenanceRepository_FindOrphans_Call {
+	return &MaintenanceRepository_FindOrphans_Call{Call: _e.mock.On("FindOrphans", ctx)}
+}
+
+func (_c *MaintenanceRepository_FindOrphans_Call) Run(run func(ctx context.Context)) *MaintenanceRepository_FindOrphans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MaintenanceRepository_FindOrphans_Call) Return(_a0 *dao.OrphansModel, _a1 error) *MaintenanceRepository_FindOrphans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MaintenanceRepository_FindOrphans_Call) RunAndReturn(run func(context.Context) (*dao.OrphansModel, error)) *MaintenanceRepository_FindOrphans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RepairOrphans provides a mock function with given fields: ctx, policy, now
+func (_m *MaintenanceRepository) RepairOrphans(ctx context.Context, policy dao.SuggestionOrphanPolicy, now time.Time) (*dao.OrphansModel, error) {
+	ret := _m.Called(ctx, policy, now)
+
+	var r0 *dao.OrphansModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dao.SuggestionOrphanPolicy, time.Time) (*dao.OrphansModel, error)); ok {
+		return rf(ctx, policy, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dao.SuggestionOrphanPolicy, time.Time) *dao.OrphansModel); ok {
+		r0 = rf(ctx, policy, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.OrphansModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dao.SuggestionOrphanPolicy, time.Time) error); ok {
+		r1 = rf(ctx, policy, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MaintenanceRepository_RepairOrphans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepairOrphans'
+type MaintenanceRepository_RepairOrphans_Call struct {
+	*mock.Call
+}
+
+// RepairOrphans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy dao.SuggestionOrphanPolicy
+//   - now time.Time
+func (_e *MaintenanceRepository_Expecter) RepairOrphans(ctx interface{}, policy interface{}, now interface{}) *MaintenanceRepository_RepairOrphans_Call {
+	return &MaintenanceRepository_RepairOrphans_Call{Call: _e.mock.On("RepairOrphans", ctx, policy, now)}
+}
+
+func (_c *MaintenanceRepository_RepairOrphans_Call) Run(run func(ctx context.Context, policy dao.SuggestionOrphanPolicy, now time.Time)) *MaintenanceRepository_RepairOrphans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dao.SuggestionOrphanPolicy), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MaintenanceRepository_RepairOrphans_Call) Return(_a0 *dao.OrphansModel, _a1 error) *MaintenanceRepository_RepairOrphans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MaintenanceRepository_RepairOrphans_Call) RunAndReturn(run func(context.Context, dao.SuggestionOrphanPolicy, time.Time) (*dao.OrphansModel, error)) *MaintenanceRepository_RepairOrphans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateConstraints provides a mock function with given fields: ctx
+func (_m *MaintenanceRepository) ValidateConstraints(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MaintenanceRepository_ValidateConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateConstraints'
+type MaintenanceRepository_ValidateConstraints_Call struct {
+	*mock.Call
+}
+
+// ValidateConstraints is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MaintenanceRepository_Expecter) ValidateConstraints(ctx interface{}) *MaintenanceRepository_ValidateConstraints_Call {
+	return &MaintenanceRepository_ValidateConstraints_Call{Call: _e.mock.On("ValidateConstraints", ctx)}
+}
+
+func (_c *MaintenanceRepository_ValidateConstraints_Call) Run(run func(ctx context.Context)) *MaintenanceRepository_ValidateConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MaintenanceRepository_ValidateConstraints_Call) Return(_a0 error) *MaintenanceRepository_ValidateConstraints_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MaintenanceRepository_ValidateConstraints_Call) RunAndReturn(run func(context.Context) error) *MaintenanceRepository_ValidateConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMaintenanceRepository creates a new instance of MaintenanceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMaintenanceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MaintenanceRepository {
+	mock := &MaintenanceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
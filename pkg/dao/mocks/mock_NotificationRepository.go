@@ -0,0 +1,315 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/a-novel/forum-service/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// NotificationRepository is an autogenerated mock type for the NotificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+type NotificationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NotificationRepository) EXPECT() *NotificationRepository_Expecter {
+	return &NotificationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountUnread provides a mock function with given fields: ctx, userID
+func (_m *NotificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_CountUnread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnread'
+type NotificationRepository_CountUnread_Call struct {
+	*mock.Call
+}
+
+// CountUnread is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *NotificationRepository_Expecter) CountUnread(ctx interface{}, userID interface{}) *NotificationRepository_CountUnread_Call {
+	return &NotificationRepository_CountUnread_Call{Call: _e.mock.On("CountUnread", ctx, userID)}
+}
+
+func (_c *NotificationRepository_CountUnread_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *NotificationRepository_CountUnread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_CountUnread_Call) Return(_a0 int, _a1 error) *NotificationRepository_CountUnread_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_CountUnread_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int, error)) *NotificationRepository_CountUnread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FanOut provides a mock function with given fields: ctx, eventID, event, createdAt
+func (_m *NotificationRepository) FanOut(ctx context.Context, eventID int64, event *dao.EventModelCore, createdAt time.Time) error {
+	ret := _m.Called(ctx, eventID, event, createdAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *dao.EventModelCore, time.Time) error); ok {
+		r0 = rf(ctx, eventID, event, createdAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationRepository_FanOut_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FanOut'
+type NotificationRepository_FanOut_Call struct {
+	*mock.Call
+}
+
+// FanOut is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID int64
+//   - event *dao.EventModelCore
+//   - createdAt time.Time
+func (_e *NotificationRepository_Expecter) FanOut(ctx interface{}, eventID interface{}, event interface{}, createdAt interface{}) *NotificationRepository_FanOut_Call {
+	return &NotificationRepository_FanOut_Call{Call: _e.mock.On("FanOut", ctx, eventID, event, createdAt)}
+}
+
+func (_c *NotificationRepository_FanOut_Call) Run(run func(ctx context.Context, eventID int64, event *dao.EventModelCore, createdAt time.Time)) *NotificationRepository_FanOut_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*dao.EventModelCore), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_FanOut_Call) Return(_a0 error) *NotificationRepository_FanOut_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationRepository_FanOut_Call) RunAndReturn(run func(context.Context, int64, *dao.EventModelCore, time.Time) error) *NotificationRepository_FanOut_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, userID, query, limit, offset
+func (_m *NotificationRepository) List(ctx context.Context, userID uuid.UUID, query dao.NotificationListQuery, limit int, offset int) ([]*dao.NotificationModel, int, error) {
+	ret := _m.Called(ctx, userID, query, limit, offset)
+
+	var r0 []*dao.NotificationModel
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dao.NotificationListQuery, int, int) ([]*dao.NotificationModel, int, error)); ok {
+		return rf(ctx, userID, query, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dao.NotificationListQuery, int, int) []*dao.NotificationModel); ok {
+		r0 = rf(ctx, userID, query, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.NotificationModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dao.NotificationListQuery, int, int) int); ok {
+		r1 = rf(ctx, userID, query, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, dao.NotificationListQuery, int, int) error); ok {
+		r2 = rf(ctx, userID, query, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NotificationRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type NotificationRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - query dao.NotificationListQuery
+//   - limit int
+//   - offset int
+func (_e *NotificationRepository_Expecter) List(ctx interface{}, userID interface{}, query interface{}, limit interface{}, offset interface{}) *NotificationRepository_List_Call {
+	return &NotificationRepository_List_Call{Call: _e.mock.On("List", ctx, userID, query, limit, offset)}
+}
+
+func (_c *NotificationRepository_List_Call) Run(run func(ctx context.Context, userID uuid.UUID, query dao.NotificationListQuery, limit int, offset int)) *NotificationRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dao.NotificationListQuery), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_List_Call) Return(_a0 []*dao.NotificationModel, _a1 int, _a2 error) *NotificationRepository_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *NotificationRepository_List_Call) RunAndReturn(run func(context.Context, uuid.UUID, dao.NotificationListQuery, int, int) ([]*dao.NotificationModel, int, error)) *NotificationRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAllRead provides a mock function with given fields: ctx, userID, now
+func (_m *NotificationRepository) MarkAllRead(ctx context.Context, userID uuid.UUID, now time.Time) (int, error) {
+	ret := _m.Called(ctx, userID, now)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (int, error)); ok {
+		return rf(ctx, userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) int); ok {
+		r0 = rf(ctx, userID, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_MarkAllRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllRead'
+type NotificationRepository_MarkAllRead_Call struct {
+	*mock.Call
+}
+
+// MarkAllRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - now time.Time
+func (_e *NotificationRepository_Expecter) MarkAllRead(ctx interface{}, userID interface{}, now interface{}) *NotificationRepository_MarkAllRead_Call {
+	return &NotificationRepository_MarkAllRead_Call{Call: _e.mock.On("MarkAllRead", ctx, userID, now)}
+}
+
+func (_c *NotificationRepository_MarkAllRead_Call) Run(run func(ctx context.Context, userID uuid.UUID, now time.Time)) *NotificationRepository_MarkAllRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_MarkAllRead_Call) Return(_a0 int, _a1 error) *NotificationRepository_MarkAllRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_MarkAllRead_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) (int, error)) *NotificationRepository_MarkAllRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRead provides a mock function with given fields: ctx, userID, id, now
+func (_m *NotificationRepository) MarkRead(ctx context.Context, userID uuid.UUID, id uuid.UUID, now time.Time) (*dao.NotificationModel, error) {
+	ret := _m.Called(ctx, userID, id, now)
+
+	var r0 *dao.NotificationModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*dao.NotificationModel, error)); ok {
+		return rf(ctx, userID, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) *dao.NotificationModel); ok {
+		r0 = rf(ctx, userID, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.NotificationModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, userID, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type NotificationRepository_MarkRead_Call struct {
+	*mock.Call
+}
+
+// MarkRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *NotificationRepository_Expecter) MarkRead(ctx interface{}, userID interface{}, id interface{}, now interface{}) *NotificationRepository_MarkRead_Call {
+	return &NotificationRepository_MarkRead_Call{Call: _e.mock.On("MarkRead", ctx, userID, id, now)}
+}
+
+func (_c *NotificationRepository_MarkRead_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID, now time.Time)) *NotificationRepository_MarkRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_MarkRead_Call) Return(_a0 *dao.NotificationModel, _a1 error) *NotificationRepository_MarkRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_MarkRead_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*dao.NotificationModel, error)) *NotificationRepository_MarkRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationRepository {
+	mock := &NotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// RateLimitRepository is an autogenerated mock type for the RateLimitRepository type
+type RateLimitRepository struct {
+	mock.Mock
+}
+
+type RateLimitRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RateLimitRepository) EXPECT() *RateLimitRepository_Expecter {
+	return &RateLimitRepository_Expecter{mock: &_m.Mock}
+}
+
+// Hit provides a mock function with given fields: ctx, userID, action, windowStart
+func (_m *RateLimitRepository) Hit(ctx context.Context, userID uuid.UUID, action string, windowStart time.Time) (int, error) {
+	ret := _m.Called(ctx, userID, action, windowStart)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time) (int, error)); ok {
+		return rf(ctx, userID, action, windowStart)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time) int); ok {
+		r0 = rf(ctx, userID, action, windowStart)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, time.Time) error); ok {
+		r1 = rf(ctx, userID, action, windowStart)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RateLimitRepository_Hit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hit'
+type RateLimitRepository_Hit_Call struct {
+	*mock.Call
+}
+
+// Hit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - action string
+//   - windowStart time.Time
+func (_e *RateLimitRepository_Expecter) Hit(ctx interface{}, userID interface{}, action interface{}, windowStart interface{}) *RateLimitRepository_Hit_Call {
+	return &RateLimitRepository_Hit_Call{Call: _e.mock.On("Hit", ctx, userID, action, windowStart)}
+}
+
+func (_c *RateLimitRepository_Hit_Call) Run(run func(ctx context.Context, userID uuid.UUID, action string, windowStart time.Time)) *RateLimitRepository_Hit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *RateLimitRepository_Hit_Call) Return(_a0 int, _a1 error) *RateLimitRepository_Hit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RateLimitRepository_Hit_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, time.Time) (int, error)) *RateLimitRepository_Hit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRateLimitRepository creates a new instance of RateLimitRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRateLimitRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RateLimitRepository {
+	mock := &RateLimitRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,333 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/a-novel/forum-service/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// ReportRepository is an autogenerated mock type for the ReportRepository type
+type ReportRepository struct {
+	mock.Mock
+}
+
+type ReportRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReportRepository) EXPECT() *ReportRepository_Expecter {
+	return &ReportRepository_Expecter{mock: &_m.Mock}
+}
+
+// Claim provides a mock function with given fields: ctx, moderatorID, id, now
+func (_m *ReportRepository) Claim(ctx context.Context, moderatorID uuid.UUID, id uuid.UUID, now time.Time) (*dao.ReportModel, error) {
+	ret := _m.Called(ctx, moderatorID, id, now)
+
+	var r0 *dao.ReportModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*dao.ReportModel, error)); ok {
+		return rf(ctx, moderatorID, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) *dao.ReportModel); ok {
+		r0 = rf(ctx, moderatorID, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ReportModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, moderatorID, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRepository_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type ReportRepository_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - ctx context.Context
+//   - moderatorID uuid.UUID
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *ReportRepository_Expecter) Claim(ctx interface{}, moderatorID interface{}, id interface{}, now interface{}) *ReportRepository_Claim_Call {
+	return &ReportRepository_Claim_Call{Call: _e.mock.On("Claim", ctx, moderatorID, id, now)}
+}
+
+func (_c *ReportRepository_Claim_Call) Run(run func(ctx context.Context, moderatorID uuid.UUID, id uuid.UUID, now time.Time)) *ReportRepository_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_Claim_Call) Return(_a0 *dao.ReportModel, _a1 error) *ReportRepository_Claim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportRepository_Claim_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*dao.ReportModel, error)) *ReportRepository_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, data, userID, id, now
+func (_m *ReportRepository) Create(ctx context.Context, data *dao.ReportModelCore, userID uuid.UUID, id uuid.UUID, now time.Time) (*dao.ReportModel, error) {
+	ret := _m.Called(ctx, data, userID, id, now)
+
+	var r0 *dao.ReportModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ReportModelCore, uuid.UUID, uuid.UUID, time.Time) (*dao.ReportModel, error)); ok {
+		return rf(ctx, data, userID, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ReportModelCore, uuid.UUID, uuid.UUID, time.Time) *dao.ReportModel); ok {
+		r0 = rf(ctx, data, userID, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ReportModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.ReportModelCore, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, data, userID, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ReportRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *dao.ReportModelCore
+//   - userID uuid.UUID
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *ReportRepository_Expecter) Create(ctx interface{}, data interface{}, userID interface{}, id interface{}, now interface{}) *ReportRepository_Create_Call {
+	return &ReportRepository_Create_Call{Call: _e.mock.On("Create", ctx, data, userID, id, now)}
+}
+
+func (_c *ReportRepository_Create_Call) Run(run func(ctx context.Context, data *dao.ReportModelCore, userID uuid.UUID, id uuid.UUID, now time.Time)) *ReportRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.ReportModelCore), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_Create_Call) Return(_a0 *dao.ReportModel, _a1 error) *ReportRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportRepository_Create_Call) RunAndReturn(run func(context.Context, *dao.ReportModelCore, uuid.UUID, uuid.UUID, time.Time) (*dao.ReportModel, error)) *ReportRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *ReportRepository) Get(ctx context.Context, id uuid.UUID) (*dao.ReportModel, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *dao.ReportModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dao.ReportModel, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dao.ReportModel); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ReportModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ReportRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ReportRepository_Expecter) Get(ctx interface{}, id interface{}) *ReportRepository_Get_Call {
+	return &ReportRepository_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *ReportRepository_Get_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ReportRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_Get_Call) Return(_a0 *dao.ReportModel, _a1 error) *ReportRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportRepository_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dao.ReportModel, error)) *ReportRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, query, limit, offset
+func (_m *ReportRepository) List(ctx context.Context, query dao.ReportListQuery, limit int, offset int) ([]*dao.ReportModel, int, error) {
+	ret := _m.Called(ctx, query, limit, offset)
+
+	var r0 []*dao.ReportModel
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, dao.ReportListQuery, int, int) ([]*dao.ReportModel, int, error)); ok {
+		return rf(ctx, query, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dao.ReportListQuery, int, int) []*dao.ReportModel); ok {
+		r0 = rf(ctx, query, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ReportModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dao.ReportListQuery, int, int) int); ok {
+		r1 = rf(ctx, query, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, dao.ReportListQuery, int, int) error); ok {
+		r2 = rf(ctx, query, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ReportRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ReportRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query dao.ReportListQuery
+//   - limit int
+//   - offset int
+func (_e *ReportRepository_Expecter) List(ctx interface{}, query interface{}, limit interface{}, offset interface{}) *ReportRepository_List_Call {
+	return &ReportRepository_List_Call{Call: _e.mock.On("List", ctx, query, limit, offset)}
+}
+
+func (_c *ReportRepository_List_Call) Run(run func(ctx context.Context, query dao.ReportListQuery, limit int, offset int)) *ReportRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dao.ReportListQuery), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_List_Call) Return(_a0 []*dao.ReportModel, _a1 int, _a2 error) *ReportRepository_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ReportRepository_List_Call) RunAndReturn(run func(context.Context, dao.ReportListQuery, int, int) ([]*dao.ReportModel, int, error)) *ReportRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resolve provides a mock function with given fields: ctx, moderatorID, action, id, now
+func (_m *ReportRepository) Resolve(ctx context.Context, moderatorID uuid.UUID, action dao.ReportAction, id uuid.UUID, now time.Time) (*dao.ReportModel, error) {
+	ret := _m.Called(ctx, moderatorID, action, id, now)
+
+	var r0 *dao.ReportModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dao.ReportAction, uuid.UUID, time.Time) (*dao.ReportModel, error)); ok {
+		return rf(ctx, moderatorID, action, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dao.ReportAction, uuid.UUID, time.Time) *dao.ReportModel); ok {
+		r0 = rf(ctx, moderatorID, action, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ReportModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dao.ReportAction, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, moderatorID, action, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRepository_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type ReportRepository_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - moderatorID uuid.UUID
+//   - action dao.ReportAction
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *ReportRepository_Expecter) Resolve(ctx interface{}, moderatorID interface{}, action interface{}, id interface{}, now interface{}) *ReportRepository_Resolve_Call {
+	return &ReportRepository_Resolve_Call{Call: _e.mock.On("Resolve", ctx, moderatorID, action, id, now)}
+}
+
+func (_c *ReportRepository_Resolve_Call) Run(run func(ctx context.Context, moderatorID uuid.UUID, action dao.ReportAction, id uuid.UUID, now time.Time)) *ReportRepository_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dao.ReportAction), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_Resolve_Call) Return(_a0 *dao.ReportModel, _a1 error) *ReportRepository_Resolve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportRepository_Resolve_Call) RunAndReturn(run func(context.Context, uuid.UUID, dao.ReportAction, uuid.UUID, time.Time) (*dao.ReportModel, error)) *ReportRepository_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReportRepository creates a new instance of ReportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportRepository {
+	mock := &ReportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,129 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// SubscriptionRepository is an autogenerated mock type for the SubscriptionRepository type
+type SubscriptionRepository struct {
+	mock.Mock
+}
+
+type SubscriptionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SubscriptionRepository) EXPECT() *SubscriptionRepository_Expecter {
+	return &SubscriptionRepository_Expecter{mock: &_m.Mock}
+}
+
+// Subscribe provides a mock function with given fields: ctx, userID, sourceID, now
+func (_m *SubscriptionRepository) Subscribe(ctx context.Context, userID uuid.UUID, sourceID uuid.UUID, now time.Time) error {
+	ret := _m.Called(ctx, userID, sourceID, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, userID, sourceID, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubscriptionRepository_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type SubscriptionRepository_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - sourceID uuid.UUID
+//   - now time.Time
+func (_e *SubscriptionRepository_Expecter) Subscribe(ctx interface{}, userID interface{}, sourceID interface{}, now interface{}) *SubscriptionRepository_Subscribe_Call {
+	return &SubscriptionRepository_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, userID, sourceID, now)}
+}
+
+func (_c *SubscriptionRepository_Subscribe_Call) Run(run func(ctx context.Context, userID uuid.UUID, sourceID uuid.UUID, now time.Time)) *SubscriptionRepository_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *SubscriptionRepository_Subscribe_Call) Return(_a0 error) *SubscriptionRepository_Subscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionRepository_Subscribe_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, time.Time) error) *SubscriptionRepository_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unsubscribe provides a mock function with given fields: ctx, userID, sourceID
+func (_m *SubscriptionRepository) Unsubscribe(ctx context.Context, userID uuid.UUID, sourceID uuid.UUID) error {
+	ret := _m.Called(ctx, userID, sourceID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID, sourceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubscriptionRepository_Unsubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unsubscribe'
+type SubscriptionRepository_Unsubscribe_Call struct {
+	*mock.Call
+}
+
+// Unsubscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - sourceID uuid.UUID
+func (_e *SubscriptionRepository_Expecter) Unsubscribe(ctx interface{}, userID interface{}, sourceID interface{}) *SubscriptionRepository_Unsubscribe_Call {
+	return &SubscriptionRepository_Unsubscribe_Call{Call: _e.mock.On("Unsubscribe", ctx, userID, sourceID)}
+}
+
+func (_c *SubscriptionRepository_Unsubscribe_Call) Run(run func(ctx context.Context, userID uuid.UUID, sourceID uuid.UUID)) *SubscriptionRepository_Unsubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SubscriptionRepository_Unsubscribe_Call) Return(_a0 error) *SubscriptionRepository_Unsubscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionRepository_Unsubscribe_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *SubscriptionRepository_Unsubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSubscriptionRepository creates a new instance of SubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubscriptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SubscriptionRepository {
+	mock := &SubscriptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
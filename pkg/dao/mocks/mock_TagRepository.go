@@ -0,0 +1,92 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/a-novel/forum-service/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagRepository is an autogenerated mock type for the TagRepository type
+type TagRepository struct {
+	mock.Mock
+}
+
+type TagRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagRepository) EXPECT() *TagRepository_Expecter {
+	return &TagRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListPopular provides a mock function with given fields: ctx, limit
+func (_m *TagRepository) ListPopular(ctx context.Context, limit int) ([]*dao.TagUsage, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 []*dao.TagUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*dao.TagUsage, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*dao.TagUsage); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.TagUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_ListPopular_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPopular'
+type TagRepository_ListPopular_Call struct {
+	*mock.Call
+}
+
+// ListPopular is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *TagRepository_Expecter) ListPopular(ctx interface{}, limit interface{}) *TagRepository_ListPopular_Call {
+	return &TagRepository_ListPopular_Call{Call: _e.mock.On("ListPopular", ctx, limit)}
+}
+
+func (_c *TagRepository_ListPopular_Call) Run(run func(ctx context.Context, limit int)) *TagRepository_ListPopular_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *TagRepository_ListPopular_Call) Return(_a0 []*dao.TagUsage, _a1 error) *TagRepository_ListPopular_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_ListPopular_Call) RunAndReturn(run func(context.Context, int) ([]*dao.TagUsage, error)) *TagRepository_ListPopular_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagRepository creates a new instance of TagRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagRepository {
+	mock := &TagRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
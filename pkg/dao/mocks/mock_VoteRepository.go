@@ -0,0 +1,145 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/a-novel/forum-service/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// VoteRepository is an autogenerated mock type for the VoteRepository type
+type VoteRepository struct {
+	mock.Mock
+}
+
+type VoteRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *VoteRepository) EXPECT() *VoteRepository_Expecter {
+	return &VoteRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListUserVotes provides a mock function with given fields: ctx, targetType, userID, targetIDs
+func (_m *VoteRepository) ListUserVotes(ctx context.Context, targetType dao.VoteTarget, userID uuid.UUID, targetIDs []uuid.UUID) ([]*dao.VoteModel, error) {
+	ret := _m.Called(ctx, targetType, userID, targetIDs)
+
+	var r0 []*dao.VoteModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dao.VoteTarget, uuid.UUID, []uuid.UUID) ([]*dao.VoteModel, error)); ok {
+		return rf(ctx, targetType, userID, targetIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dao.VoteTarget, uuid.UUID, []uuid.UUID) []*dao.VoteModel); ok {
+		r0 = rf(ctx, targetType, userID, targetIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.VoteModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dao.VoteTarget, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, targetType, userID, targetIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VoteRepository_ListUserVotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserVotes'
+type VoteRepository_ListUserVotes_Call struct {
+	*mock.Call
+}
+
+// ListUserVotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetType dao.VoteTarget
+//   - userID uuid.UUID
+//   - targetIDs []uuid.UUID
+func (_e *VoteRepository_Expecter) ListUserVotes(ctx interface{}, targetType interface{}, userID interface{}, targetIDs interface{}) *VoteRepository_ListUserVotes_Call {
+	return &VoteRepository_ListUserVotes_Call{Call: _e.mock.On("ListUserVotes", ctx, targetType, userID, targetIDs)}
+}
+
+func (_c *VoteRepository_ListUserVotes_Call) Run(run func(ctx context.Context, targetType dao.VoteTarget, userID uuid.UUID, targetIDs []uuid.UUID)) *VoteRepository_ListUserVotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dao.VoteTarget), args[2].(uuid.UUID), args[3].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *VoteRepository_ListUserVotes_Call) Return(_a0 []*dao.VoteModel, _a1 error) *VoteRepository_ListUserVotes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VoteRepository_ListUserVotes_Call) RunAndReturn(run func(context.Context, dao.VoteTarget, uuid.UUID, []uuid.UUID) ([]*dao.VoteModel, error)) *VoteRepository_ListUserVotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Vote provides a mock function with given fields: ctx, targetType, targetID, userID, vote, now
+func (_m *VoteRepository) Vote(ctx context.Context, targetType dao.VoteTarget, targetID uuid.UUID, userID uuid.UUID, vote int, now time.Time) error {
+	ret := _m.Called(ctx, targetType, targetID, userID, vote, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dao.VoteTarget, uuid.UUID, uuid.UUID, int, time.Time) error); ok {
+		r0 = rf(ctx, targetType, targetID, userID, vote, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VoteRepository_Vote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Vote'
+type VoteRepository_Vote_Call struct {
+	*mock.Call
+}
+
+// Vote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetType dao.VoteTarget
+//   - targetID uuid.UUID
+//   - userID uuid.UUID
+//   - vote int
+//   - now time.Time
+func (_e *VoteRepository_Expecter) Vote(ctx interface{}, targetType interface{}, targetID interface{}, userID interface{}, vote interface{}, now interface{}) *VoteRepository_Vote_Call {
+	return &VoteRepository_Vote_Call{Call: _e.mock.On("Vote", ctx, targetType, targetID, userID, vote, now)}
+}
+
+func (_c *VoteRepository_Vote_Call) Run(run func(ctx context.Context, targetType dao.VoteTarget, targetID uuid.UUID, userID uuid.UUID, vote int, now time.Time)) *VoteRepository_Vote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dao.VoteTarget), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(int), args[5].(time.Time))
+	})
+	return _c
+}
+
+func (_c *VoteRepository_Vote_Call) Return(_a0 error) *VoteRepository_Vote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VoteRepository_Vote_Call) RunAndReturn(run func(context.Context, dao.VoteTarget, uuid.UUID, uuid.UUID, int, time.Time) error) *VoteRepository_Vote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewVoteRepository creates a new instance of VoteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVoteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VoteRepository {
+	mock := &VoteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,191 @@
+package dao
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type NotificationRepository interface {
+	// List returns the notifications of a user, most recent first. Results must be paginated using the limit and
+	// offset parameters.
+	// It also returns the total number of available results, to help with pagination.
+	List(ctx context.Context, userID uuid.UUID, query NotificationListQuery, limit, offset int) ([]*NotificationModel, int, error)
+	// CountUnread returns the number of notifications a user has not read yet.
+	CountUnread(ctx context.Context, userID uuid.UUID) (int, error)
+	// MarkRead flags a notification of the user as read. A notification that belongs to another user is reported as
+	// not found.
+	MarkRead(ctx context.Context, userID, id uuid.UUID, now time.Time) (*NotificationModel, error)
+	// MarkAllRead flags every unread notification of the user as read, and returns how many were updated.
+	MarkAllRead(ctx context.Context, userID uuid.UUID, now time.Time) (int, error)
+
+	// FanOut creates a notification for every user concerned by an event. Users are never notified of their own
+	// actions, and fanning out the same event again does not duplicate notifications.
+	FanOut(ctx context.Context, eventID int64, event *EventModelCore, createdAt time.Time) error
+}
+
+type NotificationModel struct {
+	bun.BaseModel `bun:"table:notifications"`
+	bunovel.Metadata
+
+	// UserID is the ID of the user who receives the notification.
+	UserID uuid.UUID `bun:"user_id,type:uuid"`
+	// EventID is the ID of the event the notification was created from.
+	EventID int64     `bun:"event_id"`
+	Type    EventType `bun:"type"`
+	// ActorID is the ID of the user whose action triggered the notification.
+	ActorID uuid.UUID `bun:"actor_id,type:uuid"`
+	// TargetID is the ID of the object the notification is about: a revision for request events, a suggestion for
+	// suggestion events.
+	TargetID uuid.UUID `bun:"target_id,type:uuid"`
+	// SourceID is the ID of the improvement request the target belongs to.
+	SourceID uuid.UUID `bun:"source_id,type:uuid"`
+	// ReadAt is set once the user has read the notification.
+	ReadAt *time.Time `bun:"read_at"`
+}
+
+// NotificationListQuery allows to filter notifications.
+type NotificationListQuery struct {
+	// Unread only returns the notifications that have not been read yet.
+	Unread bool
+}
+
+// notificationRecipients returns, for each type of event, the query selecting the IDs of the users concerned by
+// the event. Events with no recipient query do not create notifications.
+var notificationRecipients = map[EventType]string{
+	// The creator of the revision the suggestion was posted on, and every user who follows the request.
+	EventTypeSuggestionCreated: `
+		SELECT improve_requests_revisions.user_id FROM improve_suggestions
+		JOIN improve_requests_revisions ON improve_requests_revisions.id = improve_suggestions.request_id
+		WHERE improve_suggestions.id = ?target_id
+		UNION
+		SELECT subscriptions.user_id FROM subscriptions
+		WHERE subscriptions.source_id = ?source_id`,
+	// The author of the suggestion.
+	EventTypeSuggestionValidated: `
+		SELECT improve_suggestions.user_id FROM improve_suggestions
+		WHERE improve_suggestions.id = ?target_id`,
+	// Every user who posted a suggestion on the request, and every user who follows it.
+	EventTypeRequestRevised: `
+		SELECT improve_suggestions.user_id FROM improve_suggestions
+		WHERE improve_suggestions.source_id = ?source_id
+		UNION
+		SELECT subscriptions.user_id FROM subscriptions
+		WHERE subscriptions.source_id = ?source_id`,
+}
+
+type notificationRepositoryImpl struct {
+	db bun.IDB
+}
+
+func NewNotificationRepository(db bun.IDB) NotificationRepository {
+	return &notificationRepositoryImpl{db: db}
+}
+
+func (repository *notificationRepositoryImpl) List(ctx context.Context, userID uuid.UUID, query NotificationListQuery, limit, offset int) ([]*NotificationModel, int, error) {
+	notifications := make([]*NotificationModel, 0)
+
+	queryBuilder := repository.db.NewSelect().
+		Model(&notifications).
+		Where("user_id = ?", userID).
+		Order("created_at DESC", "event_id DESC").
+		Limit(limit).
+		Offset(offset)
+
+	if query.Unread {
+		queryBuilder.Where("read_at IS NULL")
+	}
+
+	count, err := queryBuilder.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, bunovel.HandlePGError(err)
+	}
+
+	return notifications, count, nil
+}
+
+func (repository *notificationRepositoryImpl) CountUnread(ctx context.Context, userID uuid.UUID) (int, error) {
+	count, err := repository.db.NewSelect().
+		Model((*NotificationModel)(nil)).
+		Where("user_id = ?", userID).
+		Where("read_at IS NULL").
+		Count(ctx)
+	if err != nil {
+		return 0, bunovel.HandlePGError(err)
+	}
+
+	return count, nil
+}
+
+func (repository *notificationRepositoryImpl) MarkRead(ctx context.Context, userID, id uuid.UUID, now time.Time) (*NotificationModel, error) {
+	notification := &NotificationModel{Metadata: bunovel.Metadata{ID: id}}
+
+	// Reading a notification twice keeps the time it was first read.
+	err := repository.db.NewUpdate().
+		Model(notification).
+		Set("read_at = COALESCE(read_at, ?)", now).
+		Set("updated_at = ?", now).
+		WherePK().
+		Where("user_id = ?", userID).
+		Returning("*").
+		Scan(ctx)
+	if err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return notification, nil
+}
+
+func (repository *notificationRepositoryImpl) MarkAllRead(ctx context.Context, userID uuid.UUID, now time.Time) (int, error) {
+	res, err := repository.db.NewUpdate().
+		Model((*NotificationModel)(nil)).
+		Set("read_at = ?", now).
+		Set("updated_at = ?", now).
+		Where("user_id = ?", userID).
+		Where("read_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return 0, bunovel.HandlePGError(err)
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return 0, bunovel.HandlePGError(err)
+	}
+
+	return int(updated), nil
+}
+
+func (repository *notificationRepositoryImpl) FanOut(ctx context.Context, eventID int64, event *EventModelCore, createdAt time.Time) error {
+	recipients, ok := notificationRecipients[event.Type]
+	if !ok {
+		return nil
+	}
+
+	// The model provides the values of the named placeholders. Its user_id is not used: each recipient gets its own
+	// notification.
+	notification := &NotificationModel{
+		Metadata: bunovel.Metadata{CreatedAt: createdAt},
+		EventID:  eventID,
+		Type:     event.Type,
+		ActorID:  event.UserID,
+		TargetID: event.TargetID,
+		SourceID: event.SourceID,
+	}
+
+	_, err := repository.db.NewRaw(
+		`INSERT INTO notifications (created_at, user_id, event_id, type, actor_id, target_id, source_id)
+		SELECT ?created_at, recipients.user_id, ?event_id, ?type, ?actor_id, ?target_id, ?source_id
+		FROM (`+recipients+`) AS recipients
+		WHERE recipients.user_id <> ?actor_id
+		ON CONFLICT (event_id, user_id) DO NOTHING`,
+		notification,
+	).Exec(ctx)
+	if err != nil {
+		return bunovel.HandlePGError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,490 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"io/fs"
+	"testing"
+	"time"
+)
+
+func newNotificationFixture(id, userID uuid.UUID, eventID int64, createdAt time.Time, readAt *time.Time) *dao.NotificationModel {
+	return &dao.NotificationModel{
+		Metadata: bunovel.NewMetadata(id, createdAt, nil),
+		UserID:   userID,
+		EventID:  eventID,
+		Type:     dao.EventTypeSuggestionCreated,
+		ActorID:  goframework.NumberUUID(200),
+		TargetID: goframework.NumberUUID(20),
+		SourceID: goframework.NumberUUID(10),
+		ReadAt:   readAt,
+	}
+}
+
+func TestNotificationRepository_List(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		newNotificationFixture(goframework.NumberUUID(1), goframework.NumberUUID(100), 1, baseTime, &updateTime),
+		newNotificationFixture(goframework.NumberUUID(2), goframework.NumberUUID(100), 2, baseTime.Add(time.Hour), nil),
+		newNotificationFixture(goframework.NumberUUID(3), goframework.NumberUUID(100), 3, baseTime.Add(2*time.Hour), nil),
+		newNotificationFixture(goframework.NumberUUID(4), goframework.NumberUUID(300), 3, baseTime.Add(2*time.Hour), nil),
+	}
+
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+		query  dao.NotificationListQuery
+		limit  int
+		offset int
+
+		expect      []*dao.NotificationModel
+		expectCount int
+		expectErr   error
+	}{
+		{
+			name:   "Success",
+			userID: goframework.NumberUUID(100),
+			limit:  10,
+			expect: []*dao.NotificationModel{
+				newNotificationFixture(goframework.NumberUUID(3), goframework.NumberUUID(100), 3, baseTime.Add(2*time.Hour), nil),
+				newNotificationFixture(goframework.NumberUUID(2), goframework.NumberUUID(100), 2, baseTime.Add(time.Hour), nil),
+				newNotificationFixture(goframework.NumberUUID(1), goframework.NumberUUID(100), 1, baseTime, &updateTime),
+			},
+			expectCount: 3,
+		},
+		{
+			name:   "Success/Unread",
+			userID: goframework.NumberUUID(100),
+			query:  dao.NotificationListQuery{Unread: true},
+			limit:  10,
+			expect: []*dao.NotificationModel{
+				newNotificationFixture(goframework.NumberUUID(3), goframework.NumberUUID(100), 3, baseTime.Add(2*time.Hour), nil),
+				newNotificationFixture(goframework.NumberUUID(2), goframework.NumberUUID(100), 2, baseTime.Add(time.Hour), nil),
+			},
+			expectCount: 2,
+		},
+		{
+			name:   "Success/Paginated",
+			userID: goframework.NumberUUID(100),
+			limit:  1,
+			offset: 1,
+			expect: []*dao.NotificationModel{
+				newNotificationFixture(goframework.NumberUUID(2), goframework.NumberUUID(100), 2, baseTime.Add(time.Hour), nil),
+			},
+			expectCount: 3,
+		},
+		{
+			name:   "Success/NoResults",
+			userID: goframework.NumberUUID(400),
+			limit:  10,
+			expect: []*dao.NotificationModel{},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewNotificationRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, count, err := repository.List(ctx, d.userID, d.query, d.limit, d.offset)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+				require.Equal(t, d.expectCount, count)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestNotificationRepository_CountUnread(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		newNotificationFixture(goframework.NumberUUID(1), goframework.NumberUUID(100), 1, baseTime, &updateTime),
+		newNotificationFixture(goframework.NumberUUID(2), goframework.NumberUUID(100), 2, baseTime.Add(time.Hour), nil),
+		newNotificationFixture(goframework.NumberUUID(3), goframework.NumberUUID(100), 3, baseTime.Add(2*time.Hour), nil),
+		newNotificationFixture(goframework.NumberUUID(4), goframework.NumberUUID(300), 3, baseTime.Add(2*time.Hour), nil),
+	}
+
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			userID: goframework.NumberUUID(100),
+			expect: 2,
+		},
+		{
+			name:   "Success/OtherUser",
+			userID: goframework.NumberUUID(300),
+			expect: 1,
+		},
+		{
+			name:   "Success/NoResults",
+			userID: goframework.NumberUUID(400),
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewNotificationRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.CountUnread(ctx, d.userID)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestNotificationRepository_MarkRead(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		newNotificationFixture(goframework.NumberUUID(1), goframework.NumberUUID(100), 1, baseTime, &updateTime),
+		newNotificationFixture(goframework.NumberUUID(2), goframework.NumberUUID(100), 2, baseTime.Add(time.Hour), nil),
+	}
+
+	readTime := updateTime.Add(time.Hour)
+
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+		id     uuid.UUID
+		now    time.Time
+
+		expect    *dao.NotificationModel
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			userID: goframework.NumberUUID(100),
+			id:     goframework.NumberUUID(2),
+			now:    readTime,
+			expect: &dao.NotificationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Hour), &readTime),
+				UserID:   goframework.NumberUUID(100),
+				EventID:  2,
+				Type:     dao.EventTypeSuggestionCreated,
+				ActorID:  goframework.NumberUUID(200),
+				TargetID: goframework.NumberUUID(20),
+				SourceID: goframework.NumberUUID(10),
+				ReadAt:   &readTime,
+			},
+		},
+		{
+			name:   "Success/AlreadyRead",
+			userID: goframework.NumberUUID(100),
+			id:     goframework.NumberUUID(1),
+			now:    readTime,
+			expect: &dao.NotificationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &readTime),
+				UserID:   goframework.NumberUUID(100),
+				EventID:  1,
+				Type:     dao.EventTypeSuggestionCreated,
+				ActorID:  goframework.NumberUUID(200),
+				TargetID: goframework.NumberUUID(20),
+				SourceID: goframework.NumberUUID(10),
+				ReadAt:   &updateTime,
+			},
+		},
+		{
+			name:      "Error/OtherUser",
+			userID:    goframework.NumberUUID(300),
+			id:        goframework.NumberUUID(2),
+			now:       readTime,
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:      "Error/NotFound",
+			userID:    goframework.NumberUUID(100),
+			id:        goframework.NumberUUID(3),
+			now:       readTime,
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewNotificationRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.MarkRead(ctx, d.userID, d.id, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestNotificationRepository_MarkAllRead(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		newNotificationFixture(goframework.NumberUUID(1), goframework.NumberUUID(100), 1, baseTime, &updateTime),
+		newNotificationFixture(goframework.NumberUUID(2), goframework.NumberUUID(100), 2, baseTime.Add(time.Hour), nil),
+		newNotificationFixture(goframework.NumberUUID(3), goframework.NumberUUID(100), 3, baseTime.Add(2*time.Hour), nil),
+		newNotificationFixture(goframework.NumberUUID(4), goframework.NumberUUID(300), 3, baseTime.Add(2*time.Hour), nil),
+	}
+
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+		now    time.Time
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			userID: goframework.NumberUUID(100),
+			now:    updateTime.Add(time.Hour),
+			expect: 2,
+		},
+		{
+			name:   "Success/NoResults",
+			userID: goframework.NumberUUID(400),
+			now:    updateTime.Add(time.Hour),
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewNotificationRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.MarkAllRead(ctx, d.userID, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+
+				if err == nil {
+					unread, err := repository.CountUnread(ctx, d.userID)
+					require.NoError(t, err)
+					require.Zero(t, unread)
+				}
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestNotificationRepository_FanOut(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(1),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+			SourceID: goframework.NumberUUID(1),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime, nil),
+			SourceID: goframework.NumberUUID(1),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(22), baseTime, nil),
+			SourceID: goframework.NumberUUID(1),
+			UserID:   goframework.NumberUUID(300),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		// A contributor who stopped following the request.
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(23), baseTime, nil),
+			SourceID: goframework.NumberUUID(1),
+			UserID:   goframework.NumberUUID(600),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		&dao.SubscriptionModel{UserID: goframework.NumberUUID(100), SourceID: goframework.NumberUUID(1), CreatedAt: baseTime},
+		&dao.SubscriptionModel{UserID: goframework.NumberUUID(200), SourceID: goframework.NumberUUID(1), CreatedAt: baseTime},
+		&dao.SubscriptionModel{UserID: goframework.NumberUUID(300), SourceID: goframework.NumberUUID(1), CreatedAt: baseTime},
+		// A follower who never posted on the request.
+		&dao.SubscriptionModel{UserID: goframework.NumberUUID(400), SourceID: goframework.NumberUUID(1), CreatedAt: baseTime},
+		// A follower of another request.
+		&dao.SubscriptionModel{UserID: goframework.NumberUUID(500), SourceID: goframework.NumberUUID(2), CreatedAt: baseTime},
+	}
+
+	data := []struct {
+		name string
+
+		eventID int64
+		event   *dao.EventModelCore
+
+		// expect maps the ID of each user to the number of notifications they should have received.
+		expect    map[uuid.UUID]int
+		expectErr error
+	}{
+		{
+			name:    "Success/SuggestionCreated",
+			eventID: 1,
+			event: &dao.EventModelCore{
+				Type:     dao.EventTypeSuggestionCreated,
+				UserID:   goframework.NumberUUID(200),
+				TargetID: goframework.NumberUUID(20),
+				SourceID: goframework.NumberUUID(1),
+			},
+			expect: map[uuid.UUID]int{
+				goframework.NumberUUID(100): 1,
+				goframework.NumberUUID(200): 0,
+				goframework.NumberUUID(300): 1,
+				goframework.NumberUUID(400): 1,
+				goframework.NumberUUID(500): 0,
+				goframework.NumberUUID(600): 0,
+			},
+		},
+		{
+			name:    "Success/SuggestionValidated",
+			eventID: 1,
+			event: &dao.EventModelCore{
+				Type:     dao.EventTypeSuggestionValidated,
+				UserID:   goframework.NumberUUID(100),
+				TargetID: goframework.NumberUUID(20),
+				SourceID: goframework.NumberUUID(1),
+			},
+			expect: map[uuid.UUID]int{
+				goframework.NumberUUID(100): 0,
+				goframework.NumberUUID(200): 1,
+				goframework.NumberUUID(300): 0,
+				goframework.NumberUUID(400): 0,
+				goframework.NumberUUID(500): 0,
+				goframework.NumberUUID(600): 0,
+			},
+		},
+		{
+			name:    "Success/RequestRevised",
+			eventID: 1,
+			event: &dao.EventModelCore{
+				Type:     dao.EventTypeRequestRevised,
+				UserID:   goframework.NumberUUID(100),
+				TargetID: goframework.NumberUUID(2),
+				SourceID: goframework.NumberUUID(1),
+			},
+			expect: map[uuid.UUID]int{
+				goframework.NumberUUID(100): 0,
+				goframework.NumberUUID(200): 1,
+				goframework.NumberUUID(300): 1,
+				goframework.NumberUUID(400): 1,
+				goframework.NumberUUID(500): 0,
+				goframework.NumberUUID(600): 1,
+			},
+		},
+		{
+			name:    "Success/RequestRevisedByContributor",
+			eventID: 1,
+			event: &dao.EventModelCore{
+				Type:     dao.EventTypeRequestRevised,
+				UserID:   goframework.NumberUUID(300),
+				TargetID: goframework.NumberUUID(2),
+				SourceID: goframework.NumberUUID(1),
+			},
+			expect: map[uuid.UUID]int{
+				goframework.NumberUUID(100): 1,
+				goframework.NumberUUID(200): 1,
+				goframework.NumberUUID(300): 0,
+				goframework.NumberUUID(400): 1,
+				goframework.NumberUUID(500): 0,
+				goframework.NumberUUID(600): 1,
+			},
+		},
+		{
+			name:    "Success/RequestCreated",
+			eventID: 1,
+			event: &dao.EventModelCore{
+				Type:     dao.EventTypeRequestCreated,
+				UserID:   goframework.NumberUUID(100),
+				TargetID: goframework.NumberUUID(1),
+				SourceID: goframework.NumberUUID(1),
+			},
+			expect: map[uuid.UUID]int{
+				goframework.NumberUUID(100): 0,
+				goframework.NumberUUID(200): 0,
+				goframework.NumberUUID(300): 0,
+				goframework.NumberUUID(400): 0,
+				goframework.NumberUUID(500): 0,
+				goframework.NumberUUID(600): 0,
+			},
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewNotificationRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				require.ErrorIs(t, repository.FanOut(ctx, d.eventID, d.event, updateTime), d.expectErr)
+				// Delivering the same event twice must not duplicate notifications.
+				require.ErrorIs(t, repository.FanOut(ctx, d.eventID, d.event, updateTime), d.expectErr)
+
+				for userID, expectCount := range d.expect {
+					res, count, err := repository.List(ctx, userID, dao.NotificationListQuery{}, 10, 0)
+					require.NoError(t, err)
+					require.Equal(t, expectCount, count)
+
+					for _, notification := range res {
+						require.Equal(t, d.eventID, notification.EventID)
+						require.Equal(t, d.event.Type, notification.Type)
+						require.Equal(t, d.event.UserID, notification.ActorID)
+						require.Equal(t, d.event.TargetID, notification.TargetID)
+						require.Equal(t, d.event.SourceID, notification.SourceID)
+						require.True(t, notification.CreatedAt.Equal(updateTime))
+						require.Nil(t, notification.ReadAt)
+					}
+				}
+			})
+		})
+		require.NoError(t, err)
+	}
+}
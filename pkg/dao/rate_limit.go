@@ -0,0 +1,71 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/bunovel"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type RateLimitRepository interface {
+	// Hit records an action of a user in the window starting at the given date, and returns the number of times the
+	// action was performed in this window, this one included. Older windows of the action are discarded.
+	Hit(ctx context.Context, userID uuid.UUID, action string, windowStart time.Time) (int, error)
+}
+
+type RateLimitModel struct {
+	bun.BaseModel `bun:"table:rate_limits"`
+
+	UserID      uuid.UUID `bun:"user_id,pk,type:uuid"`
+	Action      string    `bun:"action,pk"`
+	WindowStart time.Time `bun:"window_start,pk"`
+	// Hits is the number of times the user performed the action, since the start of the window.
+	Hits int `bun:"hits"`
+}
+
+type rateLimitRepositoryImpl struct {
+	db bun.IDB
+}
+
+func NewRateLimitRepository(db bun.IDB) RateLimitRepository {
+	return &rateLimitRepositoryImpl{db: db}
+}
+
+func (repository *rateLimitRepositoryImpl) Hit(ctx context.Context, userID uuid.UUID, action string, windowStart time.Time) (int, error) {
+	model := &RateLimitModel{
+		UserID:      userID,
+		Action:      action,
+		WindowStart: windowStart,
+		Hits:        1,
+	}
+
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().
+			Model((*RateLimitModel)(nil)).
+			Where("user_id = ?", userID).
+			Where("action = ?", action).
+			Where("window_start < ?", windowStart).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to discard expired rate limit windows: %w", err)
+		}
+
+		err = tx.NewInsert().
+			Model(model).
+			On("CONFLICT (user_id, action, window_start) DO UPDATE").
+			Set("hits = ?TableAlias.hits + 1").
+			Returning("hits").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to record rate limit hit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return 0, bunovel.HandlePGError(err)
+	}
+
+	return model.Hits, nil
+}
@@ -0,0 +1,90 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"io/fs"
+	"testing"
+	"time"
+)
+
+func TestRateLimitRepository_Hit(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.RateLimitModel{
+			UserID:      goframework.NumberUUID(100),
+			Action:      "improve_request.create",
+			WindowStart: baseTime,
+			Hits:        3,
+		},
+		// Another action of the same user.
+		&dao.RateLimitModel{
+			UserID:      goframework.NumberUUID(100),
+			Action:      "improve_suggestion.create",
+			WindowStart: baseTime,
+			Hits:        5,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		userID      uuid.UUID
+		action      string
+		windowStart time.Time
+
+		expect        int
+		expectWindows int
+		expectErr     error
+	}{
+		{
+			name:          "Success/CurrentWindow",
+			userID:        goframework.NumberUUID(100),
+			action:        "improve_request.create",
+			windowStart:   baseTime,
+			expect:        4,
+			expectWindows: 2,
+		},
+		{
+			name:          "Success/NewWindow",
+			userID:        goframework.NumberUUID(100),
+			action:        "improve_request.create",
+			windowStart:   updateTime,
+			expect:        1,
+			expectWindows: 2,
+		},
+		{
+			name:          "Success/OtherUser",
+			userID:        goframework.NumberUUID(200),
+			action:        "improve_request.create",
+			windowStart:   baseTime,
+			expect:        1,
+			expectWindows: 3,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewRateLimitRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Hit(ctx, d.userID, d.action, d.windowStart)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+
+				windows, err := tx.NewSelect().Model((*dao.RateLimitModel)(nil)).Count(ctx)
+				require.NoError(t, err)
+				require.Equal(t, d.expectWindows, windows)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,330 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/a-novel/bunovel"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type ReportRepository interface {
+	// Get returns the report with the given ID.
+	Get(ctx context.Context, id uuid.UUID) (*ReportModel, error)
+	// Create files a new report against a piece of content.
+	Create(ctx context.Context, data *ReportModelCore, userID, id uuid.UUID, now time.Time) (*ReportModel, error)
+	// List returns the reports in the review queue, oldest first. Results must be paginated using the limit and
+	// offset parameters.
+	// It also returns the total number of available results, to help with pagination.
+	List(ctx context.Context, query ReportListQuery, limit, offset int) ([]*ReportModel, int, error)
+	// Claim assigns an open report to a moderator. A report that is no longer open is reported as not found.
+	Claim(ctx context.Context, moderatorID, id uuid.UUID, now time.Time) (*ReportModel, error)
+	// Resolve closes a report, and applies the moderation action to the reported content. When the content is
+	// hidden or deleted, every other pending report on the same content is resolved along the way. A report that is
+	// already resolved is reported as not found.
+	Resolve(ctx context.Context, moderatorID uuid.UUID, action ReportAction, id uuid.UUID, now time.Time) (*ReportModel, error)
+}
+
+// ReportTarget is the type of content a report is filed against.
+type ReportTarget string
+
+const (
+	ReportTargetImproveRequestRevision ReportTarget = "improve_request_revision"
+	ReportTargetImproveSuggestion      ReportTarget = "improve_suggestion"
+	ReportTargetComment                ReportTarget = "comment"
+)
+
+// ReportReason is the category of abuse a report is about.
+type ReportReason string
+
+const (
+	ReportReasonSpam       ReportReason = "spam"
+	ReportReasonAbuse      ReportReason = "abuse"
+	ReportReasonPlagiarism ReportReason = "plagiarism"
+	ReportReasonOther      ReportReason = "other"
+	// ReportReasonDuplicate is only used by the reports filed automatically, against content that resembles a recent
+	// post of the same author.
+	ReportReasonDuplicate ReportReason = "duplicate"
+)
+
+// ReportSystemUserID is the author of the reports filed automatically by the service.
+var ReportSystemUserID = uuid.Nil
+
+// ReportStatus is the position of a report in the review queue.
+type ReportStatus string
+
+const (
+	ReportStatusOpen     ReportStatus = "open"
+	ReportStatusClaimed  ReportStatus = "claimed"
+	ReportStatusResolved ReportStatus = "resolved"
+)
+
+// ReportAction is the decision a moderator takes when resolving a report.
+type ReportAction string
+
+const (
+	// ReportActionDismiss closes the report, and leaves the content untouched.
+	ReportActionDismiss ReportAction = "dismiss"
+	// ReportActionHide keeps the content in the database, but leaves it out of every read.
+	ReportActionHide ReportAction = "hide"
+	// ReportActionDelete deletes the content. Revisions and suggestions are soft deleted, and purged once their
+	// retention period is over, while comments are deleted right away.
+	ReportActionDelete ReportAction = "delete"
+)
+
+// reportTargetTables maps each type of reportable content to the table it is stored in.
+var reportTargetTables = map[ReportTarget]string{
+	ReportTargetImproveRequestRevision: "improve_requests_revisions",
+	ReportTargetImproveSuggestion:      "improve_suggestions",
+	ReportTargetComment:                "comments",
+}
+
+type ReportModel struct {
+	bun.BaseModel `bun:"table:reports"`
+	bunovel.Metadata
+
+	// UserID is the ID of the user who filed the report.
+	UserID uuid.UUID `bun:"user_id,type:uuid"`
+
+	Status ReportStatus `bun:"status"`
+	// ModeratorID is the ID of the moderator who claimed or resolved the report.
+	ModeratorID *uuid.UUID `bun:"moderator_id,type:uuid"`
+	ClaimedAt   *time.Time `bun:"claimed_at"`
+	ResolvedAt  *time.Time `bun:"resolved_at"`
+	// Action is the decision taken by the moderator. It is only set once the report is resolved.
+	Action ReportAction `bun:"action,nullzero"`
+
+	ReportModelCore
+}
+
+type ReportModelCore struct {
+	// TargetType is the type of the reported content.
+	TargetType ReportTarget `bun:"target_type"`
+	// TargetID is the ID of the reported content.
+	TargetID uuid.UUID `bun:"target_id,type:uuid"`
+	// Reason is the category of abuse the content is reported for.
+	Reason ReportReason `bun:"reason"`
+	// Content is an optional text, to give moderators more details.
+	Content string `bun:"content"`
+}
+
+// ReportListQuery allows to filter reports.
+type ReportListQuery struct {
+	// Status is an optional parameter, to only list reports in a given state.
+	Status *ReportStatus
+	// TargetType is an optional parameter, to only list reports on a given type of content.
+	TargetType *ReportTarget
+	// ModeratorID is an optional parameter, to only list reports claimed or resolved by a given moderator.
+	ModeratorID *uuid.UUID
+}
+
+type reportRepositoryImpl struct {
+	db bun.IDB
+}
+
+func NewReportRepository(db bun.IDB) ReportRepository {
+	return &reportRepositoryImpl{db: db}
+}
+
+func (repository *reportRepositoryImpl) Get(ctx context.Context, id uuid.UUID) (*ReportModel, error) {
+	report := &ReportModel{Metadata: bunovel.Metadata{ID: id}}
+	if err := repository.db.NewSelect().Model(report).WherePK().Scan(ctx); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return report, nil
+}
+
+func (repository *reportRepositoryImpl) Create(ctx context.Context, data *ReportModelCore, userID, id uuid.UUID, now time.Time) (*ReportModel, error) {
+	report := &ReportModel{
+		Metadata:        bunovel.NewMetadata(id, now, nil),
+		UserID:          userID,
+		Status:          ReportStatusOpen,
+		ReportModelCore: *data,
+	}
+
+	if err := repository.db.NewInsert().Model(report).Returning("*").Scan(ctx); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return report, nil
+}
+
+func (repository *reportRepositoryImpl) List(ctx context.Context, query ReportListQuery, limit, offset int) ([]*ReportModel, int, error) {
+	reports := make([]*ReportModel, 0)
+
+	queryBuilder := repository.db.NewSelect().
+		Model(&reports).
+		Order("created_at ASC", "id ASC").
+		Limit(limit).
+		Offset(offset)
+
+	if query.Status != nil {
+		queryBuilder.Where("status = ?", *query.Status)
+	}
+
+	if query.TargetType != nil {
+		queryBuilder.Where("target_type = ?", *query.TargetType)
+	}
+
+	if query.ModeratorID != nil {
+		queryBuilder.Where("moderator_id = ?", *query.ModeratorID)
+	}
+
+	count, err := queryBuilder.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, bunovel.HandlePGError(err)
+	}
+
+	return reports, count, nil
+}
+
+func (repository *reportRepositoryImpl) Claim(ctx context.Context, moderatorID, id uuid.UUID, now time.Time) (*ReportModel, error) {
+	report := &ReportModel{
+		Metadata:    bunovel.Metadata{ID: id, UpdatedAt: &now},
+		Status:      ReportStatusClaimed,
+		ModeratorID: &moderatorID,
+		ClaimedAt:   &now,
+	}
+
+	err := repository.db.NewUpdate().
+		Model(report).
+		Column("updated_at", "status", "moderator_id", "claimed_at").
+		WherePK().
+		Where("status = ?", ReportStatusOpen).
+		Returning("*").
+		Scan(ctx)
+	if err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return report, nil
+}
+
+func (repository *reportRepositoryImpl) Resolve(ctx context.Context, moderatorID uuid.UUID, action ReportAction, id uuid.UUID, now time.Time) (*ReportModel, error) {
+	report := &ReportModel{
+		Metadata:    bunovel.Metadata{ID: id, UpdatedAt: &now},
+		Status:      ReportStatusResolved,
+		ModeratorID: &moderatorID,
+		ResolvedAt:  &now,
+		Action:      action,
+	}
+
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewUpdate().
+			Model(report).
+			Column("updated_at", "status", "moderator_id", "resolved_at", "action").
+			WherePK().
+			Where("status <> ?", ReportStatusResolved).
+			Returning("*").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		if action == ReportActionDismiss {
+			return nil
+		}
+
+		switch action {
+		case ReportActionHide:
+			table, ok := reportTargetTables[report.TargetType]
+			if !ok {
+				return fmt.Errorf("unknown report target type %q", report.TargetType)
+			}
+
+			_, err = tx.NewUpdate().
+				Table(table).
+				Set("hidden = TRUE").
+				Where("id = ?", report.TargetID).
+				Exec(ctx)
+		case ReportActionDelete:
+			err = deleteReportedContent(ctx, tx, report.TargetType, report.TargetID, now)
+		default:
+			return fmt.Errorf("unknown report action %q", action)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to moderate reported content: %w", err)
+		}
+
+		// The content is gone, so the other reports on it are settled by the same decision.
+		_, err = tx.NewUpdate().
+			Model((*ReportModel)(nil)).
+			Set("updated_at = ?", now).
+			Set("status = ?", ReportStatusResolved).
+			Set("moderator_id = ?", moderatorID).
+			Set("resolved_at = ?", now).
+			Set("action = ?", action).
+			Where("target_type = ?", report.TargetType).
+			Where("target_id = ?", report.TargetID).
+			Where("status <> ?", ReportStatusResolved).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to resolve related reports: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return report, nil
+}
+
+// deleteReportedContent deletes reported content the same way its author would. Revisions and suggestions are soft
+// deleted, so they follow the retention policy like any other deleted content. The suggestions other users made on a
+// deleted revision are not theirs to lose, so they are reattached to the nearest surviving revision. When the revision
+// is the last one of its request, the whole request is deleted instead.
+func deleteReportedContent(ctx context.Context, tx bun.IDB, targetType ReportTarget, targetID uuid.UUID, now time.Time) error {
+	switch targetType {
+	case ReportTargetImproveRequestRevision:
+		revision := &ImproveRequestRevisionModel{Metadata: bunovel.Metadata{ID: targetID}}
+		err := tx.NewSelect().Model(revision).WherePK().Where("deleted_at IS NULL").Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			// Nothing left to delete.
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get improve request revision: %w", err)
+		}
+
+		surviving, err := tx.NewSelect().
+			Model((*ImproveRequestRevisionModel)(nil)).
+			Where("source_id = ?", revision.SourceID).
+			Where("id <> ?", targetID).
+			Where("deleted_at IS NULL").
+			Count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count improve request revisions: %w", err)
+		}
+
+		requestRepository := NewImproveRequestRepository(tx)
+		if surviving == 0 {
+			return requestRepository.Delete(ctx, revision.SourceID, now)
+		}
+
+		return requestRepository.DeleteRevision(ctx, targetID, SuggestionOrphanPolicyReattach, now)
+	case ReportTargetImproveSuggestion:
+		return NewImproveSuggestionRepository(tx).Delete(ctx, targetID, now)
+	case ReportTargetComment:
+		return NewCommentRepository(tx).Delete(ctx, targetID)
+	default:
+		return fmt.Errorf("unknown report target type %q", targetType)
+	}
+}
+
+// insertReport files a report in the transaction of the content it flags, so the content is never posted unflagged.
+func insertReport(ctx context.Context, tx bun.IDB, report *ReportModel) error {
+	if report == nil {
+		return nil
+	}
+
+	if _, err := tx.NewInsert().Model(report).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,734 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"io/fs"
+	"testing"
+	"time"
+)
+
+func TestReportRepository_Get(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ReportModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			Status:   dao.ReportStatusOpen,
+			ReportModelCore: dao.ReportModelCore{
+				TargetType: dao.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     dao.ReportReasonSpam,
+				Content:    "content",
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expect    *dao.ReportModel
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   goframework.NumberUUID(1),
+			expect: &dao.ReportModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				Status:   dao.ReportStatusOpen,
+				ReportModelCore: dao.ReportModelCore{
+					TargetType: dao.ReportTargetComment,
+					TargetID:   goframework.NumberUUID(10),
+					Reason:     dao.ReportReasonSpam,
+					Content:    "content",
+				},
+			},
+		},
+		{
+			name:      "Error/NotFound",
+			id:        goframework.NumberUUID(2),
+			expectErr: bunovel.ErrNotFound,
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewReportRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Get(ctx, d.id)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestReportRepository_Create(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		data   *dao.ReportModelCore
+		userID uuid.UUID
+		id     uuid.UUID
+		now    time.Time
+
+		expect    *dao.ReportModel
+		expectErr error
+	}{
+		{
+			name: "Success",
+			data: &dao.ReportModelCore{
+				TargetType: dao.ReportTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     dao.ReportReasonPlagiarism,
+				Content:    "content",
+			},
+			userID: goframework.NumberUUID(100),
+			id:     goframework.NumberUUID(1),
+			now:    baseTime,
+			expect: &dao.ReportModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				Status:   dao.ReportStatusOpen,
+				ReportModelCore: dao.ReportModelCore{
+					TargetType: dao.ReportTargetImproveSuggestion,
+					TargetID:   goframework.NumberUUID(10),
+					Reason:     dao.ReportReasonPlagiarism,
+					Content:    "content",
+				},
+			},
+		},
+		{
+			name: "Success/NoContent",
+			data: &dao.ReportModelCore{
+				TargetType: dao.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     dao.ReportReasonSpam,
+			},
+			userID: goframework.NumberUUID(100),
+			id:     goframework.NumberUUID(1),
+			now:    baseTime,
+			expect: &dao.ReportModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				Status:   dao.ReportStatusOpen,
+				ReportModelCore: dao.ReportModelCore{
+					TargetType: dao.ReportTargetComment,
+					TargetID:   goframework.NumberUUID(10),
+					Reason:     dao.ReportReasonSpam,
+				},
+			},
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, nil, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewReportRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Create(ctx, d.data, d.userID, d.id, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestReportRepository_List(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ReportModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			Status:   dao.ReportStatusOpen,
+			ReportModelCore: dao.ReportModelCore{
+				TargetType: dao.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     dao.ReportReasonSpam,
+			},
+		},
+		&dao.ReportModel{
+			Metadata:    bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Minute), &updateTime),
+			UserID:      goframework.NumberUUID(101),
+			Status:      dao.ReportStatusClaimed,
+			ModeratorID: lo.ToPtr(goframework.NumberUUID(200)),
+			ClaimedAt:   &updateTime,
+			ReportModelCore: dao.ReportModelCore{
+				TargetType: dao.ReportTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(20),
+				Reason:     dao.ReportReasonAbuse,
+			},
+		},
+		&dao.ReportModel{
+			Metadata:    bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Minute), &updateTime),
+			UserID:      goframework.NumberUUID(102),
+			Status:      dao.ReportStatusResolved,
+			ModeratorID: lo.ToPtr(goframework.NumberUUID(200)),
+			ResolvedAt:  &updateTime,
+			Action:      dao.ReportActionDismiss,
+			ReportModelCore: dao.ReportModelCore{
+				TargetType: dao.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(30),
+				Reason:     dao.ReportReasonOther,
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		query  dao.ReportListQuery
+		limit  int
+		offset int
+
+		expect      []*dao.ReportModel
+		expectTotal int
+		expectErr   error
+	}{
+		{
+			name:  "Success",
+			limit: 10,
+			expect: []*dao.ReportModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+					UserID:   goframework.NumberUUID(100),
+					Status:   dao.ReportStatusOpen,
+					ReportModelCore: dao.ReportModelCore{
+						TargetType: dao.ReportTargetComment,
+						TargetID:   goframework.NumberUUID(10),
+						Reason:     dao.ReportReasonSpam,
+					},
+				},
+				{
+					Metadata:    bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Minute), &updateTime),
+					UserID:      goframework.NumberUUID(101),
+					Status:      dao.ReportStatusClaimed,
+					ModeratorID: lo.ToPtr(goframework.NumberUUID(200)),
+					ClaimedAt:   &updateTime,
+					ReportModelCore: dao.ReportModelCore{
+						TargetType: dao.ReportTargetImproveSuggestion,
+						TargetID:   goframework.NumberUUID(20),
+						Reason:     dao.ReportReasonAbuse,
+					},
+				},
+				{
+					Metadata:    bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Minute), &updateTime),
+					UserID:      goframework.NumberUUID(102),
+					Status:      dao.ReportStatusResolved,
+					ModeratorID: lo.ToPtr(goframework.NumberUUID(200)),
+					ResolvedAt:  &updateTime,
+					Action:      dao.ReportActionDismiss,
+					ReportModelCore: dao.ReportModelCore{
+						TargetType: dao.ReportTargetComment,
+						TargetID:   goframework.NumberUUID(30),
+						Reason:     dao.ReportReasonOther,
+					},
+				},
+			},
+			expectTotal: 3,
+		},
+		{
+			name:  "Success/Paginated",
+			limit: 1,
+			// The oldest report comes first.
+			offset: 1,
+			expect: []*dao.ReportModel{
+				{
+					Metadata:    bunovel.NewMetadata(goframework.NumberUUID(2), baseTime.Add(time.Minute), &updateTime),
+					UserID:      goframework.NumberUUID(101),
+					Status:      dao.ReportStatusClaimed,
+					ModeratorID: lo.ToPtr(goframework.NumberUUID(200)),
+					ClaimedAt:   &updateTime,
+					ReportModelCore: dao.ReportModelCore{
+						TargetType: dao.ReportTargetImproveSuggestion,
+						TargetID:   goframework.NumberUUID(20),
+						Reason:     dao.ReportReasonAbuse,
+					},
+				},
+			},
+			expectTotal: 3,
+		},
+		{
+			name:  "Success/ByStatus",
+			query: dao.ReportListQuery{Status: lo.ToPtr(dao.ReportStatusOpen)},
+			limit: 10,
+			expect: []*dao.ReportModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+					UserID:   goframework.NumberUUID(100),
+					Status:   dao.ReportStatusOpen,
+					ReportModelCore: dao.ReportModelCore{
+						TargetType: dao.ReportTargetComment,
+						TargetID:   goframework.NumberUUID(10),
+						Reason:     dao.ReportReasonSpam,
+					},
+				},
+			},
+			expectTotal: 1,
+		},
+		{
+			name: "Success/ByTargetTypeAndModerator",
+			query: dao.ReportListQuery{
+				TargetType:  lo.ToPtr(dao.ReportTargetComment),
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(200)),
+			},
+			limit: 10,
+			expect: []*dao.ReportModel{
+				{
+					Metadata:    bunovel.NewMetadata(goframework.NumberUUID(3), baseTime.Add(2*time.Minute), &updateTime),
+					UserID:      goframework.NumberUUID(102),
+					Status:      dao.ReportStatusResolved,
+					ModeratorID: lo.ToPtr(goframework.NumberUUID(200)),
+					ResolvedAt:  &updateTime,
+					Action:      dao.ReportActionDismiss,
+					ReportModelCore: dao.ReportModelCore{
+						TargetType: dao.ReportTargetComment,
+						TargetID:   goframework.NumberUUID(30),
+						Reason:     dao.ReportReasonOther,
+					},
+				},
+			},
+			expectTotal: 1,
+		},
+		{
+			name:   "Success/NoResults",
+			query:  dao.ReportListQuery{ModeratorID: lo.ToPtr(goframework.NumberUUID(201))},
+			limit:  10,
+			expect: []*dao.ReportModel{},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewReportRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, total, err := repository.List(ctx, d.query, d.limit, d.offset)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+				require.Equal(t, d.expectTotal, total)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestReportRepository_Claim(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ReportModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			Status:   dao.ReportStatusOpen,
+			ReportModelCore: dao.ReportModelCore{
+				TargetType: dao.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     dao.ReportReasonSpam,
+			},
+		},
+		&dao.ReportModel{
+			Metadata:    bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &baseTime),
+			UserID:      goframework.NumberUUID(100),
+			Status:      dao.ReportStatusClaimed,
+			ModeratorID: lo.ToPtr(goframework.NumberUUID(201)),
+			ClaimedAt:   &baseTime,
+			ReportModelCore: dao.ReportModelCore{
+				TargetType: dao.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     dao.ReportReasonSpam,
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		moderatorID uuid.UUID
+		id          uuid.UUID
+		now         time.Time
+
+		expect    *dao.ReportModel
+		expectErr error
+	}{
+		{
+			name:        "Success",
+			moderatorID: goframework.NumberUUID(200),
+			id:          goframework.NumberUUID(1),
+			now:         updateTime,
+			expect: &dao.ReportModel{
+				Metadata:    bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+				UserID:      goframework.NumberUUID(100),
+				Status:      dao.ReportStatusClaimed,
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(200)),
+				ClaimedAt:   &updateTime,
+				ReportModelCore: dao.ReportModelCore{
+					TargetType: dao.ReportTargetComment,
+					TargetID:   goframework.NumberUUID(10),
+					Reason:     dao.ReportReasonSpam,
+				},
+			},
+		},
+		{
+			name:        "Error/AlreadyClaimed",
+			moderatorID: goframework.NumberUUID(200),
+			id:          goframework.NumberUUID(2),
+			now:         updateTime,
+			expectErr:   bunovel.ErrNotFound,
+		},
+		{
+			name:        "Error/NotFound",
+			moderatorID: goframework.NumberUUID(200),
+			id:          goframework.NumberUUID(3),
+			now:         updateTime,
+			expectErr:   bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewReportRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Claim(ctx, d.moderatorID, d.id, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestReportRepository_Resolve(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.CommentModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+			UserID:   goframework.NumberUUID(300),
+			CommentModelCore: dao.CommentModelCore{
+				TargetType: dao.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(50),
+				Content:    "my comment",
+			},
+		},
+		&dao.ReportModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(100),
+			Status:   dao.ReportStatusOpen,
+			ReportModelCore: dao.ReportModelCore{
+				TargetType: dao.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     dao.ReportReasonSpam,
+			},
+		},
+		// Another report on the same comment.
+		&dao.ReportModel{
+			Metadata:    bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &baseTime),
+			UserID:      goframework.NumberUUID(101),
+			Status:      dao.ReportStatusClaimed,
+			ModeratorID: lo.ToPtr(goframework.NumberUUID(201)),
+			ClaimedAt:   &baseTime,
+			ReportModelCore: dao.ReportModelCore{
+				TargetType: dao.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     dao.ReportReasonAbuse,
+			},
+		},
+		&dao.ReportModel{
+			Metadata:    bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, &baseTime),
+			UserID:      goframework.NumberUUID(100),
+			Status:      dao.ReportStatusResolved,
+			ModeratorID: lo.ToPtr(goframework.NumberUUID(201)),
+			ResolvedAt:  &baseTime,
+			Action:      dao.ReportActionDismiss,
+			ReportModelCore: dao.ReportModelCore{
+				TargetType: dao.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     dao.ReportReasonSpam,
+			},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		moderatorID uuid.UUID
+		action      dao.ReportAction
+		id          uuid.UUID
+		now         time.Time
+
+		expect    *dao.ReportModel
+		expectErr error
+
+		expectCommentErr    error
+		expectRelatedStatus dao.ReportStatus
+	}{
+		{
+			name:        "Success/Dismiss",
+			moderatorID: goframework.NumberUUID(200),
+			action:      dao.ReportActionDismiss,
+			id:          goframework.NumberUUID(1),
+			now:         updateTime,
+			expect: &dao.ReportModel{
+				Metadata:    bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+				UserID:      goframework.NumberUUID(100),
+				Status:      dao.ReportStatusResolved,
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(200)),
+				ResolvedAt:  &updateTime,
+				Action:      dao.ReportActionDismiss,
+				ReportModelCore: dao.ReportModelCore{
+					TargetType: dao.ReportTargetComment,
+					TargetID:   goframework.NumberUUID(10),
+					Reason:     dao.ReportReasonSpam,
+				},
+			},
+			expectRelatedStatus: dao.ReportStatusClaimed,
+		},
+		{
+			name:        "Success/Hide",
+			moderatorID: goframework.NumberUUID(200),
+			action:      dao.ReportActionHide,
+			id:          goframework.NumberUUID(1),
+			now:         updateTime,
+			expect: &dao.ReportModel{
+				Metadata:    bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+				UserID:      goframework.NumberUUID(100),
+				Status:      dao.ReportStatusResolved,
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(200)),
+				ResolvedAt:  &updateTime,
+				Action:      dao.ReportActionHide,
+				ReportModelCore: dao.ReportModelCore{
+					TargetType: dao.ReportTargetComment,
+					TargetID:   goframework.NumberUUID(10),
+					Reason:     dao.ReportReasonSpam,
+				},
+			},
+			expectCommentErr:    bunovel.ErrNotFound,
+			expectRelatedStatus: dao.ReportStatusResolved,
+		},
+		{
+			name:        "Success/Delete",
+			moderatorID: goframework.NumberUUID(201),
+			action:      dao.ReportActionDelete,
+			id:          goframework.NumberUUID(2),
+			now:         updateTime,
+			expect: &dao.ReportModel{
+				Metadata:    bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, &updateTime),
+				UserID:      goframework.NumberUUID(101),
+				Status:      dao.ReportStatusResolved,
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(201)),
+				ClaimedAt:   &baseTime,
+				ResolvedAt:  &updateTime,
+				Action:      dao.ReportActionDelete,
+				ReportModelCore: dao.ReportModelCore{
+					TargetType: dao.ReportTargetComment,
+					TargetID:   goframework.NumberUUID(10),
+					Reason:     dao.ReportReasonAbuse,
+				},
+			},
+			expectCommentErr:    bunovel.ErrNotFound,
+			expectRelatedStatus: dao.ReportStatusResolved,
+		},
+		{
+			name:        "Error/AlreadyResolved",
+			moderatorID: goframework.NumberUUID(200),
+			action:      dao.ReportActionHide,
+			id:          goframework.NumberUUID(3),
+			now:         updateTime,
+			expectErr:   bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewReportRepository(tx)
+			commentRepository := dao.NewCommentRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Resolve(ctx, d.moderatorID, d.action, d.id, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+
+				if err != nil {
+					return
+				}
+
+				_, err = commentRepository.Get(ctx, goframework.NumberUUID(10))
+				require.ErrorIs(t, err, d.expectCommentErr)
+
+				// Report 2 is pending, unless it is the one being resolved.
+				relatedID := goframework.NumberUUID(2)
+				if d.id == relatedID {
+					relatedID = goframework.NumberUUID(1)
+				}
+
+				related, err := repository.Get(ctx, relatedID)
+				require.NoError(t, err)
+				require.Equal(t, d.expectRelatedStatus, related.Status)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestReportRepository_Resolve_DeleteContent(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(11), baseTime.Add(time.Hour), nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my reported content",
+		},
+		// A suggestion of another user, on the reported revision.
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime.Add(2*time.Hour), nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(101),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(11),
+				Title:     "my title",
+				Content:   "my suggested content",
+			},
+		},
+
+		// A request with a single revision.
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(30), baseTime, nil),
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(30), baseTime, nil),
+			SourceID: goframework.NumberUUID(30),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "my title",
+			Content:  "my reported content",
+		},
+
+		&dao.ReportModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			UserID:   goframework.NumberUUID(102),
+			Status:   dao.ReportStatusOpen,
+			ReportModelCore: dao.ReportModelCore{
+				TargetType: dao.ReportTargetImproveRequestRevision,
+				TargetID:   goframework.NumberUUID(11),
+				Reason:     dao.ReportReasonSpam,
+			},
+		},
+		&dao.ReportModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			UserID:   goframework.NumberUUID(102),
+			Status:   dao.ReportStatusOpen,
+			ReportModelCore: dao.ReportModelCore{
+				TargetType: dao.ReportTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(20),
+				Reason:     dao.ReportReasonSpam,
+			},
+		},
+		&dao.ReportModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, nil),
+			UserID:   goframework.NumberUUID(102),
+			Status:   dao.ReportStatusOpen,
+			ReportModelCore: dao.ReportModelCore{
+				TargetType: dao.ReportTargetImproveRequestRevision,
+				TargetID:   goframework.NumberUUID(30),
+				Reason:     dao.ReportReasonSpam,
+			},
+		},
+	}
+
+	t.Run("Revision", func(st *testing.T) {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewReportRepository(tx)
+			requestRepository := dao.NewImproveRequestRepository(tx)
+			suggestionRepository := dao.NewImproveSuggestionRepository(tx)
+
+			_, err := repository.Resolve(ctx, goframework.NumberUUID(200), dao.ReportActionDelete, goframework.NumberUUID(1), updateTime)
+			require.NoError(st, err)
+
+			// The revision is soft deleted, so it can still be restored until it is purged.
+			deleted, err := requestRepository.GetDeletedRevision(ctx, goframework.NumberUUID(11))
+			require.NoError(st, err)
+			require.Equal(st, &updateTime, deleted.DeletedAt)
+
+			// The suggestion of the other user survives, on the nearest revision.
+			suggestion, err := suggestionRepository.Get(ctx, goframework.NumberUUID(20))
+			require.NoError(st, err)
+			require.Equal(st, goframework.NumberUUID(10), suggestion.RequestID)
+
+			_, err = requestRepository.Get(ctx, goframework.NumberUUID(10))
+			require.NoError(st, err)
+		})
+		require.NoError(st, err)
+	})
+
+	t.Run("Suggestion", func(st *testing.T) {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewReportRepository(tx)
+			suggestionRepository := dao.NewImproveSuggestionRepository(tx)
+
+			_, err := repository.Resolve(ctx, goframework.NumberUUID(200), dao.ReportActionDelete, goframework.NumberUUID(2), updateTime)
+			require.NoError(st, err)
+
+			_, err = suggestionRepository.GetDeleted(ctx, goframework.NumberUUID(20))
+			require.NoError(st, err)
+		})
+		require.NoError(st, err)
+	})
+
+	t.Run("LastRevision", func(st *testing.T) {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewReportRepository(tx)
+			requestRepository := dao.NewImproveRequestRepository(tx)
+
+			_, err := repository.Resolve(ctx, goframework.NumberUUID(200), dao.ReportActionDelete, goframework.NumberUUID(3), updateTime)
+			require.NoError(st, err)
+
+			// The request has nothing left to show, so it is deleted along with its last revision.
+			_, err = requestRepository.Get(ctx, goframework.NumberUUID(30))
+			require.ErrorIs(st, err, bunovel.ErrNotFound)
+
+			_, err = requestRepository.GetDeleted(ctx, goframework.NumberUUID(30))
+			require.NoError(st, err)
+		})
+		require.NoError(st, err)
+	})
+}
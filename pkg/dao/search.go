@@ -0,0 +1,125 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/uptrace/bun"
+	"strings"
+)
+
+const (
+	// titleHeadlineOptions highlight every match of a title, which is short enough to be returned whole.
+	titleHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, HighlightAll=TRUE"
+	// contentHeadlineOptions reduce a content to its best fragments, with their matches highlighted.
+	contentHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxFragments=3, MaxWords=25, MinWords=10, " +
+		"FragmentDelimiter=\" … \""
+)
+
+// searchSortKey is an expression the results of a search are sorted on, in descending order.
+type searchSortKey struct {
+	// expr is the sorted expression.
+	expr string
+	// placeholder is the placeholder of the cursor value, in the keyset condition. It defaults to "?".
+	placeholder string
+	// value is the value of the expression for the last result of the previous page. It is only used with a cursor.
+	value interface{}
+}
+
+// sortSearch sorts the results of a search on the given keys. The last keys must identify a row, so every result has
+// a stable position. When withCursor is true, only the results that come after the values of the keys are returned.
+func sortSearch(queryBuilder *bun.SelectQuery, keys []searchSortKey, withCursor bool) *bun.SelectQuery {
+	orderBy := make([]string, len(keys))
+	exprs := make([]string, len(keys))
+	placeholders := make([]string, len(keys))
+	values := make([]interface{}, len(keys))
+
+	for i, key := range keys {
+		orderBy[i] = key.expr + " DESC"
+		exprs[i] = key.expr
+		placeholders[i] = key.placeholder
+		if placeholders[i] == "" {
+			placeholders[i] = "?"
+		}
+		values[i] = key.value
+	}
+
+	queryBuilder = queryBuilder.OrderExpr(strings.Join(orderBy, ", "))
+
+	if withCursor {
+		// Every key is sorted in descending order, so the next page holds the rows whose keys compare lower.
+		queryBuilder = queryBuilder.Where(
+			"("+strings.Join(exprs, ", ")+") < ("+strings.Join(placeholders, ", ")+")",
+			values...,
+		)
+	}
+
+	return queryBuilder
+}
+
+// textSearchQuery turns a user query into a prefix tsquery: every word of the query must match the beginning of a
+// word of the searched content. The query is parsed once per language, with the dictionary of this language, or only
+// with the given language when it is set. It selects a "language" and a "query" column: the searched content must be
+// matched with the query of its own language. The raw query is also selected as a "text" column, for fuzzy matching.
+func textSearchQuery(db bun.IDB, query string, language Language) *bun.SelectQuery {
+	languages := Languages
+	if language != "" {
+		languages = []Language{language}
+	}
+
+	return db.NewSelect().
+		ColumnExpr("languages.language").
+		ColumnExpr("?::text AS text", query).
+		ColumnExpr(
+			"to_tsquery(search_config(languages.language), string_agg(lexeme || ':*', ' & ' order by positions)) AS query",
+		).
+		TableExpr("unnest(ARRAY[?]::varchar[]) AS languages(language)", bun.In(languages)).
+		Join("CROSS JOIN LATERAL unnest(to_tsvector(search_config(languages.language), unaccent(?)))", query).
+		GroupExpr("languages.language")
+}
+
+// suggestSearchQuery corrects the misspelled words of a search query, with the closest words from the titles of the
+// given model. It returns an empty string when no word could be corrected. Each word is looked up on its own, so
+// callers must bound the number of words of the query.
+func suggestSearchQuery(ctx context.Context, db bun.IDB, model interface{}, query string) (string, error) {
+	words := strings.Fields(strings.ToLower(query))
+	corrected := false
+
+	for i, word := range words {
+		// The trigram index only returns the titles with a word close enough to the searched one.
+		titleWords := db.NewSelect().
+			Model(model).
+			ColumnExpr("regexp_split_to_table(lower(title), '[^[:alnum:]]+') AS word").
+			Where("hidden = FALSE").
+			Where("deleted_at IS NULL").
+			Where("draft = FALSE").
+			Where("? <% title", word)
+
+		var suggestion string
+		err := db.NewSelect().
+			ColumnExpr("words.word").
+			TableExpr("(?) AS words", titleWords).
+			Where("words.word <> ''").
+			OrderExpr("similarity(words.word, ?) DESC, words.word ASC", word).
+			Limit(1).
+			Scan(ctx, &suggestion)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to suggest a correction for %q: %w", word, err)
+		}
+
+		if suggestion != word {
+			words[i] = suggestion
+			corrected = true
+		}
+	}
+
+	if !corrected {
+		return "", nil
+	}
+
+	return strings.Join(words, " "), nil
+}
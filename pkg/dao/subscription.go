@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type SubscriptionRepository interface {
+	// Subscribe makes a user follow an improvement request. Subscribing twice to the same request is a no-op.
+	Subscribe(ctx context.Context, userID, sourceID uuid.UUID, now time.Time) error
+	// Unsubscribe stops a user from following an improvement request. Unsubscribing from a request that is not
+	// followed is a no-op.
+	Unsubscribe(ctx context.Context, userID, sourceID uuid.UUID) error
+}
+
+type SubscriptionModel struct {
+	bun.BaseModel `bun:"table:subscriptions"`
+
+	// UserID is the ID of the user who follows the request.
+	UserID uuid.UUID `bun:"user_id,pk,type:uuid"`
+	// SourceID is the ID of the first revision of the followed improvement request.
+	SourceID  uuid.UUID `bun:"source_id,pk,type:uuid"`
+	CreatedAt time.Time `bun:"created_at"`
+}
+
+type subscriptionRepositoryImpl struct {
+	db bun.IDB
+}
+
+func NewSubscriptionRepository(db bun.IDB) SubscriptionRepository {
+	return &subscriptionRepositoryImpl{db: db}
+}
+
+func (repository *subscriptionRepositoryImpl) Subscribe(ctx context.Context, userID, sourceID uuid.UUID, now time.Time) error {
+	if err := subscribe(ctx, repository.db, userID, sourceID, now); err != nil {
+		return bunovel.HandlePGError(err)
+	}
+
+	return nil
+}
+
+func (repository *subscriptionRepositoryImpl) Unsubscribe(ctx context.Context, userID, sourceID uuid.UUID) error {
+	model := &SubscriptionModel{UserID: userID, SourceID: sourceID}
+
+	if _, err := repository.db.NewDelete().Model(model).WherePK().Exec(ctx); err != nil {
+		return bunovel.HandlePGError(err)
+	}
+
+	return nil
+}
+
+// subscribe makes a user follow an improvement request, unless they already do. It is shared with the repositories
+// that subscribe authors automatically, within their own transaction.
+func subscribe(ctx context.Context, tx bun.IDB, userID, sourceID uuid.UUID, now time.Time) error {
+	model := &SubscriptionModel{UserID: userID, SourceID: sourceID, CreatedAt: now}
+
+	_, err := tx.NewInsert().Model(model).On("CONFLICT (user_id, source_id) DO NOTHING").Exec(ctx)
+	return err
+}
@@ -0,0 +1,148 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"io/fs"
+	"testing"
+	"time"
+)
+
+func TestSubscriptionRepository_Subscribe(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.SubscriptionModel{
+			UserID:    goframework.NumberUUID(100),
+			SourceID:  goframework.NumberUUID(10),
+			CreatedAt: baseTime,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		userID   uuid.UUID
+		sourceID uuid.UUID
+		now      time.Time
+
+		expect    *dao.SubscriptionModel
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			userID:   goframework.NumberUUID(200),
+			sourceID: goframework.NumberUUID(10),
+			now:      updateTime,
+			expect: &dao.SubscriptionModel{
+				UserID:    goframework.NumberUUID(200),
+				SourceID:  goframework.NumberUUID(10),
+				CreatedAt: updateTime,
+			},
+		},
+		{
+			name:     "Success/AlreadySubscribed",
+			userID:   goframework.NumberUUID(100),
+			sourceID: goframework.NumberUUID(10),
+			now:      updateTime,
+			// The original subscription is kept.
+			expect: &dao.SubscriptionModel{
+				UserID:    goframework.NumberUUID(100),
+				SourceID:  goframework.NumberUUID(10),
+				CreatedAt: baseTime,
+			},
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewSubscriptionRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				err := repository.Subscribe(ctx, d.userID, d.sourceID, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+
+				if err != nil {
+					return
+				}
+
+				subscription := &dao.SubscriptionModel{UserID: d.userID, SourceID: d.sourceID}
+				require.NoError(t, tx.NewSelect().Model(subscription).WherePK().Scan(ctx))
+				require.Equal(t, d.expect, subscription)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestSubscriptionRepository_Unsubscribe(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.SubscriptionModel{
+			UserID:    goframework.NumberUUID(100),
+			SourceID:  goframework.NumberUUID(10),
+			CreatedAt: baseTime,
+		},
+		&dao.SubscriptionModel{
+			UserID:    goframework.NumberUUID(100),
+			SourceID:  goframework.NumberUUID(20),
+			CreatedAt: baseTime,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		userID   uuid.UUID
+		sourceID uuid.UUID
+
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			userID:   goframework.NumberUUID(100),
+			sourceID: goframework.NumberUUID(10),
+		},
+		{
+			name:     "Success/NotSubscribed",
+			userID:   goframework.NumberUUID(200),
+			sourceID: goframework.NumberUUID(10),
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewSubscriptionRepository(tx)
+			t.Run(d.name, func(st *testing.T) {
+				err := repository.Unsubscribe(ctx, d.userID, d.sourceID)
+				require.ErrorIs(t, err, d.expectErr)
+
+				subscribed, err := tx.NewSelect().
+					Model(&dao.SubscriptionModel{UserID: d.userID, SourceID: d.sourceID}).
+					WherePK().
+					Exists(ctx)
+				require.NoError(t, err)
+				require.False(t, subscribed)
+
+				// Other subscriptions of the user are kept.
+				subscribed, err = tx.NewSelect().
+					Model(&dao.SubscriptionModel{UserID: goframework.NumberUUID(100), SourceID: goframework.NumberUUID(20)}).
+					WherePK().
+					Exists(ctx)
+				require.NoError(t, err)
+				require.True(t, subscribed)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,109 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/bunovel"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type TagRepository interface {
+	// ListPopular returns the most used tags, with the number of improvement requests labeled with them. Deleted
+	// requests, and requests that were never published, are not counted.
+	ListPopular(ctx context.Context, limit int) ([]*TagUsage, error)
+}
+
+// TagModel is an entry of the tag vocabulary. Tags are added to the vocabulary the first time a request is labeled
+// with them.
+type TagModel struct {
+	bun.BaseModel `bun:"table:tags"`
+
+	Name      string    `bun:"name,pk"`
+	CreatedAt time.Time `bun:"created_at"`
+}
+
+type ImproveRequestTagModel struct {
+	bun.BaseModel `bun:"table:improve_requests_tags"`
+
+	// SourceID is the ID of the first revision of the labeled improvement request.
+	SourceID  uuid.UUID `bun:"source_id,pk,type:uuid"`
+	Tag       string    `bun:"tag,pk"`
+	CreatedAt time.Time `bun:"created_at"`
+}
+
+type TagUsage struct {
+	Name string `bun:"name"`
+	// Count is the number of improvement requests labeled with the tag.
+	Count int `bun:"count"`
+}
+
+type tagRepositoryImpl struct {
+	db bun.IDB
+}
+
+func NewTagRepository(db bun.IDB) TagRepository {
+	return &tagRepositoryImpl{db: db}
+}
+
+func (repository *tagRepositoryImpl) ListPopular(ctx context.Context, limit int) ([]*TagUsage, error) {
+	model := make([]*TagUsage, 0)
+
+	// Requests that were never published are only visible to their author, so their tags must not leak either.
+	publishedRequests := repository.db.NewSelect().
+		Model((*ImproveRequestRevisionModel)(nil)).
+		Column("source_id").
+		Where("draft = FALSE").
+		Where("deleted_at IS NULL")
+
+	activeRequests := repository.db.NewSelect().
+		Model((*ImproveRequestModel)(nil)).
+		Column("id").
+		Where("deleted_at IS NULL").
+		Where("id IN (?)", publishedRequests)
+
+	err := repository.db.NewSelect().
+		Model((*ImproveRequestTagModel)(nil)).
+		ColumnExpr("tag AS name").
+		ColumnExpr("COUNT(*) AS count").
+		Where("source_id IN (?)", activeRequests).
+		Group("tag").
+		Order("count DESC", "tag ASC").
+		Limit(limit).
+		Scan(ctx, &model)
+	if err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+// setTags replaces the tags of an improvement request. Tags that are not part of the vocabulary yet are added to it.
+func setTags(ctx context.Context, tx bun.IDB, sourceID uuid.UUID, tags []string, now time.Time) error {
+	_, err := tx.NewDelete().Model((*ImproveRequestTagModel)(nil)).Where("source_id = ?", sourceID).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to clear improve request tags: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	vocabulary := make([]*TagModel, len(tags))
+	links := make([]*ImproveRequestTagModel, len(tags))
+	for i, tag := range tags {
+		vocabulary[i] = &TagModel{Name: tag, CreatedAt: now}
+		links[i] = &ImproveRequestTagModel{SourceID: sourceID, Tag: tag, CreatedAt: now}
+	}
+
+	if _, err := tx.NewInsert().Model(&vocabulary).On("CONFLICT (name) DO NOTHING").Exec(ctx); err != nil {
+		return fmt.Errorf("failed to add tags: %w", err)
+	}
+
+	if _, err := tx.NewInsert().Model(&links).On("CONFLICT (source_id, tag) DO NOTHING").Exec(ctx); err != nil {
+		return fmt.Errorf("failed to label improve request: %w", err)
+	}
+
+	return nil
+}
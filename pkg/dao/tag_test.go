@@ -0,0 +1,135 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"io/fs"
+	"testing"
+)
+
+func TestTagRepository_ListPopular(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+		},
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(30), baseTime, nil),
+		},
+		// Deleted requests are not counted.
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(40), baseTime, nil),
+			DeletedAt: &updateTime,
+		},
+
+		// Requests that were never published are not counted.
+		&dao.ImproveRequestModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(50), baseTime, nil),
+		},
+
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+			SourceID: goframework.NumberUUID(20),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(3), baseTime, nil),
+			SourceID: goframework.NumberUUID(30),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(4), baseTime, nil),
+			SourceID: goframework.NumberUUID(40),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(5), baseTime, nil),
+			SourceID: goframework.NumberUUID(50),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+			Draft:    true,
+		},
+
+		&dao.TagModel{Name: "dialogue", CreatedAt: baseTime},
+		&dao.TagModel{Name: "horror", CreatedAt: baseTime},
+		&dao.TagModel{Name: "science-fiction", CreatedAt: baseTime},
+		// Tags that no request uses are left out.
+		&dao.TagModel{Name: "romance", CreatedAt: baseTime},
+
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(10), Tag: "dialogue", CreatedAt: baseTime},
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(20), Tag: "dialogue", CreatedAt: baseTime},
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(30), Tag: "dialogue", CreatedAt: baseTime},
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(10), Tag: "science-fiction", CreatedAt: baseTime},
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(20), Tag: "horror", CreatedAt: baseTime},
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(40), Tag: "horror", CreatedAt: baseTime},
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(40), Tag: "romance", CreatedAt: baseTime},
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(50), Tag: "horror", CreatedAt: baseTime},
+		&dao.ImproveRequestTagModel{SourceID: goframework.NumberUUID(50), Tag: "romance", CreatedAt: baseTime},
+	}
+
+	data := []struct {
+		name string
+
+		limit int
+
+		expect    []*dao.TagUsage
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			limit: 10,
+			// Tags used as often are sorted by name.
+			expect: []*dao.TagUsage{
+				{Name: "dialogue", Count: 3},
+				{Name: "horror", Count: 1},
+				{Name: "science-fiction", Count: 1},
+			},
+		},
+		{
+			name:  "Success/Limit",
+			limit: 2,
+			expect: []*dao.TagUsage{
+				{Name: "dialogue", Count: 3},
+				{Name: "horror", Count: 1},
+			},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewTagRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.ListPopular(ctx, d.limit)
+				require.ErrorIs(t, err, d.expectErr)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
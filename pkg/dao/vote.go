@@ -0,0 +1,134 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/bunovel"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type VoteRepository interface {
+	// Vote casts, changes or retracts the vote of a user on a given target, then recomputes the vote counters of
+	// the target. A vote of 0 retracts any existing vote.
+	Vote(ctx context.Context, targetType VoteTarget, targetID, userID uuid.UUID, vote int, now time.Time) error
+	// ListUserVotes returns the votes cast by a user on the given targets. Targets the user did not vote on are
+	// omitted from the results.
+	ListUserVotes(ctx context.Context, targetType VoteTarget, userID uuid.UUID, targetIDs []uuid.UUID) ([]*VoteModel, error)
+}
+
+// VoteTarget is the type of content a vote is cast on.
+type VoteTarget string
+
+const (
+	VoteTargetImproveRequest    VoteTarget = "improve_request"
+	VoteTargetImproveSuggestion VoteTarget = "improve_suggestion"
+)
+
+type VoteModel struct {
+	bun.BaseModel `bun:"table:votes"`
+
+	CreatedAt time.Time  `bun:"created_at"`
+	UpdatedAt *time.Time `bun:"updated_at"`
+
+	// TargetType is the type of content the vote is cast on.
+	TargetType VoteTarget `bun:"target_type,pk"`
+	// TargetID is the ID of the content the vote is cast on. For improve requests, it points to the source ID.
+	TargetID uuid.UUID `bun:"target_id,pk,type:uuid"`
+	// UserID is the ID of the user who cast the vote. A user has at most one vote per target.
+	UserID uuid.UUID `bun:"user_id,pk,type:uuid"`
+	// Vote is 1 for an up vote, and -1 for a down vote.
+	Vote int `bun:"vote"`
+}
+
+type voteRepositoryImpl struct {
+	db bun.IDB
+}
+
+func NewVoteRepository(db bun.IDB) VoteRepository {
+	return &voteRepositoryImpl{db: db}
+}
+
+// newVoteTargetModel returns the model holding the vote counters of the given target type.
+func newVoteTargetModel(targetType VoteTarget) (interface{}, error) {
+	switch targetType {
+	case VoteTargetImproveRequest:
+		return new(ImproveRequestModel), nil
+	case VoteTargetImproveSuggestion:
+		return new(ImproveSuggestionModel), nil
+	default:
+		return nil, fmt.Errorf("unsupported vote target %q", targetType)
+	}
+}
+
+func (repository *voteRepositoryImpl) Vote(ctx context.Context, targetType VoteTarget, targetID, userID uuid.UUID, vote int, now time.Time) error {
+	target, err := newVoteTargetModel(targetType)
+	if err != nil {
+		return err
+	}
+
+	if err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Lock the target first, so concurrent votes on the same target are applied one after the other, and each
+		// recount sees the votes committed before it.
+		if err := tx.NewSelect().Model(target).Column("id").Where("id = ?", targetID).For("UPDATE").Scan(ctx); err != nil {
+			return fmt.Errorf("failed to lock vote target: %w", err)
+		}
+
+		model := &VoteModel{
+			CreatedAt:  now,
+			TargetType: targetType,
+			TargetID:   targetID,
+			UserID:     userID,
+			Vote:       vote,
+		}
+
+		if vote == 0 {
+			if _, err := tx.NewDelete().Model(model).WherePK().Exec(ctx); err != nil {
+				return fmt.Errorf("failed to retract vote: %w", err)
+			}
+		} else {
+			_, err := tx.NewInsert().
+				Model(model).
+				On("CONFLICT (target_type, target_id, user_id) DO UPDATE").
+				Set("vote = EXCLUDED.vote").
+				Set("updated_at = EXCLUDED.created_at").
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to cast vote: %w", err)
+			}
+		}
+
+		_, err := tx.NewUpdate().
+			Model(target).
+			Set("up_votes = (SELECT count(*) FROM votes WHERE target_type = ? AND target_id = ? AND vote > 0)", targetType, targetID).
+			Set("down_votes = (SELECT count(*) FROM votes WHERE target_type = ? AND target_id = ? AND vote < 0)", targetType, targetID).
+			Where("id = ?", targetID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update vote counters: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return bunovel.HandlePGError(err)
+	}
+
+	return nil
+}
+
+func (repository *voteRepositoryImpl) ListUserVotes(ctx context.Context, targetType VoteTarget, userID uuid.UUID, targetIDs []uuid.UUID) ([]*VoteModel, error) {
+	votes := make([]*VoteModel, 0)
+
+	err := repository.db.NewSelect().
+		Model(&votes).
+		Where("target_type = ?", targetType).
+		Where("user_id = ?", userID).
+		Where("target_id IN (?)", bun.In(targetIDs)).
+		Scan(ctx)
+	if err != nil {
+		return nil, bunovel.HandlePGError(err)
+	}
+
+	return votes, nil
+}
@@ -0,0 +1,258 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/migrations"
+	"github.com/a-novel/forum-service/pkg/dao"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"io/fs"
+	"testing"
+	"time"
+)
+
+func TestVoteRepository_Vote(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.ImproveRequestModel{
+			Metadata:  bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, &updateTime),
+			UpVotes:   1,
+			DownVotes: 1,
+		},
+		&dao.ImproveRequestRevisionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(100),
+			Title:    "title",
+			Content:  "content",
+		},
+		&dao.ImproveSuggestionModel{
+			Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, &updateTime),
+			SourceID: goframework.NumberUUID(10),
+			UserID:   goframework.NumberUUID(200),
+			ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		&dao.VoteModel{
+			CreatedAt:  baseTime,
+			TargetType: dao.VoteTargetImproveRequest,
+			TargetID:   goframework.NumberUUID(10),
+			UserID:     goframework.NumberUUID(200),
+			Vote:       1,
+		},
+		&dao.VoteModel{
+			CreatedAt:  baseTime,
+			TargetType: dao.VoteTargetImproveRequest,
+			TargetID:   goframework.NumberUUID(10),
+			UserID:     goframework.NumberUUID(300),
+			Vote:       -1,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		targetType dao.VoteTarget
+		targetID   uuid.UUID
+		userID     uuid.UUID
+		vote       int
+		now        time.Time
+
+		expectUpVotes   int
+		expectDownVotes int
+		expectErr       error
+	}{
+		{
+			name:            "Success/Cast",
+			targetType:      dao.VoteTargetImproveRequest,
+			targetID:        goframework.NumberUUID(10),
+			userID:          goframework.NumberUUID(100),
+			vote:            1,
+			now:             updateTime,
+			expectUpVotes:   2,
+			expectDownVotes: 1,
+		},
+		{
+			name:            "Success/Change",
+			targetType:      dao.VoteTargetImproveRequest,
+			targetID:        goframework.NumberUUID(10),
+			userID:          goframework.NumberUUID(300),
+			vote:            1,
+			now:             updateTime,
+			expectUpVotes:   2,
+			expectDownVotes: 0,
+		},
+		{
+			name:            "Success/Retract",
+			targetType:      dao.VoteTargetImproveRequest,
+			targetID:        goframework.NumberUUID(10),
+			userID:          goframework.NumberUUID(200),
+			vote:            0,
+			now:             updateTime,
+			expectUpVotes:   0,
+			expectDownVotes: 1,
+		},
+		{
+			name:            "Success/RetractWithoutVote",
+			targetType:      dao.VoteTargetImproveRequest,
+			targetID:        goframework.NumberUUID(10),
+			userID:          goframework.NumberUUID(100),
+			vote:            0,
+			now:             updateTime,
+			expectUpVotes:   1,
+			expectDownVotes: 1,
+		},
+		{
+			name:            "Success/ImproveSuggestion",
+			targetType:      dao.VoteTargetImproveSuggestion,
+			targetID:        goframework.NumberUUID(20),
+			userID:          goframework.NumberUUID(100),
+			vote:            -1,
+			now:             updateTime,
+			expectUpVotes:   0,
+			expectDownVotes: 1,
+		},
+		{
+			name:       "Error/NotFound",
+			targetType: dao.VoteTargetImproveRequest,
+			targetID:   goframework.NumberUUID(11),
+			userID:     goframework.NumberUUID(100),
+			vote:       1,
+			now:        updateTime,
+			expectErr:  bunovel.ErrNotFound,
+		},
+	}
+
+	for _, d := range data {
+		err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+			repository := dao.NewVoteRepository(tx)
+
+			t.Run(d.name, func(st *testing.T) {
+				err := repository.Vote(ctx, d.targetType, d.targetID, d.userID, d.vote, d.now)
+				require.ErrorIs(t, err, d.expectErr)
+
+				if d.expectErr != nil {
+					return
+				}
+
+				var upVotes, downVotes int
+				switch d.targetType {
+				case dao.VoteTargetImproveRequest:
+					target := &dao.ImproveRequestModel{Metadata: bunovel.Metadata{ID: d.targetID}}
+					require.NoError(t, tx.NewSelect().Model(target).WherePK().Scan(ctx))
+					upVotes, downVotes = target.UpVotes, target.DownVotes
+				case dao.VoteTargetImproveSuggestion:
+					target := &dao.ImproveSuggestionModel{Metadata: bunovel.Metadata{ID: d.targetID}}
+					require.NoError(t, tx.NewSelect().Model(target).WherePK().Scan(ctx))
+					upVotes, downVotes = target.UpVotes, target.DownVotes
+				}
+
+				require.Equal(t, d.expectUpVotes, upVotes)
+				require.Equal(t, d.expectDownVotes, downVotes)
+			})
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestVoteRepository_ListUserVotes(t *testing.T) {
+	db, sqlDB := bunovel.GetTestPostgres(t, []fs.FS{migrations.Migrations})
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&dao.VoteModel{
+			CreatedAt:  baseTime,
+			TargetType: dao.VoteTargetImproveRequest,
+			TargetID:   goframework.NumberUUID(10),
+			UserID:     goframework.NumberUUID(100),
+			Vote:       1,
+		},
+		&dao.VoteModel{
+			CreatedAt:  baseTime,
+			UpdatedAt:  &updateTime,
+			TargetType: dao.VoteTargetImproveRequest,
+			TargetID:   goframework.NumberUUID(11),
+			UserID:     goframework.NumberUUID(100),
+			Vote:       -1,
+		},
+		&dao.VoteModel{
+			CreatedAt:  baseTime,
+			TargetType: dao.VoteTargetImproveRequest,
+			TargetID:   goframework.NumberUUID(10),
+			UserID:     goframework.NumberUUID(200),
+			Vote:       -1,
+		},
+		&dao.VoteModel{
+			CreatedAt:  baseTime,
+			TargetType: dao.VoteTargetImproveSuggestion,
+			TargetID:   goframework.NumberUUID(10),
+			UserID:     goframework.NumberUUID(100),
+			Vote:       -1,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		targetType dao.VoteTarget
+		userID     uuid.UUID
+		targetIDs  []uuid.UUID
+
+		expect    []*dao.VoteModel
+		expectErr error
+	}{
+		{
+			name:       "Success",
+			targetType: dao.VoteTargetImproveRequest,
+			userID:     goframework.NumberUUID(100),
+			targetIDs:  []uuid.UUID{goframework.NumberUUID(10), goframework.NumberUUID(11), goframework.NumberUUID(12)},
+			expect: []*dao.VoteModel{
+				{
+					CreatedAt:  baseTime,
+					TargetType: dao.VoteTargetImproveRequest,
+					TargetID:   goframework.NumberUUID(10),
+					UserID:     goframework.NumberUUID(100),
+					Vote:       1,
+				},
+				{
+					CreatedAt:  baseTime,
+					UpdatedAt:  &updateTime,
+					TargetType: dao.VoteTargetImproveRequest,
+					TargetID:   goframework.NumberUUID(11),
+					UserID:     goframework.NumberUUID(100),
+					Vote:       -1,
+				},
+			},
+		},
+		{
+			name:       "Success/NoVotes",
+			targetType: dao.VoteTargetImproveSuggestion,
+			userID:     goframework.NumberUUID(200),
+			targetIDs:  []uuid.UUID{goframework.NumberUUID(10)},
+			expect:     []*dao.VoteModel{},
+		},
+	}
+
+	err := bunovel.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := dao.NewVoteRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.ListUserVotes(ctx, d.targetType, d.userID, d.targetIDs)
+				require.ErrorIs(t, err, d.expectErr)
+				require.ElementsMatch(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,130 @@
+package diff
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Anchorer moves character ranges of a text onto a newer version of the same text.
+type Anchorer struct {
+	oldTokens []Token
+	newTokens []Token
+	matches   []int
+	newText   string
+}
+
+func NewAnchorer(oldText, newText string) *Anchorer {
+	oldTokens := Words(oldText)
+	newTokens := Words(newText)
+
+	return &Anchorer{
+		oldTokens: oldTokens,
+		newTokens: newTokens,
+		matches:   Match(Texts(oldTokens), Texts(newTokens)),
+		newText:   newText,
+	}
+}
+
+// Anchor returns the position, in the new text, of the [start, end) range of the old text, which must contain quote.
+// Ranges whose tokens all survived are moved along with them. Otherwise, the quote is looked up in the new text,
+// and the occurrence closest to the expected position is used. The last return value is false if the quote cannot
+// be found in the new text anymore.
+func (anchorer *Anchorer) Anchor(start, end int, quote string) (int, int, bool) {
+	quoteLength := utf8.RuneCountInString(quote)
+	if quote == "" || end-start != quoteLength {
+		return 0, 0, false
+	}
+
+	first := anchorer.tokenAt(start)
+	last := anchorer.tokenAt(end - 1)
+	if first < 0 || last < 0 {
+		return 0, 0, false
+	}
+
+	hint := anchorer.expectedPosition(first, start)
+
+	if anchorer.matches[first] >= 0 && anchorer.matches[last] >= 0 {
+		newStart := hint
+		newEnd := anchorer.newTokens[anchorer.matches[last]].Start + end - anchorer.oldTokens[last].Start
+		if newEnd-newStart == quoteLength && anchorer.slice(newStart, newEnd) == quote {
+			return newStart, newEnd, true
+		}
+	}
+
+	newStart := anchorer.closestOccurrence(quote, hint)
+	if newStart < 0 {
+		return 0, 0, false
+	}
+
+	return newStart, newStart + quoteLength, true
+}
+
+// tokenAt returns the index of the old token that contains the given offset.
+func (anchorer *Anchorer) tokenAt(offset int) int {
+	for i, token := range anchorer.oldTokens {
+		if offset >= token.Start && offset < token.End {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// expectedPosition guesses where an offset of the old text, located in the given old token, lands in the new text,
+// using the closest token that survived at or before it.
+func (anchorer *Anchorer) expectedPosition(token, offset int) int {
+	for i := token; i >= 0; i-- {
+		if match := anchorer.matches[i]; match >= 0 {
+			if i == token {
+				return anchorer.newTokens[match].Start + offset - anchorer.oldTokens[i].Start
+			}
+
+			return anchorer.newTokens[match].End
+		}
+	}
+
+	return 0
+}
+
+// slice returns the runes of the new text in the [start, end) range.
+func (anchorer *Anchorer) slice(start, end int) string {
+	runes := []rune(anchorer.newText)
+	if start < 0 || end > len(runes) || start >= end {
+		return ""
+	}
+
+	return string(runes[start:end])
+}
+
+// closestOccurrence returns the rune offset of the occurrence of quote in the new text that is closest to hint, or
+// -1 if the new text does not contain quote.
+func (anchorer *Anchorer) closestOccurrence(quote string, hint int) int {
+	best := -1
+	bestDistance := 0
+
+	byteOffset := 0
+	runeOffset := 0
+	for {
+		index := strings.Index(anchorer.newText[byteOffset:], quote)
+		if index < 0 {
+			return best
+		}
+
+		runeOffset += utf8.RuneCountInString(anchorer.newText[byteOffset : byteOffset+index])
+		byteOffset += index
+
+		distance := runeOffset - hint
+		if distance < 0 {
+			distance = -distance
+		}
+		if best < 0 || distance < bestDistance {
+			best = runeOffset
+			bestDistance = distance
+		}
+
+		// Move past the first rune of the occurrence, so overlapping occurrences are found too.
+		_, size := utf8.DecodeRuneInString(anchorer.newText[byteOffset:])
+		byteOffset += size
+		runeOffset++
+	}
+}
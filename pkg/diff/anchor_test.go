@@ -0,0 +1,117 @@
+package diff_test
+
+import (
+	"github.com/a-novel/forum-service/pkg/diff"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAnchorer_Anchor(t *testing.T) {
+	data := []struct {
+		name string
+
+		oldText string
+		newText string
+		start   int
+		end     int
+		quote   string
+
+		expectStart int
+		expectEnd   int
+		expectOK    bool
+	}{
+		{
+			name:        "Success/Unchanged",
+			oldText:     "The robot walks. The sun sets.",
+			newText:     "The robot walks. The sun sets.",
+			start:       4,
+			end:         15,
+			quote:       "robot walks",
+			expectStart: 4,
+			expectEnd:   15,
+			expectOK:    true,
+		},
+		{
+			name:        "Success/TextInsertedBefore",
+			oldText:     "The robot walks. The sun sets.",
+			newText:     "At dawn, the robot walks. The sun sets.",
+			start:       4,
+			end:         15,
+			quote:       "robot walks",
+			expectStart: 13,
+			expectEnd:   24,
+			expectOK:    true,
+		},
+		{
+			name:        "Success/PartialToken",
+			oldText:     "The robots walk.",
+			newText:     "Suddenly, the robots walk.",
+			start:       5,
+			end:         9,
+			quote:       "obot",
+			expectStart: 15,
+			expectEnd:   19,
+			expectOK:    true,
+		},
+		{
+			name:        "Success/MovedText",
+			oldText:     "The sun sets. The robot walks.",
+			newText:     "The robot walks. Then, the sun sets.",
+			start:       18,
+			end:         29,
+			quote:       "robot walks",
+			expectStart: 4,
+			expectEnd:   15,
+			expectOK:    true,
+		},
+		{
+			name:        "Success/ClosestOccurrence",
+			oldText:     "A cat. A dog. A cat.",
+			newText:     "A bird. A cat. A dog. A cat.",
+			start:       16,
+			end:         19,
+			quote:       "cat",
+			expectStart: 24,
+			expectEnd:   27,
+			expectOK:    true,
+		},
+		{
+			name:     "Error/QuoteRemoved",
+			oldText:  "The robot walks. The sun sets.",
+			newText:  "The robot runs. The sun sets.",
+			start:    4,
+			end:      15,
+			quote:    "robot walks",
+			expectOK: false,
+		},
+		{
+			name:     "Error/QuoteMismatch",
+			oldText:  "The robot walks.",
+			newText:  "The robot walks.",
+			start:    4,
+			end:      15,
+			quote:    "robot",
+			expectOK: false,
+		},
+		{
+			name:     "Error/OutOfRange",
+			oldText:  "The robot walks.",
+			newText:  "The robot walks.",
+			start:    40,
+			end:      45,
+			quote:    "robot",
+			expectOK: false,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			start, end, ok := diff.NewAnchorer(d.oldText, d.newText).Anchor(d.start, d.end, d.quote)
+			require.Equal(t, d.expectOK, ok)
+			if d.expectOK {
+				require.Equal(t, d.expectStart, start)
+				require.Equal(t, d.expectEnd, end)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package diff
+
+// Operation describes how a chunk of text changed between two versions.
+type Operation string
+
+const (
+	// OperationEqual marks text present in both versions.
+	OperationEqual Operation = "equal"
+	// OperationInsert marks text only present in the new version.
+	OperationInsert Operation = "insert"
+	// OperationDelete marks text only present in the old version.
+	OperationDelete Operation = "delete"
+)
+
+// Chunk is a run of consecutive tokens that went through the same Operation.
+type Chunk struct {
+	Operation Operation
+	Text      string
+}
+
+// Compare returns the chunks required to turn the old tokens into the new ones. Within a changed area, deleted text
+// always comes before inserted text.
+func Compare(oldTokens, newTokens []Token) []Chunk {
+	var (
+		chunks  []Chunk
+		matches = Match(Texts(oldTokens), Texts(newTokens))
+		next    int
+	)
+
+	push := func(operation Operation, text string) {
+		if len(chunks) > 0 && chunks[len(chunks)-1].Operation == operation {
+			chunks[len(chunks)-1].Text += text
+			return
+		}
+
+		chunks = append(chunks, Chunk{Operation: operation, Text: text})
+	}
+
+	for i, token := range oldTokens {
+		if matches[i] < 0 {
+			push(OperationDelete, token.Text)
+			continue
+		}
+
+		for ; next < matches[i]; next++ {
+			push(OperationInsert, newTokens[next].Text)
+		}
+
+		push(OperationEqual, token.Text)
+		next++
+	}
+
+	for ; next < len(newTokens); next++ {
+		push(OperationInsert, newTokens[next].Text)
+	}
+
+	return chunks
+}
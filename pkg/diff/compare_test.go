@@ -0,0 +1,76 @@
+package diff_test
+
+import (
+	"github.com/a-novel/forum-service/pkg/diff"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	data := []struct {
+		name string
+
+		oldText string
+		newText string
+
+		expect []diff.Chunk
+	}{
+		{
+			name:    "Success/Identical",
+			oldText: "The quick brown fox.",
+			newText: "The quick brown fox.",
+			expect: []diff.Chunk{
+				{Operation: diff.OperationEqual, Text: "The quick brown fox."},
+			},
+		},
+		{
+			name:    "Success/Replacement",
+			oldText: "The quick brown fox.",
+			newText: "The slow brown fox.",
+			expect: []diff.Chunk{
+				{Operation: diff.OperationEqual, Text: "The "},
+				{Operation: diff.OperationDelete, Text: "quick"},
+				{Operation: diff.OperationInsert, Text: "slow"},
+				{Operation: diff.OperationEqual, Text: " brown fox."},
+			},
+		},
+		{
+			name:    "Success/Insertion",
+			oldText: "The fox.",
+			newText: "The brown fox jumps.",
+			expect: []diff.Chunk{
+				{Operation: diff.OperationEqual, Text: "The "},
+				{Operation: diff.OperationInsert, Text: "brown "},
+				{Operation: diff.OperationEqual, Text: "fox"},
+				{Operation: diff.OperationInsert, Text: " jumps"},
+				{Operation: diff.OperationEqual, Text: "."},
+			},
+		},
+		{
+			name:    "Success/Deletion",
+			oldText: "The quick brown fox.",
+			newText: "The fox.",
+			expect: []diff.Chunk{
+				{Operation: diff.OperationEqual, Text: "The "},
+				{Operation: diff.OperationDelete, Text: "quick brown "},
+				{Operation: diff.OperationEqual, Text: "fox."},
+			},
+		},
+		{
+			name:    "Success/FromEmpty",
+			newText: "The fox.",
+			expect: []diff.Chunk{
+				{Operation: diff.OperationInsert, Text: "The fox."},
+			},
+		},
+		{
+			name: "Success/Empty",
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			require.Equal(t, d.expect, diff.Compare(diff.Words(d.oldText), diff.Words(d.newText)))
+		})
+	}
+}
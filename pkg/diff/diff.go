@@ -0,0 +1,225 @@
+// Package diff compares two versions of a text, token by token.
+package diff
+
+import (
+	"unicode"
+)
+
+// maxMatchCells bounds the size of the table used to compute the longest common subsequence between two token lists.
+// Texts that differ on a larger area only get their common prefix and suffix matched.
+const maxMatchCells = 1 << 22
+
+// Token is a slice of a text. Start and End are offsets in runes, End being exclusive.
+type Token struct {
+	Text  string
+	Start int
+	End   int
+}
+
+type tokenClass int
+
+const (
+	tokenClassWord tokenClass = iota
+	tokenClassSpace
+	tokenClassOther
+)
+
+func classOf(r rune) tokenClass {
+	switch {
+	case unicode.IsLetter(r), unicode.IsDigit(r), unicode.IsMark(r):
+		return tokenClassWord
+	case unicode.IsSpace(r):
+		return tokenClassSpace
+	default:
+		return tokenClassOther
+	}
+}
+
+// Words splits a text into words, whitespace runs and punctuation signs. Concatenating the tokens always gives back
+// the original text.
+func Words(text string) []Token {
+	var (
+		tokens  []Token
+		current []rune
+		class   tokenClass
+		start   int
+		offset  int
+	)
+
+	flush := func() {
+		if len(current) > 0 {
+			tokens = append(tokens, Token{Text: string(current), Start: start, End: offset})
+			current = current[:0]
+		}
+	}
+
+	for _, r := range text {
+		rClass := classOf(r)
+		// Punctuation signs are never grouped together.
+		if len(current) > 0 && (rClass != class || rClass == tokenClassOther) {
+			flush()
+		}
+		if len(current) == 0 {
+			start = offset
+			class = rClass
+		}
+
+		current = append(current, r)
+		offset++
+	}
+
+	flush()
+
+	return tokens
+}
+
+// Sentences splits a text into sentences and the whitespace runs between them. A sentence ends with a terminal
+// punctuation sign followed by a space, or with a line break. Concatenating the tokens always gives back the original
+// text.
+func Sentences(text string) []Token {
+	var (
+		tokens []Token
+		runes  = []rune(text)
+		start  int
+	)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		i++
+
+		boundary := r == '\n'
+		if isTerminal(r) {
+			// Catch sequences such as "?!" or closing quotes.
+			for i < len(runes) && (isTerminal(runes[i]) || isClosing(runes[i])) {
+				i++
+			}
+			boundary = i == len(runes) || unicode.IsSpace(runes[i])
+		}
+
+		if !boundary {
+			continue
+		}
+
+		tokens = append(tokens, Token{Text: string(runes[start:i]), Start: start, End: i})
+		start = i
+
+		for i < len(runes) && unicode.IsSpace(runes[i]) {
+			i++
+		}
+
+		if i > start {
+			tokens = append(tokens, Token{Text: string(runes[start:i]), Start: start, End: i})
+			start = i
+		}
+	}
+
+	if start < len(runes) {
+		tokens = append(tokens, Token{Text: string(runes[start:]), Start: start, End: len(runes)})
+	}
+
+	return tokens
+}
+
+func isTerminal(r rune) bool {
+	switch r {
+	case '.', '!', '?', '…':
+		return true
+	default:
+		return false
+	}
+}
+
+func isClosing(r rune) bool {
+	switch r {
+	case '"', '\'', ')', '»', '”', '’':
+		return true
+	default:
+		return false
+	}
+}
+
+// Texts returns the text of each token.
+func Texts(tokens []Token) []string {
+	output := make([]string, len(tokens))
+	for i, token := range tokens {
+		output[i] = token.Text
+	}
+
+	return output
+}
+
+// Match returns, for each element of a, the index of the element of b it is matched with, or -1 if it has no
+// counterpart in b. Matches follow the longest common subsequence of both lists, so they are always in increasing
+// order.
+func Match(a, b []string) []int {
+	matches := make([]int, len(a))
+	for i := range matches {
+		matches[i] = -1
+	}
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		matches[prefix] = prefix
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		matches[len(a)-1-suffix] = len(b) - 1 - suffix
+		suffix++
+	}
+
+	middleA := a[prefix : len(a)-suffix]
+	middleB := b[prefix : len(b)-suffix]
+	if len(middleA) == 0 || len(middleB) == 0 || (len(middleA)+1)*(len(middleB)+1) > maxMatchCells {
+		return matches
+	}
+
+	for i, j := range lcs(middleA, middleB) {
+		if j >= 0 {
+			matches[prefix+i] = prefix + j
+		}
+	}
+
+	return matches
+}
+
+// lcs computes the longest common subsequence of a and b, and returns the matches the same way as Match.
+func lcs(a, b []string) []int {
+	width := len(b) + 1
+	// table[i*width+j] is the length of the longest common subsequence of a[i:] and b[j:].
+	table := make([]int32, (len(a)+1)*width)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				table[i*width+j] = table[(i+1)*width+j+1] + 1
+			} else if table[(i+1)*width+j] >= table[i*width+j+1] {
+				table[i*width+j] = table[(i+1)*width+j]
+			} else {
+				table[i*width+j] = table[i*width+j+1]
+			}
+		}
+	}
+
+	matches := make([]int, len(a))
+	for i := range matches {
+		matches[i] = -1
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			matches[i] = j
+			i++
+			j++
+		case table[(i+1)*width+j] >= table[i*width+j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return matches
+}
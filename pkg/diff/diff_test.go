@@ -0,0 +1,157 @@
+package diff_test
+
+import (
+	"github.com/a-novel/forum-service/pkg/diff"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestWords(t *testing.T) {
+	data := []struct {
+		name string
+
+		text string
+
+		expect []diff.Token
+	}{
+		{
+			name: "Success",
+			text: "Hello, world!",
+			expect: []diff.Token{
+				{Text: "Hello", Start: 0, End: 5},
+				{Text: ",", Start: 5, End: 6},
+				{Text: " ", Start: 6, End: 7},
+				{Text: "world", Start: 7, End: 12},
+				{Text: "!", Start: 12, End: 13},
+			},
+		},
+		{
+			name: "Success/Unicode",
+			text: "l'été  arrive...",
+			expect: []diff.Token{
+				{Text: "l", Start: 0, End: 1},
+				{Text: "'", Start: 1, End: 2},
+				{Text: "été", Start: 2, End: 5},
+				{Text: "  ", Start: 5, End: 7},
+				{Text: "arrive", Start: 7, End: 13},
+				{Text: ".", Start: 13, End: 14},
+				{Text: ".", Start: 14, End: 15},
+				{Text: ".", Start: 15, End: 16},
+			},
+		},
+		{
+			name: "Success/Empty",
+			text: "",
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			require.Equal(t, d.expect, diff.Words(d.text))
+		})
+	}
+}
+
+func TestSentences(t *testing.T) {
+	data := []struct {
+		name string
+
+		text string
+
+		expect []diff.Token
+	}{
+		{
+			name: "Success",
+			text: "It was late. Nobody came!  Why?",
+			expect: []diff.Token{
+				{Text: "It was late.", Start: 0, End: 12},
+				{Text: " ", Start: 12, End: 13},
+				{Text: "Nobody came!", Start: 13, End: 25},
+				{Text: "  ", Start: 25, End: 27},
+				{Text: "Why?", Start: 27, End: 31},
+			},
+		},
+		{
+			name: "Success/LineBreaks",
+			text: "First line\nSecond line",
+			expect: []diff.Token{
+				{Text: "First line\n", Start: 0, End: 11},
+				{Text: "Second line", Start: 11, End: 22},
+			},
+		},
+		{
+			name: "Success/QuotesAndAbbreviations",
+			text: "\"Really?!\" she asked. It cost 3.5 euros…",
+			expect: []diff.Token{
+				{Text: "\"Really?!\"", Start: 0, End: 10},
+				{Text: " ", Start: 10, End: 11},
+				{Text: "she asked.", Start: 11, End: 21},
+				{Text: " ", Start: 21, End: 22},
+				{Text: "It cost 3.5 euros…", Start: 22, End: 40},
+			},
+		},
+		{
+			name: "Success/Empty",
+			text: "",
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			require.Equal(t, d.expect, diff.Sentences(d.text))
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	data := []struct {
+		name string
+
+		a []string
+		b []string
+
+		expect []int
+	}{
+		{
+			name:   "Success/Identical",
+			a:      []string{"a", "b", "c"},
+			b:      []string{"a", "b", "c"},
+			expect: []int{0, 1, 2},
+		},
+		{
+			name:   "Success/Insertion",
+			a:      []string{"a", "b", "c"},
+			b:      []string{"a", "x", "b", "c"},
+			expect: []int{0, 2, 3},
+		},
+		{
+			name:   "Success/Deletion",
+			a:      []string{"a", "b", "c"},
+			b:      []string{"a", "c"},
+			expect: []int{0, -1, 1},
+		},
+		{
+			name:   "Success/Replacement",
+			a:      []string{"a", "b", "c", "d", "e"},
+			b:      []string{"a", "x", "c", "y", "e"},
+			expect: []int{0, -1, 2, -1, 4},
+		},
+		{
+			name:   "Success/NoCommonElement",
+			a:      []string{"a", "b"},
+			b:      []string{"c", "d"},
+			expect: []int{-1, -1},
+		},
+		{
+			name:   "Success/Empty",
+			a:      []string{"a", "b"},
+			expect: []int{-1, -1},
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			require.Equal(t, d.expect, diff.Match(d.a, d.b))
+		})
+	}
+}
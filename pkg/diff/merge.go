@@ -0,0 +1,207 @@
+package diff
+
+import (
+	"sort"
+	"strings"
+)
+
+// Hunk is the text a version puts in place of a conflicting area of the base text.
+type Hunk struct {
+	// Version is the index of the version, in the list passed to Merge.
+	Version int
+	Text    string
+}
+
+// Conflict is an area of the base text that several versions changed in different ways. Start and End are offsets
+// in runes in the base text, End being exclusive.
+type Conflict struct {
+	Start int
+	End   int
+	// Base is the original text of the conflicting area.
+	Base string
+	// Hunks contains the text of each version involved in the conflict, ordered by version.
+	Hunks []Hunk
+}
+
+// Resolver returns the text to use in place of a conflicting area. It returns false if the conflict is not resolved
+// yet.
+type Resolver func(conflict Conflict) (string, bool)
+
+// edit replaces the base tokens between start and end (exclusive) with a new text.
+type edit struct {
+	version int
+	start   int
+	end     int
+	text    string
+}
+
+func (e edit) overlaps(other edit) bool {
+	// Two edits starting at the same position always conflict, even if one of them is a pure insertion.
+	return e.start == other.start || (e.start < other.end && other.start < e.end)
+}
+
+// Merge applies the changes made by every version of a base text at once, word by word. Changes that touch
+// different areas of the base text are combined. When several versions change the same area differently, the
+// conflict is passed to resolve (that may be nil); the base text is kept for every conflict left unresolved, and
+// those conflicts are returned in the order they appear in the text.
+func Merge(base string, versions []string, resolve Resolver) (string, []Conflict) {
+	baseTokens := Words(base)
+
+	var edits []edit
+	for version, text := range versions {
+		edits = append(edits, versionEdits(baseTokens, version, text)...)
+	}
+
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].start < edits[j].start
+	})
+
+	var (
+		output    strings.Builder
+		conflicts []Conflict
+		cursor    int
+	)
+
+	for i := 0; i < len(edits); {
+		cluster := []edit{edits[i]}
+		start, end := edits[i].start, edits[i].end
+
+		i++
+		for i < len(edits) && overlapsAny(edits[i], cluster) {
+			cluster = append(cluster, edits[i])
+			if edits[i].end > end {
+				end = edits[i].end
+			}
+			i++
+		}
+
+		output.WriteString(join(baseTokens[cursor:start]))
+		cursor = end
+
+		if identical(cluster) {
+			output.WriteString(cluster[0].text)
+			continue
+		}
+
+		conflict := Conflict{
+			Start: runeOffset(baseTokens, start),
+			End:   runeOffset(baseTokens, end),
+			Base:  join(baseTokens[start:end]),
+			Hunks: hunks(baseTokens, cluster, start, end),
+		}
+
+		if resolve != nil {
+			if text, ok := resolve(conflict); ok {
+				output.WriteString(text)
+				continue
+			}
+		}
+
+		conflicts = append(conflicts, conflict)
+		output.WriteString(conflict.Base)
+	}
+
+	output.WriteString(join(baseTokens[cursor:]))
+
+	return output.String(), conflicts
+}
+
+// versionEdits lists the minimal edits that turn the base tokens into the given text.
+func versionEdits(baseTokens []Token, version int, text string) []edit {
+	var (
+		edits     []edit
+		tokens    = Words(text)
+		matches   = Match(Texts(baseTokens), Texts(tokens))
+		nextToken int
+	)
+
+	for i := 0; i <= len(baseTokens); i++ {
+		start := i
+		for i < len(baseTokens) && matches[i] < 0 {
+			i++
+		}
+
+		upTo := len(tokens)
+		if i < len(baseTokens) {
+			upTo = matches[i]
+		}
+
+		if start < i || nextToken < upTo {
+			edits = append(edits, edit{version: version, start: start, end: i, text: join(tokens[nextToken:upTo])})
+		}
+
+		nextToken = upTo + 1
+	}
+
+	return edits
+}
+
+func overlapsAny(e edit, cluster []edit) bool {
+	for _, other := range cluster {
+		if e.overlaps(other) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func identical(cluster []edit) bool {
+	for _, e := range cluster[1:] {
+		if e.start != cluster[0].start || e.end != cluster[0].end || e.text != cluster[0].text {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hunks rebuilds, for each version involved in a cluster, its text for the whole area covered by the cluster.
+func hunks(baseTokens []Token, cluster []edit, start, end int) []Hunk {
+	byVersion := make(map[int][]edit)
+	versions := make([]int, 0)
+	for _, e := range cluster {
+		if _, ok := byVersion[e.version]; !ok {
+			versions = append(versions, e.version)
+		}
+		byVersion[e.version] = append(byVersion[e.version], e)
+	}
+
+	sort.Ints(versions)
+
+	output := make([]Hunk, len(versions))
+	for i, version := range versions {
+		var text strings.Builder
+		cursor := start
+		for _, e := range byVersion[version] {
+			text.WriteString(join(baseTokens[cursor:e.start]))
+			text.WriteString(e.text)
+			cursor = e.end
+		}
+		text.WriteString(join(baseTokens[cursor:end]))
+
+		output[i] = Hunk{Version: version, Text: text.String()}
+	}
+
+	return output
+}
+
+func runeOffset(tokens []Token, index int) int {
+	if index < len(tokens) {
+		return tokens[index].Start
+	}
+	if len(tokens) == 0 {
+		return 0
+	}
+
+	return tokens[len(tokens)-1].End
+}
+
+func join(tokens []Token) string {
+	var output strings.Builder
+	for _, token := range tokens {
+		output.WriteString(token.Text)
+	}
+
+	return output.String()
+}
@@ -0,0 +1,120 @@
+package diff_test
+
+import (
+	"github.com/a-novel/forum-service/pkg/diff"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	data := []struct {
+		name string
+
+		base     string
+		versions []string
+		resolve  diff.Resolver
+
+		expect          string
+		expectConflicts []diff.Conflict
+	}{
+		{
+			name: "Success/DistinctAreas",
+			base: "The quick brown fox jumps over the lazy dog.",
+			versions: []string{
+				"The slow brown fox jumps over the lazy dog.",
+				"The quick brown fox jumps over the sleeping dog.",
+			},
+			expect: "The slow brown fox jumps over the sleeping dog.",
+		},
+		{
+			name: "Success/SameChange",
+			base: "The quick brown fox.",
+			versions: []string{
+				"The slow brown fox.",
+				"The slow brown fox.",
+			},
+			expect: "The slow brown fox.",
+		},
+		{
+			name: "Success/Insertions",
+			base: "The fox.",
+			versions: []string{
+				"The brown fox.",
+				"The fox jumps.",
+			},
+			expect: "The brown fox jumps.",
+		},
+		{
+			name: "Success/Unchanged",
+			base: "The fox.",
+			versions: []string{
+				"The fox.",
+			},
+			expect: "The fox.",
+		},
+		{
+			name: "Success/Resolved",
+			base: "The quick brown fox.",
+			versions: []string{
+				"The slow brown fox.",
+				"The fast brown fox.",
+			},
+			resolve: func(conflict diff.Conflict) (string, bool) {
+				return "lazy", conflict.Start == 4 && conflict.End == 9
+			},
+			expect: "The lazy brown fox.",
+		},
+		{
+			name: "Conflict",
+			base: "The quick brown fox.",
+			versions: []string{
+				"The slow brown fox.",
+				"The fox.",
+				"The quick brown fox!",
+			},
+			expect: "The quick brown fox!",
+			expectConflicts: []diff.Conflict{
+				{
+					Start: 4,
+					End:   16,
+					Base:  "quick brown ",
+					Hunks: []diff.Hunk{
+						{Version: 0, Text: "slow brown "},
+						{Version: 1, Text: ""},
+					},
+				},
+			},
+		},
+		{
+			name: "Conflict/SameInsertionPoint",
+			base: "The fox.",
+			versions: []string{
+				"The brown fox.",
+				"The red fox.",
+			},
+			resolve: func(conflict diff.Conflict) (string, bool) {
+				return "", false
+			},
+			expect: "The fox.",
+			expectConflicts: []diff.Conflict{
+				{
+					Start: 4,
+					End:   4,
+					Base:  "",
+					Hunks: []diff.Hunk{
+						{Version: 0, Text: "brown "},
+						{Version: 1, Text: "red "},
+					},
+				},
+			},
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			res, conflicts := diff.Merge(d.base, d.versions, d.resolve)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectConflicts, conflicts)
+		})
+	}
+}
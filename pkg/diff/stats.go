@@ -0,0 +1,36 @@
+package diff
+
+import (
+	"unicode/utf8"
+)
+
+// Stats sums up the changes between two versions of a text.
+type Stats struct {
+	// Added is the number of characters only present in the new version.
+	Added int
+	// Removed is the number of characters only present in the old version.
+	Removed int
+	// ChangedRatio is the share of the characters of both versions that were added or removed, from 0 (identical
+	// versions) to 1 (nothing in common).
+	ChangedRatio float64
+}
+
+// Measure compares two versions of a text word by word, and counts the characters that changed.
+func Measure(oldText, newText string) Stats {
+	var stats Stats
+
+	for _, chunk := range Compare(Words(oldText), Words(newText)) {
+		switch chunk.Operation {
+		case OperationInsert:
+			stats.Added += utf8.RuneCountInString(chunk.Text)
+		case OperationDelete:
+			stats.Removed += utf8.RuneCountInString(chunk.Text)
+		}
+	}
+
+	if total := utf8.RuneCountInString(oldText) + utf8.RuneCountInString(newText); total > 0 {
+		stats.ChangedRatio = float64(stats.Added+stats.Removed) / float64(total)
+	}
+
+	return stats
+}
@@ -0,0 +1,70 @@
+package diff_test
+
+import (
+	"github.com/a-novel/forum-service/pkg/diff"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMeasure(t *testing.T) {
+	data := []struct {
+		name string
+
+		oldText string
+		newText string
+
+		expect diff.Stats
+	}{
+		{
+			name:    "Success/Identical",
+			oldText: "The quick brown fox.",
+			newText: "The quick brown fox.",
+		},
+		{
+			name:    "Success/Replacement",
+			oldText: "The quick brown fox.",
+			newText: "The slow brown fox.",
+			expect: diff.Stats{
+				Added:        4,
+				Removed:      5,
+				ChangedRatio: 9.0 / 39.0,
+			},
+		},
+		{
+			name:    "Success/Insertion",
+			oldText: "The fox.",
+			newText: "The brown fox.",
+			expect: diff.Stats{
+				Added:        6,
+				ChangedRatio: 6.0 / 22.0,
+			},
+		},
+		{
+			name:    "Success/Runes",
+			oldText: "Un été.",
+			newText: "Un hiver.",
+			expect: diff.Stats{
+				Added:        5,
+				Removed:      3,
+				ChangedRatio: 8.0 / 16.0,
+			},
+		},
+		{
+			name:    "Success/FromEmpty",
+			newText: "The fox.",
+			expect: diff.Stats{
+				Added:        8,
+				ChangedRatio: 1,
+			},
+		},
+		{
+			name: "Success/Empty",
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			require.Equal(t, d.expect, diff.Measure(d.oldText, d.newText))
+		})
+	}
+}
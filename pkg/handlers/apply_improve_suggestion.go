@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+)
+
+type ApplyImproveSuggestionHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewApplyImproveSuggestionHandler(service services.ApplyImproveSuggestionService) ApplyImproveSuggestionHandler {
+	return &applyImproveSuggestionHandlerImpl{
+		service: service,
+	}
+}
+
+type applyImproveSuggestionHandlerImpl struct {
+	service services.ApplyImproveSuggestionService
+}
+
+func (h *applyImproveSuggestionHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	form := new(models.ApplyImproveSuggestionForm)
+	if err := c.BindJSON(form); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.service.Apply(c, token, form.ID, uuid.New(), time.Now())
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{services.ErrNotTheCreator, http.StatusUnauthorized},
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{goframework.ErrInvalidEntity, http.StatusUnprocessableEntity},
+			{bunovel.ErrNotFound, http.StatusNotFound},
+		}, true)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
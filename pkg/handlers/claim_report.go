@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type ClaimReportHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewClaimReportHandler(service services.ClaimReportService) ClaimReportHandler {
+	return &claimReportHandlerImpl{
+		service: service,
+	}
+}
+
+type claimReportHandlerImpl struct {
+	service services.ClaimReportService
+}
+
+func (h *claimReportHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	form := new(models.ClaimReportForm)
+	if err := c.BindJSON(form); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.service.Claim(c, token, form.ID, time.Now())
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{bunovel.ErrNotFound, http.StatusNotFound},
+			{services.ErrReportClaimed, http.StatusConflict},
+			{services.ErrReportResolved, http.StatusConflict},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,172 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClaimReportHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+
+		body interface{}
+
+		shouldCallService       bool
+		shouldCallServiceWithID uuid.UUID
+		serviceResp             *models.Report
+		serviceErr              error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:          "Success",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceResp: &models.Report{
+				ID:          goframework.NumberUUID(1),
+				CreatedAt:   baseTime,
+				UpdatedAt:   lo.ToPtr(baseTime.Add(time.Hour)),
+				UserID:      goframework.NumberUUID(200),
+				TargetType:  models.ReportTargetComment,
+				TargetID:    goframework.NumberUUID(10),
+				Reason:      models.ReportReasonSpam,
+				Status:      models.ReportStatusClaimed,
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(100)),
+				ClaimedAt:   lo.ToPtr(baseTime.Add(time.Hour)),
+			},
+			expect: map[string]interface{}{
+				"id":          goframework.NumberUUID(1).String(),
+				"createdAt":   baseTime.Format(time.RFC3339),
+				"updatedAt":   baseTime.Add(time.Hour).Format(time.RFC3339),
+				"userID":      goframework.NumberUUID(200).String(),
+				"targetType":  models.ReportTargetComment,
+				"targetID":    goframework.NumberUUID(10).String(),
+				"reason":      models.ReportReasonSpam,
+				"content":     "",
+				"status":      models.ReportStatusClaimed,
+				"moderatorID": goframework.NumberUUID(100).String(),
+				"claimedAt":   baseTime.Add(time.Hour).Format(time.RFC3339),
+				"resolvedAt":  nil,
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:          "Error/ErrInvalidCredentials",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceErr:              goframework.ErrInvalidCredentials,
+			expectStatus:            http.StatusForbidden,
+		},
+		{
+			name:          "Error/ErrNotFound",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceErr:              bunovel.ErrNotFound,
+			expectStatus:            http.StatusNotFound,
+		},
+		{
+			name:          "Error/ErrReportClaimed",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceErr:              services.ErrReportClaimed,
+			expectStatus:            http.StatusConflict,
+		},
+		{
+			name:          "Error/ErrReportResolved",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceErr:              services.ErrReportResolved,
+			expectStatus:            http.StatusConflict,
+		},
+		{
+			name:          "Error/InternalError",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceErr:              errors.New("uwups"),
+			expectStatus:            http.StatusInternalServerError,
+		},
+		{
+			name:          "Error/BadRequest",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": "fake uuid",
+			},
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewClaimReportService(t)
+
+			mrshBody, err := json.Marshal(d.body)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("POST", "/", bytes.NewReader(mrshBody))
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("Claim", c, d.authorization, d.shouldCallServiceWithID, mock.Anything).
+					Return(d.serviceResp, d.serviceErr)
+			}
+
+			handler := handlers.NewClaimReportHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type CountUnreadNotificationsHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewCountUnreadNotificationsHandler(service services.CountUnreadNotificationsService) CountUnreadNotificationsHandler {
+	return &countUnreadNotificationsHandlerImpl{
+		service: service,
+	}
+}
+
+type countUnreadNotificationsHandlerImpl struct {
+	service services.CountUnreadNotificationsService
+}
+
+func (h *countUnreadNotificationsHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	count, err := h.service.Count(c, token)
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"count": count})
+}
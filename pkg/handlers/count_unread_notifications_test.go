@@ -0,0 +1,73 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCountUnreadNotificationsHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+
+		serviceResp int
+		serviceErr  error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:          "Success",
+			authorization: "Bearer my-token",
+			serviceResp:   12,
+			expect:        map[string]interface{}{"count": float64(12)},
+			expectStatus:  http.StatusOK,
+		},
+		{
+			name:          "Error/ErrInvalidCredentials",
+			authorization: "Bearer my-token",
+			serviceErr:    goframework.ErrInvalidCredentials,
+			expectStatus:  http.StatusForbidden,
+		},
+		{
+			name:          "Error/InternalError",
+			authorization: "Bearer my-token",
+			serviceErr:    errors.New("uwups"),
+			expectStatus:  http.StatusInternalServerError,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewCountUnreadNotificationsService(t)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("GET", "/", nil)
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			service.On("Count", c, d.authorization).Return(d.serviceResp, d.serviceErr)
+
+			handler := handlers.NewCountUnreadNotificationsHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
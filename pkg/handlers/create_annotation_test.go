@@ -0,0 +1,188 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateAnnotationHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+
+		body interface{}
+
+		shouldCallService     bool
+		shouldCallServiceWith *models.AnnotationForm
+		serviceResp           *models.Annotation
+		serviceErr            error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:          "Success",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"revisionID":  goframework.NumberUUID(10).String(),
+				"startOffset": 4,
+				"endOffset":   9,
+				"quote":       "quick",
+				"content":     "content",
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "content",
+			},
+			serviceResp: &models.Annotation{
+				ID:          goframework.NumberUUID(1),
+				CreatedAt:   baseTime,
+				UserID:      goframework.NumberUUID(100),
+				SourceID:    goframework.NumberUUID(20),
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "content",
+			},
+			expect: map[string]interface{}{
+				"id":          goframework.NumberUUID(1).String(),
+				"createdAt":   baseTime.Format(time.RFC3339),
+				"updatedAt":   nil,
+				"userID":      goframework.NumberUUID(100).String(),
+				"sourceID":    goframework.NumberUUID(20).String(),
+				"revisionID":  goframework.NumberUUID(10).String(),
+				"startOffset": float64(4),
+				"endOffset":   float64(9),
+				"quote":       "quick",
+				"content":     "content",
+				"orphaned":    false,
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:          "Error/ErrInvalidCredentials",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"revisionID":  goframework.NumberUUID(10).String(),
+				"startOffset": 4,
+				"endOffset":   9,
+				"quote":       "quick",
+				"content":     "content",
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "content",
+			},
+			serviceErr:   goframework.ErrInvalidCredentials,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name:          "Error/ErrInvalidEntity",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"revisionID":  goframework.NumberUUID(10).String(),
+				"startOffset": 4,
+				"endOffset":   9,
+				"quote":       "brown",
+				"content":     "content",
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "brown",
+				Content:     "content",
+			},
+			serviceErr:   goframework.ErrInvalidEntity,
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "Error/ErrNotFound",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"revisionID":  goframework.NumberUUID(10).String(),
+				"startOffset": 4,
+				"endOffset":   9,
+				"quote":       "quick",
+				"content":     "content",
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "content",
+			},
+			serviceErr:   bunovel.ErrNotFound,
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name:          "Error/BadRequest",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"revisionID":  "fake uuid",
+				"startOffset": 4,
+				"endOffset":   9,
+				"quote":       "quick",
+				"content":     "content",
+			},
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewCreateAnnotationService(t)
+
+			mrshBody, err := json.Marshal(d.body)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("POST", "/", bytes.NewReader(mrshBody))
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("Create", c, d.authorization, d.shouldCallServiceWith, mock.Anything, mock.Anything).
+					Return(d.serviceResp, d.serviceErr)
+			}
+
+			handler := handlers.NewCreateAnnotationHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateCommentHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+
+		body interface{}
+
+		shouldCallService bool
+		serviceResp       *models.Comment
+		serviceErr        error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:          "Success",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"targetType": models.CommentTargetImproveSuggestion,
+				"targetID":   goframework.NumberUUID(10).String(),
+				"parentID":   goframework.NumberUUID(2).String(),
+				"content":    "content",
+			},
+			shouldCallService: true,
+			serviceResp: &models.Comment{
+				ID:         goframework.NumberUUID(1),
+				CreatedAt:  baseTime,
+				UserID:     goframework.NumberUUID(100),
+				TargetType: models.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				ParentID:   lo.ToPtr(goframework.NumberUUID(2)),
+				Content:    "content",
+			},
+			expect: map[string]interface{}{
+				"id":         goframework.NumberUUID(1).String(),
+				"createdAt":  baseTime.Format(time.RFC3339),
+				"updatedAt":  nil,
+				"userID":     goframework.NumberUUID(100).String(),
+				"targetType": models.CommentTargetImproveSuggestion,
+				"targetID":   goframework.NumberUUID(10).String(),
+				"parentID":   goframework.NumberUUID(2).String(),
+				"content":    "content",
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:          "Error/ErrInvalidCredentials",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"targetType": models.CommentTargetImproveRequest,
+				"targetID":   goframework.NumberUUID(10).String(),
+				"content":    "content",
+			},
+			shouldCallService: true,
+			serviceErr:        goframework.ErrInvalidCredentials,
+			expectStatus:      http.StatusForbidden,
+		},
+		{
+			name:          "Error/ErrInvalidEntity",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"targetType": models.CommentTargetImproveRequest,
+				"targetID":   goframework.NumberUUID(10).String(),
+				"content":    "content",
+			},
+			shouldCallService: true,
+			serviceErr:        goframework.ErrInvalidEntity,
+			expectStatus:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "Error/ErrNotFound",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"targetType": models.CommentTargetImproveRequest,
+				"targetID":   goframework.NumberUUID(10).String(),
+				"content":    "content",
+			},
+			shouldCallService: true,
+			serviceErr:        bunovel.ErrNotFound,
+			expectStatus:      http.StatusNotFound,
+		},
+		{
+			name:          "Error/BadRequest",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"targetType": models.CommentTargetImproveRequest,
+				"targetID":   "fake uuid",
+				"content":    "content",
+			},
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewCreateCommentService(t)
+
+			mrshBody, err := json.Marshal(d.body)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("POST", "/", bytes.NewReader(mrshBody))
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("Create", c, d.authorization, mock.Anything, mock.Anything, mock.Anything).
+					Return(d.serviceResp, d.serviceErr)
+			}
+
+			handler := handlers.NewCreateCommentHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
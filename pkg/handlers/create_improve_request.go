@@ -34,12 +34,14 @@ func (h *createImproveRequestHandlerImpl) Handle(c *gin.Context) {
 		return
 	}
 
-	res, err := h.service.Create(c, token, form.Title, form.Content, form.SourceID, uuid.New(), time.Now())
+	res, err := h.service.Create(c, token, form.Title, form.Content, form.Language, form.Tags, form.Draft, form.SourceID, uuid.New(), time.Now())
 	if err != nil {
+		setRetryAfter(c, err)
 		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
 			{services.ErrNotTheCreator, http.StatusUnauthorized},
 			{goframework.ErrInvalidCredentials, http.StatusForbidden},
 			{goframework.ErrInvalidEntity, http.StatusUnprocessableEntity},
+			{services.ErrRateLimited, http.StatusTooManyRequests},
 		}, true)
 		return
 	}
@@ -3,6 +3,7 @@ package handlers_test
 import (
 	"bytes"
 	"encoding/json"
+	goerrors "errors"
 	"github.com/a-novel/forum-service/pkg/handlers"
 	"github.com/a-novel/forum-service/pkg/models"
 	"github.com/a-novel/forum-service/pkg/services"
@@ -26,15 +27,19 @@ func TestCreateImproveRequestHandler(t *testing.T) {
 
 		body interface{}
 
-		shouldCallService            bool
-		shouldCallServiceWithTitle   string
-		shouldCallServiceWithContent string
-		shouldCallServiceWithSource  uuid.UUID
-		serviceResp                  *models.ImproveRequestPreview
-		serviceErr                   error
+		shouldCallService             bool
+		shouldCallServiceWithTitle    string
+		shouldCallServiceWithContent  string
+		shouldCallServiceWithLanguage string
+		shouldCallServiceWithTags     []string
+		shouldCallServiceWithDraft    bool
+		shouldCallServiceWithSource   uuid.UUID
+		serviceResp                   *models.ImproveRequestPreview
+		serviceErr                    error
 
-		expect       interface{}
-		expectStatus int
+		expect           interface{}
+		expectStatus     int
+		expectRetryAfter string
 	}{
 		{
 			name:          "Success",
@@ -42,11 +47,85 @@ func TestCreateImproveRequestHandler(t *testing.T) {
 			body: map[string]interface{}{
 				"title":    "title",
 				"content":  "content",
+				"language": "en",
+				"sourceID": goframework.NumberUUID(10).String(),
+			},
+			shouldCallService:             true,
+			shouldCallServiceWithTitle:    "title",
+			shouldCallServiceWithContent:  "content",
+			shouldCallServiceWithLanguage: "en",
+			shouldCallServiceWithSource:   goframework.NumberUUID(10),
+			serviceResp: &models.ImproveRequestPreview{
+				ID:        goframework.NumberUUID(10),
+				CreatedAt: baseTime,
+				UserID:    goframework.NumberUUID(100),
+				Title:     "title",
+				Content:   "content",
+			},
+			expect: map[string]interface{}{
+				"id":                       goframework.NumberUUID(10).String(),
+				"createdAt":                baseTime.Format(time.RFC3339),
+				"userID":                   goframework.NumberUUID(100).String(),
+				"title":                    "title",
+				"content":                  "content",
+				"upVotes":                  float64(0),
+				"downVotes":                float64(0),
+				"revisionsCount":           float64(0),
+				"suggestionsCount":         float64(0),
+				"acceptedSuggestionsCount": float64(0),
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:          "Success/Tags",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"title":    "title",
+				"content":  "content",
+				"tags":     []string{"horror", "dialogue"},
+				"sourceID": goframework.NumberUUID(10).String(),
+			},
+			shouldCallService:            true,
+			shouldCallServiceWithTitle:   "title",
+			shouldCallServiceWithContent: "content",
+			shouldCallServiceWithTags:    []string{"horror", "dialogue"},
+			shouldCallServiceWithSource:  goframework.NumberUUID(10),
+			serviceResp: &models.ImproveRequestPreview{
+				ID:        goframework.NumberUUID(10),
+				CreatedAt: baseTime,
+				UserID:    goframework.NumberUUID(100),
+				Title:     "title",
+				Content:   "content",
+				Tags:      []string{"horror", "dialogue"},
+			},
+			expect: map[string]interface{}{
+				"id":                       goframework.NumberUUID(10).String(),
+				"createdAt":                baseTime.Format(time.RFC3339),
+				"userID":                   goframework.NumberUUID(100).String(),
+				"title":                    "title",
+				"content":                  "content",
+				"upVotes":                  float64(0),
+				"downVotes":                float64(0),
+				"revisionsCount":           float64(0),
+				"suggestionsCount":         float64(0),
+				"acceptedSuggestionsCount": float64(0),
+				"tags":                     []interface{}{"horror", "dialogue"},
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:          "Success/Draft",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"title":    "title",
+				"content":  "content",
+				"draft":    true,
 				"sourceID": goframework.NumberUUID(10).String(),
 			},
 			shouldCallService:            true,
 			shouldCallServiceWithTitle:   "title",
 			shouldCallServiceWithContent: "content",
+			shouldCallServiceWithDraft:   true,
 			shouldCallServiceWithSource:  goframework.NumberUUID(10),
 			serviceResp: &models.ImproveRequestPreview{
 				ID:        goframework.NumberUUID(10),
@@ -54,6 +133,7 @@ func TestCreateImproveRequestHandler(t *testing.T) {
 				UserID:    goframework.NumberUUID(100),
 				Title:     "title",
 				Content:   "content",
+				Draft:     true,
 			},
 			expect: map[string]interface{}{
 				"id":                       goframework.NumberUUID(10).String(),
@@ -66,6 +146,7 @@ func TestCreateImproveRequestHandler(t *testing.T) {
 				"revisionsCount":           float64(0),
 				"suggestionsCount":         float64(0),
 				"acceptedSuggestionsCount": float64(0),
+				"draft":                    true,
 			},
 			expectStatus: http.StatusCreated,
 		},
@@ -114,6 +195,22 @@ func TestCreateImproveRequestHandler(t *testing.T) {
 			serviceErr:                   goframework.ErrInvalidEntity,
 			expectStatus:                 http.StatusUnprocessableEntity,
 		},
+		{
+			name:          "Error/ErrRateLimited",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"title":    "title",
+				"content":  "content",
+				"sourceID": goframework.NumberUUID(10).String(),
+			},
+			shouldCallService:            true,
+			shouldCallServiceWithTitle:   "title",
+			shouldCallServiceWithContent: "content",
+			shouldCallServiceWithSource:  goframework.NumberUUID(10),
+			serviceErr:                   goerrors.Join(services.ErrRateLimited, &services.RetryAfterError{Delay: 89500 * time.Millisecond}),
+			expectStatus:                 http.StatusTooManyRequests,
+			expectRetryAfter:             "90",
+		},
 		{
 			name:          "Error/BadRequest",
 			authorization: "Bearer my-token",
@@ -145,6 +242,9 @@ func TestCreateImproveRequestHandler(t *testing.T) {
 						d.authorization,
 						d.shouldCallServiceWithTitle,
 						d.shouldCallServiceWithContent,
+						d.shouldCallServiceWithLanguage,
+						d.shouldCallServiceWithTags,
+						d.shouldCallServiceWithDraft,
 						d.shouldCallServiceWithSource,
 						mock.Anything, mock.Anything,
 					).
@@ -155,6 +255,7 @@ func TestCreateImproveRequestHandler(t *testing.T) {
 			handler.Handle(c)
 
 			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			require.Equal(t, d.expectRetryAfter, w.Header().Get("Retry-After"))
 			if d.expect != nil {
 				var body interface{}
 				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
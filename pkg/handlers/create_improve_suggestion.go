@@ -37,10 +37,13 @@ func (h *createImproveSuggestionHandlerImpl) Handle(c *gin.Context) {
 
 	res, err := h.service.Create(c, token, form, uuid.New(), time.Now())
 	if err != nil {
+		setRetryAfter(c, err)
 		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
 			{goframework.ErrInvalidCredentials, http.StatusForbidden},
 			{bunovel.ErrNotFound, http.StatusNotFound},
 			{goframework.ErrInvalidEntity, http.StatusUnprocessableEntity},
+			{services.ErrRequestNotOpen, http.StatusConflict},
+			{services.ErrRateLimited, http.StatusTooManyRequests},
 		}, true)
 		return
 	}
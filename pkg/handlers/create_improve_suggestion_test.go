@@ -3,9 +3,11 @@ package handlers_test
 import (
 	"bytes"
 	"encoding/json"
+	goerrors "errors"
 	"github.com/a-novel/bunovel"
 	"github.com/a-novel/forum-service/pkg/handlers"
 	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
 	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/gin-gonic/gin"
@@ -29,8 +31,9 @@ func TestCreateImproveSuggestionHandler(t *testing.T) {
 		serviceResp       *models.ImproveSuggestion
 		serviceErr        error
 
-		expect       interface{}
-		expectStatus int
+		expect           interface{}
+		expectStatus     int
+		expectRetryAfter string
 	}{
 		{
 			name:          "Success",
@@ -101,6 +104,31 @@ func TestCreateImproveSuggestionHandler(t *testing.T) {
 			serviceErr:        bunovel.ErrNotFound,
 			expectStatus:      http.StatusNotFound,
 		},
+		{
+			name:          "Error/ErrRequestNotOpen",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"title":     "title",
+				"content":   "content",
+				"requestID": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService: true,
+			serviceErr:        services.ErrRequestNotOpen,
+			expectStatus:      http.StatusConflict,
+		},
+		{
+			name:          "Error/ErrRateLimited",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"title":     "title",
+				"content":   "content",
+				"requestID": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService: true,
+			serviceErr:        goerrors.Join(services.ErrRateLimited, &services.RetryAfterError{Delay: 90 * time.Second}),
+			expectStatus:      http.StatusTooManyRequests,
+			expectRetryAfter:  "90",
+		},
 		{
 			name:          "Error/BadRequest",
 			authorization: "Bearer my-token",
@@ -135,6 +163,7 @@ func TestCreateImproveSuggestionHandler(t *testing.T) {
 			handler.Handle(c)
 
 			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			require.Equal(t, d.expectRetryAfter, w.Header().Get("Retry-After"))
 			if d.expect != nil {
 				var body interface{}
 				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
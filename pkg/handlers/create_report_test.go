@@ -0,0 +1,190 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateReportHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+
+		body interface{}
+
+		shouldCallService     bool
+		shouldCallServiceWith *models.ReportForm
+		serviceResp           *models.Report
+		serviceErr            error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:          "Success",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"targetType": models.ReportTargetComment,
+				"targetID":   goframework.NumberUUID(10).String(),
+				"reason":     models.ReportReasonSpam,
+				"content":    "content",
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.ReportForm{
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+				Content:    "content",
+			},
+			serviceResp: &models.Report{
+				ID:         goframework.NumberUUID(1),
+				CreatedAt:  baseTime,
+				UserID:     goframework.NumberUUID(100),
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+				Content:    "content",
+				Status:     models.ReportStatusOpen,
+			},
+			expect: map[string]interface{}{
+				"id":          goframework.NumberUUID(1).String(),
+				"createdAt":   baseTime.Format(time.RFC3339),
+				"updatedAt":   nil,
+				"userID":      goframework.NumberUUID(100).String(),
+				"targetType":  models.ReportTargetComment,
+				"targetID":    goframework.NumberUUID(10).String(),
+				"reason":      models.ReportReasonSpam,
+				"content":     "content",
+				"status":      models.ReportStatusOpen,
+				"moderatorID": nil,
+				"claimedAt":   nil,
+				"resolvedAt":  nil,
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:          "Error/ErrInvalidCredentials",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"targetType": models.ReportTargetComment,
+				"targetID":   goframework.NumberUUID(10).String(),
+				"reason":     models.ReportReasonSpam,
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.ReportForm{
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+			},
+			serviceErr:   goframework.ErrInvalidCredentials,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name:          "Error/ErrNotFound",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"targetType": models.ReportTargetComment,
+				"targetID":   goframework.NumberUUID(10).String(),
+				"reason":     models.ReportReasonSpam,
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.ReportForm{
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+			},
+			serviceErr:   bunovel.ErrNotFound,
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name:          "Error/ErrInvalidEntity",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"targetType": models.ReportTargetComment,
+				"targetID":   goframework.NumberUUID(10).String(),
+				"reason":     "fake",
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.ReportForm{
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     "fake",
+			},
+			serviceErr:   goframework.ErrInvalidEntity,
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "Error/InternalError",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"targetType": models.ReportTargetComment,
+				"targetID":   goframework.NumberUUID(10).String(),
+				"reason":     models.ReportReasonSpam,
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.ReportForm{
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+			},
+			serviceErr:   errors.New("uwups"),
+			expectStatus: http.StatusInternalServerError,
+		},
+		{
+			name:          "Error/BadRequest",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"targetType": models.ReportTargetComment,
+				"targetID":   "fake uuid",
+				"reason":     models.ReportReasonSpam,
+			},
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewCreateReportService(t)
+
+			mrshBody, err := json.Marshal(d.body)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("PUT", "/", bytes.NewReader(mrshBody))
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("Create", c, d.authorization, d.shouldCallServiceWith, mock.Anything, mock.Anything).
+					Return(d.serviceResp, d.serviceErr)
+			}
+
+			handler := handlers.NewCreateReportHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type DeleteAnnotationHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewDeleteAnnotationHandler(service services.DeleteAnnotationService) DeleteAnnotationHandler {
+	return &deleteAnnotationHandlerImpl{
+		service: service,
+	}
+}
+
+type deleteAnnotationHandlerImpl struct {
+	service services.DeleteAnnotationService
+}
+
+func (h *deleteAnnotationHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	query := new(models.DeleteAnnotationQuery)
+	if err := c.BindQuery(query); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.service.Delete(c, token, query.ID.Value()); err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{services.ErrNotTheCreator, http.StatusUnauthorized},
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{bunovel.ErrNotFound, http.StatusNotFound},
+		}, false)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
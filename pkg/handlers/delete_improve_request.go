@@ -7,6 +7,7 @@ import (
 	goframework "github.com/a-novel/go-framework"
 	"github.com/gin-gonic/gin"
 	"net/http"
+	"time"
 )
 
 type DeleteImproveRequestHandler interface {
@@ -32,7 +33,7 @@ func (h *deleteImproveRequestHandlerImpl) Handle(c *gin.Context) {
 		return
 	}
 
-	err := h.service.Delete(c, token, query.ID.Value())
+	err := h.service.Delete(c, token, query.ID.Value(), time.Now())
 	if err != nil {
 		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
 			{services.ErrNotTheCreator, http.StatusUnauthorized},
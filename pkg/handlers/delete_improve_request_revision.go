@@ -7,6 +7,7 @@ import (
 	goframework "github.com/a-novel/go-framework"
 	"github.com/gin-gonic/gin"
 	"net/http"
+	"time"
 )
 
 type DeleteImproveRequestRevisionHandler interface {
@@ -32,11 +33,12 @@ func (h *deleteImproveRequestRevisionHandlerImpl) Handle(c *gin.Context) {
 		return
 	}
 
-	err := h.service.Delete(c, token, query.ID.Value())
+	err := h.service.Delete(c, token, query.ID.Value(), query.Suggestions, time.Now())
 	if err != nil {
 		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
 			{services.ErrNotTheCreator, http.StatusUnauthorized},
 			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{goframework.ErrInvalidEntity, http.StatusUnprocessableEntity},
 		}, false)
 		return
 	}
@@ -8,6 +8,7 @@ import (
 	goframework "github.com/a-novel/go-framework"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"net/http"
 	"net/http/httptest"
@@ -21,9 +22,10 @@ func TestDeleteImproveRequestRevisionHandler(t *testing.T) {
 		authorization string
 		query         string
 
-		shouldCallService       bool
-		shouldCallServiceWithID uuid.UUID
-		serviceErr              error
+		shouldCallService           bool
+		shouldCallServiceWithID     uuid.UUID
+		shouldCallServiceWithPolicy string
+		serviceErr                  error
 
 		expect       interface{}
 		expectStatus int
@@ -36,6 +38,15 @@ func TestDeleteImproveRequestRevisionHandler(t *testing.T) {
 			shouldCallServiceWithID: goframework.NumberUUID(1),
 			expectStatus:            http.StatusNoContent,
 		},
+		{
+			name:                        "Success/WithPolicy",
+			authorization:               "Bearer my-token",
+			query:                       "?id=01010101-0101-0101-0101-010101010101&suggestions=cascade",
+			shouldCallService:           true,
+			shouldCallServiceWithID:     goframework.NumberUUID(1),
+			shouldCallServiceWithPolicy: "cascade",
+			expectStatus:                http.StatusNoContent,
+		},
 		{
 			name:                    "Error/ErrInvalidCredentials",
 			authorization:           "Bearer my-token",
@@ -54,6 +65,16 @@ func TestDeleteImproveRequestRevisionHandler(t *testing.T) {
 			serviceErr:              services.ErrNotTheCreator,
 			expectStatus:            http.StatusUnauthorized,
 		},
+		{
+			name:                        "Error/ErrInvalidEntity",
+			authorization:               "Bearer my-token",
+			query:                       "?id=01010101-0101-0101-0101-010101010101&suggestions=fake",
+			shouldCallService:           true,
+			shouldCallServiceWithID:     goframework.NumberUUID(1),
+			shouldCallServiceWithPolicy: "fake",
+			serviceErr:                  goframework.ErrInvalidEntity,
+			expectStatus:                http.StatusUnprocessableEntity,
+		},
 	}
 
 	for _, d := range data {
@@ -67,7 +88,7 @@ func TestDeleteImproveRequestRevisionHandler(t *testing.T) {
 
 			if d.shouldCallService {
 				service.
-					On("Delete", c, d.authorization, d.shouldCallServiceWithID).
+					On("Delete", c, d.authorization, d.shouldCallServiceWithID, d.shouldCallServiceWithPolicy, mock.Anything).
 					Return(d.serviceErr)
 			}
 
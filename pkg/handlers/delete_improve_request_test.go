@@ -8,6 +8,7 @@ import (
 	goframework "github.com/a-novel/go-framework"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"net/http"
 	"net/http/httptest"
@@ -67,7 +68,7 @@ func TestDeleteImproveRequestHandler(t *testing.T) {
 
 			if d.shouldCallService {
 				service.
-					On("Delete", c, d.authorization, d.shouldCallServiceWithID).
+					On("Delete", c, d.authorization, d.shouldCallServiceWithID, mock.Anything).
 					Return(d.serviceErr)
 			}
 
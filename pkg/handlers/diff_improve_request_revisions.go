@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type DiffImproveRequestRevisionsHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewDiffImproveRequestRevisionsHandler(service services.DiffImproveRequestRevisionsService) DiffImproveRequestRevisionsHandler {
+	return &diffImproveRequestRevisionsHandlerImpl{
+		service: service,
+	}
+}
+
+type diffImproveRequestRevisionsHandlerImpl struct {
+	service services.DiffImproveRequestRevisionsService
+}
+
+func (h *diffImproveRequestRevisionsHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	query := new(models.DiffImproveRequestRevisionsQuery)
+	if err := c.BindQuery(query); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.service.Diff(c, token, query.From.Value(), query.To.Value())
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{bunovel.ErrNotFound, http.StatusNotFound},
+			{goframework.ErrInvalidEntity, http.StatusUnprocessableEntity},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
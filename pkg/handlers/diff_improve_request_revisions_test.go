@@ -0,0 +1,131 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDiffImproveRequestRevisionsHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+		query         string
+
+		shouldCallService     bool
+		shouldCallServiceFrom uuid.UUID
+		shouldCallServiceTo   uuid.UUID
+		serviceResp           *models.Diff
+		serviceErr            error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:                  "Success",
+			authorization:         "Bearer my-token",
+			query:                 "?from=01010101-0101-0101-0101-010101010101&to=02020202-0202-0202-0202-020202020202",
+			shouldCallService:     true,
+			shouldCallServiceFrom: goframework.NumberUUID(1),
+			shouldCallServiceTo:   goframework.NumberUUID(2),
+			serviceResp: &models.Diff{
+				Title: &models.TextDiff{
+					Words: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "my title"},
+					},
+					Sentences: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "my title"},
+					},
+				},
+				Content: &models.TextDiff{
+					Words: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "The fox."},
+						{Operation: models.DiffOperationInsert, Text: " It jumps."},
+					},
+					Sentences: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "The fox."},
+						{Operation: models.DiffOperationInsert, Text: " It jumps."},
+					},
+				},
+			},
+			expect: map[string]interface{}{
+				"title": map[string]interface{}{
+					"words": []interface{}{
+						map[string]interface{}{"operation": "equal", "text": "my title"},
+					},
+					"sentences": []interface{}{
+						map[string]interface{}{"operation": "equal", "text": "my title"},
+					},
+				},
+				"content": map[string]interface{}{
+					"words": []interface{}{
+						map[string]interface{}{"operation": "equal", "text": "The fox."},
+						map[string]interface{}{"operation": "insert", "text": " It jumps."},
+					},
+					"sentences": []interface{}{
+						map[string]interface{}{"operation": "equal", "text": "The fox."},
+						map[string]interface{}{"operation": "insert", "text": " It jumps."},
+					},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:                  "Error/ErrInvalidEntity",
+			query:                 "?from=01010101-0101-0101-0101-010101010101&to=02020202-0202-0202-0202-020202020202",
+			shouldCallService:     true,
+			shouldCallServiceFrom: goframework.NumberUUID(1),
+			shouldCallServiceTo:   goframework.NumberUUID(2),
+			serviceErr:            goframework.ErrInvalidEntity,
+			expectStatus:          http.StatusUnprocessableEntity,
+		},
+		{
+			name:                  "Error/NotFound",
+			query:                 "?from=01010101-0101-0101-0101-010101010101&to=02020202-0202-0202-0202-020202020202",
+			shouldCallService:     true,
+			shouldCallServiceFrom: goframework.NumberUUID(1),
+			shouldCallServiceTo:   goframework.NumberUUID(2),
+			serviceErr:            bunovel.ErrNotFound,
+			expectStatus:          http.StatusNotFound,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewDiffImproveRequestRevisionsService(t)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("POST", "/"+d.query, nil)
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("Diff", c, d.authorization, d.shouldCallServiceFrom, d.shouldCallServiceTo).
+					Return(d.serviceResp, d.serviceErr)
+			}
+
+			handler := handlers.NewDiffImproveRequestRevisionsHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
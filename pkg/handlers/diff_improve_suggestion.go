@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type DiffImproveSuggestionHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewDiffImproveSuggestionHandler(service services.DiffImproveSuggestionService) DiffImproveSuggestionHandler {
+	return &diffImproveSuggestionHandlerImpl{
+		service: service,
+	}
+}
+
+type diffImproveSuggestionHandlerImpl struct {
+	service services.DiffImproveSuggestionService
+}
+
+func (h *diffImproveSuggestionHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	query := new(models.DiffImproveSuggestionQuery)
+	if err := c.BindQuery(query); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.service.Diff(c, token, query.ID.Value())
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{bunovel.ErrNotFound, http.StatusNotFound},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,123 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDiffImproveSuggestionHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+		query         string
+
+		shouldCallService       bool
+		shouldCallServiceWithID uuid.UUID
+		serviceResp             *models.Diff
+		serviceErr              error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:                    "Success",
+			authorization:           "Bearer my-token",
+			query:                   "?id=01010101-0101-0101-0101-010101010101",
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceResp: &models.Diff{
+				Title: &models.TextDiff{
+					Words: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "my title"},
+					},
+					Sentences: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "my title"},
+					},
+				},
+				Content: &models.TextDiff{
+					Words: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "The "},
+						{Operation: models.DiffOperationDelete, Text: "quick"},
+						{Operation: models.DiffOperationInsert, Text: "slow"},
+						{Operation: models.DiffOperationEqual, Text: " fox."},
+					},
+					Sentences: []*models.DiffChunk{
+						{Operation: models.DiffOperationDelete, Text: "The quick fox."},
+						{Operation: models.DiffOperationInsert, Text: "The slow fox."},
+					},
+				},
+			},
+			expect: map[string]interface{}{
+				"title": map[string]interface{}{
+					"words": []interface{}{
+						map[string]interface{}{"operation": "equal", "text": "my title"},
+					},
+					"sentences": []interface{}{
+						map[string]interface{}{"operation": "equal", "text": "my title"},
+					},
+				},
+				"content": map[string]interface{}{
+					"words": []interface{}{
+						map[string]interface{}{"operation": "equal", "text": "The "},
+						map[string]interface{}{"operation": "delete", "text": "quick"},
+						map[string]interface{}{"operation": "insert", "text": "slow"},
+						map[string]interface{}{"operation": "equal", "text": " fox."},
+					},
+					"sentences": []interface{}{
+						map[string]interface{}{"operation": "delete", "text": "The quick fox."},
+						map[string]interface{}{"operation": "insert", "text": "The slow fox."},
+					},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:                    "Error/NotFound",
+			query:                   "?id=01010101-0101-0101-0101-010101010101",
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceErr:              bunovel.ErrNotFound,
+			expectStatus:            http.StatusNotFound,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewDiffImproveSuggestionService(t)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("POST", "/"+d.query, nil)
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("Diff", c, d.authorization, d.shouldCallServiceWithID).
+					Return(d.serviceResp, d.serviceErr)
+			}
+
+			handler := handlers.NewDiffImproveSuggestionHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
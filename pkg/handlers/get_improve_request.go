@@ -24,13 +24,15 @@ type getImproveRequestHandlerImpl struct {
 }
 
 func (h *getImproveRequestHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
 	query := new(models.GetImproveRequestQuery)
 	if err := c.BindQuery(query); err != nil {
 		_ = c.AbortWithError(http.StatusBadRequest, err)
 		return
 	}
 
-	request, err := h.service.Get(c, query.ID.Value())
+	request, err := h.service.Get(c, token, query.ID.Value())
 	if err != nil {
 		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
 			{bunovel.ErrNotFound, http.StatusNotFound},
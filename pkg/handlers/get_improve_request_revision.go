@@ -24,13 +24,15 @@ type getImproveRequestRevisionHandlerImpl struct {
 }
 
 func (h *getImproveRequestRevisionHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
 	query := new(models.GetImproveRequestRevisionQuery)
 	if err := c.BindQuery(query); err != nil {
 		_ = c.AbortWithError(http.StatusBadRequest, err)
 		return
 	}
 
-	revision, err := h.service.Get(c, query.ID.Value())
+	revision, err := h.service.Get(c, token, query.ID.Value())
 	if err != nil {
 		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
 			{bunovel.ErrNotFound, http.StatusNotFound},
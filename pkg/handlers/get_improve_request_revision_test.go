@@ -20,7 +20,8 @@ func TestGetImproveRequestRevisionHandler(t *testing.T) {
 	data := []struct {
 		name string
 
-		query string
+		authorization string
+		query         string
 
 		shouldCallService       bool
 		shouldCallServiceWithID uuid.UUID
@@ -32,6 +33,7 @@ func TestGetImproveRequestRevisionHandler(t *testing.T) {
 	}{
 		{
 			name:                    "Success",
+			authorization:           "token",
 			query:                   "?id=01010101-0101-0101-0101-010101010101",
 			shouldCallService:       true,
 			shouldCallServiceWithID: goframework.NumberUUID(1),
@@ -42,14 +44,17 @@ func TestGetImproveRequestRevisionHandler(t *testing.T) {
 				UserID:    goframework.NumberUUID(100),
 				Title:     "title",
 				Content:   "content",
+				Language:  "fr",
 			},
 			expect: map[string]interface{}{
-				"id":        goframework.NumberUUID(1).String(),
-				"createdAt": baseTime.Format(time.RFC3339),
-				"sourceID":  goframework.NumberUUID(10).String(),
-				"userID":    goframework.NumberUUID(100).String(),
-				"title":     "title",
-				"content":   "content",
+				"id":            goframework.NumberUUID(1).String(),
+				"createdAt":     baseTime.Format(time.RFC3339),
+				"sourceID":      goframework.NumberUUID(10).String(),
+				"userID":        goframework.NumberUUID(100).String(),
+				"title":         "title",
+				"content":       "content",
+				"suggestionIDs": nil,
+				"language":      "fr",
 			},
 			expectStatus: http.StatusOK,
 		},
@@ -70,10 +75,11 @@ func TestGetImproveRequestRevisionHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(w)
 			c.Request = httptest.NewRequest("POST", "/"+d.query, nil)
+			c.Request.Header.Set("Authorization", d.authorization)
 
 			if d.shouldCallService {
 				service.
-					On("Get", c, d.shouldCallServiceWithID).
+					On("Get", c, d.authorization, d.shouldCallServiceWithID).
 					Return(d.serviceResp, d.serviceErr)
 			}
 
@@ -20,7 +20,8 @@ func TestGetImproveRequestHandler(t *testing.T) {
 	data := []struct {
 		name string
 
-		query string
+		authorization string
+		query         string
 
 		shouldCallService       bool
 		shouldCallServiceWithID uuid.UUID
@@ -32,6 +33,7 @@ func TestGetImproveRequestHandler(t *testing.T) {
 	}{
 		{
 			name:                    "Success",
+			authorization:           "token",
 			query:                   "?id=01010101-0101-0101-0101-010101010101",
 			shouldCallService:       true,
 			shouldCallServiceWithID: goframework.NumberUUID(1),
@@ -78,10 +80,11 @@ func TestGetImproveRequestHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(w)
 			c.Request = httptest.NewRequest("POST", "/"+d.query, nil)
+			c.Request.Header.Set("Authorization", d.authorization)
 
 			if d.shouldCallService {
 				service.
-					On("Get", c, d.shouldCallServiceWithID).
+					On("Get", c, d.authorization, d.shouldCallServiceWithID).
 					Return(d.serviceResp, d.serviceErr)
 			}
 
@@ -24,13 +24,15 @@ type getImproveSuggestionHandlerImpl struct {
 }
 
 func (h *getImproveSuggestionHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
 	query := new(models.GetImproveSuggestionQuery)
 	if err := c.BindQuery(query); err != nil {
 		_ = c.AbortWithError(http.StatusBadRequest, err)
 		return
 	}
 
-	suggestion, err := h.service.Get(c, query.ID.Value())
+	suggestion, err := h.service.Get(c, token, query.ID.Value())
 	if err != nil {
 		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
 			{bunovel.ErrNotFound, http.StatusNotFound},
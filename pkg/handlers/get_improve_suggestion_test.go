@@ -20,7 +20,8 @@ func TestGetImproveSuggestionHandler(t *testing.T) {
 	data := []struct {
 		name string
 
-		query string
+		authorization string
+		query         string
 
 		shouldCallService       bool
 		shouldCallServiceWithID uuid.UUID
@@ -32,6 +33,7 @@ func TestGetImproveSuggestionHandler(t *testing.T) {
 	}{
 		{
 			name:                    "Success",
+			authorization:           "token",
 			query:                   "?id=01010101-0101-0101-0101-010101010101",
 			shouldCallService:       true,
 			shouldCallServiceWithID: goframework.NumberUUID(1),
@@ -80,10 +82,11 @@ func TestGetImproveSuggestionHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(w)
 			c.Request = httptest.NewRequest("POST", "/"+d.query, nil)
+			c.Request.Header.Set("Authorization", d.authorization)
 
 			if d.shouldCallService {
 				service.
-					On("Get", c, d.shouldCallServiceWithID).
+					On("Get", c, d.authorization, d.shouldCallServiceWithID).
 					Return(d.serviceResp, d.serviceErr)
 			}
 
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type ListAnnotationsHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewListAnnotationsHandler(service services.ListAnnotationsService) ListAnnotationsHandler {
+	return &listAnnotationsHandlerImpl{
+		service: service,
+	}
+}
+
+type listAnnotationsHandlerImpl struct {
+	service services.ListAnnotationsService
+}
+
+func (h *listAnnotationsHandlerImpl) Handle(c *gin.Context) {
+	query := new(models.ListAnnotationsQuery)
+	if err := c.BindQuery(query); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	annotations, err := h.service.List(c, *query)
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"annotations": annotations})
+}
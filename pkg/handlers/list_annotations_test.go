@@ -0,0 +1,127 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListAnnotationsHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		query string
+
+		shouldCallService     bool
+		shouldCallServiceWith models.ListAnnotationsQuery
+		serviceResp           []*models.Annotation
+		serviceErr            error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:              "Success",
+			query:             "?revisionID=0a0a0a0a-0a0a-0a0a-0a0a-0a0a0a0a0a0a",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListAnnotationsQuery{
+				RevisionID: apis.StringUUID(goframework.NumberUUID(10).String()),
+			},
+			serviceResp: []*models.Annotation{
+				{
+					ID:          goframework.NumberUUID(1),
+					CreatedAt:   baseTime,
+					UpdatedAt:   lo.ToPtr(baseTime.Add(time.Hour)),
+					UserID:      goframework.NumberUUID(100),
+					SourceID:    goframework.NumberUUID(20),
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 4,
+					EndOffset:   9,
+					Quote:       "quick",
+					Content:     "content",
+				},
+			},
+			expect: map[string]interface{}{
+				"annotations": []interface{}{
+					map[string]interface{}{
+						"id":          goframework.NumberUUID(1).String(),
+						"createdAt":   baseTime.Format(time.RFC3339),
+						"updatedAt":   baseTime.Add(time.Hour).Format(time.RFC3339),
+						"userID":      goframework.NumberUUID(100).String(),
+						"sourceID":    goframework.NumberUUID(20).String(),
+						"revisionID":  goframework.NumberUUID(10).String(),
+						"startOffset": float64(4),
+						"endOffset":   float64(9),
+						"quote":       "quick",
+						"content":     "content",
+						"orphaned":    false,
+					},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:              "Success/Orphaned",
+			query:             "?revisionID=0a0a0a0a-0a0a-0a0a-0a0a-0a0a0a0a0a0a&orphaned=true",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListAnnotationsQuery{
+				RevisionID: apis.StringUUID(goframework.NumberUUID(10).String()),
+				Orphaned:   lo.ToPtr(true),
+			},
+			serviceResp: []*models.Annotation{},
+			expect: map[string]interface{}{
+				"annotations": []interface{}{},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:              "Error/InternalError",
+			query:             "?revisionID=0a0a0a0a-0a0a-0a0a-0a0a-0a0a0a0a0a0a",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListAnnotationsQuery{
+				RevisionID: apis.StringUUID(goframework.NumberUUID(10).String()),
+			},
+			serviceErr:   errors.New("uwups"),
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewListAnnotationsService(t)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("POST", "/"+d.query, nil)
+
+			if d.shouldCallService {
+				service.
+					On("List", c, d.shouldCallServiceWith).
+					Return(d.serviceResp, d.serviceErr)
+			}
+
+			handler := handlers.NewListAnnotationsHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type ListCommentsHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewListCommentsHandler(service services.ListCommentsService) ListCommentsHandler {
+	return &listCommentsHandlerImpl{
+		service: service,
+	}
+}
+
+type listCommentsHandlerImpl struct {
+	service services.ListCommentsService
+}
+
+func (h *listCommentsHandlerImpl) Handle(c *gin.Context) {
+	query := new(models.ListCommentsQuery)
+	if err := c.BindQuery(query); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	comments, total, err := h.service.List(c, *query)
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{goframework.ErrInvalidEntity, http.StatusBadRequest},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"res":   comments,
+		"total": total,
+	})
+}
@@ -0,0 +1,132 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListCommentsHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		query string
+
+		shouldCallService     bool
+		shouldCallServiceWith models.ListCommentsQuery
+		serviceResp           []*models.Comment
+		serviceRespTotal      int
+		serviceErr            error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:              "Success",
+			query:             "?targetType=improve_suggestion&targetID=0a0a0a0a-0a0a-0a0a-0a0a-0a0a0a0a0a0a&parentID=01010101-0101-0101-0101-010101010101&limit=10&offset=20",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListCommentsQuery{
+				TargetType: models.CommentTargetImproveSuggestion,
+				TargetID:   apis.StringUUID(goframework.NumberUUID(10).String()),
+				ParentID:   apis.StringUUID(goframework.NumberUUID(1).String()),
+				Limit:      10,
+				Offset:     20,
+			},
+			serviceResp: []*models.Comment{
+				{
+					ID:         goframework.NumberUUID(2),
+					CreatedAt:  baseTime,
+					UpdatedAt:  lo.ToPtr(baseTime.Add(time.Hour)),
+					UserID:     goframework.NumberUUID(100),
+					TargetType: models.CommentTargetImproveSuggestion,
+					TargetID:   goframework.NumberUUID(10),
+					ParentID:   lo.ToPtr(goframework.NumberUUID(1)),
+					Content:    "content",
+				},
+			},
+			serviceRespTotal: 200,
+			expect: map[string]interface{}{
+				"total": float64(200),
+				"res": []interface{}{
+					map[string]interface{}{
+						"id":         goframework.NumberUUID(2).String(),
+						"createdAt":  baseTime.Format(time.RFC3339),
+						"updatedAt":  baseTime.Add(time.Hour).Format(time.RFC3339),
+						"userID":     goframework.NumberUUID(100).String(),
+						"targetType": models.CommentTargetImproveSuggestion,
+						"targetID":   goframework.NumberUUID(10).String(),
+						"parentID":   goframework.NumberUUID(1).String(),
+						"content":    "content",
+					},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:              "Success/NoResults",
+			query:             "?targetType=improve_request&targetID=0a0a0a0a-0a0a-0a0a-0a0a-0a0a0a0a0a0a&limit=10",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListCommentsQuery{
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   apis.StringUUID(goframework.NumberUUID(10).String()),
+				Limit:      10,
+			},
+			serviceResp:      []*models.Comment{},
+			serviceRespTotal: 0,
+			expect: map[string]interface{}{
+				"total": float64(0),
+				"res":   []interface{}{},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:              "Error/ErrInvalidEntity",
+			query:             "?targetType=improve_request&targetID=0a0a0a0a-0a0a-0a0a-0a0a-0a0a0a0a0a0a",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListCommentsQuery{
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   apis.StringUUID(goframework.NumberUUID(10).String()),
+			},
+			serviceErr:   goframework.ErrInvalidEntity,
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewListCommentsService(t)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("POST", "/"+d.query, nil)
+
+			if d.shouldCallService {
+				service.
+					On("List", c, d.shouldCallServiceWith).
+					Return(d.serviceResp, d.serviceRespTotal, d.serviceErr)
+			}
+
+			handler := handlers.NewListCommentsHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
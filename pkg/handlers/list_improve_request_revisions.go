@@ -24,13 +24,15 @@ type listImproveRequestRevisionsHandlerImpl struct {
 }
 
 func (h *listImproveRequestRevisionsHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
 	query := new(models.ListImproveRequestRevisionsQuery)
 	if err := c.BindQuery(query); err != nil {
 		_ = c.AbortWithError(http.StatusBadRequest, err)
 		return
 	}
 
-	revisions, err := h.service.List(c, query.ID.Value())
+	revisions, err := h.service.List(c, token, query.ID.Value())
 	if err != nil {
 		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
 			{bunovel.ErrNotFound, http.StatusNotFound},
@@ -20,7 +20,8 @@ func TestListImproveRequestRevisionsHandler(t *testing.T) {
 	data := []struct {
 		name string
 
-		query string
+		authorization string
+		query         string
 
 		shouldCallService       bool
 		shouldCallServiceWithID uuid.UUID
@@ -32,6 +33,7 @@ func TestListImproveRequestRevisionsHandler(t *testing.T) {
 	}{
 		{
 			name:                    "Success",
+			authorization:           "token",
 			query:                   "?id=01010101-0101-0101-0101-010101010101",
 			shouldCallService:       true,
 			shouldCallServiceWithID: goframework.NumberUUID(1),
@@ -45,6 +47,7 @@ func TestListImproveRequestRevisionsHandler(t *testing.T) {
 				{
 					ID:                       goframework.NumberUUID(2),
 					CreatedAt:                baseTime,
+					SuggestionIDs:            []uuid.UUID{goframework.NumberUUID(20)},
 					SuggestionsCount:         8,
 					AcceptedSuggestionsCount: 4,
 				},
@@ -54,12 +57,14 @@ func TestListImproveRequestRevisionsHandler(t *testing.T) {
 					map[string]interface{}{
 						"id":                       goframework.NumberUUID(1).String(),
 						"createdAt":                baseTime.Format(time.RFC3339),
+						"suggestionIDs":            nil,
 						"suggestionsCount":         float64(10),
 						"acceptedSuggestionsCount": float64(5),
 					},
 					map[string]interface{}{
 						"id":                       goframework.NumberUUID(2).String(),
 						"createdAt":                baseTime.Format(time.RFC3339),
+						"suggestionIDs":            []interface{}{goframework.NumberUUID(20).String()},
 						"suggestionsCount":         float64(8),
 						"acceptedSuggestionsCount": float64(4),
 					},
@@ -84,10 +89,11 @@ func TestListImproveRequestRevisionsHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(w)
 			c.Request = httptest.NewRequest("POST", "/"+d.query, nil)
+			c.Request.Header.Set("Authorization", d.authorization)
 
 			if d.shouldCallService {
 				service.
-					On("List", c, d.shouldCallServiceWithID).
+					On("List", c, d.authorization, d.shouldCallServiceWithID).
 					Return(d.serviceResp, d.serviceErr)
 			}
 
@@ -22,13 +22,15 @@ type listImproveRequestsHandlerImpl struct {
 }
 
 func (h *listImproveRequestsHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
 	query := new(models.ListImproveRequestsQuery)
 	if err := c.BindQuery(query); err != nil {
 		_ = c.AbortWithError(http.StatusBadRequest, err)
 		return
 	}
 
-	previews, err := h.service.List(c, query.IDs.Value())
+	previews, err := h.service.List(c, token, query.IDs.Value())
 	if err != nil {
 		_ = c.AbortWithError(http.StatusInternalServerError, err)
 		return
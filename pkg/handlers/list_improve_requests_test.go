@@ -19,7 +19,8 @@ func TestListImproveRequestsHandler(t *testing.T) {
 	data := []struct {
 		name string
 
-		query string
+		authorization string
+		query         string
 
 		shouldCallService        bool
 		shouldCallServiceWithIDs []uuid.UUID
@@ -31,6 +32,7 @@ func TestListImproveRequestsHandler(t *testing.T) {
 	}{
 		{
 			name:                     "Success",
+			authorization:            "token",
 			query:                    "?ids=01010101-0101-0101-0101-010101010101,02020202-0202-0202-0202-020202020202",
 			shouldCallService:        true,
 			shouldCallServiceWithIDs: []uuid.UUID{goframework.NumberUUID(1), goframework.NumberUUID(2)},
@@ -99,10 +101,11 @@ func TestListImproveRequestsHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(w)
 			c.Request = httptest.NewRequest("POST", "/"+d.query, nil)
+			c.Request.Header.Set("Authorization", d.authorization)
 
 			if d.shouldCallService {
 				service.
-					On("List", c, d.shouldCallServiceWithIDs).
+					On("List", c, d.authorization, d.shouldCallServiceWithIDs).
 					Return(d.serviceResp, d.serviceErr)
 			}
 
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type ListNotificationsHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewListNotificationsHandler(service services.ListNotificationsService) ListNotificationsHandler {
+	return &listNotificationsHandlerImpl{
+		service: service,
+	}
+}
+
+type listNotificationsHandlerImpl struct {
+	service services.ListNotificationsService
+}
+
+func (h *listNotificationsHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	query := new(models.ListNotificationsQuery)
+	if err := c.BindQuery(query); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	notifications, total, err := h.service.List(c, token, *query)
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{goframework.ErrInvalidEntity, http.StatusBadRequest},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"res":   notifications,
+		"total": total,
+	})
+}
@@ -0,0 +1,153 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListNotificationsHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+		query         string
+
+		shouldCallService     bool
+		shouldCallServiceWith models.ListNotificationsQuery
+		serviceResp           []*models.Notification
+		serviceRespTotal      int
+		serviceErr            error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:              "Success",
+			authorization:     "Bearer my-token",
+			query:             "?unread=true&limit=10&offset=20",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListNotificationsQuery{
+				Unread: true,
+				Limit:  10,
+				Offset: 20,
+			},
+			serviceResp: []*models.Notification{
+				{
+					ID:        goframework.NumberUUID(1),
+					CreatedAt: baseTime,
+					Type:      models.EventTypeSuggestionCreated,
+					ActorID:   goframework.NumberUUID(200),
+					TargetID:  goframework.NumberUUID(20),
+					SourceID:  goframework.NumberUUID(10),
+				},
+				{
+					ID:        goframework.NumberUUID(2),
+					CreatedAt: baseTime,
+					Type:      models.EventTypeSuggestionValidated,
+					ActorID:   goframework.NumberUUID(300),
+					TargetID:  goframework.NumberUUID(30),
+					SourceID:  goframework.NumberUUID(10),
+					ReadAt:    lo.ToPtr(baseTime.Add(time.Hour)),
+				},
+			},
+			serviceRespTotal: 30,
+			expect: map[string]interface{}{
+				"res": []interface{}{
+					map[string]interface{}{
+						"id":        goframework.NumberUUID(1).String(),
+						"createdAt": baseTime.Format(time.RFC3339),
+						"type":      models.EventTypeSuggestionCreated,
+						"actorID":   goframework.NumberUUID(200).String(),
+						"targetID":  goframework.NumberUUID(20).String(),
+						"sourceID":  goframework.NumberUUID(10).String(),
+						"readAt":    nil,
+					},
+					map[string]interface{}{
+						"id":        goframework.NumberUUID(2).String(),
+						"createdAt": baseTime.Format(time.RFC3339),
+						"type":      models.EventTypeSuggestionValidated,
+						"actorID":   goframework.NumberUUID(300).String(),
+						"targetID":  goframework.NumberUUID(30).String(),
+						"sourceID":  goframework.NumberUUID(10).String(),
+						"readAt":    baseTime.Add(time.Hour).Format(time.RFC3339),
+					},
+				},
+				"total": float64(30),
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:              "Error/ErrInvalidCredentials",
+			authorization:     "Bearer my-token",
+			query:             "?limit=10",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListNotificationsQuery{
+				Limit: 10,
+			},
+			serviceErr:   goframework.ErrInvalidCredentials,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name:              "Error/ErrInvalidEntity",
+			authorization:     "Bearer my-token",
+			query:             "?limit=1000",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListNotificationsQuery{
+				Limit: 1000,
+			},
+			serviceErr:   goframework.ErrInvalidEntity,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "Error/InternalError",
+			authorization:     "Bearer my-token",
+			query:             "?limit=10",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListNotificationsQuery{
+				Limit: 10,
+			},
+			serviceErr:   errors.New("uwups"),
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewListNotificationsService(t)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("GET", "/"+d.query, nil)
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("List", c, d.authorization, d.shouldCallServiceWith).
+					Return(d.serviceResp, d.serviceRespTotal, d.serviceErr)
+			}
+
+			handler := handlers.NewListNotificationsHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type ListPopularTagsHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewListPopularTagsHandler(service services.ListPopularTagsService) ListPopularTagsHandler {
+	return &listPopularTagsHandlerImpl{
+		service: service,
+	}
+}
+
+type listPopularTagsHandlerImpl struct {
+	service services.ListPopularTagsService
+}
+
+func (h *listPopularTagsHandlerImpl) Handle(c *gin.Context) {
+	query := new(models.ListPopularTagsQuery)
+	if err := c.BindQuery(query); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	tags, err := h.service.List(c, query.Limit)
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{goframework.ErrInvalidEntity, http.StatusBadRequest},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"tags": tags})
+}
@@ -0,0 +1,87 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListPopularTagsHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		query string
+
+		shouldCallService          bool
+		shouldCallServiceWithLimit int
+		serviceResp                []*models.Tag
+		serviceErr                 error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:                       "Success",
+			query:                      "?limit=10",
+			shouldCallService:          true,
+			shouldCallServiceWithLimit: 10,
+			serviceResp: []*models.Tag{
+				{Name: "dialogue", Count: 3},
+				{Name: "horror", Count: 1},
+			},
+			expect: map[string]interface{}{
+				"tags": []interface{}{
+					map[string]interface{}{"name": "dialogue", "count": float64(3)},
+					map[string]interface{}{"name": "horror", "count": float64(1)},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:                       "Error/ErrInvalidEntity",
+			query:                      "?limit=1000",
+			shouldCallService:          true,
+			shouldCallServiceWithLimit: 1000,
+			serviceErr:                 goframework.ErrInvalidEntity,
+			expectStatus:               http.StatusBadRequest,
+		},
+		{
+			name:         "Error/InvalidQuery",
+			query:        "?limit=many",
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewListPopularTagsService(t)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("GET", "/"+d.query, nil)
+
+			if d.shouldCallService {
+				service.On("List", c, d.shouldCallServiceWithLimit).Return(d.serviceResp, d.serviceErr)
+			}
+
+			handler := handlers.NewListPopularTagsHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type ListReportsHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewListReportsHandler(service services.ListReportsService) ListReportsHandler {
+	return &listReportsHandlerImpl{
+		service: service,
+	}
+}
+
+type listReportsHandlerImpl struct {
+	service services.ListReportsService
+}
+
+func (h *listReportsHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	query := new(models.ListReportsQuery)
+	if err := c.BindQuery(query); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	reports, total, err := h.service.List(c, token, *query)
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{goframework.ErrInvalidEntity, http.StatusBadRequest},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"res":   reports,
+		"total": total,
+	})
+}
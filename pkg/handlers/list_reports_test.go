@@ -0,0 +1,149 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListReportsHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+		query         string
+
+		shouldCallService     bool
+		shouldCallServiceWith models.ListReportsQuery
+		serviceResp           []*models.Report
+		serviceRespTotal      int
+		serviceErr            error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:              "Success",
+			authorization:     "Bearer my-token",
+			query:             "?status=claimed&targetType=comment&moderatorID=" + goframework.NumberUUID(100).String() + "&limit=10&offset=20",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListReportsQuery{
+				Status:      models.ReportStatusClaimed,
+				TargetType:  models.ReportTargetComment,
+				ModeratorID: apis.StringUUID(goframework.NumberUUID(100).String()),
+				Limit:       10,
+				Offset:      20,
+			},
+			serviceResp: []*models.Report{
+				{
+					ID:          goframework.NumberUUID(1),
+					CreatedAt:   baseTime,
+					UpdatedAt:   lo.ToPtr(baseTime.Add(time.Hour)),
+					UserID:      goframework.NumberUUID(200),
+					TargetType:  models.ReportTargetComment,
+					TargetID:    goframework.NumberUUID(10),
+					Reason:      models.ReportReasonAbuse,
+					Content:     "content",
+					Status:      models.ReportStatusClaimed,
+					ModeratorID: lo.ToPtr(goframework.NumberUUID(100)),
+					ClaimedAt:   lo.ToPtr(baseTime.Add(time.Hour)),
+				},
+			},
+			serviceRespTotal: 30,
+			expect: map[string]interface{}{
+				"res": []interface{}{
+					map[string]interface{}{
+						"id":          goframework.NumberUUID(1).String(),
+						"createdAt":   baseTime.Format(time.RFC3339),
+						"updatedAt":   baseTime.Add(time.Hour).Format(time.RFC3339),
+						"userID":      goframework.NumberUUID(200).String(),
+						"targetType":  models.ReportTargetComment,
+						"targetID":    goframework.NumberUUID(10).String(),
+						"reason":      models.ReportReasonAbuse,
+						"content":     "content",
+						"status":      models.ReportStatusClaimed,
+						"moderatorID": goframework.NumberUUID(100).String(),
+						"claimedAt":   baseTime.Add(time.Hour).Format(time.RFC3339),
+						"resolvedAt":  nil,
+					},
+				},
+				"total": float64(30),
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:              "Error/ErrInvalidCredentials",
+			authorization:     "Bearer my-token",
+			query:             "?limit=10",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListReportsQuery{
+				Limit: 10,
+			},
+			serviceErr:   goframework.ErrInvalidCredentials,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name:              "Error/ErrInvalidEntity",
+			authorization:     "Bearer my-token",
+			query:             "?status=fake&limit=10",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListReportsQuery{
+				Status: "fake",
+				Limit:  10,
+			},
+			serviceErr:   goframework.ErrInvalidEntity,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "Error/InternalError",
+			authorization:     "Bearer my-token",
+			query:             "?limit=10",
+			shouldCallService: true,
+			shouldCallServiceWith: models.ListReportsQuery{
+				Limit: 10,
+			},
+			serviceErr:   errors.New("uwups"),
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewListReportsService(t)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("GET", "/"+d.query, nil)
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("List", c, d.authorization, d.shouldCallServiceWith).
+					Return(d.serviceResp, d.serviceRespTotal, d.serviceErr)
+			}
+
+			handler := handlers.NewListReportsHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
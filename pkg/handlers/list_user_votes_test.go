@@ -0,0 +1,114 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListUserVotesHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+		query         string
+
+		shouldCallService           bool
+		shouldCallServiceWithTarget string
+		shouldCallServiceWithIDs    []uuid.UUID
+		serviceResp                 []*models.Vote
+		serviceErr                  error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:                        "Success",
+			authorization:               "Bearer my-token",
+			query:                       "?targetType=improve_request&ids=01010101-0101-0101-0101-010101010101,02020202-0202-0202-0202-020202020202",
+			shouldCallService:           true,
+			shouldCallServiceWithTarget: models.VoteTargetImproveRequest,
+			shouldCallServiceWithIDs:    []uuid.UUID{goframework.NumberUUID(1), goframework.NumberUUID(2)},
+			serviceResp: []*models.Vote{
+				{
+					CreatedAt:  baseTime,
+					UpdatedAt:  lo.ToPtr(baseTime.Add(time.Hour)),
+					TargetType: models.VoteTargetImproveRequest,
+					TargetID:   goframework.NumberUUID(1),
+					Vote:       1,
+				},
+			},
+			expect: map[string]interface{}{
+				"votes": []interface{}{
+					map[string]interface{}{
+						"createdAt":  baseTime.Format(time.RFC3339),
+						"updatedAt":  baseTime.Add(time.Hour).Format(time.RFC3339),
+						"targetType": models.VoteTargetImproveRequest,
+						"targetID":   goframework.NumberUUID(1).String(),
+						"vote":       float64(1),
+					},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:                        "Error/ErrInvalidCredentials",
+			authorization:               "Bearer my-token",
+			query:                       "?targetType=improve_request&ids=01010101-0101-0101-0101-010101010101",
+			shouldCallService:           true,
+			shouldCallServiceWithTarget: models.VoteTargetImproveRequest,
+			shouldCallServiceWithIDs:    []uuid.UUID{goframework.NumberUUID(1)},
+			serviceErr:                  goframework.ErrInvalidCredentials,
+			expectStatus:                http.StatusForbidden,
+		},
+		{
+			name:                        "Error/ErrInvalidEntity",
+			authorization:               "Bearer my-token",
+			query:                       "?targetType=fake&ids=01010101-0101-0101-0101-010101010101",
+			shouldCallService:           true,
+			shouldCallServiceWithTarget: "fake",
+			shouldCallServiceWithIDs:    []uuid.UUID{goframework.NumberUUID(1)},
+			serviceErr:                  goframework.ErrInvalidEntity,
+			expectStatus:                http.StatusBadRequest,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewListUserVotesService(t)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("GET", "/"+d.query, nil)
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("List", c, d.authorization, d.shouldCallServiceWithTarget, d.shouldCallServiceWithIDs).
+					Return(d.serviceResp, d.serviceErr)
+			}
+
+			handler := handlers.NewListUserVotesHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type MarkAllNotificationsReadHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewMarkAllNotificationsReadHandler(service services.MarkAllNotificationsReadService) MarkAllNotificationsReadHandler {
+	return &markAllNotificationsReadHandlerImpl{
+		service: service,
+	}
+}
+
+type markAllNotificationsReadHandlerImpl struct {
+	service services.MarkAllNotificationsReadService
+}
+
+func (h *markAllNotificationsReadHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	updated, err := h.service.MarkAllRead(c, token, time.Now())
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"updated": updated})
+}
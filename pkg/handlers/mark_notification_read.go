@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type MarkNotificationReadHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewMarkNotificationReadHandler(service services.MarkNotificationReadService) MarkNotificationReadHandler {
+	return &markNotificationReadHandlerImpl{
+		service: service,
+	}
+}
+
+type markNotificationReadHandlerImpl struct {
+	service services.MarkNotificationReadService
+}
+
+func (h *markNotificationReadHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	form := new(models.MarkNotificationReadForm)
+	if err := c.BindJSON(form); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.service.MarkRead(c, token, form.ID, time.Now())
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{bunovel.ErrNotFound, http.StatusNotFound},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
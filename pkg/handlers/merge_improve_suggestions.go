@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+)
+
+type MergeImproveSuggestionsHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewMergeImproveSuggestionsHandler(service services.MergeImproveSuggestionsService) MergeImproveSuggestionsHandler {
+	return &mergeImproveSuggestionsHandlerImpl{
+		service: service,
+	}
+}
+
+type mergeImproveSuggestionsHandlerImpl struct {
+	service services.MergeImproveSuggestionsService
+}
+
+func (h *mergeImproveSuggestionsHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	form := new(models.MergeImproveSuggestionsForm)
+	if err := c.BindJSON(form); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.service.Merge(c, token, form, uuid.New(), time.Now())
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{services.ErrNotTheCreator, http.StatusUnauthorized},
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{bunovel.ErrNotFound, http.StatusNotFound},
+			{goframework.ErrInvalidEntity, http.StatusUnprocessableEntity},
+		}, true)
+		return
+	}
+
+	// Conflicts must be resolved by the creator, who can send the same form again with resolutions.
+	if len(res.Conflicts) > 0 {
+		c.JSON(http.StatusConflict, res)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
@@ -0,0 +1,213 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMergeImproveSuggestionsHandler(t *testing.T) {
+	body := map[string]interface{}{
+		"revisionID":    goframework.NumberUUID(1).String(),
+		"suggestionIDs": []string{goframework.NumberUUID(20).String(), goframework.NumberUUID(21).String()},
+		"resolutions": []map[string]interface{}{
+			{"field": models.MergeFieldContent, "start": 4, "end": 9, "text": "lazy"},
+		},
+	}
+	form := &models.MergeImproveSuggestionsForm{
+		RevisionID:    goframework.NumberUUID(1),
+		SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+		Resolutions: []*models.MergeResolutionForm{
+			{Field: models.MergeFieldContent, Start: 4, End: 9, Text: "lazy"},
+		},
+	}
+
+	data := []struct {
+		name string
+
+		authorization string
+
+		body interface{}
+
+		shouldCallService     bool
+		shouldCallServiceWith *models.MergeImproveSuggestionsForm
+		serviceResp           *models.MergeResult
+		serviceErr            error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:                  "Success",
+			authorization:         "Bearer my-token",
+			body:                  body,
+			shouldCallService:     true,
+			shouldCallServiceWith: form,
+			serviceResp: &models.MergeResult{
+				Revision: &models.ImproveRequestPreview{
+					ID:        goframework.NumberUUID(10),
+					CreatedAt: baseTime,
+					UserID:    goframework.NumberUUID(100),
+					Title:     "my title",
+					Content:   "The lazy brown fox jumps.",
+				},
+			},
+			expect: map[string]interface{}{
+				"revision": map[string]interface{}{
+					"id":                       goframework.NumberUUID(10).String(),
+					"createdAt":                baseTime.Format(time.RFC3339),
+					"userID":                   goframework.NumberUUID(100).String(),
+					"title":                    "my title",
+					"content":                  "The lazy brown fox jumps.",
+					"upVotes":                  float64(0),
+					"downVotes":                float64(0),
+					"suggestionsCount":         float64(0),
+					"acceptedSuggestionsCount": float64(0),
+					"revisionsCount":           float64(0),
+				},
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:                  "Conflicts",
+			authorization:         "Bearer my-token",
+			body:                  body,
+			shouldCallService:     true,
+			shouldCallServiceWith: form,
+			serviceResp: &models.MergeResult{
+				Conflicts: []*models.MergeConflict{
+					{
+						Field: models.MergeFieldContent,
+						Start: 4,
+						End:   9,
+						Base:  "quick",
+						Hunks: []*models.MergeHunk{
+							{SuggestionID: goframework.NumberUUID(20), Text: "slow"},
+							{SuggestionID: goframework.NumberUUID(21), Text: "fast"},
+						},
+					},
+				},
+			},
+			expect: map[string]interface{}{
+				"conflicts": []interface{}{
+					map[string]interface{}{
+						"field": models.MergeFieldContent,
+						"start": float64(4),
+						"end":   float64(9),
+						"base":  "quick",
+						"hunks": []interface{}{
+							map[string]interface{}{
+								"suggestionID": goframework.NumberUUID(20).String(),
+								"text":         "slow",
+							},
+							map[string]interface{}{
+								"suggestionID": goframework.NumberUUID(21).String(),
+								"text":         "fast",
+							},
+						},
+					},
+				},
+			},
+			expectStatus: http.StatusConflict,
+		},
+		{
+			name:                  "Error/ErrNotTheCreator",
+			authorization:         "Bearer my-token",
+			body:                  body,
+			shouldCallService:     true,
+			shouldCallServiceWith: form,
+			serviceErr:            services.ErrNotTheCreator,
+			expectStatus:          http.StatusUnauthorized,
+		},
+		{
+			name:                  "Error/ErrInvalidCredentials",
+			authorization:         "Bearer my-token",
+			body:                  body,
+			shouldCallService:     true,
+			shouldCallServiceWith: form,
+			serviceErr:            goframework.ErrInvalidCredentials,
+			expectStatus:          http.StatusForbidden,
+		},
+		{
+			name:                  "Error/ErrNotFound",
+			authorization:         "Bearer my-token",
+			body:                  body,
+			shouldCallService:     true,
+			shouldCallServiceWith: form,
+			serviceErr:            bunovel.ErrNotFound,
+			expectStatus:          http.StatusNotFound,
+		},
+		{
+			name:                  "Error/ErrInvalidEntity",
+			authorization:         "Bearer my-token",
+			body:                  body,
+			shouldCallService:     true,
+			shouldCallServiceWith: form,
+			serviceErr:            goframework.ErrInvalidEntity,
+			expectStatus:          http.StatusUnprocessableEntity,
+		},
+		{
+			name:                  "Error/InternalError",
+			authorization:         "Bearer my-token",
+			body:                  body,
+			shouldCallService:     true,
+			shouldCallServiceWith: form,
+			serviceErr:            errors.New("uwups"),
+			expectStatus:          http.StatusInternalServerError,
+		},
+		{
+			name:          "Error/BadRequest",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"revisionID": "fake uuid",
+			},
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewMergeImproveSuggestionsService(t)
+
+			mrshBody, err := json.Marshal(d.body)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("POST", "/", bytes.NewReader(mrshBody))
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("Merge", c, d.authorization, d.shouldCallServiceWith, mock.Anything, mock.Anything).
+					Return(d.serviceResp, d.serviceErr)
+			}
+
+			handler := handlers.NewMergeImproveSuggestionsHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type PublishImproveSuggestionHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewPublishImproveSuggestionHandler(service services.PublishImproveSuggestionService) PublishImproveSuggestionHandler {
+	return &publishImproveSuggestionHandlerImpl{
+		service: service,
+	}
+}
+
+type publishImproveSuggestionHandlerImpl struct {
+	service services.PublishImproveSuggestionService
+}
+
+func (h *publishImproveSuggestionHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	form := new(models.PublishImproveSuggestionForm)
+	if err := c.BindJSON(form); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.service.Publish(c, token, form.ID, time.Now())
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{services.ErrNotTheCreator, http.StatusUnauthorized},
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{bunovel.ErrNotFound, http.StatusNotFound},
+			{services.ErrNotDraft, http.StatusConflict},
+			{services.ErrRequestNotOpen, http.StatusConflict},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,180 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestPublishImproveSuggestionHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+
+		body interface{}
+
+		shouldCallService       bool
+		shouldCallServiceWithID uuid.UUID
+		serviceResp             *models.ImproveSuggestion
+		serviceErr              error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:          "Success",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceResp: &models.ImproveSuggestion{
+				ID:          goframework.NumberUUID(1),
+				CreatedAt:   baseTime,
+				SourceID:    goframework.NumberUUID(10),
+				UserID:      goframework.NumberUUID(100),
+				RequestID:   goframework.NumberUUID(2),
+				Title:       "title",
+				Content:     "content",
+				PublishedAt: &updateTime,
+			},
+			expect: map[string]interface{}{
+				"id":          goframework.NumberUUID(1).String(),
+				"createdAt":   baseTime.Format(time.RFC3339),
+				"updatedAt":   nil,
+				"sourceID":    goframework.NumberUUID(10).String(),
+				"userID":      goframework.NumberUUID(100).String(),
+				"validated":   false,
+				"upVotes":     float64(0),
+				"downVotes":   float64(0),
+				"requestID":   goframework.NumberUUID(2).String(),
+				"title":       "title",
+				"content":     "content",
+				"publishedAt": updateTime.Format(time.RFC3339),
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:          "Error/ErrNotTheCreator",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceErr:              services.ErrNotTheCreator,
+			expectStatus:            http.StatusUnauthorized,
+		},
+		{
+			name:          "Error/ErrInvalidCredentials",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceErr:              goframework.ErrInvalidCredentials,
+			expectStatus:            http.StatusForbidden,
+		},
+		{
+			name:          "Error/ErrNotFound",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceErr:              bunovel.ErrNotFound,
+			expectStatus:            http.StatusNotFound,
+		},
+		{
+			name:          "Error/ErrNotDraft",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceErr:              services.ErrNotDraft,
+			expectStatus:            http.StatusConflict,
+		},
+		{
+			name:          "Error/ErrRequestNotOpen",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceErr:              services.ErrRequestNotOpen,
+			expectStatus:            http.StatusConflict,
+		},
+		{
+			name:          "Error/InternalError",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(1),
+			serviceErr:              errors.New("uwups"),
+			expectStatus:            http.StatusInternalServerError,
+		},
+		{
+			name:          "Error/BadRequest",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id": "fake uuid",
+			},
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewPublishImproveSuggestionService(t)
+
+			mrshBody, err := json.Marshal(d.body)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("POST", "/", bytes.NewReader(mrshBody))
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("Publish", c, d.authorization, d.shouldCallServiceWithID, mock.Anything).
+					Return(d.serviceResp, d.serviceErr)
+			}
+
+			handler := handlers.NewPublishImproveSuggestionHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type ResolveReportHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewResolveReportHandler(service services.ResolveReportService) ResolveReportHandler {
+	return &resolveReportHandlerImpl{
+		service: service,
+	}
+}
+
+type resolveReportHandlerImpl struct {
+	service services.ResolveReportService
+}
+
+func (h *resolveReportHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	form := new(models.ResolveReportForm)
+	if err := c.BindJSON(form); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.service.Resolve(c, token, form, time.Now())
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{bunovel.ErrNotFound, http.StatusNotFound},
+			{services.ErrReportClaimed, http.StatusConflict},
+			{services.ErrReportResolved, http.StatusConflict},
+			{goframework.ErrInvalidEntity, http.StatusUnprocessableEntity},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,213 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestResolveReportHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+
+		body interface{}
+
+		shouldCallService     bool
+		shouldCallServiceWith *models.ResolveReportForm
+		serviceResp           *models.Report
+		serviceErr            error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:          "Success",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"action": models.ReportActionHide,
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.ResolveReportForm{
+				ID:     goframework.NumberUUID(1),
+				Action: models.ReportActionHide,
+			},
+			serviceResp: &models.Report{
+				ID:          goframework.NumberUUID(1),
+				CreatedAt:   baseTime,
+				UpdatedAt:   lo.ToPtr(baseTime.Add(time.Hour)),
+				UserID:      goframework.NumberUUID(200),
+				TargetType:  models.ReportTargetComment,
+				TargetID:    goframework.NumberUUID(10),
+				Reason:      models.ReportReasonSpam,
+				Status:      models.ReportStatusResolved,
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(100)),
+				ResolvedAt:  lo.ToPtr(baseTime.Add(time.Hour)),
+				Action:      models.ReportActionHide,
+			},
+			expect: map[string]interface{}{
+				"id":          goframework.NumberUUID(1).String(),
+				"createdAt":   baseTime.Format(time.RFC3339),
+				"updatedAt":   baseTime.Add(time.Hour).Format(time.RFC3339),
+				"userID":      goframework.NumberUUID(200).String(),
+				"targetType":  models.ReportTargetComment,
+				"targetID":    goframework.NumberUUID(10).String(),
+				"reason":      models.ReportReasonSpam,
+				"content":     "",
+				"status":      models.ReportStatusResolved,
+				"moderatorID": goframework.NumberUUID(100).String(),
+				"claimedAt":   nil,
+				"resolvedAt":  baseTime.Add(time.Hour).Format(time.RFC3339),
+				"action":      models.ReportActionHide,
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:          "Error/ErrInvalidCredentials",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"action": models.ReportActionHide,
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.ResolveReportForm{
+				ID:     goframework.NumberUUID(1),
+				Action: models.ReportActionHide,
+			},
+			serviceErr:   goframework.ErrInvalidCredentials,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name:          "Error/ErrNotFound",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"action": models.ReportActionHide,
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.ResolveReportForm{
+				ID:     goframework.NumberUUID(1),
+				Action: models.ReportActionHide,
+			},
+			serviceErr:   bunovel.ErrNotFound,
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name:          "Error/ErrReportClaimed",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"action": models.ReportActionHide,
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.ResolveReportForm{
+				ID:     goframework.NumberUUID(1),
+				Action: models.ReportActionHide,
+			},
+			serviceErr:   services.ErrReportClaimed,
+			expectStatus: http.StatusConflict,
+		},
+		{
+			name:          "Error/ErrReportResolved",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"action": models.ReportActionHide,
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.ResolveReportForm{
+				ID:     goframework.NumberUUID(1),
+				Action: models.ReportActionHide,
+			},
+			serviceErr:   services.ErrReportResolved,
+			expectStatus: http.StatusConflict,
+		},
+		{
+			name:          "Error/ErrInvalidEntity",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"action": "fake",
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.ResolveReportForm{
+				ID:     goframework.NumberUUID(1),
+				Action: "fake",
+			},
+			serviceErr:   goframework.ErrInvalidEntity,
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "Error/InternalError",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"action": models.ReportActionHide,
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: &models.ResolveReportForm{
+				ID:     goframework.NumberUUID(1),
+				Action: models.ReportActionHide,
+			},
+			serviceErr:   errors.New("uwups"),
+			expectStatus: http.StatusInternalServerError,
+		},
+		{
+			name:          "Error/BadRequest",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     "fake uuid",
+				"action": models.ReportActionHide,
+			},
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewResolveReportService(t)
+
+			mrshBody, err := json.Marshal(d.body)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("POST", "/", bytes.NewReader(mrshBody))
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("Resolve", c, d.authorization, d.shouldCallServiceWith, mock.Anything).
+					Return(d.serviceResp, d.serviceErr)
+			}
+
+			handler := handlers.NewResolveReportHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type RestoreImproveRequestRevisionHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewRestoreImproveRequestRevisionHandler(service services.RestoreImproveRequestRevisionService) RestoreImproveRequestRevisionHandler {
+	return &restoreImproveRequestRevisionHandlerImpl{
+		service: service,
+	}
+}
+
+type restoreImproveRequestRevisionHandlerImpl struct {
+	service services.RestoreImproveRequestRevisionService
+}
+
+func (h *restoreImproveRequestRevisionHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	form := new(models.RestoreImproveRequestRevisionForm)
+	if err := c.BindJSON(form); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.service.Restore(c, token, form.ID)
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{services.ErrNotTheCreator, http.StatusUnauthorized},
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{bunovel.ErrNotFound, http.StatusNotFound},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -24,22 +24,33 @@ type searchImproveRequestsHandlerImpl struct {
 }
 
 func (h *searchImproveRequestsHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
 	query := new(models.SearchImproveRequestsQuery)
 	if err := c.BindQuery(query); err != nil {
 		_ = c.AbortWithError(http.StatusBadRequest, err)
 		return
 	}
 
-	previews, total, err := h.service.Search(c, *query)
+	result, err := h.service.Search(c, token, *query)
 	if err != nil {
 		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
 			{goframework.ErrInvalidEntity, http.StatusBadRequest},
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
 		}, false)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"res":   previews,
-		"total": total,
-	})
+	res := gin.H{"res": result.Res}
+	if !query.SkipTotal {
+		res["total"] = result.Total
+	}
+	if result.NextCursor != "" {
+		res["nextCursor"] = result.NextCursor
+	}
+	if result.DidYouMean != "" {
+		res["didYouMean"] = result.DidYouMean
+	}
+
+	c.JSON(http.StatusOK, res)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/a-novel/go-apis"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"net/http"
 	"net/http/httptest"
@@ -19,12 +20,12 @@ func TestSearchImproveRequestHandler(t *testing.T) {
 	data := []struct {
 		name string
 
-		query string
+		authorization string
+		query         string
 
 		shouldCallService     bool
 		shouldCallServiceWith models.SearchImproveRequestsQuery
-		serviceResp           []*models.ImproveRequestPreview
-		serviceRespTotal      int
+		serviceResp           *models.SearchImproveRequestsResult
 		serviceErr            error
 
 		expect       interface{}
@@ -32,42 +33,46 @@ func TestSearchImproveRequestHandler(t *testing.T) {
 	}{
 		{
 			name:              "Success",
-			query:             "?userID=01010101-0101-0101-0101-010101010101&query=foobar&limit=10&offset=20&order=score",
+			authorization:     "token",
+			query:             "?userID=01010101-0101-0101-0101-010101010101&followed=true&query=foobar&limit=10&offset=20&order=score",
 			shouldCallService: true,
 			shouldCallServiceWith: models.SearchImproveRequestsQuery{
-				UserID: apis.StringUUID(goframework.NumberUUID(1).String()),
-				Query:  "foobar",
-				Order:  models.OrderScore,
-				Limit:  10,
-				Offset: 20,
-			},
-			serviceResp: []*models.ImproveRequestPreview{
-				{
-					ID:                       goframework.NumberUUID(22),
-					CreatedAt:                baseTime.Add(time.Hour),
-					UserID:                   goframework.NumberUUID(201),
-					Title:                    "title",
-					Content:                  "content",
-					UpVotes:                  128,
-					DownVotes:                64,
-					SuggestionsCount:         2,
-					AcceptedSuggestionsCount: 1,
-					RevisionCount:            3,
-				},
-				{
-					ID:                       goframework.NumberUUID(2),
-					CreatedAt:                baseTime,
-					UserID:                   goframework.NumberUUID(200),
-					Title:                    "title-2",
-					Content:                  "content-2",
-					UpVotes:                  256,
-					DownVotes:                512,
-					SuggestionsCount:         3,
-					AcceptedSuggestionsCount: 2,
-					RevisionCount:            2,
+				UserID:   apis.StringUUID(goframework.NumberUUID(1).String()),
+				Followed: true,
+				Query:    "foobar",
+				Order:    models.OrderScore,
+				Limit:    10,
+				Offset:   20,
+			},
+			serviceResp: &models.SearchImproveRequestsResult{
+				Res: []*models.ImproveRequestPreview{
+					{
+						ID:                       goframework.NumberUUID(22),
+						CreatedAt:                baseTime.Add(time.Hour),
+						UserID:                   goframework.NumberUUID(201),
+						Title:                    "title",
+						Content:                  "content",
+						UpVotes:                  128,
+						DownVotes:                64,
+						SuggestionsCount:         2,
+						AcceptedSuggestionsCount: 1,
+						RevisionCount:            3,
+					},
+					{
+						ID:                       goframework.NumberUUID(2),
+						CreatedAt:                baseTime,
+						UserID:                   goframework.NumberUUID(200),
+						Title:                    "title-2",
+						Content:                  "content-2",
+						UpVotes:                  256,
+						DownVotes:                512,
+						SuggestionsCount:         3,
+						AcceptedSuggestionsCount: 2,
+						RevisionCount:            2,
+					},
 				},
+				Total: 200,
 			},
-			serviceRespTotal: 200,
 			expect: map[string]interface{}{
 				"total": float64(200),
 				"res": []interface{}{
@@ -104,33 +109,35 @@ func TestSearchImproveRequestHandler(t *testing.T) {
 			query:                 "?limit=10",
 			shouldCallService:     true,
 			shouldCallServiceWith: models.SearchImproveRequestsQuery{Limit: 10},
-			serviceResp: []*models.ImproveRequestPreview{
-				{
-					ID:                       goframework.NumberUUID(22),
-					CreatedAt:                baseTime.Add(time.Hour),
-					UserID:                   goframework.NumberUUID(201),
-					Title:                    "title",
-					Content:                  "content",
-					UpVotes:                  128,
-					DownVotes:                64,
-					SuggestionsCount:         2,
-					AcceptedSuggestionsCount: 1,
-					RevisionCount:            3,
-				},
-				{
-					ID:                       goframework.NumberUUID(2),
-					CreatedAt:                baseTime,
-					UserID:                   goframework.NumberUUID(200),
-					Title:                    "title-2",
-					Content:                  "content-2",
-					UpVotes:                  256,
-					DownVotes:                512,
-					SuggestionsCount:         3,
-					AcceptedSuggestionsCount: 2,
-					RevisionCount:            2,
+			serviceResp: &models.SearchImproveRequestsResult{
+				Res: []*models.ImproveRequestPreview{
+					{
+						ID:                       goframework.NumberUUID(22),
+						CreatedAt:                baseTime.Add(time.Hour),
+						UserID:                   goframework.NumberUUID(201),
+						Title:                    "title",
+						Content:                  "content",
+						UpVotes:                  128,
+						DownVotes:                64,
+						SuggestionsCount:         2,
+						AcceptedSuggestionsCount: 1,
+						RevisionCount:            3,
+					},
+					{
+						ID:                       goframework.NumberUUID(2),
+						CreatedAt:                baseTime,
+						UserID:                   goframework.NumberUUID(200),
+						Title:                    "title-2",
+						Content:                  "content-2",
+						UpVotes:                  256,
+						DownVotes:                512,
+						SuggestionsCount:         3,
+						AcceptedSuggestionsCount: 2,
+						RevisionCount:            2,
+					},
 				},
+				Total: 200,
 			},
-			serviceRespTotal: 200,
 			expect: map[string]interface{}{
 				"total": float64(200),
 				"res": []interface{}{
@@ -162,6 +169,158 @@ func TestSearchImproveRequestHandler(t *testing.T) {
 			},
 			expectStatus: http.StatusOK,
 		},
+		{
+			name:              "Success/Filters",
+			query:             "?limit=10&createdAfter=2020-05-04T08:00:00Z&createdBefore=2020-05-05T08:00:00Z&minScore=-5&maxScore=5&minSuggestions=1&maxSuggestions=3&hasAcceptedSuggestions=true&minRevisions=1&maxRevisions=2",
+			shouldCallService: true,
+			shouldCallServiceWith: models.SearchImproveRequestsQuery{
+				Limit:                  10,
+				CreatedAfter:           lo.ToPtr(time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)),
+				CreatedBefore:          lo.ToPtr(time.Date(2020, time.May, 5, 8, 0, 0, 0, time.UTC)),
+				MinScore:               lo.ToPtr(-5),
+				MaxScore:               lo.ToPtr(5),
+				MinSuggestions:         lo.ToPtr(1),
+				MaxSuggestions:         lo.ToPtr(3),
+				HasAcceptedSuggestions: lo.ToPtr(true),
+				MinRevisions:           lo.ToPtr(1),
+				MaxRevisions:           lo.ToPtr(2),
+			},
+			serviceResp: &models.SearchImproveRequestsResult{
+				Res:   []*models.ImproveRequestPreview{},
+				Total: 0,
+			},
+			expect: map[string]interface{}{
+				"res":   []interface{}{},
+				"total": float64(0),
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:              "Success/Cursor",
+			query:             "?limit=10&cursor=foo&skipTotal=true",
+			shouldCallService: true,
+			shouldCallServiceWith: models.SearchImproveRequestsQuery{
+				Limit:     10,
+				Cursor:    "foo",
+				SkipTotal: true,
+			},
+			serviceResp: &models.SearchImproveRequestsResult{
+				Res:        []*models.ImproveRequestPreview{},
+				NextCursor: "bar",
+			},
+			expect: map[string]interface{}{
+				"res":        []interface{}{},
+				"nextCursor": "bar",
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:              "Success/Highlight",
+			query:             "?limit=10&query=foo&highlight=true&excerpt=true",
+			shouldCallService: true,
+			shouldCallServiceWith: models.SearchImproveRequestsQuery{
+				Limit:     10,
+				Query:     "foo",
+				Highlight: true,
+				Excerpt:   true,
+			},
+			serviceResp: &models.SearchImproveRequestsResult{
+				Res: []*models.ImproveRequestPreview{
+					{
+						ID:               goframework.NumberUUID(10),
+						CreatedAt:        baseTime,
+						UserID:           goframework.NumberUUID(100),
+						Title:            "foo title",
+						Content:          "foo content",
+						TitleHighlight:   "<mark>foo</mark> title",
+						ContentHighlight: "<mark>foo</mark> content",
+					},
+				},
+				Total: 1,
+			},
+			expect: map[string]interface{}{
+				"res": []interface{}{
+					map[string]interface{}{
+						"id":                       goframework.NumberUUID(10).String(),
+						"createdAt":                baseTime.Format(time.RFC3339),
+						"userID":                   goframework.NumberUUID(100).String(),
+						"title":                    "foo title",
+						"content":                  "foo content",
+						"titleHighlight":           "<mark>foo</mark> title",
+						"contentHighlight":         "<mark>foo</mark> content",
+						"upVotes":                  float64(0),
+						"downVotes":                float64(0),
+						"revisionsCount":           float64(0),
+						"suggestionsCount":         float64(0),
+						"acceptedSuggestionsCount": float64(0),
+					},
+				},
+				"total": float64(1),
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:              "Success/Tags",
+			query:             "?limit=10&tags=horror&tags=dialogue&tagsMatch=all",
+			shouldCallService: true,
+			shouldCallServiceWith: models.SearchImproveRequestsQuery{
+				Limit:     10,
+				Tags:      []string{"horror", "dialogue"},
+				TagsMatch: models.TagsMatchAll,
+			},
+			serviceResp: &models.SearchImproveRequestsResult{
+				Res: []*models.ImproveRequestPreview{
+					{
+						ID:        goframework.NumberUUID(10),
+						CreatedAt: baseTime,
+						UserID:    goframework.NumberUUID(100),
+						Title:     "title",
+						Content:   "content",
+						Tags:      []string{"dialogue", "horror"},
+					},
+				},
+				Total: 1,
+			},
+			expect: map[string]interface{}{
+				"res": []interface{}{
+					map[string]interface{}{
+						"id":                       goframework.NumberUUID(10).String(),
+						"createdAt":                baseTime.Format(time.RFC3339),
+						"userID":                   goframework.NumberUUID(100).String(),
+						"title":                    "title",
+						"content":                  "content",
+						"tags":                     []interface{}{"dialogue", "horror"},
+						"upVotes":                  float64(0),
+						"downVotes":                float64(0),
+						"revisionsCount":           float64(0),
+						"suggestionsCount":         float64(0),
+						"acceptedSuggestionsCount": float64(0),
+					},
+				},
+				"total": float64(1),
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:              "Success/DidYouMean",
+			query:             "?limit=10&query=gandlaf&fuzzy=true",
+			shouldCallService: true,
+			shouldCallServiceWith: models.SearchImproveRequestsQuery{
+				Limit: 10,
+				Query: "gandlaf",
+				Fuzzy: true,
+			},
+			serviceResp: &models.SearchImproveRequestsResult{
+				Res:        []*models.ImproveRequestPreview{},
+				DidYouMean: "gandalf",
+			},
+			expect: map[string]interface{}{
+				"res":        []interface{}{},
+				"total":      float64(0),
+				"didYouMean": "gandalf",
+			},
+			expectStatus: http.StatusOK,
+		},
 		{
 			name:                  "Error/ErrInvalidEntity",
 			query:                 "?limit=10",
@@ -170,6 +329,14 @@ func TestSearchImproveRequestHandler(t *testing.T) {
 			serviceErr:            goframework.ErrInvalidEntity,
 			expectStatus:          http.StatusBadRequest,
 		},
+		{
+			name:                  "Error/ErrInvalidCredentials",
+			query:                 "?followed=true&limit=10",
+			shouldCallService:     true,
+			shouldCallServiceWith: models.SearchImproveRequestsQuery{Followed: true, Limit: 10},
+			serviceErr:            goframework.ErrInvalidCredentials,
+			expectStatus:          http.StatusForbidden,
+		},
 	}
 
 	for _, d := range data {
@@ -179,11 +346,12 @@ func TestSearchImproveRequestHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(w)
 			c.Request = httptest.NewRequest("POST", "/"+d.query, nil)
+			c.Request.Header.Set("Authorization", d.authorization)
 
 			if d.shouldCallService {
 				service.
-					On("Search", c, d.shouldCallServiceWith).
-					Return(d.serviceResp, d.serviceRespTotal, d.serviceErr)
+					On("Search", c, d.authorization, d.shouldCallServiceWith).
+					Return(d.serviceResp, d.serviceErr)
 			}
 
 			handler := handlers.NewSearchImproveRequestsHandler(service)
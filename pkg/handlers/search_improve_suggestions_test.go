@@ -20,12 +20,12 @@ func TestSearchImproveSuggestionHandler(t *testing.T) {
 	data := []struct {
 		name string
 
-		query string
+		authorization string
+		query         string
 
 		shouldCallService     bool
 		shouldCallServiceWith models.SearchImproveSuggestionsQuery
-		serviceResp           []*models.ImproveSuggestion
-		serviceRespTotal      int
+		serviceResp           *models.SearchImproveSuggestionsResult
 		serviceErr            error
 
 		expect       interface{}
@@ -33,45 +33,49 @@ func TestSearchImproveSuggestionHandler(t *testing.T) {
 	}{
 		{
 			name:              "Success",
-			query:             "?userID=01010101-0101-0101-0101-010101010101&limit=10&offset=20&order=score&validated=true&sourceID=02020202-0202-0202-0202-020202020202&requestID=03030303-0303-0303-0303-030303030303",
+			authorization:     "token",
+			query:             "?userID=01010101-0101-0101-0101-010101010101&limit=10&offset=20&order=score&validated=true&sourceID=02020202-0202-0202-0202-020202020202&requestID=03030303-0303-0303-0303-030303030303&query=foobar",
 			shouldCallService: true,
 			shouldCallServiceWith: models.SearchImproveSuggestionsQuery{
 				UserID:    apis.StringUUID(goframework.NumberUUID(1).String()),
 				SourceID:  apis.StringUUID(goframework.NumberUUID(2).String()),
 				RequestID: apis.StringUUID(goframework.NumberUUID(3).String()),
 				Validated: lo.ToPtr(true),
+				Query:     "foobar",
 				Order:     models.OrderScore,
 				Limit:     10,
 				Offset:    20,
 			},
-			serviceResp: []*models.ImproveSuggestion{
-				{
-					ID:        goframework.NumberUUID(1),
-					CreatedAt: baseTime,
-					UpdatedAt: lo.ToPtr(baseTime.Add(3 * time.Hour)),
-					SourceID:  goframework.NumberUUID(10),
-					UserID:    goframework.NumberUUID(200),
-					UpVotes:   16,
-					DownVotes: 8,
-					Validated: true,
-					RequestID: goframework.NumberUUID(1),
-					Title:     "title",
-					Content:   "content",
-				},
-				{
-					ID:        goframework.NumberUUID(2),
-					CreatedAt: baseTime,
-					UpdatedAt: lo.ToPtr(baseTime.Add(2 * time.Hour)),
-					SourceID:  goframework.NumberUUID(20),
-					UserID:    goframework.NumberUUID(100),
-					UpVotes:   32,
-					DownVotes: 16,
-					RequestID: goframework.NumberUUID(1),
-					Title:     "title",
-					Content:   "content",
+			serviceResp: &models.SearchImproveSuggestionsResult{
+				Res: []*models.ImproveSuggestion{
+					{
+						ID:        goframework.NumberUUID(1),
+						CreatedAt: baseTime,
+						UpdatedAt: lo.ToPtr(baseTime.Add(3 * time.Hour)),
+						SourceID:  goframework.NumberUUID(10),
+						UserID:    goframework.NumberUUID(200),
+						UpVotes:   16,
+						DownVotes: 8,
+						Validated: true,
+						RequestID: goframework.NumberUUID(1),
+						Title:     "title",
+						Content:   "content",
+					},
+					{
+						ID:        goframework.NumberUUID(2),
+						CreatedAt: baseTime,
+						UpdatedAt: lo.ToPtr(baseTime.Add(2 * time.Hour)),
+						SourceID:  goframework.NumberUUID(20),
+						UserID:    goframework.NumberUUID(100),
+						UpVotes:   32,
+						DownVotes: 16,
+						RequestID: goframework.NumberUUID(1),
+						Title:     "title",
+						Content:   "content",
+					},
 				},
+				Total: 200,
 			},
-			serviceRespTotal: 200,
 			expect: map[string]interface{}{
 				"total": float64(200),
 				"res": []interface{}{
@@ -110,34 +114,36 @@ func TestSearchImproveSuggestionHandler(t *testing.T) {
 			query:                 "?limit=10",
 			shouldCallService:     true,
 			shouldCallServiceWith: models.SearchImproveSuggestionsQuery{Limit: 10},
-			serviceResp: []*models.ImproveSuggestion{
-				{
-					ID:        goframework.NumberUUID(1),
-					CreatedAt: baseTime,
-					UpdatedAt: lo.ToPtr(baseTime.Add(3 * time.Hour)),
-					SourceID:  goframework.NumberUUID(10),
-					UserID:    goframework.NumberUUID(200),
-					UpVotes:   16,
-					DownVotes: 8,
-					Validated: true,
-					RequestID: goframework.NumberUUID(1),
-					Title:     "title",
-					Content:   "content",
-				},
-				{
-					ID:        goframework.NumberUUID(2),
-					CreatedAt: baseTime,
-					UpdatedAt: lo.ToPtr(baseTime.Add(2 * time.Hour)),
-					SourceID:  goframework.NumberUUID(20),
-					UserID:    goframework.NumberUUID(100),
-					UpVotes:   32,
-					DownVotes: 16,
-					RequestID: goframework.NumberUUID(1),
-					Title:     "title",
-					Content:   "content",
+			serviceResp: &models.SearchImproveSuggestionsResult{
+				Res: []*models.ImproveSuggestion{
+					{
+						ID:        goframework.NumberUUID(1),
+						CreatedAt: baseTime,
+						UpdatedAt: lo.ToPtr(baseTime.Add(3 * time.Hour)),
+						SourceID:  goframework.NumberUUID(10),
+						UserID:    goframework.NumberUUID(200),
+						UpVotes:   16,
+						DownVotes: 8,
+						Validated: true,
+						RequestID: goframework.NumberUUID(1),
+						Title:     "title",
+						Content:   "content",
+					},
+					{
+						ID:        goframework.NumberUUID(2),
+						CreatedAt: baseTime,
+						UpdatedAt: lo.ToPtr(baseTime.Add(2 * time.Hour)),
+						SourceID:  goframework.NumberUUID(20),
+						UserID:    goframework.NumberUUID(100),
+						UpVotes:   32,
+						DownVotes: 16,
+						RequestID: goframework.NumberUUID(1),
+						Title:     "title",
+						Content:   "content",
+					},
 				},
+				Total: 200,
 			},
-			serviceRespTotal: 200,
 			expect: map[string]interface{}{
 				"total": float64(200),
 				"res": []interface{}{
@@ -171,6 +177,45 @@ func TestSearchImproveSuggestionHandler(t *testing.T) {
 			},
 			expectStatus: http.StatusOK,
 		},
+		{
+			name:              "Success/Cursor",
+			query:             "?limit=10&cursor=foo&skipTotal=true",
+			shouldCallService: true,
+			shouldCallServiceWith: models.SearchImproveSuggestionsQuery{
+				Limit:     10,
+				Cursor:    "foo",
+				SkipTotal: true,
+			},
+			serviceResp: &models.SearchImproveSuggestionsResult{
+				Res:        []*models.ImproveSuggestion{},
+				NextCursor: "bar",
+			},
+			expect: map[string]interface{}{
+				"res":        []interface{}{},
+				"nextCursor": "bar",
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:              "Success/DidYouMean",
+			query:             "?limit=10&query=gandlaf&fuzzy=true",
+			shouldCallService: true,
+			shouldCallServiceWith: models.SearchImproveSuggestionsQuery{
+				Limit: 10,
+				Query: "gandlaf",
+				Fuzzy: true,
+			},
+			serviceResp: &models.SearchImproveSuggestionsResult{
+				Res:        []*models.ImproveSuggestion{},
+				DidYouMean: "gandalf",
+			},
+			expect: map[string]interface{}{
+				"res":        []interface{}{},
+				"total":      float64(0),
+				"didYouMean": "gandalf",
+			},
+			expectStatus: http.StatusOK,
+		},
 		{
 			name:                  "Error/ErrInvalidEntity",
 			query:                 "?limit=10",
@@ -188,11 +233,12 @@ func TestSearchImproveSuggestionHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(w)
 			c.Request = httptest.NewRequest("POST", "/"+d.query, nil)
+			c.Request.Header.Set("Authorization", d.authorization)
 
 			if d.shouldCallService {
 				service.
-					On("Search", c, d.shouldCallServiceWith).
-					Return(d.serviceResp, d.serviceRespTotal, d.serviceErr)
+					On("Search", c, d.authorization, d.shouldCallServiceWith).
+					Return(d.serviceResp, d.serviceErr)
 			}
 
 			handler := handlers.NewSearchImproveSuggestionsHandler(service)
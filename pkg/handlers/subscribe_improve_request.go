@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type SubscribeImproveRequestHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewSubscribeImproveRequestHandler(service services.SubscribeImproveRequestService) SubscribeImproveRequestHandler {
+	return &subscribeImproveRequestHandlerImpl{
+		service: service,
+	}
+}
+
+type subscribeImproveRequestHandlerImpl struct {
+	service services.SubscribeImproveRequestService
+}
+
+func (h *subscribeImproveRequestHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	form := new(models.SubscribeImproveRequestForm)
+	if err := c.BindJSON(form); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.service.Subscribe(c, token, form.ID, time.Now()); err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{bunovel.ErrNotFound, http.StatusNotFound},
+		}, false)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
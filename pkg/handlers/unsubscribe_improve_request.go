@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type UnsubscribeImproveRequestHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewUnsubscribeImproveRequestHandler(service services.UnsubscribeImproveRequestService) UnsubscribeImproveRequestHandler {
+	return &unsubscribeImproveRequestHandlerImpl{
+		service: service,
+	}
+}
+
+type unsubscribeImproveRequestHandlerImpl struct {
+	service services.UnsubscribeImproveRequestService
+}
+
+func (h *unsubscribeImproveRequestHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	query := new(models.UnsubscribeImproveRequestQuery)
+	if err := c.BindQuery(query); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.service.Unsubscribe(c, token, query.ID.Value()); err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+		}, false)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
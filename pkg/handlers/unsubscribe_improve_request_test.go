@@ -0,0 +1,80 @@
+package handlers_test
+
+import (
+	"errors"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUnsubscribeImproveRequestHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+		query         string
+
+		shouldCallService       bool
+		shouldCallServiceWithID uuid.UUID
+		serviceErr              error
+
+		expectStatus int
+	}{
+		{
+			name:                    "Success",
+			authorization:           "Bearer my-token",
+			query:                   "?id=0a0a0a0a-0a0a-0a0a-0a0a-0a0a0a0a0a0a",
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(10),
+			expectStatus:            http.StatusNoContent,
+		},
+		{
+			name:                    "Error/ErrInvalidCredentials",
+			authorization:           "Bearer my-token",
+			query:                   "?id=0a0a0a0a-0a0a-0a0a-0a0a-0a0a0a0a0a0a",
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(10),
+			serviceErr:              goframework.ErrInvalidCredentials,
+			expectStatus:            http.StatusForbidden,
+		},
+		{
+			name:                    "Error/InternalError",
+			authorization:           "Bearer my-token",
+			query:                   "?id=0a0a0a0a-0a0a-0a0a-0a0a-0a0a0a0a0a0a",
+			shouldCallService:       true,
+			shouldCallServiceWithID: goframework.NumberUUID(10),
+			serviceErr:              errors.New("uwups"),
+			expectStatus:            http.StatusInternalServerError,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewUnsubscribeImproveRequestService(t)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("DELETE", "/"+d.query, nil)
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("Unsubscribe", c, d.authorization, d.shouldCallServiceWithID).
+					Return(d.serviceErr)
+			}
+
+			handler := handlers.NewUnsubscribeImproveRequestHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type UpdateAnnotationHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewUpdateAnnotationHandler(service services.UpdateAnnotationService) UpdateAnnotationHandler {
+	return &updateAnnotationHandlerImpl{
+		service: service,
+	}
+}
+
+type updateAnnotationHandlerImpl struct {
+	service services.UpdateAnnotationService
+}
+
+func (h *updateAnnotationHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	form := new(models.UpdateAnnotationForm)
+	if err := c.BindJSON(form); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.service.Update(c, token, form, time.Now())
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{services.ErrNotTheCreator, http.StatusUnauthorized},
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{bunovel.ErrNotFound, http.StatusNotFound},
+			{goframework.ErrInvalidEntity, http.StatusUnprocessableEntity},
+		}, true)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
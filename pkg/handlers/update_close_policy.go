@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type UpdateClosePolicyHandler interface {
+	Handle(c *gin.Context)
+}
+
+func NewUpdateClosePolicyHandler(service services.UpdateClosePolicyService) UpdateClosePolicyHandler {
+	return &updateClosePolicyHandlerImpl{
+		service: service,
+	}
+}
+
+type updateClosePolicyHandlerImpl struct {
+	service services.UpdateClosePolicyService
+}
+
+func (h *updateClosePolicyHandlerImpl) Handle(c *gin.Context) {
+	token := c.GetHeader("Authorization")
+
+	form := new(models.UpdateClosePolicyForm)
+	if err := c.BindJSON(form); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.service.Update(c, token, form)
+	if err != nil {
+		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
+			{services.ErrNotTheCreator, http.StatusUnauthorized},
+			{goframework.ErrInvalidCredentials, http.StatusForbidden},
+			{bunovel.ErrNotFound, http.StatusNotFound},
+			{goframework.ErrInvalidEntity, http.StatusUnprocessableEntity},
+		}, false)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
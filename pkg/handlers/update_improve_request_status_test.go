@@ -0,0 +1,191 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/handlers"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestUpdateImproveRequestStatusHandler(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorization string
+
+		body interface{}
+
+		shouldCallService         bool
+		shouldCallServiceWithForm *models.UpdateImproveRequestStatusForm
+		serviceResp               *models.ImproveRequestPreview
+		serviceErr                error
+
+		expect       interface{}
+		expectStatus int
+	}{
+		{
+			name:          "Success",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"status": "closed",
+			},
+			shouldCallService: true,
+			shouldCallServiceWithForm: &models.UpdateImproveRequestStatusForm{
+				ID:     goframework.NumberUUID(1),
+				Status: "closed",
+			},
+			serviceResp: &models.ImproveRequestPreview{
+				ID:            goframework.NumberUUID(1),
+				CreatedAt:     baseTime,
+				UserID:        goframework.NumberUUID(100),
+				Title:         "title",
+				Content:       "content",
+				RevisionCount: 1,
+				Status:        models.RequestStatusClosed,
+			},
+			expect: map[string]interface{}{
+				"id":                       goframework.NumberUUID(1).String(),
+				"createdAt":                baseTime.Format(time.RFC3339),
+				"userID":                   goframework.NumberUUID(100).String(),
+				"title":                    "title",
+				"content":                  "content",
+				"upVotes":                  float64(0),
+				"downVotes":                float64(0),
+				"suggestionsCount":         float64(0),
+				"acceptedSuggestionsCount": float64(0),
+				"revisionsCount":           float64(1),
+				"status":                   "closed",
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:          "Error/ErrNotTheCreator",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"status": "closed",
+			},
+			shouldCallService: true,
+			shouldCallServiceWithForm: &models.UpdateImproveRequestStatusForm{
+				ID:     goframework.NumberUUID(1),
+				Status: "closed",
+			},
+			serviceErr:   services.ErrNotTheCreator,
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "Error/ErrInvalidCredentials",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"status": "closed",
+			},
+			shouldCallService: true,
+			shouldCallServiceWithForm: &models.UpdateImproveRequestStatusForm{
+				ID:     goframework.NumberUUID(1),
+				Status: "closed",
+			},
+			serviceErr:   goframework.ErrInvalidCredentials,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name:          "Error/ErrNotFound",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"status": "closed",
+			},
+			shouldCallService: true,
+			shouldCallServiceWithForm: &models.UpdateImproveRequestStatusForm{
+				ID:     goframework.NumberUUID(1),
+				Status: "closed",
+			},
+			serviceErr:   bunovel.ErrNotFound,
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name:          "Error/ErrInvalidEntity",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"status": "paused",
+			},
+			shouldCallService: true,
+			shouldCallServiceWithForm: &models.UpdateImproveRequestStatusForm{
+				ID:     goframework.NumberUUID(1),
+				Status: "paused",
+			},
+			serviceErr:   goframework.ErrInvalidEntity,
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "Error/InternalError",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"status": "closed",
+			},
+			shouldCallService: true,
+			shouldCallServiceWithForm: &models.UpdateImproveRequestStatusForm{
+				ID:     goframework.NumberUUID(1),
+				Status: "closed",
+			},
+			serviceErr:   errors.New("uwups"),
+			expectStatus: http.StatusInternalServerError,
+		},
+		{
+			name:          "Error/BadRequest",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"id":     "fake uuid",
+				"status": "closed",
+			},
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			service := servicesmocks.NewUpdateImproveRequestStatusService(t)
+
+			mrshBody, err := json.Marshal(d.body)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("POST", "/", bytes.NewReader(mrshBody))
+			c.Request.Header.Set("Authorization", d.authorization)
+
+			if d.shouldCallService {
+				service.
+					On("Update", c, d.authorization, d.shouldCallServiceWithForm, mock.Anything).
+					Return(d.serviceResp, d.serviceErr)
+			}
+
+			handler := handlers.NewUpdateImproveRequestStatusHandler(service)
+			handler.Handle(c)
+
+			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			if d.expect != nil {
+				var body interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, d.expect, body)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -37,11 +37,13 @@ func (h *updateImproveSuggestionHandlerImpl) Handle(c *gin.Context) {
 
 	res, err := h.service.Update(c, token, form, uuid.New(), time.Now())
 	if err != nil {
+		setRetryAfter(c, err)
 		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
 			{services.ErrSwitchSource, http.StatusUnauthorized},
 			{goframework.ErrInvalidCredentials, http.StatusForbidden},
 			{bunovel.ErrNotFound, http.StatusNotFound},
 			{goframework.ErrInvalidEntity, http.StatusUnprocessableEntity},
+			{services.ErrRateLimited, http.StatusTooManyRequests},
 		}, true)
 		return
 	}
@@ -3,6 +3,7 @@ package handlers_test
 import (
 	"bytes"
 	"encoding/json"
+	goerrors "errors"
 	"github.com/a-novel/bunovel"
 	"github.com/a-novel/forum-service/pkg/handlers"
 	"github.com/a-novel/forum-service/pkg/models"
@@ -30,8 +31,9 @@ func TestUpdateImproveSuggestionHandler(t *testing.T) {
 		serviceResp       *models.ImproveSuggestion
 		serviceErr        error
 
-		expect       interface{}
-		expectStatus int
+		expect           interface{}
+		expectStatus     int
+		expectRetryAfter string
 	}{
 		{
 			name:          "Success",
@@ -114,6 +116,19 @@ func TestUpdateImproveSuggestionHandler(t *testing.T) {
 			serviceErr:        services.ErrSwitchSource,
 			expectStatus:      http.StatusUnauthorized,
 		},
+		{
+			name:          "Error/ErrRateLimited",
+			authorization: "Bearer my-token",
+			body: map[string]interface{}{
+				"title":     "title",
+				"content":   "content",
+				"requestID": goframework.NumberUUID(1).String(),
+			},
+			shouldCallService: true,
+			serviceErr:        goerrors.Join(services.ErrRateLimited, &services.RetryAfterError{Delay: 90 * time.Second}),
+			expectStatus:      http.StatusTooManyRequests,
+			expectRetryAfter:  "90",
+		},
 		{
 			name:          "Error/BadRequest",
 			authorization: "Bearer my-token",
@@ -148,6 +163,7 @@ func TestUpdateImproveSuggestionHandler(t *testing.T) {
 			handler.Handle(c)
 
 			require.Equal(t, d.expectStatus, w.Code, c.Errors.String())
+			require.Equal(t, d.expectRetryAfter, w.Header().Get("Retry-After"))
 			if d.expect != nil {
 				var body interface{}
 				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
@@ -0,0 +1,17 @@
+package handlers
+
+import (
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/gin-gonic/gin"
+	"math"
+	"strconv"
+)
+
+// setRetryAfter sets the Retry-After header, in seconds, when the error carries a services.RetryAfterError.
+func setRetryAfter(c *gin.Context, err error) {
+	var retryAfter *services.RetryAfterError
+	if goerrors.As(err, &retryAfter) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Delay.Seconds()))))
+	}
+}
@@ -7,6 +7,7 @@ import (
 	goframework "github.com/a-novel/go-framework"
 	"github.com/gin-gonic/gin"
 	"net/http"
+	"time"
 )
 
 type ValidateImproveSuggestionHandler interface {
@@ -32,7 +33,7 @@ func (h *validateImproveSuggestionHandlerImpl) Handle(c *gin.Context) {
 		return
 	}
 
-	if err := h.service.Validate(c, token, form.Validated, form.ID); err != nil {
+	if err := h.service.Validate(c, token, form.Validated, form.ID, time.Now()); err != nil {
 		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
 			{services.ErrNotTheCreator, http.StatusUnauthorized},
 			{goframework.ErrInvalidCredentials, http.StatusForbidden},
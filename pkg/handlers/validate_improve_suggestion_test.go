@@ -9,6 +9,7 @@ import (
 	goframework "github.com/a-novel/go-framework"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"net/http"
 	"net/http/httptest"
@@ -89,7 +90,7 @@ func TestValidateImproveSuggestionHandler(t *testing.T) {
 
 			if d.shouldCallService {
 				service.
-					On("Validate", c, d.authorization, d.shouldCallServiceWithValidated, d.shouldCallServiceWithID).
+					On("Validate", c, d.authorization, d.shouldCallServiceWithValidated, d.shouldCallServiceWithID, mock.Anything).
 					Return(d.serviceErr)
 			}
 
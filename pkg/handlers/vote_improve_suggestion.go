@@ -5,8 +5,10 @@ import (
 	"github.com/a-novel/forum-service/pkg/models"
 	"github.com/a-novel/forum-service/pkg/services"
 	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
 	"github.com/gin-gonic/gin"
 	"net/http"
+	"time"
 )
 
 type VoteImproveSuggestionHandler interface {
@@ -30,10 +32,11 @@ func (h *voteImproveSuggestionHandlerImpl) Handle(c *gin.Context) {
 		return
 	}
 
-	if err := h.service.Vote(c, form.ID, form.UserID, form.UpVotes, form.DownVotes); err != nil {
+	if err := h.service.Vote(c, form.ID, form.UserID, form.Vote, time.Now()); err != nil {
 		apis.ErrorToHTTPCode(c, err, []apis.HTTPError{
 			{services.ErrTheCreator, http.StatusUnauthorized},
 			{bunovel.ErrNotFound, http.StatusNotFound},
+			{goframework.ErrInvalidEntity, http.StatusUnprocessableEntity},
 		}, false)
 		return
 	}
@@ -10,6 +10,7 @@ import (
 	servicesmocks "github.com/a-novel/forum-service/pkg/services/mocks"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"net/http"
 	"net/http/httptest"
@@ -31,34 +32,30 @@ func TestVoteImproveSuggestionHandler(t *testing.T) {
 		{
 			name: "Success",
 			body: map[string]interface{}{
-				"id":        goframework.NumberUUID(1).String(),
-				"userID":    goframework.NumberUUID(2).String(),
-				"upVotes":   10,
-				"downVotes": 5,
+				"id":     goframework.NumberUUID(1).String(),
+				"userID": goframework.NumberUUID(2).String(),
+				"vote":   1,
 			},
 			shouldCallService: true,
 			shouldCallServiceWith: models.UpdateImproveSuggestionVotesForm{
-				ID:        goframework.NumberUUID(1),
-				UserID:    goframework.NumberUUID(2),
-				UpVotes:   10,
-				DownVotes: 5,
+				ID:     goframework.NumberUUID(1),
+				UserID: goframework.NumberUUID(2),
+				Vote:   1,
 			},
 			expectStatus: http.StatusNoContent,
 		},
 		{
 			name: "Error/ErrNotFound",
 			body: map[string]interface{}{
-				"id":        goframework.NumberUUID(1).String(),
-				"userID":    goframework.NumberUUID(2).String(),
-				"upVotes":   10,
-				"downVotes": 5,
+				"id":     goframework.NumberUUID(1).String(),
+				"userID": goframework.NumberUUID(2).String(),
+				"vote":   1,
 			},
 			shouldCallService: true,
 			shouldCallServiceWith: models.UpdateImproveSuggestionVotesForm{
-				ID:        goframework.NumberUUID(1),
-				UserID:    goframework.NumberUUID(2),
-				UpVotes:   10,
-				DownVotes: 5,
+				ID:     goframework.NumberUUID(1),
+				UserID: goframework.NumberUUID(2),
+				Vote:   1,
 			},
 			serviceErr:   bunovel.ErrNotFound,
 			expectStatus: http.StatusNotFound,
@@ -66,28 +63,41 @@ func TestVoteImproveSuggestionHandler(t *testing.T) {
 		{
 			name: "Error/ErrTheCreator",
 			body: map[string]interface{}{
-				"id":        goframework.NumberUUID(1).String(),
-				"userID":    goframework.NumberUUID(2).String(),
-				"upVotes":   10,
-				"downVotes": 5,
+				"id":     goframework.NumberUUID(1).String(),
+				"userID": goframework.NumberUUID(2).String(),
+				"vote":   1,
 			},
 			shouldCallService: true,
 			shouldCallServiceWith: models.UpdateImproveSuggestionVotesForm{
-				ID:        goframework.NumberUUID(1),
-				UserID:    goframework.NumberUUID(2),
-				UpVotes:   10,
-				DownVotes: 5,
+				ID:     goframework.NumberUUID(1),
+				UserID: goframework.NumberUUID(2),
+				Vote:   1,
 			},
 			serviceErr:   services.ErrTheCreator,
 			expectStatus: http.StatusUnauthorized,
 		},
+		{
+			name: "Error/ErrInvalidEntity",
+			body: map[string]interface{}{
+				"id":     goframework.NumberUUID(1).String(),
+				"userID": goframework.NumberUUID(2).String(),
+				"vote":   2,
+			},
+			shouldCallService: true,
+			shouldCallServiceWith: models.UpdateImproveSuggestionVotesForm{
+				ID:     goframework.NumberUUID(1),
+				UserID: goframework.NumberUUID(2),
+				Vote:   2,
+			},
+			serviceErr:   goframework.ErrInvalidEntity,
+			expectStatus: http.StatusUnprocessableEntity,
+		},
 		{
 			name: "Error/BadFor,",
 			body: map[string]interface{}{
-				"id":        "fake uuid",
-				"userID":    goframework.NumberUUID(2).String(),
-				"upVotes":   10,
-				"downVotes": 5,
+				"id":     "fake uuid",
+				"userID": goframework.NumberUUID(2).String(),
+				"vote":   1,
 			},
 			expectStatus: http.StatusBadRequest,
 		},
@@ -110,8 +120,8 @@ func TestVoteImproveSuggestionHandler(t *testing.T) {
 						"Vote", c,
 						d.shouldCallServiceWith.ID,
 						d.shouldCallServiceWith.UserID,
-						d.shouldCallServiceWith.UpVotes,
-						d.shouldCallServiceWith.DownVotes,
+						d.shouldCallServiceWith.Vote,
+						mock.Anything,
 					).
 					Return(d.serviceErr)
 			}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Annotation struct {
+	ID        uuid.UUID  `json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+
+	// UserID is the ID of the user who wrote the annotation.
+	UserID uuid.UUID `json:"userID"`
+	// SourceID is the ID of the first revision of the related improvement request.
+	SourceID uuid.UUID `json:"sourceID"`
+	// RevisionID is the ID of the improvement request revision the annotation is anchored on.
+	RevisionID uuid.UUID `json:"revisionID"`
+	// StartOffset is the position of the first annotated character in the revision content, in characters.
+	StartOffset int `json:"startOffset"`
+	// EndOffset is the position right after the last annotated character in the revision content, in characters.
+	EndOffset int `json:"endOffset"`
+	// Quote is a copy of the annotated text.
+	Quote string `json:"quote"`
+	// Content is the text of the annotation.
+	Content string `json:"content"`
+	// Orphaned is true when the annotated text could not be found in the newer revisions of the request.
+	Orphaned bool `json:"orphaned"`
+}
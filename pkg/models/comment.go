@@ -0,0 +1,30 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	CommentTargetImproveRequest         = "improve_request"
+	CommentTargetImproveRequestRevision = "improve_request_revision"
+	CommentTargetImproveSuggestion      = "improve_suggestion"
+)
+
+type Comment struct {
+	ID        uuid.UUID  `json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+
+	// UserID is the ID of the user who wrote the comment.
+	UserID uuid.UUID `json:"userID"`
+	// TargetType is the type of content the comment is attached to.
+	TargetType string `json:"targetType"`
+	// TargetID is the ID of the content the comment is attached to. For improve requests, it points to the
+	// source ID.
+	TargetID uuid.UUID `json:"targetID"`
+	// ParentID is set when the comment is a reply to another comment.
+	ParentID *uuid.UUID `json:"parentID"`
+	// Content is the text of the comment.
+	Content string `json:"content"`
+}
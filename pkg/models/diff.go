@@ -0,0 +1,27 @@
+package models
+
+const (
+	DiffOperationEqual  = "equal"
+	DiffOperationInsert = "insert"
+	DiffOperationDelete = "delete"
+)
+
+// Diff describes the changes between two versions of an improvement request.
+type Diff struct {
+	Title   *TextDiff `json:"title"`
+	Content *TextDiff `json:"content"`
+}
+
+// TextDiff describes the changes between two versions of a text, at different levels of detail. Concatenating the
+// chunks that are not inserted gives back the old text, and concatenating the chunks that are not deleted gives
+// back the new text.
+type TextDiff struct {
+	Words     []*DiffChunk `json:"words"`
+	Sentences []*DiffChunk `json:"sentences"`
+}
+
+type DiffChunk struct {
+	// Operation is either DiffOperationEqual, DiffOperationInsert or DiffOperationDelete.
+	Operation string `json:"operation"`
+	Text      string `json:"text"`
+}
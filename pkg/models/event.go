@@ -0,0 +1,28 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	EventTypeRequestCreated      = "request.created"
+	EventTypeRequestRevised      = "request.revised"
+	EventTypeSuggestionCreated   = "suggestion.created"
+	EventTypeSuggestionValidated = "suggestion.validated"
+)
+
+// Event describes an activity on the forum. It is sent to the event sinks by the dispatcher.
+type Event struct {
+	ID        int64     `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	Type string `json:"type"`
+	// UserID is the ID of the user whose action triggered the event.
+	UserID uuid.UUID `json:"userID"`
+	// TargetID is the ID of the object the event is about: a revision for request events, a suggestion for
+	// suggestion events.
+	TargetID uuid.UUID `json:"targetID"`
+	// SourceID is the ID of the improvement request the target belongs to.
+	SourceID uuid.UUID `json:"sourceID"`
+}
@@ -6,12 +6,33 @@ type CreateImproveRequestForm struct {
 	Title    string    `json:"title" form:"title"`
 	Content  string    `json:"content" form:"content"`
 	SourceID uuid.UUID `json:"sourceID" form:"sourceID"`
+	// Language is only read when the request is created. It defaults to French.
+	Language string `json:"language" form:"language"`
+	// Tags replace the tags of the request. They are left unchanged when omitted.
+	Tags []string `json:"tags" form:"tags"`
+	// Draft keeps the revision private, until it is published.
+	Draft bool `json:"draft" form:"draft"`
+}
+
+type UpdateImproveRequestStatusForm struct {
+	ID     uuid.UUID `json:"id" form:"id"`
+	Status string    `json:"status" form:"status"`
+}
+
+// UpdateClosePolicyForm sets the conditions under which an improvement request is closed automatically. Omitted
+// conditions are disabled.
+type UpdateClosePolicyForm struct {
+	ID                 uuid.UUID `json:"id" form:"id"`
+	CloseAfterDays     *int      `json:"closeAfterDays,omitempty" form:"closeAfterDays,omitempty"`
+	CloseAfterAccepted *int      `json:"closeAfterAccepted,omitempty" form:"closeAfterAccepted,omitempty"`
 }
 
 type ImproveSuggestionForm struct {
 	RequestID uuid.UUID `json:"requestID" form:"requestID"`
 	Title     string    `json:"title" form:"title"`
 	Content   string    `json:"content" form:"content"`
+	// Draft keeps the suggestion private, until it is published. It is only read when the suggestion is created.
+	Draft bool `json:"draft" form:"draft"`
 }
 
 type ValidateImproveSuggestionForm struct {
@@ -19,16 +40,107 @@ type ValidateImproveSuggestionForm struct {
 	Validated bool      `json:"validated" form:"validated"`
 }
 
+type ApplyImproveSuggestionForm struct {
+	ID uuid.UUID `json:"id" form:"id"`
+}
+
 type UpdateImproveRequestVotesForm struct {
-	ID        uuid.UUID `json:"id" form:"id"`
-	UserID    uuid.UUID `json:"userID" form:"userID"`
-	UpVotes   int       `json:"upVotes" form:"upVotes"`
-	DownVotes int       `json:"downVotes" form:"downVotes"`
+	ID     uuid.UUID `json:"id" form:"id"`
+	UserID uuid.UUID `json:"userID" form:"userID"`
+	// Vote is 1 for an up vote, -1 for a down vote, and 0 to retract a previous vote.
+	Vote int `json:"vote" form:"vote"`
 }
 
 type UpdateImproveSuggestionVotesForm struct {
-	ID        uuid.UUID `json:"id" form:"id"`
-	UserID    uuid.UUID `json:"userID" form:"userID"`
-	UpVotes   int       `json:"upVotes" form:"upVotes"`
-	DownVotes int       `json:"downVotes" form:"downVotes"`
+	ID     uuid.UUID `json:"id" form:"id"`
+	UserID uuid.UUID `json:"userID" form:"userID"`
+	// Vote is 1 for an up vote, -1 for a down vote, and 0 to retract a previous vote.
+	Vote int `json:"vote" form:"vote"`
+}
+
+type CommentForm struct {
+	TargetType string     `json:"targetType" form:"targetType"`
+	TargetID   uuid.UUID  `json:"targetID" form:"targetID"`
+	ParentID   *uuid.UUID `json:"parentID,omitempty" form:"parentID,omitempty"`
+	Content    string     `json:"content" form:"content"`
+}
+
+type UpdateCommentForm struct {
+	ID      uuid.UUID `json:"id" form:"id"`
+	Content string    `json:"content" form:"content"`
+}
+
+type AnnotationForm struct {
+	RevisionID  uuid.UUID `json:"revisionID" form:"revisionID"`
+	StartOffset int       `json:"startOffset" form:"startOffset"`
+	EndOffset   int       `json:"endOffset" form:"endOffset"`
+	Quote       string    `json:"quote" form:"quote"`
+	Content     string    `json:"content" form:"content"`
+}
+
+type UpdateAnnotationForm struct {
+	ID      uuid.UUID `json:"id" form:"id"`
+	Content string    `json:"content" form:"content"`
+}
+
+type MergeImproveSuggestionsForm struct {
+	// RevisionID is the ID of the revision the suggestions are merged into.
+	RevisionID uuid.UUID `json:"revisionID" form:"revisionID"`
+	// SuggestionIDs lists the suggestions to merge. When they don't conflict, their changes are applied in this order.
+	SuggestionIDs []uuid.UUID `json:"suggestionIDs" form:"suggestionIDs"`
+	// Resolutions settle the conflicts reported by a previous merge attempt.
+	Resolutions []*MergeResolutionForm `json:"resolutions,omitempty" form:"resolutions,omitempty"`
+}
+
+// MergeResolutionForm gives the text to use in place of a conflict. It matches the conflict with the same Field,
+// Start and End.
+type MergeResolutionForm struct {
+	Field string `json:"field" form:"field"`
+	Start int    `json:"start" form:"start"`
+	End   int    `json:"end" form:"end"`
+	Text  string `json:"text" form:"text"`
+}
+
+type MarkNotificationReadForm struct {
+	ID uuid.UUID `json:"id" form:"id"`
+}
+
+type SubscribeImproveRequestForm struct {
+	ID uuid.UUID `json:"id" form:"id"`
+}
+
+type ReportForm struct {
+	TargetType string    `json:"targetType" form:"targetType"`
+	TargetID   uuid.UUID `json:"targetID" form:"targetID"`
+	Reason     string    `json:"reason" form:"reason"`
+	Content    string    `json:"content" form:"content"`
+}
+
+type ClaimReportForm struct {
+	ID uuid.UUID `json:"id" form:"id"`
+}
+
+type ResolveReportForm struct {
+	ID     uuid.UUID `json:"id" form:"id"`
+	Action string    `json:"action" form:"action"`
+}
+
+type RestoreImproveRequestForm struct {
+	ID uuid.UUID `json:"id" form:"id"`
+}
+
+type RestoreImproveRequestRevisionForm struct {
+	ID uuid.UUID `json:"id" form:"id"`
+}
+
+type RestoreImproveSuggestionForm struct {
+	ID uuid.UUID `json:"id" form:"id"`
+}
+
+type PublishImproveRequestRevisionForm struct {
+	ID uuid.UUID `json:"id" form:"id"`
+}
+
+type PublishImproveSuggestionForm struct {
+	ID uuid.UUID `json:"id" form:"id"`
 }
@@ -5,6 +5,22 @@ import (
 	"time"
 )
 
+// Supported languages of the improvement requests. The language of a request decides how its content is indexed,
+// for full-text search.
+const (
+	LanguageFrench  = "fr"
+	LanguageEnglish = "en"
+	LanguageSpanish = "es"
+)
+
+// Lifecycle states of the improvement requests. Only open requests accept new suggestions.
+const (
+	RequestStatusOpen     = "open"
+	RequestStatusClosed   = "closed"
+	RequestStatusResolved = "resolved"
+	RequestStatusArchived = "archived"
+)
+
 type ImproveRequestRevision struct {
 	ID        uuid.UUID `json:"id"`
 	CreatedAt time.Time `json:"createdAt"`
@@ -17,17 +33,31 @@ type ImproveRequestRevision struct {
 	Title string `json:"title"`
 	// Content is a novel scene that the user wants to improve.
 	Content string `json:"content"`
+	// SuggestionIDs are the IDs of the suggestions this revision was created from, if any.
+	SuggestionIDs []uuid.UUID `json:"suggestionIDs"`
+	// Language is the language of the request, shared by all its revisions.
+	Language string `json:"language"`
+	// Draft is true while the revision is only visible to its author.
+	Draft bool `json:"draft,omitempty"`
+	// PublishedAt is the date a draft revision was published.
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
 }
 
 type ImproveRequestRevisionPreview struct {
 	ID        uuid.UUID `json:"id"`
 	CreatedAt time.Time `json:"createdAt"`
 
+	// SuggestionIDs are the IDs of the suggestions this revision was created from, if any.
+	SuggestionIDs []uuid.UUID `json:"suggestionIDs"`
+
 	// SuggestionsCount returns the total number of suggestions, associated with the request revision.
 	SuggestionsCount int `json:"suggestionsCount"`
 	// AcceptedSuggestionsCount returns the number of suggestions that have been accepted by the user, on the current
 	// revision.
 	AcceptedSuggestionsCount int `json:"acceptedSuggestionsCount"`
+
+	// Draft is true while the revision is only visible to its author.
+	Draft bool `json:"draft,omitempty"`
 }
 
 type ImproveRequestPreview struct {
@@ -55,4 +85,21 @@ type ImproveRequestPreview struct {
 	AcceptedSuggestionsCount int `json:"acceptedSuggestionsCount"`
 	// RevisionCount is the number of revisions the request has.
 	RevisionCount int `json:"revisionsCount"`
+
+	// Tags are the labels of the request, in alphabetical order.
+	Tags []string `json:"tags,omitempty"`
+	// Draft is true when the request was never published. Only its author can see it.
+	Draft bool `json:"draft,omitempty"`
+
+	// Status is the lifecycle state of the request. Only open requests accept new suggestions.
+	Status string `json:"status,omitempty"`
+	// CloseAfterDays closes the request once it has been open for this many days.
+	CloseAfterDays *int `json:"closeAfterDays,omitempty"`
+	// CloseAfterAccepted resolves the request once this many of its suggestions have been accepted.
+	CloseAfterAccepted *int `json:"closeAfterAccepted,omitempty"`
+
+	// TitleHighlight and ContentHighlight are only returned by searches with highlights. The words matching the query
+	// are wrapped in <mark> tags, and the content is reduced to its best fragments. The rest of the text is HTML escaped.
+	TitleHighlight   string `json:"titleHighlight,omitempty"`
+	ContentHighlight string `json:"contentHighlight,omitempty"`
 }
@@ -5,6 +5,13 @@ import (
 	"time"
 )
 
+const (
+	// SuggestionOrphanPolicyReattach moves the suggestions of a deleted revision to the nearest surviving revision.
+	SuggestionOrphanPolicyReattach = "reattach"
+	// SuggestionOrphanPolicyCascade deletes the suggestions of a deleted revision along with it.
+	SuggestionOrphanPolicyCascade = "cascade"
+)
+
 type ImproveSuggestion struct {
 	ID        uuid.UUID  `json:"id"`
 	CreatedAt time.Time  `json:"createdAt"`
@@ -31,4 +38,16 @@ type ImproveSuggestion struct {
 	Title string `json:"title"`
 	// Content contains the updated content of the source request.
 	Content string `json:"content"`
+
+	// AddedChars and RemovedChars count the characters the suggestion inserts in, and deletes from, the content of
+	// its revision. Edit stats are omitted for the suggestions created before they were measured.
+	AddedChars   *int `json:"addedChars,omitempty"`
+	RemovedChars *int `json:"removedChars,omitempty"`
+	// ChangedRatio is the share of the content that was added or removed, from 0 to 1.
+	ChangedRatio *float64 `json:"changedRatio,omitempty"`
+
+	// Draft is true while the suggestion is only visible to its author.
+	Draft bool `json:"draft,omitempty"`
+	// PublishedAt is the date a draft suggestion was published.
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
 }
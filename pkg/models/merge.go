@@ -0,0 +1,34 @@
+package models
+
+import "github.com/google/uuid"
+
+const (
+	MergeFieldTitle   = "title"
+	MergeFieldContent = "content"
+)
+
+// MergeResult is the outcome of merging several suggestions into a revision. Either the merged revision is set, or
+// the conflicts that have to be resolved before the revision can be created are listed.
+type MergeResult struct {
+	Revision  *ImproveRequestPreview `json:"revision,omitempty"`
+	Conflicts []*MergeConflict       `json:"conflicts,omitempty"`
+}
+
+// MergeConflict is an area of the base revision that several suggestions changed in different ways.
+type MergeConflict struct {
+	// Field is either MergeFieldTitle or MergeFieldContent.
+	Field string `json:"field"`
+	// Start is the position of the first conflicting character in the base field, in characters.
+	Start int `json:"start"`
+	// End is the position right after the last conflicting character in the base field, in characters.
+	End int `json:"end"`
+	// Base is the original text of the conflicting area.
+	Base string `json:"base"`
+	// Hunks contains the text each conflicting suggestion puts in place of Base.
+	Hunks []*MergeHunk `json:"hunks"`
+}
+
+type MergeHunk struct {
+	SuggestionID uuid.UUID `json:"suggestionID"`
+	Text         string    `json:"text"`
+}
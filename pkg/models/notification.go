@@ -0,0 +1,23 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Notification struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Type is the type of the event the notification was created from.
+	Type string `json:"type"`
+	// ActorID is the ID of the user whose action triggered the notification.
+	ActorID uuid.UUID `json:"actorID"`
+	// TargetID is the ID of the object the notification is about: a revision for request events, a suggestion for
+	// suggestion events.
+	TargetID uuid.UUID `json:"targetID"`
+	// SourceID is the ID of the improvement request the target belongs to.
+	SourceID uuid.UUID `json:"sourceID"`
+	// ReadAt is set once the user has read the notification.
+	ReadAt *time.Time `json:"readAt"`
+}
@@ -0,0 +1,10 @@
+package models
+
+type Orphans struct {
+	// Revisions is the number of revisions whose improvement request no longer exists.
+	Revisions int `json:"revisions"`
+	// DetachedSuggestions is the number of suggestions whose improvement request no longer exists.
+	DetachedSuggestions int `json:"detachedSuggestions"`
+	// OrphanedSuggestions is the number of suggestions whose revision no longer exists, or was deleted without them.
+	OrphanedSuggestions int `json:"orphanedSuggestions"`
+}
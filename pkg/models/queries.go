@@ -1,17 +1,77 @@
 package models
 
-import "github.com/a-novel/go-apis"
+import (
+	"github.com/a-novel/go-apis"
+	"time"
+)
 
 const (
 	OrderScore = "score"
+	// OrderActivity sorts results by last activity: the latest revision or suggestion of a request, or the latest
+	// edit or comment of a suggestion.
+	OrderActivity = "activity"
+	// OrderSuggestions sorts requests by number of suggestions.
+	OrderSuggestions = "suggestions"
+	// OrderComments sorts suggestions by number of comments.
+	OrderComments = "comments"
+	// OrderControversial sorts results by number of votes, when they are evenly split between up and down votes.
+	OrderControversial = "controversial"
+	// OrderHot sorts results by score, with a bonus for recent content.
+	OrderHot = "hot"
+)
+
+const (
+	// TagsMatchAny targets the results labeled with any of the requested tags.
+	TagsMatchAny = "any"
+	// TagsMatchAll targets the results labeled with every requested tag.
+	TagsMatchAll = "all"
 )
 
 type SearchImproveRequestsQuery struct {
 	UserID apis.StringUUID `json:"userID" form:"userID"`
-	Query  string          `json:"query" form:"query"`
-	Order  string          `json:"order" form:"order"`
-	Limit  int             `json:"limit" form:"limit"`
-	Offset int             `json:"offset" form:"offset"`
+	// Followed only targets the requests the authenticated user is subscribed to. It requires a valid token.
+	Followed bool   `json:"followed" form:"followed"`
+	Query    string `json:"query" form:"query"`
+	Language string `json:"language" form:"language"`
+	Order    string `json:"order" form:"order"`
+	Limit    int    `json:"limit" form:"limit"`
+	Offset   int    `json:"offset" form:"offset"`
+	// Every filter below is optional, and every range bound is inclusive.
+	CreatedAfter           *time.Time `json:"createdAfter,omitempty" form:"createdAfter,omitempty"`
+	CreatedBefore          *time.Time `json:"createdBefore,omitempty" form:"createdBefore,omitempty"`
+	MinScore               *int       `json:"minScore,omitempty" form:"minScore,omitempty"`
+	MaxScore               *int       `json:"maxScore,omitempty" form:"maxScore,omitempty"`
+	MinSuggestions         *int       `json:"minSuggestions,omitempty" form:"minSuggestions,omitempty"`
+	MaxSuggestions         *int       `json:"maxSuggestions,omitempty" form:"maxSuggestions,omitempty"`
+	HasAcceptedSuggestions *bool      `json:"hasAcceptedSuggestions,omitempty" form:"hasAcceptedSuggestions,omitempty"`
+	MinRevisions           *int       `json:"minRevisions,omitempty" form:"minRevisions,omitempty"`
+	MaxRevisions           *int       `json:"maxRevisions,omitempty" form:"maxRevisions,omitempty"`
+	// Tags targets the requests labeled with the given tags. TagsMatch decides whether any or all of them are required,
+	// and defaults to any.
+	Tags      []string `json:"tags" form:"tags"`
+	TagsMatch string   `json:"tagsMatch" form:"tagsMatch"`
+	// Cursor is the nextCursor returned with a previous page. It cannot be combined with an offset.
+	Cursor string `json:"cursor" form:"cursor"`
+	// SkipTotal prevents the total number of results from being computed, which is faster on large result sets.
+	SkipTotal bool `json:"skipTotal" form:"skipTotal"`
+	// Fuzzy tolerates misspelled words in the query, by also matching the titles with a close enough word.
+	Fuzzy bool `json:"fuzzy" form:"fuzzy"`
+	// Highlight returns the title and content of the results with the words matching the query highlighted.
+	Highlight bool `json:"highlight" form:"highlight"`
+	// Excerpt returns the beginning of the content of the results, instead of the whole content.
+	Excerpt bool `json:"excerpt" form:"excerpt"`
+}
+
+// SearchImproveRequestsResult is a page of improvement requests, matching a SearchImproveRequestsQuery.
+type SearchImproveRequestsResult struct {
+	Res []*ImproveRequestPreview `json:"res"`
+	// Total is the number of available results, or 0 when the query skips it.
+	Total int `json:"total"`
+	// NextCursor points to the next page. It is empty when there is no next page.
+	NextCursor string `json:"nextCursor,omitempty"`
+	// DidYouMean is a corrected version of a text query that has no results at all, if one of its words looks
+	// misspelled.
+	DidYouMean string `json:"didYouMean,omitempty"`
 }
 
 type SearchImproveSuggestionsQuery struct {
@@ -19,9 +79,29 @@ type SearchImproveSuggestionsQuery struct {
 	SourceID  apis.StringUUID `json:"sourceID" form:"sourceID"`
 	RequestID apis.StringUUID `json:"requestID" form:"requestID"`
 	Validated *bool           `json:"validated,omitempty" form:"validated,omitempty"`
+	Query     string          `json:"query" form:"query"`
+	Language  string          `json:"language" form:"language"`
 	Order     string          `json:"order" form:"order"`
 	Limit     int             `json:"limit" form:"limit"`
 	Offset    int             `json:"offset" form:"offset"`
+	// Cursor is the nextCursor returned with a previous page. It cannot be combined with an offset.
+	Cursor string `json:"cursor" form:"cursor"`
+	// SkipTotal prevents the total number of results from being computed, which is faster on large result sets.
+	SkipTotal bool `json:"skipTotal" form:"skipTotal"`
+	// Fuzzy tolerates misspelled words in the query, by also matching the titles with a close enough word.
+	Fuzzy bool `json:"fuzzy" form:"fuzzy"`
+}
+
+// SearchImproveSuggestionsResult is a page of improvement suggestions, matching a SearchImproveSuggestionsQuery.
+type SearchImproveSuggestionsResult struct {
+	Res []*ImproveSuggestion `json:"res"`
+	// Total is the number of available results, or 0 when the query skips it.
+	Total int `json:"total"`
+	// NextCursor points to the next page. It is empty when there is no next page.
+	NextCursor string `json:"nextCursor,omitempty"`
+	// DidYouMean is a corrected version of a text query that has no results at all, if one of its words looks
+	// misspelled.
+	DidYouMean string `json:"didYouMean,omitempty"`
 }
 
 type DeleteImproveRequestQuery struct {
@@ -30,6 +110,8 @@ type DeleteImproveRequestQuery struct {
 
 type DeleteImproveRequestRevisionQuery struct {
 	ID apis.StringUUID `json:"id" form:"id"`
+	// Suggestions is the policy applied to the suggestions of the revision. It defaults to reattach.
+	Suggestions string `json:"suggestions" form:"suggestions"`
 }
 
 type DeleteImproveSuggestionQuery struct {
@@ -59,3 +141,60 @@ type ListImproveRequestsQuery struct {
 type ListImproveSuggestionQuery struct {
 	IDs apis.StringUUIDs `json:"id" form:"ids"`
 }
+
+type DeleteCommentQuery struct {
+	ID apis.StringUUID `json:"id" form:"id"`
+}
+
+type ListCommentsQuery struct {
+	TargetType string          `json:"targetType" form:"targetType"`
+	TargetID   apis.StringUUID `json:"targetID" form:"targetID"`
+	ParentID   apis.StringUUID `json:"parentID" form:"parentID"`
+	Limit      int             `json:"limit" form:"limit"`
+	Offset     int             `json:"offset" form:"offset"`
+}
+
+type ListUserVotesQuery struct {
+	TargetType string           `json:"targetType" form:"targetType"`
+	IDs        apis.StringUUIDs `json:"id" form:"ids"`
+}
+
+type DeleteAnnotationQuery struct {
+	ID apis.StringUUID `json:"id" form:"id"`
+}
+
+type ListAnnotationsQuery struct {
+	RevisionID apis.StringUUID `json:"revisionID" form:"revisionID"`
+	Orphaned   *bool           `json:"orphaned,omitempty" form:"orphaned,omitempty"`
+}
+
+type DiffImproveSuggestionQuery struct {
+	ID apis.StringUUID `json:"id" form:"id"`
+}
+
+type DiffImproveRequestRevisionsQuery struct {
+	From apis.StringUUID `json:"from" form:"from"`
+	To   apis.StringUUID `json:"to" form:"to"`
+}
+
+type ListNotificationsQuery struct {
+	Unread bool `json:"unread" form:"unread"`
+	Limit  int  `json:"limit" form:"limit"`
+	Offset int  `json:"offset" form:"offset"`
+}
+
+type UnsubscribeImproveRequestQuery struct {
+	ID apis.StringUUID `json:"id" form:"id"`
+}
+
+type ListReportsQuery struct {
+	Status      string          `json:"status" form:"status"`
+	TargetType  string          `json:"targetType" form:"targetType"`
+	ModeratorID apis.StringUUID `json:"moderatorID" form:"moderatorID"`
+	Limit       int             `json:"limit" form:"limit"`
+	Offset      int             `json:"offset" form:"offset"`
+}
+
+type ListPopularTagsQuery struct {
+	Limit int `json:"limit" form:"limit"`
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	ReportTargetImproveRequestRevision = "improve_request_revision"
+	ReportTargetImproveSuggestion      = "improve_suggestion"
+	ReportTargetComment                = "comment"
+)
+
+const (
+	ReportReasonSpam       = "spam"
+	ReportReasonAbuse      = "abuse"
+	ReportReasonPlagiarism = "plagiarism"
+	ReportReasonOther      = "other"
+	// ReportReasonDuplicate is only used by the reports filed automatically.
+	ReportReasonDuplicate = "duplicate"
+)
+
+const (
+	ReportStatusOpen     = "open"
+	ReportStatusClaimed  = "claimed"
+	ReportStatusResolved = "resolved"
+)
+
+const (
+	ReportActionDismiss = "dismiss"
+	ReportActionHide    = "hide"
+	ReportActionDelete  = "delete"
+)
+
+type Report struct {
+	ID        uuid.UUID  `json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+
+	// UserID is the ID of the user who filed the report.
+	UserID uuid.UUID `json:"userID"`
+	// TargetType is the type of the reported content.
+	TargetType string `json:"targetType"`
+	// TargetID is the ID of the reported content.
+	TargetID uuid.UUID `json:"targetID"`
+	// Reason is the category of abuse the content is reported for.
+	Reason string `json:"reason"`
+	// Content gives moderators more details about the report.
+	Content string `json:"content"`
+
+	// Status is the position of the report in the review queue.
+	Status string `json:"status"`
+	// ModeratorID is the ID of the moderator who claimed or resolved the report.
+	ModeratorID *uuid.UUID `json:"moderatorID"`
+	ClaimedAt   *time.Time `json:"claimedAt"`
+	ResolvedAt  *time.Time `json:"resolvedAt"`
+	// Action is the decision taken by the moderator, once the report is resolved.
+	Action string `json:"action,omitempty"`
+}
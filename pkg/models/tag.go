@@ -0,0 +1,8 @@
+package models
+
+type Tag struct {
+	// Name is the tag itself, such as "science-fiction" or "opening-chapter".
+	Name string `json:"name"`
+	// Count is the number of improvement requests labeled with the tag.
+	Count int `json:"count"`
+}
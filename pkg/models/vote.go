@@ -0,0 +1,23 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	VoteTargetImproveRequest    = "improve_request"
+	VoteTargetImproveSuggestion = "improve_suggestion"
+)
+
+type Vote struct {
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+
+	// TargetType is the type of content the vote is cast on.
+	TargetType string `json:"targetType"`
+	// TargetID is the ID of the content the vote is cast on. For improve requests, it points to the source ID.
+	TargetID uuid.UUID `json:"targetID"`
+	// Vote is 1 for an up vote, and -1 for a down vote.
+	Vote int `json:"vote"`
+}
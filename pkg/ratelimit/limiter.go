@@ -0,0 +1,51 @@
+// Package ratelimit throttles the actions of the users, so a single account cannot flood the forum.
+package ratelimit
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Action is a throttled user action.
+type Action string
+
+const (
+	// ActionCreateImproveRequest covers new improvement requests, and new revisions of existing ones.
+	ActionCreateImproveRequest    Action = "improve_request.create"
+	ActionCreateImproveSuggestion Action = "improve_suggestion.create"
+	ActionUpdateImproveSuggestion Action = "improve_suggestion.update"
+)
+
+// Quota allows a user to perform an action Limit times per Window. Windows are fixed: they start at a multiple of
+// their duration, so every counter of a given window resets at the same time.
+type Quota struct {
+	Limit  int
+	Window time.Duration
+}
+
+// Quotas maps each throttled action to its quota. Actions without a quota are not throttled.
+type Quotas map[Action]Quota
+
+// Limiter counts the actions of the users against their quota.
+type Limiter interface {
+	// Allow records an action of a user. When the user has exhausted the quota of the action, it returns the time
+	// left until the quota resets. It returns 0 when the action is allowed.
+	Allow(ctx context.Context, userID uuid.UUID, action Action, now time.Time) (time.Duration, error)
+}
+
+// windowStart returns the start of the window the given date belongs to.
+func windowStart(quota Quota, now time.Time) time.Time {
+	return now.Truncate(quota.Window)
+}
+
+// retryAfter returns the time left until the window of the given date ends.
+func retryAfter(quota Quota, now time.Time) time.Duration {
+	return windowStart(quota, now).Add(quota.Window).Sub(now)
+}
+
+// lookup returns the quota of an action, and whether the action is throttled.
+func (quotas Quotas) lookup(action Action) (Quota, bool) {
+	quota, ok := quotas[action]
+	return quota, ok && quota.Window > 0
+}
@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sync"
+	"time"
+)
+
+type memoryCounter struct {
+	windowStart time.Time
+	hits        int
+}
+
+type memoryKey struct {
+	userID uuid.UUID
+	action Action
+}
+
+// MemoryLimiter keeps its counters in memory. It is meant for tests.
+type MemoryLimiter struct {
+	mu       sync.Mutex
+	quotas   Quotas
+	counters map[memoryKey]*memoryCounter
+}
+
+func NewMemoryLimiter(quotas Quotas) *MemoryLimiter {
+	return &MemoryLimiter{quotas: quotas, counters: make(map[memoryKey]*memoryCounter)}
+}
+
+func (limiter *MemoryLimiter) Allow(_ context.Context, userID uuid.UUID, action Action, now time.Time) (time.Duration, error) {
+	quota, ok := limiter.quotas.lookup(action)
+	if !ok {
+		return 0, nil
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	key := memoryKey{userID: userID, action: action}
+	start := windowStart(quota, now)
+
+	counter, ok := limiter.counters[key]
+	if !ok || counter.windowStart.Before(start) {
+		counter = &memoryCounter{windowStart: start}
+		limiter.counters[key] = counter
+	}
+
+	counter.hits++
+	if counter.hits > quota.Limit {
+		return retryAfter(quota, now), nil
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,46 @@
+package ratelimit_test
+
+import (
+	"context"
+	"github.com/a-novel/forum-service/pkg/ratelimit"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestMemoryLimiter(t *testing.T) {
+	now := time.Date(2020, time.May, 4, 8, 15, 0, 0, time.UTC)
+
+	limiter := ratelimit.NewMemoryLimiter(ratelimit.Quotas{
+		ratelimit.ActionCreateImproveRequest: {Limit: 2, Window: time.Hour},
+	})
+
+	for i := 0; i < 2; i++ {
+		retryAfter, err := limiter.Allow(context.Background(), goframework.NumberUUID(100), ratelimit.ActionCreateImproveRequest, now)
+		require.NoError(t, err)
+		require.Zero(t, retryAfter)
+	}
+
+	// The quota is exhausted until the end of the window.
+	retryAfter, err := limiter.Allow(context.Background(), goframework.NumberUUID(100), ratelimit.ActionCreateImproveRequest, now)
+	require.NoError(t, err)
+	require.Equal(t, 45*time.Minute, retryAfter)
+
+	// Other users have their own quota.
+	retryAfter, err = limiter.Allow(context.Background(), goframework.NumberUUID(200), ratelimit.ActionCreateImproveRequest, now)
+	require.NoError(t, err)
+	require.Zero(t, retryAfter)
+
+	// Actions without a quota are not throttled.
+	for i := 0; i < 5; i++ {
+		retryAfter, err = limiter.Allow(context.Background(), goframework.NumberUUID(100), ratelimit.ActionCreateImproveSuggestion, now)
+		require.NoError(t, err)
+		require.Zero(t, retryAfter)
+	}
+
+	// The quota resets with the next window.
+	retryAfter, err = limiter.Allow(context.Background(), goframework.NumberUUID(100), ratelimit.ActionCreateImproveRequest, now.Add(45*time.Minute))
+	require.NoError(t, err)
+	require.Zero(t, retryAfter)
+}
@@ -0,0 +1,38 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/google/uuid"
+	"time"
+)
+
+// NewPostgresLimiter returns a limiter that keeps its counters in Postgres, so the quotas are shared by every
+// instance of the API.
+func NewPostgresLimiter(repository dao.RateLimitRepository, quotas Quotas) Limiter {
+	return &postgresLimiterImpl{repository: repository, quotas: quotas}
+}
+
+type postgresLimiterImpl struct {
+	repository dao.RateLimitRepository
+	quotas     Quotas
+}
+
+func (limiter *postgresLimiterImpl) Allow(ctx context.Context, userID uuid.UUID, action Action, now time.Time) (time.Duration, error) {
+	quota, ok := limiter.quotas.lookup(action)
+	if !ok {
+		return 0, nil
+	}
+
+	hits, err := limiter.repository.Hit(ctx, userID, string(action), windowStart(quota, now))
+	if err != nil {
+		return 0, fmt.Errorf("failed to record action: %w", err)
+	}
+
+	if hits > quota.Limit {
+		return retryAfter(quota, now), nil
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,80 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/ratelimit"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestPostgresLimiter(t *testing.T) {
+	now := time.Date(2020, time.May, 4, 8, 15, 0, 0, time.UTC)
+	windowStart := time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	fooErr := errors.New("it broken")
+
+	quotas := ratelimit.Quotas{
+		ratelimit.ActionCreateImproveRequest: {Limit: 2, Window: time.Hour},
+	}
+
+	data := []struct {
+		name string
+
+		action ratelimit.Action
+
+		shouldCallHit bool
+		hitResp       int
+		hitErr        error
+
+		expect    time.Duration
+		expectErr error
+	}{
+		{
+			name:          "Success",
+			action:        ratelimit.ActionCreateImproveRequest,
+			shouldCallHit: true,
+			hitResp:       2,
+		},
+		{
+			name:          "Success/Limited",
+			action:        ratelimit.ActionCreateImproveRequest,
+			shouldCallHit: true,
+			hitResp:       3,
+			expect:        45 * time.Minute,
+		},
+		{
+			name:   "Success/NoQuota",
+			action: ratelimit.ActionCreateImproveSuggestion,
+		},
+		{
+			name:          "Error/HitFailure",
+			action:        ratelimit.ActionCreateImproveRequest,
+			shouldCallHit: true,
+			hitErr:        fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewRateLimitRepository(t)
+
+			if d.shouldCallHit {
+				repository.
+					On("Hit", context.Background(), goframework.NumberUUID(100), string(d.action), windowStart).
+					Return(d.hitResp, d.hitErr)
+			}
+
+			limiter := ratelimit.NewPostgresLimiter(repository, quotas)
+			res, err := limiter.Allow(context.Background(), goframework.NumberUUID(100), d.action, now)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"time"
+)
+
+type ApplyImproveSuggestionService interface {
+	Apply(ctx context.Context, tokenRaw string, suggestionID, id uuid.UUID, now time.Time) (*models.ImproveRequestPreview, error)
+}
+
+func NewApplyImproveSuggestionService(
+	repository dao.ImproveSuggestionRepository,
+	requestRepository dao.ImproveRequestRepository,
+	authClient apiclients.AuthClient,
+	permissionsClient apiclients.PermissionsClient,
+) ApplyImproveSuggestionService {
+	return &applyImproveSuggestionServiceImpl{
+		repository:        repository,
+		requestRepository: requestRepository,
+		authClient:        authClient,
+		permissionsClient: permissionsClient,
+	}
+}
+
+type applyImproveSuggestionServiceImpl struct {
+	repository        dao.ImproveSuggestionRepository
+	requestRepository dao.ImproveRequestRepository
+	authClient        apiclients.AuthClient
+	permissionsClient apiclients.PermissionsClient
+}
+
+func (s *applyImproveSuggestionServiceImpl) Apply(ctx context.Context, tokenRaw string, suggestionID, id uuid.UUID, now time.Time) (*models.ImproveRequestPreview, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	if err := s.permissionsClient.HasUserScope(ctx, apiclients.HasUserScopeQuery{
+		UserID: token.Token.Payload.ID,
+		Scope:  apiclients.CanPostImproveRequest,
+	}); err != nil {
+		return nil, goerrors.Join(ErrGetScopes, err)
+	}
+
+	suggestion, err := s.repository.Get(ctx, suggestionID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveSuggestion, err)
+	}
+	if !canView(suggestion.Draft, suggestion.UserID, &token.Token.Payload.ID) {
+		return nil, goerrors.Join(ErrGetImproveSuggestion, bunovel.ErrNotFound)
+	}
+	if suggestion.Validated {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrSuggestionAlreadyApplied)
+	}
+
+	request, err := s.requestRepository.Get(ctx, suggestion.SourceID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequest, err)
+	}
+
+	// Applying a suggestion creates a new revision, so only the creator of the request is allowed to do it.
+	if request.UserID != token.Token.Payload.ID {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
+	}
+
+	res, err := s.requestRepository.ApplySuggestion(
+		ctx, token.Token.Payload.ID, suggestionID, id, now,
+		&dao.EventModelCore{
+			Type:     dao.EventTypeRequestRevised,
+			UserID:   token.Token.Payload.ID,
+			TargetID: id,
+			SourceID: suggestion.SourceID,
+		},
+		&dao.EventModelCore{
+			Type:     dao.EventTypeSuggestionValidated,
+			UserID:   token.Token.Payload.ID,
+			TargetID: suggestionID,
+			SourceID: suggestion.SourceID,
+		},
+	)
+	// The suggestion may have been applied since it was read.
+	if goerrors.Is(err, dao.ErrSuggestionAlreadyApplied) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrSuggestionAlreadyApplied, err)
+	}
+	if err != nil {
+		return nil, goerrors.Join(ErrApplyImproveSuggestion, err)
+	}
+
+	return adapters.ImproveRequestPreviewToModel(res), nil
+}
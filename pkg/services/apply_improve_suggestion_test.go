@@ -0,0 +1,344 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestApplyImproveSuggestionService(t *testing.T) {
+	data := []struct {
+		name string
+
+		tokenRaw     string
+		suggestionID uuid.UUID
+		id           uuid.UUID
+		now          time.Time
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallPermissionsClient bool
+		permissionsClientErr        error
+
+		shouldCallGetSuggestion bool
+		getSuggestionResp       *dao.ImproveSuggestionModel
+		getSuggestionErr        error
+
+		shouldCallGetRequest bool
+		getRequestResp       *dao.ImproveRequestPreview
+		getRequestErr        error
+
+		shouldCallApply bool
+		applyResp       *dao.ImproveRequestPreview
+		applyErr        error
+
+		expect    *models.ImproveRequestPreview
+		expectErr error
+	}{
+		{
+			name:         "Success",
+			tokenRaw:     "token",
+			suggestionID: goframework.NumberUUID(1),
+			id:           goframework.NumberUUID(2),
+			now:          baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetSuggestion:     true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(200),
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallApply: true,
+			applyResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "suggested title",
+				Content:  "suggested content",
+			},
+			expect: &models.ImproveRequestPreview{
+				ID:        goframework.NumberUUID(10),
+				CreatedAt: baseTime,
+				UserID:    goframework.NumberUUID(100),
+				Title:     "suggested title",
+				Content:   "suggested content",
+			},
+		},
+		{
+			name:         "Error/ApplyFailure",
+			tokenRaw:     "token",
+			suggestionID: goframework.NumberUUID(1),
+			id:           goframework.NumberUUID(2),
+			now:          baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetSuggestion:     true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(200),
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallApply: true,
+			applyErr:        fooErr,
+			expectErr:       fooErr,
+		},
+		{
+			name:         "Error/AppliedMeanwhile",
+			tokenRaw:     "token",
+			suggestionID: goframework.NumberUUID(1),
+			id:           goframework.NumberUUID(2),
+			now:          baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetSuggestion:     true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(200),
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallApply: true,
+			applyErr:        dao.ErrSuggestionAlreadyApplied,
+			expectErr:       goframework.ErrInvalidEntity,
+		},
+		{
+			name:         "Error/AlreadyValidated",
+			tokenRaw:     "token",
+			suggestionID: goframework.NumberUUID(1),
+			id:           goframework.NumberUUID(2),
+			now:          baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetSuggestion:     true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				SourceID:  goframework.NumberUUID(10),
+				UserID:    goframework.NumberUUID(200),
+				Validated: true,
+			},
+			expectErr: services.ErrSuggestionAlreadyApplied,
+		},
+		{
+			name:         "Error/NotTheCreator",
+			tokenRaw:     "token",
+			suggestionID: goframework.NumberUUID(1),
+			id:           goframework.NumberUUID(2),
+			now:          baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetSuggestion:     true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(200),
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(300),
+			},
+			expectErr: services.ErrNotTheCreator,
+		},
+		{
+			name:         "Error/GetRequestFailure",
+			tokenRaw:     "token",
+			suggestionID: goframework.NumberUUID(1),
+			id:           goframework.NumberUUID(2),
+			now:          baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetSuggestion:     true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(200),
+			},
+			shouldCallGetRequest: true,
+			getRequestErr:        fooErr,
+			expectErr:            fooErr,
+		},
+		{
+			name:         "Error/DraftSuggestion",
+			tokenRaw:     "token",
+			suggestionID: goframework.NumberUUID(1),
+			id:           goframework.NumberUUID(2),
+			now:          baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetSuggestion:     true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(200),
+				Draft:    true,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:         "Error/GetSuggestionFailure",
+			tokenRaw:     "token",
+			suggestionID: goframework.NumberUUID(1),
+			id:           goframework.NumberUUID(2),
+			now:          baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetSuggestion:     true,
+			getSuggestionErr:            fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:         "Error/PermissionsClientFailure",
+			tokenRaw:     "token",
+			suggestionID: goframework.NumberUUID(1),
+			id:           goframework.NumberUUID(2),
+			now:          baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientErr:        fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:           "Error/NotAuthenticated",
+			tokenRaw:       "token",
+			suggestionID:   goframework.NumberUUID(1),
+			id:             goframework.NumberUUID(2),
+			now:            baseTime,
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:          "Error/AuthClientFailure",
+			tokenRaw:      "token",
+			suggestionID:  goframework.NumberUUID(1),
+			id:            goframework.NumberUUID(2),
+			now:           baseTime,
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewImproveSuggestionRepository(t)
+			requestRepository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+			permissionsClient := apiclientsmocks.NewPermissionsClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallPermissionsClient {
+				permissionsClient.
+					On("HasUserScope", context.Background(), apiclients.HasUserScopeQuery{
+						UserID: d.authClientResp.Token.Payload.ID,
+						Scope:  apiclients.CanPostImproveRequest,
+					}).
+					Return(d.permissionsClientErr)
+			}
+
+			if d.shouldCallGetSuggestion {
+				repository.On("Get", context.Background(), d.suggestionID).Return(d.getSuggestionResp, d.getSuggestionErr)
+			}
+
+			if d.shouldCallGetRequest {
+				requestRepository.
+					On("Get", context.Background(), d.getSuggestionResp.SourceID).
+					Return(d.getRequestResp, d.getRequestErr)
+			}
+
+			if d.shouldCallApply {
+				requestRepository.
+					On(
+						"ApplySuggestion",
+						context.Background(),
+						d.authClientResp.Token.Payload.ID,
+						d.suggestionID,
+						d.id,
+						d.now,
+						&dao.EventModelCore{
+							Type:     dao.EventTypeRequestRevised,
+							UserID:   d.authClientResp.Token.Payload.ID,
+							TargetID: d.id,
+							SourceID: d.getSuggestionResp.SourceID,
+						},
+						&dao.EventModelCore{
+							Type:     dao.EventTypeSuggestionValidated,
+							UserID:   d.authClientResp.Token.Payload.ID,
+							TargetID: d.suggestionID,
+							SourceID: d.getSuggestionResp.SourceID,
+						},
+					).
+					Return(d.applyResp, d.applyErr)
+			}
+
+			service := services.NewApplyImproveSuggestionService(repository, requestRepository, authClient, permissionsClient)
+			res, err := service.Apply(context.Background(), d.tokenRaw, d.suggestionID, d.id, d.now)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			requestRepository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+			permissionsClient.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"time"
+)
+
+type AutoCloseImproveRequestsService interface {
+	// AutoClose applies the close policy of the open improvement requests. It returns the number of requests that
+	// were closed or resolved.
+	AutoClose(ctx context.Context, now time.Time) (int, error)
+}
+
+func NewAutoCloseImproveRequestsService(repository dao.ImproveRequestRepository) AutoCloseImproveRequestsService {
+	return &autoCloseImproveRequestsServiceImpl{
+		repository: repository,
+	}
+}
+
+type autoCloseImproveRequestsServiceImpl struct {
+	repository dao.ImproveRequestRepository
+}
+
+func (s *autoCloseImproveRequestsServiceImpl) AutoClose(ctx context.Context, now time.Time) (int, error) {
+	updated, err := s.repository.AutoClose(ctx, now)
+	if err != nil {
+		return 0, goerrors.Join(ErrAutoCloseImproveRequests, err)
+	}
+
+	return updated, nil
+}
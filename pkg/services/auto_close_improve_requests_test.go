@@ -0,0 +1,48 @@
+package services_test
+
+import (
+	"context"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAutoCloseImproveRequestsService(t *testing.T) {
+	data := []struct {
+		name string
+
+		autoCloseResp int
+		autoCloseErr  error
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name:          "Success",
+			autoCloseResp: 3,
+			expect:        3,
+		},
+		{
+			name:         "Error/AutoCloseFailure",
+			autoCloseErr: fooErr,
+			expectErr:    fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewImproveRequestRepository(t)
+
+			repository.On("AutoClose", context.Background(), baseTime).Return(d.autoCloseResp, d.autoCloseErr)
+
+			service := services.NewAutoCloseImproveRequestsService(repository)
+			res, err := service.AutoClose(context.Background(), baseTime)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"time"
+)
+
+type ClaimReportService interface {
+	// Claim assigns a report to the moderator, so other moderators do not review it at the same time.
+	Claim(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) (*models.Report, error)
+}
+
+func NewClaimReportService(
+	repository dao.ReportRepository,
+	authClient apiclients.AuthClient,
+	permissionsClient apiclients.PermissionsClient,
+) ClaimReportService {
+	return &claimReportServiceImpl{
+		repository:        repository,
+		authClient:        authClient,
+		permissionsClient: permissionsClient,
+	}
+}
+
+type claimReportServiceImpl struct {
+	repository        dao.ReportRepository
+	authClient        apiclients.AuthClient
+	permissionsClient apiclients.PermissionsClient
+}
+
+func (s *claimReportServiceImpl) Claim(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) (*models.Report, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	if err := s.permissionsClient.HasUserScope(ctx, moderatorScopeQuery(token.Token.Payload.ID)); err != nil {
+		return nil, goerrors.Join(ErrGetScopes, err)
+	}
+
+	report, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetReport, err)
+	}
+
+	switch report.Status {
+	case dao.ReportStatusResolved:
+		return nil, ErrReportResolved
+	case dao.ReportStatusClaimed:
+		// Claiming a report twice is harmless, as long as it is done by the same moderator.
+		if report.ModeratorID != nil && *report.ModeratorID == token.Token.Payload.ID {
+			return adapters.ReportToModel(report), nil
+		}
+
+		return nil, ErrReportClaimed
+	}
+
+	report, err = s.repository.Claim(ctx, token.Token.Payload.ID, id, now)
+	if err != nil {
+		return nil, goerrors.Join(ErrClaimReport, err)
+	}
+
+	return adapters.ReportToModel(report), nil
+}
@@ -0,0 +1,253 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestClaimReportService(t *testing.T) {
+	data := []struct {
+		name string
+
+		tokenRaw string
+		id       uuid.UUID
+		now      time.Time
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallPermissionsClient bool
+		permissionsClientErr        error
+
+		shouldCallGet bool
+		getResp       *dao.ReportModel
+		getErr        error
+
+		shouldCallClaim bool
+		claimResp       *dao.ReportModel
+		claimErr        error
+
+		expect    *models.Report
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGet:               true,
+			getResp: &dao.ReportModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				Status:   dao.ReportStatusOpen,
+			},
+			shouldCallClaim: true,
+			claimResp: &dao.ReportModel{
+				Metadata:    bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+				UserID:      goframework.NumberUUID(200),
+				Status:      dao.ReportStatusClaimed,
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(100)),
+				ClaimedAt:   &updateTime,
+				ReportModelCore: dao.ReportModelCore{
+					TargetType: dao.ReportTargetComment,
+					TargetID:   goframework.NumberUUID(10),
+					Reason:     dao.ReportReasonSpam,
+				},
+			},
+			expect: &models.Report{
+				ID:          goframework.NumberUUID(1),
+				CreatedAt:   baseTime,
+				UpdatedAt:   &updateTime,
+				UserID:      goframework.NumberUUID(200),
+				TargetType:  models.ReportTargetComment,
+				TargetID:    goframework.NumberUUID(10),
+				Reason:      models.ReportReasonSpam,
+				Status:      models.ReportStatusClaimed,
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(100)),
+				ClaimedAt:   &updateTime,
+			},
+		},
+		{
+			name:     "Success/AlreadyClaimedBySameModerator",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGet:               true,
+			getResp: &dao.ReportModel{
+				Metadata:    bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &baseTime),
+				Status:      dao.ReportStatusClaimed,
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(100)),
+				ClaimedAt:   &baseTime,
+			},
+			expect: &models.Report{
+				ID:          goframework.NumberUUID(1),
+				CreatedAt:   baseTime,
+				UpdatedAt:   &baseTime,
+				Status:      models.ReportStatusClaimed,
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(100)),
+				ClaimedAt:   &baseTime,
+			},
+		},
+		{
+			name:     "Error/ClaimFailure",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGet:               true,
+			getResp: &dao.ReportModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				Status:   dao.ReportStatusOpen,
+			},
+			shouldCallClaim: true,
+			claimErr:        fooErr,
+			expectErr:       fooErr,
+		},
+		{
+			name:     "Error/ClaimedByAnotherModerator",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGet:               true,
+			getResp: &dao.ReportModel{
+				Metadata:    bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &baseTime),
+				Status:      dao.ReportStatusClaimed,
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(101)),
+				ClaimedAt:   &baseTime,
+			},
+			expectErr: services.ErrReportClaimed,
+		},
+		{
+			name:     "Error/Resolved",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGet:               true,
+			getResp: &dao.ReportModel{
+				Metadata:    bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &baseTime),
+				Status:      dao.ReportStatusResolved,
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(100)),
+				ResolvedAt:  &baseTime,
+				Action:      dao.ReportActionDismiss,
+			},
+			expectErr: services.ErrReportResolved,
+		},
+		{
+			name:     "Error/GetFailure",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGet:               true,
+			getErr:                      bunovel.ErrNotFound,
+			expectErr:                   bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/NotModerator",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientErr:        fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:           "Error/NotAuthenticated",
+			tokenRaw:       "token",
+			id:             goframework.NumberUUID(1),
+			now:            updateTime,
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:          "Error/AuthClientFailure",
+			tokenRaw:      "token",
+			id:            goframework.NumberUUID(1),
+			now:           updateTime,
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewReportRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+			permissionsClient := apiclientsmocks.NewPermissionsClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallPermissionsClient {
+				permissionsClient.
+					On("HasUserScope", context.Background(), apiclients.HasUserScopeQuery{
+						UserID: goframework.NumberUUID(100),
+						Scope:  services.CanModerate,
+					}).
+					Return(d.permissionsClientErr)
+			}
+
+			if d.shouldCallGet {
+				repository.On("Get", context.Background(), d.id).Return(d.getResp, d.getErr)
+			}
+
+			if d.shouldCallClaim {
+				repository.
+					On("Claim", context.Background(), goframework.NumberUUID(100), d.id, d.now).
+					Return(d.claimResp, d.claimErr)
+			}
+
+			service := services.NewClaimReportService(repository, authClient, permissionsClient)
+			res, err := service.Claim(context.Background(), d.tokenRaw, d.id, d.now)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+			permissionsClient.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/dao"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+)
+
+type CountUnreadNotificationsService interface {
+	Count(ctx context.Context, tokenRaw string) (int, error)
+}
+
+func NewCountUnreadNotificationsService(repository dao.NotificationRepository, authClient apiclients.AuthClient) CountUnreadNotificationsService {
+	return &countUnreadNotificationsServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type countUnreadNotificationsServiceImpl struct {
+	repository dao.NotificationRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *countUnreadNotificationsServiceImpl) Count(ctx context.Context, tokenRaw string) (int, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return 0, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return 0, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	count, err := s.repository.CountUnread(ctx, token.Token.Payload.ID)
+	if err != nil {
+		return 0, goerrors.Join(ErrCountUnreadNotifications, err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,89 @@
+package services_test
+
+import (
+	"context"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCountUnreadNotificationsService(t *testing.T) {
+	data := []struct {
+		name string
+
+		token string
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallDAO bool
+		daoResp       int
+		daoErr        error
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			token: "tokenRaw",
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallDAO: true,
+			daoResp:       12,
+			expect:        12,
+		},
+		{
+			name:  "Error/DAOFailure",
+			token: "tokenRaw",
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallDAO: true,
+			daoErr:        fooErr,
+			expectErr:     fooErr,
+		},
+		{
+			name:           "Error/NotAuthenticated",
+			token:          "tokenRaw",
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:          "Error/AuthClientFailure",
+			token:         "tokenRaw",
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewNotificationRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.token).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallDAO {
+				repository.
+					On("CountUnread", context.Background(), goframework.NumberUUID(100)).
+					Return(d.daoResp, d.daoErr)
+			}
+
+			service := services.NewCountUnreadNotificationsService(repository, authClient)
+			res, err := service.Count(context.Background(), d.token)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+		})
+	}
+}
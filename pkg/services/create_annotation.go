@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"time"
+)
+
+type CreateAnnotationService interface {
+	Create(ctx context.Context, tokenRaw string, form *models.AnnotationForm, id uuid.UUID, now time.Time) (*models.Annotation, error)
+}
+
+func NewCreateAnnotationService(
+	repository dao.AnnotationRepository,
+	requestRepository dao.ImproveRequestRepository,
+	authClient apiclients.AuthClient,
+	permissionsClient apiclients.PermissionsClient,
+) CreateAnnotationService {
+	return &createAnnotationServiceImpl{
+		repository:        repository,
+		requestRepository: requestRepository,
+		authClient:        authClient,
+		permissionsClient: permissionsClient,
+	}
+}
+
+type createAnnotationServiceImpl struct {
+	repository        dao.AnnotationRepository
+	requestRepository dao.ImproveRequestRepository
+	authClient        apiclients.AuthClient
+	permissionsClient apiclients.PermissionsClient
+}
+
+func (s *createAnnotationServiceImpl) Create(ctx context.Context, tokenRaw string, form *models.AnnotationForm, id uuid.UUID, now time.Time) (*models.Annotation, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	if err := s.permissionsClient.HasUserScope(ctx, apiclients.HasUserScopeQuery{
+		UserID: token.Token.Payload.ID,
+		Scope:  apiclients.CanPostImproveSuggestion,
+	}); err != nil {
+		return nil, goerrors.Join(ErrGetScopes, err)
+	}
+
+	if err := goframework.CheckMinMax(form.Content, MinAnnotationLength, MaxAnnotationLength); err != nil {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidContent, err)
+	}
+
+	revision, err := s.requestRepository.GetRevision(ctx, form.RevisionID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, err)
+	}
+	// Checked before the quote, so the text of a draft cannot be guessed.
+	if !canView(revision.Draft, revision.UserID, &token.Token.Payload.ID) {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, bunovel.ErrNotFound)
+	}
+
+	// The quote must match the annotated range exactly, so the annotation can be found back in later revisions.
+	content := []rune(revision.Content)
+	if form.StartOffset < 0 || form.EndOffset <= form.StartOffset || form.EndOffset > len(content) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidAnchor)
+	}
+	if string(content[form.StartOffset:form.EndOffset]) != form.Quote {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidAnchor)
+	}
+
+	annotation, err := s.repository.Create(ctx, adapters.AnnotationFormToDAO(form), token.Token.Payload.ID, revision.SourceID, id, now)
+	if err != nil {
+		return nil, goerrors.Join(ErrCreateAnnotation, err)
+	}
+
+	return adapters.AnnotationToModel(annotation), nil
+}
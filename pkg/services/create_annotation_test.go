@@ -0,0 +1,443 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateAnnotationService(t *testing.T) {
+	data := []struct {
+		name string
+
+		tokenRaw string
+		form     *models.AnnotationForm
+		id       uuid.UUID
+		now      time.Time
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallPermissionsClient bool
+		permissionsClientErr        error
+
+		shouldCallGetRevision bool
+		getRevisionResp       *dao.ImproveRequestRevisionModel
+		getRevisionErr        error
+
+		shouldCallCreate bool
+		createResp       *dao.AnnotationModel
+		createErr        error
+
+		expect    *models.Annotation
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			tokenRaw: "token",
+			form: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(20),
+				Content:  "The quick brown fox.",
+			},
+			shouldCallCreate: true,
+			createResp: &dao.AnnotationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(20),
+				AnnotationModelCore: dao.AnnotationModelCore{
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 4,
+					EndOffset:   9,
+					Quote:       "quick",
+					Content:     "content",
+				},
+			},
+			expect: &models.Annotation{
+				ID:          goframework.NumberUUID(1),
+				CreatedAt:   baseTime,
+				UserID:      goframework.NumberUUID(100),
+				SourceID:    goframework.NumberUUID(20),
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "content",
+			},
+		},
+		{
+			name:     "Success/MultiByteCharacters",
+			tokenRaw: "token",
+			form: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 3,
+				EndOffset:   8,
+				Quote:       "était",
+				Content:     "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(20),
+				Content:  "Il était une fois.",
+			},
+			shouldCallCreate: true,
+			createResp: &dao.AnnotationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(20),
+				AnnotationModelCore: dao.AnnotationModelCore{
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 3,
+					EndOffset:   8,
+					Quote:       "était",
+					Content:     "content",
+				},
+			},
+			expect: &models.Annotation{
+				ID:          goframework.NumberUUID(1),
+				CreatedAt:   baseTime,
+				UserID:      goframework.NumberUUID(100),
+				SourceID:    goframework.NumberUUID(20),
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 3,
+				EndOffset:   8,
+				Quote:       "était",
+				Content:     "content",
+			},
+		},
+		{
+			name:     "Error/CreateFailure",
+			tokenRaw: "token",
+			form: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(20),
+				Content:  "The quick brown fox.",
+			},
+			shouldCallCreate: true,
+			createErr:        fooErr,
+			expectErr:        fooErr,
+		},
+		{
+			name:     "Error/QuoteMismatch",
+			tokenRaw: "token",
+			form: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "brown",
+				Content:     "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(20),
+				Content:  "The quick brown fox.",
+			},
+			expectErr: services.ErrInvalidAnchor,
+		},
+		{
+			name:     "Error/RangeOutOfBounds",
+			tokenRaw: "token",
+			form: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 16,
+				EndOffset:   24,
+				Quote:       "fox.",
+				Content:     "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(20),
+				Content:  "The quick brown fox.",
+			},
+			expectErr: services.ErrInvalidAnchor,
+		},
+		{
+			name:     "Error/EmptyRange",
+			tokenRaw: "token",
+			form: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   4,
+				Content:     "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(20),
+				Content:  "The quick brown fox.",
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:     "Error/DraftOfAnotherUser",
+			tokenRaw: "token",
+			form: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(20),
+				UserID:   goframework.NumberUUID(200),
+				Content:  "The quick brown fox.",
+				Draft:    true,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/GetRevisionFailure",
+			tokenRaw: "token",
+			form: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionErr:              fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:     "Error/ContentTooLong",
+			tokenRaw: "token",
+			form: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     strings.Repeat("a", services.MaxAnnotationLength+1),
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			expectErr:                   goframework.ErrInvalidEntity,
+		},
+		{
+			name:     "Error/NoContent",
+			tokenRaw: "token",
+			form: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			expectErr:                   goframework.ErrInvalidEntity,
+		},
+		{
+			name:     "Error/PermissionsClientFailure",
+			tokenRaw: "token",
+			form: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientErr:        fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:     "Error/NotAuthenticated",
+			tokenRaw: "token",
+			form: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "content",
+			},
+			id:             goframework.NumberUUID(1),
+			now:            baseTime,
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:     "Error/AuthClientFailure",
+			tokenRaw: "token",
+			form: &models.AnnotationForm{
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "content",
+			},
+			id:            goframework.NumberUUID(1),
+			now:           baseTime,
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewAnnotationRepository(t)
+			requestRepository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+			permissionsClient := apiclientsmocks.NewPermissionsClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallPermissionsClient {
+				permissionsClient.
+					On("HasUserScope", context.Background(), apiclients.HasUserScopeQuery{
+						UserID: d.authClientResp.Token.Payload.ID,
+						Scope:  apiclients.CanPostImproveSuggestion,
+					}).
+					Return(d.permissionsClientErr)
+			}
+
+			if d.shouldCallGetRevision {
+				requestRepository.
+					On("GetRevision", context.Background(), d.form.RevisionID).
+					Return(d.getRevisionResp, d.getRevisionErr)
+			}
+
+			if d.shouldCallCreate {
+				repository.
+					On("Create", context.Background(), &dao.AnnotationModelCore{
+						RevisionID:  d.form.RevisionID,
+						StartOffset: d.form.StartOffset,
+						EndOffset:   d.form.EndOffset,
+						Quote:       d.form.Quote,
+						Content:     d.form.Content,
+					}, d.authClientResp.Token.Payload.ID, d.getRevisionResp.SourceID, d.id, d.now).
+					Return(d.createResp, d.createErr)
+			}
+
+			service := services.NewCreateAnnotationService(repository, requestRepository, authClient, permissionsClient)
+			res, err := service.Create(context.Background(), d.tokenRaw, d.form, d.id, d.now)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			requestRepository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+			permissionsClient.AssertExpectations(t)
+		})
+	}
+}
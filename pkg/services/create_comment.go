@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"time"
+)
+
+type CreateCommentService interface {
+	Create(ctx context.Context, tokenRaw string, form *models.CommentForm, id uuid.UUID, now time.Time) (*models.Comment, error)
+}
+
+func NewCreateCommentService(
+	repository dao.CommentRepository,
+	requestRepository dao.ImproveRequestRepository,
+	suggestionRepository dao.ImproveSuggestionRepository,
+	authClient apiclients.AuthClient,
+	permissionsClient apiclients.PermissionsClient,
+) CreateCommentService {
+	return &createCommentServiceImpl{
+		repository:           repository,
+		requestRepository:    requestRepository,
+		suggestionRepository: suggestionRepository,
+		authClient:           authClient,
+		permissionsClient:    permissionsClient,
+	}
+}
+
+type createCommentServiceImpl struct {
+	repository           dao.CommentRepository
+	requestRepository    dao.ImproveRequestRepository
+	suggestionRepository dao.ImproveSuggestionRepository
+	authClient           apiclients.AuthClient
+	permissionsClient    apiclients.PermissionsClient
+}
+
+func (s *createCommentServiceImpl) Create(ctx context.Context, tokenRaw string, form *models.CommentForm, id uuid.UUID, now time.Time) (*models.Comment, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	switch form.TargetType {
+	case models.CommentTargetImproveRequest, models.CommentTargetImproveRequestRevision, models.CommentTargetImproveSuggestion:
+	default:
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidTargetType)
+	}
+
+	if err := s.permissionsClient.HasUserScope(ctx, commentScopeQuery(token.Token.Payload.ID, form.TargetType)); err != nil {
+		return nil, goerrors.Join(ErrGetScopes, err)
+	}
+
+	if err := goframework.CheckMinMax(form.Content, MinCommentLength, MaxCommentLength); err != nil {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidContent, err)
+	}
+
+	// Make sure the commented content exists, and is visible to the user.
+	switch form.TargetType {
+	case models.CommentTargetImproveRequest:
+		request, err := s.requestRepository.Get(ctx, form.TargetID)
+		if err != nil {
+			return nil, goerrors.Join(ErrGetImproveRequest, err)
+		}
+		if !canView(request.Draft, request.UserID, &token.Token.Payload.ID) {
+			return nil, goerrors.Join(ErrGetImproveRequest, bunovel.ErrNotFound)
+		}
+	case models.CommentTargetImproveRequestRevision:
+		revision, err := s.requestRepository.GetRevision(ctx, form.TargetID)
+		if err != nil {
+			return nil, goerrors.Join(ErrGetImproveRequestRevision, err)
+		}
+		if !canView(revision.Draft, revision.UserID, &token.Token.Payload.ID) {
+			return nil, goerrors.Join(ErrGetImproveRequestRevision, bunovel.ErrNotFound)
+		}
+	case models.CommentTargetImproveSuggestion:
+		suggestion, err := s.suggestionRepository.Get(ctx, form.TargetID)
+		if err != nil {
+			return nil, goerrors.Join(ErrGetImproveSuggestion, err)
+		}
+		if !canView(suggestion.Draft, suggestion.UserID, &token.Token.Payload.ID) {
+			return nil, goerrors.Join(ErrGetImproveSuggestion, bunovel.ErrNotFound)
+		}
+	}
+
+	// A reply must belong to the same thread as its parent.
+	if form.ParentID != nil {
+		parent, err := s.repository.Get(ctx, *form.ParentID)
+		if err != nil {
+			return nil, goerrors.Join(ErrGetComment, err)
+		}
+
+		if string(parent.TargetType) != form.TargetType || parent.TargetID != form.TargetID {
+			return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrSwitchTarget)
+		}
+	}
+
+	comment, err := s.repository.Create(ctx, adapters.CommentFormToDAO(form), token.Token.Payload.ID, id, now)
+	if err != nil {
+		return nil, goerrors.Join(ErrCreateComment, err)
+	}
+
+	return adapters.CommentToModel(comment), nil
+}
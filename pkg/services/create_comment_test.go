@@ -0,0 +1,611 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateCommentService(t *testing.T) {
+	data := []struct {
+		name string
+
+		tokenRaw string
+		form     *models.CommentForm
+		id       uuid.UUID
+		now      time.Time
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallPermissionsClient bool
+		permissionsClientScope      apiclients.HasUserScopeQuery
+		permissionsClientErr        error
+
+		// targetDraft makes the commented content a draft of another user.
+		targetDraft bool
+
+		shouldCallGetRequest bool
+		getRequestErr        error
+
+		shouldCallGetRevision bool
+		getRevisionErr        error
+
+		shouldCallGetSuggestion bool
+		getSuggestionErr        error
+
+		shouldCallGetParent bool
+		getParentResp       *dao.CommentModel
+		getParentErr        error
+
+		shouldCallCreate bool
+		createResp       *dao.CommentModel
+		createErr        error
+
+		expect    *models.Comment
+		expectErr error
+	}{
+		{
+			name:     "Success/ImproveRequest",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveRequest,
+			},
+			shouldCallGetRequest: true,
+			shouldCallCreate:     true,
+			createResp: &dao.CommentModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				CommentModelCore: dao.CommentModelCore{
+					TargetType: dao.CommentTargetImproveRequest,
+					TargetID:   goframework.NumberUUID(10),
+					Content:    "content",
+				},
+			},
+			expect: &models.Comment{
+				ID:         goframework.NumberUUID(1),
+				CreatedAt:  baseTime,
+				UserID:     goframework.NumberUUID(100),
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+		},
+		{
+			name:     "Success/ImproveRequestRevision",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveRequestRevision,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveRequest,
+			},
+			shouldCallGetRevision: true,
+			shouldCallCreate:      true,
+			createResp: &dao.CommentModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				CommentModelCore: dao.CommentModelCore{
+					TargetType: dao.CommentTargetImproveRequestRevision,
+					TargetID:   goframework.NumberUUID(10),
+					Content:    "content",
+				},
+			},
+			expect: &models.Comment{
+				ID:         goframework.NumberUUID(1),
+				CreatedAt:  baseTime,
+				UserID:     goframework.NumberUUID(100),
+				TargetType: models.CommentTargetImproveRequestRevision,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+		},
+		{
+			name:     "Success/ImproveSuggestionReply",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				ParentID:   lo.ToPtr(goframework.NumberUUID(2)),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveSuggestion,
+			},
+			shouldCallGetSuggestion: true,
+			shouldCallGetParent:     true,
+			getParentResp: &dao.CommentModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				CommentModelCore: dao.CommentModelCore{
+					TargetType: dao.CommentTargetImproveSuggestion,
+					TargetID:   goframework.NumberUUID(10),
+					Content:    "parent content",
+				},
+			},
+			shouldCallCreate: true,
+			createResp: &dao.CommentModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				CommentModelCore: dao.CommentModelCore{
+					TargetType: dao.CommentTargetImproveSuggestion,
+					TargetID:   goframework.NumberUUID(10),
+					ParentID:   lo.ToPtr(goframework.NumberUUID(2)),
+					Content:    "content",
+				},
+			},
+			expect: &models.Comment{
+				ID:         goframework.NumberUUID(1),
+				CreatedAt:  baseTime,
+				UserID:     goframework.NumberUUID(100),
+				TargetType: models.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				ParentID:   lo.ToPtr(goframework.NumberUUID(2)),
+				Content:    "content",
+			},
+		},
+		{
+			name:     "Error/CreateFailure",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveRequest,
+			},
+			shouldCallGetRequest: true,
+			shouldCallCreate:     true,
+			createErr:            fooErr,
+			expectErr:            fooErr,
+		},
+		{
+			name:     "Error/ParentOnAnotherTarget",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				ParentID:   lo.ToPtr(goframework.NumberUUID(2)),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveSuggestion,
+			},
+			shouldCallGetSuggestion: true,
+			shouldCallGetParent:     true,
+			getParentResp: &dao.CommentModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				CommentModelCore: dao.CommentModelCore{
+					TargetType: dao.CommentTargetImproveSuggestion,
+					TargetID:   goframework.NumberUUID(20),
+					Content:    "parent content",
+				},
+			},
+			expectErr: services.ErrSwitchTarget,
+		},
+		{
+			name:     "Error/GetParentFailure",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				ParentID:   lo.ToPtr(goframework.NumberUUID(2)),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveSuggestion,
+			},
+			shouldCallGetSuggestion: true,
+			shouldCallGetParent:     true,
+			getParentErr:            fooErr,
+			expectErr:               fooErr,
+		},
+		{
+			name:     "Error/GetRequestFailure",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveRequest,
+			},
+			shouldCallGetRequest: true,
+			getRequestErr:        bunovel.ErrNotFound,
+			expectErr:            bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/GetRevisionFailure",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveRequestRevision,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveRequest,
+			},
+			shouldCallGetRevision: true,
+			getRevisionErr:        fooErr,
+			expectErr:             fooErr,
+		},
+		{
+			name:     "Error/GetSuggestionFailure",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveSuggestion,
+			},
+			shouldCallGetSuggestion: true,
+			getSuggestionErr:        fooErr,
+			expectErr:               fooErr,
+		},
+		{
+			name:     "Error/RequestDraftOfAnotherUser",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveRequest,
+			},
+			shouldCallGetRequest: true,
+			targetDraft:          true,
+			expectErr:            bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/RevisionDraftOfAnotherUser",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveRequestRevision,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveRequest,
+			},
+			shouldCallGetRevision: true,
+			targetDraft:           true,
+			expectErr:             bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/SuggestionDraftOfAnotherUser",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveSuggestion,
+			},
+			shouldCallGetSuggestion: true,
+			targetDraft:             true,
+			expectErr:               bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/NoContent",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveRequest,
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:     "Error/ContentTooLong",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    strings.Repeat("a", services.MaxCommentLength+1),
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveRequest,
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:     "Error/GetPermissions",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientScope: apiclients.HasUserScopeQuery{
+				UserID: goframework.NumberUUID(100),
+				Scope:  apiclients.CanPostImproveRequest,
+			},
+			permissionsClientErr: fooErr,
+			expectErr:            fooErr,
+		},
+		{
+			name:     "Error/InvalidTargetType",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: "fake target",
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:     "Error/NotAuthenticated",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+			id:             goframework.NumberUUID(1),
+			now:            baseTime,
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:     "Error/AuthClientFailure",
+			tokenRaw: "token",
+			form: &models.CommentForm{
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+				Content:    "content",
+			},
+			id:            goframework.NumberUUID(1),
+			now:           baseTime,
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewCommentRepository(t)
+			requestRepository := daomocks.NewImproveRequestRepository(t)
+			suggestionRepository := daomocks.NewImproveSuggestionRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+			permissionsClient := apiclientsmocks.NewPermissionsClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallPermissionsClient {
+				permissionsClient.
+					On("HasUserScope", context.Background(), d.permissionsClientScope).
+					Return(d.permissionsClientErr)
+			}
+
+			if d.shouldCallGetRequest {
+				requestRepository.
+					On("Get", context.Background(), d.form.TargetID).
+					Return(&dao.ImproveRequestPreview{UserID: goframework.NumberUUID(200), Draft: d.targetDraft}, d.getRequestErr)
+			}
+
+			if d.shouldCallGetRevision {
+				requestRepository.
+					On("GetRevision", context.Background(), d.form.TargetID).
+					Return(&dao.ImproveRequestRevisionModel{UserID: goframework.NumberUUID(200), Draft: d.targetDraft}, d.getRevisionErr)
+			}
+
+			if d.shouldCallGetSuggestion {
+				suggestionRepository.
+					On("Get", context.Background(), d.form.TargetID).
+					Return(&dao.ImproveSuggestionModel{UserID: goframework.NumberUUID(200), Draft: d.targetDraft}, d.getSuggestionErr)
+			}
+
+			if d.shouldCallGetParent {
+				repository.On("Get", context.Background(), *d.form.ParentID).Return(d.getParentResp, d.getParentErr)
+			}
+
+			if d.shouldCallCreate {
+				repository.
+					On("Create", context.Background(), &dao.CommentModelCore{
+						TargetType: dao.CommentTarget(d.form.TargetType),
+						TargetID:   d.form.TargetID,
+						ParentID:   d.form.ParentID,
+						Content:    d.form.Content,
+					}, d.authClientResp.Token.Payload.ID, d.id, d.now).
+					Return(d.createResp, d.createErr)
+			}
+
+			service := services.NewCreateCommentService(repository, requestRepository, suggestionRepository, authClient, permissionsClient)
+			res, err := service.Create(context.Background(), d.tokenRaw, d.form, d.id, d.now)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			requestRepository.AssertExpectations(t)
+			suggestionRepository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+			permissionsClient.AssertExpectations(t)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/a-novel/forum-service/pkg/adapters"
 	"github.com/a-novel/forum-service/pkg/dao"
 	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/ratelimit"
 	apiclients "github.com/a-novel/go-apis/clients"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
@@ -14,18 +15,25 @@ import (
 )
 
 type CreateImproveRequestService interface {
-	Create(ctx context.Context, tokenRaw, title, content string, sourceID, id uuid.UUID, now time.Time) (*models.ImproveRequestPreview, error)
+	// Create creates a new revision of an improvement request, or the request itself. The language is optional, and
+	// only used for new requests: revisions keep the language of their request. The tags replace the tags of the
+	// request, unless they are nil. Drafts are only visible to their author, until they are published. Users are
+	// throttled, according to the quota of ratelimit.ActionCreateImproveRequest. Content that resembles a recent
+	// request of the same user is reported to the moderators.
+	Create(ctx context.Context, tokenRaw, title, content, language string, tags []string, draft bool, sourceID, id uuid.UUID, now time.Time) (*models.ImproveRequestPreview, error)
 }
 
 func NewCreateImproveRequestService(
 	repository dao.ImproveRequestRepository,
 	authClient apiclients.AuthClient,
 	permissionsClient apiclients.PermissionsClient,
+	limiter ratelimit.Limiter,
 ) CreateImproveRequestService {
 	return &createImproveRequestServiceImpl{
 		repository:        repository,
 		authClient:        authClient,
 		permissionsClient: permissionsClient,
+		limiter:           limiter,
 	}
 }
 
@@ -33,9 +41,10 @@ type createImproveRequestServiceImpl struct {
 	repository        dao.ImproveRequestRepository
 	authClient        apiclients.AuthClient
 	permissionsClient apiclients.PermissionsClient
+	limiter           ratelimit.Limiter
 }
 
-func (s *createImproveRequestServiceImpl) Create(ctx context.Context, tokenRaw, title, content string, sourceID, id uuid.UUID, now time.Time) (*models.ImproveRequestPreview, error) {
+func (s *createImproveRequestServiceImpl) Create(ctx context.Context, tokenRaw, title, content, language string, tags []string, draft bool, sourceID, id uuid.UUID, now time.Time) (*models.ImproveRequestPreview, error) {
 	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
 	if err != nil {
 		return nil, goerrors.Join(ErrIntrospectToken, err)
@@ -60,6 +69,14 @@ func (s *createImproveRequestServiceImpl) Create(ctx context.Context, tokenRaw,
 	if err := goframework.CheckRegexp(title, titleRegexp); err != nil {
 		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidTitle, err)
 	}
+	if !validLanguage(language) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidLanguage)
+	}
+
+	tags = normalizeTags(tags)
+	if !validTags(tags, MaxTags) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidTags)
+	}
 
 	request, err := s.repository.Get(ctx, sourceID)
 	if err != nil && !goerrors.Is(err, bunovel.ErrNotFound) {
@@ -71,7 +88,49 @@ func (s *createImproveRequestServiceImpl) Create(ctx context.Context, tokenRaw,
 		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
 	}
 
-	res, err := s.repository.Create(ctx, token.Token.Payload.ID, title, content, sourceID, id, now)
+	// Revisions naturally resemble the previous revisions of their request.
+	duplicate, err := s.repository.FindDuplicate(ctx, duplicateQuery(token.Token.Payload.ID, content, now), sourceID)
+	if err != nil && !goerrors.Is(err, bunovel.ErrNotFound) {
+		return nil, goerrors.Join(ErrFindDuplicate, err)
+	}
+
+	if err := throttle(ctx, s.limiter, token.Token.Payload.ID, ratelimit.ActionCreateImproveRequest, now); err != nil {
+		return nil, err
+	}
+
+	// Drafts are announced when they are published.
+	var events []*dao.EventModelCore
+	if !draft {
+		event := &dao.EventModelCore{
+			Type:     dao.EventTypeRequestRevised,
+			UserID:   token.Token.Payload.ID,
+			TargetID: id,
+			SourceID: sourceID,
+		}
+		// A request that was never published is new for everyone else.
+		if request == nil || request.Draft {
+			event.Type = dao.EventTypeRequestCreated
+		}
+		events = append(events, event)
+	}
+
+	var report *dao.ReportModel
+	if duplicate != nil {
+		report = duplicateReport(
+			dao.ReportTargetImproveRequestRevision, id, duplicate, "posted recently by the same author", now,
+		)
+	}
+
+	res, err := s.repository.Create(
+		ctx,
+		&dao.ImproveRequestModelCore{
+			Title:    title,
+			Content:  content,
+			Language: dao.Language(language),
+			Tags:     tags,
+		},
+		draft, token.Token.Payload.ID, sourceID, id, report, now, events...,
+	)
 	if err != nil {
 		return nil, goerrors.Join(ErrCreateImproveRequest, err)
 	}
@@ -6,6 +6,7 @@ import (
 	"github.com/a-novel/forum-service/pkg/dao"
 	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
 	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/ratelimit"
 	"github.com/a-novel/forum-service/pkg/services"
 	apiclients "github.com/a-novel/go-apis/clients"
 	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
@@ -24,10 +25,15 @@ func TestCreateImproveRequestService(t *testing.T) {
 		tokenRaw string
 		title    string
 		content  string
+		language string
+		tags     []string
+		draft    bool
 		sourceID uuid.UUID
 		id       uuid.UUID
 		now      time.Time
 
+		rateLimited bool
+
 		authClientResp *apiclients.UserTokenStatus
 		authClientErr  error
 
@@ -38,10 +44,18 @@ func TestCreateImproveRequestService(t *testing.T) {
 		getResp       *dao.ImproveRequestPreview
 		getErr        error
 
+		shouldCallFindDuplicate bool
+		findDuplicateResp       *dao.Duplicate
+		findDuplicateErr        error
+
 		shouldCallCreateRevision bool
+		createRevisionTags       []string
+		createRevisionEventType  dao.EventType
 		createRevisionResp       *dao.ImproveRequestPreview
 		createRevisionErr        error
 
+		createReport *dao.ReportModelCore
+
 		expect    *models.ImproveRequestPreview
 		expectErr error
 	}{
@@ -61,12 +75,87 @@ func TestCreateImproveRequestService(t *testing.T) {
 			},
 			shouldCallPermissionsClient: true,
 			shouldCallGet:               true,
+			shouldCallFindDuplicate:     true,
+			findDuplicateErr:            bunovel.ErrNotFound,
+			shouldCallCreateRevision:    true,
+			createRevisionEventType:     dao.EventTypeRequestCreated,
+			createRevisionResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				Title:    "title",
+				Content:  "content",
+				UserID:   goframework.NumberUUID(100),
+			},
+			expect: &models.ImproveRequestPreview{
+				ID:        goframework.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "title",
+				Content:   "content",
+				UserID:    goframework.NumberUUID(100),
+			},
+		},
+		{
+			name:     "Success/Language",
+			tokenRaw: "token",
+			title:    "title",
+			content:  "content",
+			language: models.LanguageEnglish,
+			sourceID: goframework.NumberUUID(10),
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGet:               true,
+			shouldCallFindDuplicate:     true,
+			findDuplicateErr:            bunovel.ErrNotFound,
+			shouldCallCreateRevision:    true,
+			createRevisionEventType:     dao.EventTypeRequestCreated,
+			createRevisionResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				Title:    "title",
+				Content:  "content",
+				UserID:   goframework.NumberUUID(100),
+			},
+			expect: &models.ImproveRequestPreview{
+				ID:        goframework.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "title",
+				Content:   "content",
+				UserID:    goframework.NumberUUID(100),
+			},
+		},
+		{
+			name:     "Success/Tags",
+			tokenRaw: "token",
+			title:    "title",
+			content:  "content",
+			tags:     []string{" Opening  Chapter", "horror", "HORROR"},
+			sourceID: goframework.NumberUUID(10),
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGet:               true,
+			shouldCallFindDuplicate:     true,
+			findDuplicateErr:            bunovel.ErrNotFound,
 			shouldCallCreateRevision:    true,
+			createRevisionTags:          []string{"opening-chapter", "horror"},
+			createRevisionEventType:     dao.EventTypeRequestCreated,
 			createRevisionResp: &dao.ImproveRequestPreview{
 				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
 				Title:    "title",
 				Content:  "content",
 				UserID:   goframework.NumberUUID(100),
+				Tags:     []string{"opening-chapter", "horror"},
 			},
 			expect: &models.ImproveRequestPreview{
 				ID:        goframework.NumberUUID(10),
@@ -74,6 +163,7 @@ func TestCreateImproveRequestService(t *testing.T) {
 				Title:     "title",
 				Content:   "content",
 				UserID:    goframework.NumberUUID(100),
+				Tags:      []string{"opening-chapter", "horror"},
 			},
 		},
 		{
@@ -98,12 +188,50 @@ func TestCreateImproveRequestService(t *testing.T) {
 				Content:  "old content",
 				UserID:   goframework.NumberUUID(100),
 			},
+			shouldCallFindDuplicate:  true,
+			findDuplicateErr:         bunovel.ErrNotFound,
 			shouldCallCreateRevision: true,
+			createRevisionEventType:  dao.EventTypeRequestRevised,
+			createRevisionResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				Title:    "title",
+				Content:  "content",
+				UserID:   goframework.NumberUUID(100),
+			},
+			expect: &models.ImproveRequestPreview{
+				ID:        goframework.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "title",
+				Content:   "content",
+				UserID:    goframework.NumberUUID(100),
+			},
+		},
+		{
+			name:     "Success/Draft",
+			tokenRaw: "token",
+			title:    "title",
+			content:  "content",
+			draft:    true,
+			sourceID: goframework.NumberUUID(10),
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGet:               true,
+			shouldCallFindDuplicate:     true,
+			findDuplicateErr:            bunovel.ErrNotFound,
+			shouldCallCreateRevision:    true,
 			createRevisionResp: &dao.ImproveRequestPreview{
 				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
 				Title:    "title",
 				Content:  "content",
 				UserID:   goframework.NumberUUID(100),
+				Draft:    true,
 			},
 			expect: &models.ImproveRequestPreview{
 				ID:        goframework.NumberUUID(10),
@@ -111,8 +239,114 @@ func TestCreateImproveRequestService(t *testing.T) {
 				Title:     "title",
 				Content:   "content",
 				UserID:    goframework.NumberUUID(100),
+				Draft:     true,
 			},
 		},
+		{
+			name:     "Success/RevisionOfDraft",
+			tokenRaw: "token",
+			title:    "title",
+			content:  "content",
+			sourceID: goframework.NumberUUID(10),
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGet:               true,
+			getResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				Title:    "old title",
+				Content:  "old content",
+				UserID:   goframework.NumberUUID(100),
+				Draft:    true,
+			},
+			shouldCallFindDuplicate:  true,
+			findDuplicateErr:         bunovel.ErrNotFound,
+			shouldCallCreateRevision: true,
+			// The request is announced when its first revision is published.
+			createRevisionEventType: dao.EventTypeRequestCreated,
+			createRevisionResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				Title:    "title",
+				Content:  "content",
+				UserID:   goframework.NumberUUID(100),
+			},
+			expect: &models.ImproveRequestPreview{
+				ID:        goframework.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "title",
+				Content:   "content",
+				UserID:    goframework.NumberUUID(100),
+			},
+		},
+		{
+			name:     "Success/Duplicate",
+			tokenRaw: "token",
+			title:    "title",
+			content:  "content",
+			sourceID: goframework.NumberUUID(10),
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGet:               true,
+			shouldCallFindDuplicate:     true,
+			findDuplicateResp: &dao.Duplicate{
+				ID:         goframework.NumberUUID(2),
+				Similarity: 0.93,
+			},
+			shouldCallCreateRevision: true,
+			createRevisionEventType:  dao.EventTypeRequestCreated,
+			createRevisionResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				Title:    "title",
+				Content:  "content",
+				UserID:   goframework.NumberUUID(100),
+			},
+			createReport: &dao.ReportModelCore{
+				TargetType: dao.ReportTargetImproveRequestRevision,
+				TargetID:   goframework.NumberUUID(1),
+				Reason:     dao.ReportReasonDuplicate,
+				Content:    "93% similar to " + goframework.NumberUUID(2).String() + ", posted recently by the same author.",
+			},
+			expect: &models.ImproveRequestPreview{
+				ID:        goframework.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "title",
+				Content:   "content",
+				UserID:    goframework.NumberUUID(100),
+			},
+		},
+		{
+			name:     "Error/FindDuplicateFailure",
+			tokenRaw: "token",
+			title:    "title",
+			content:  "content",
+			sourceID: goframework.NumberUUID(10),
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGet:               true,
+			shouldCallFindDuplicate:     true,
+			findDuplicateErr:            fooErr,
+			expectErr:                   fooErr,
+		},
 		{
 			name:     "Error/CreateRevisionFailure",
 			tokenRaw: "token",
@@ -132,7 +366,10 @@ func TestCreateImproveRequestService(t *testing.T) {
 			getResp: &dao.ImproveRequestPreview{
 				UserID: goframework.NumberUUID(100),
 			},
+			shouldCallFindDuplicate:  true,
+			findDuplicateErr:         bunovel.ErrNotFound,
 			shouldCallCreateRevision: true,
+			createRevisionEventType:  dao.EventTypeRequestRevised,
 			createRevisionErr:        fooErr,
 			expectErr:                fooErr,
 		},
@@ -296,6 +533,100 @@ func TestCreateImproveRequestService(t *testing.T) {
 			shouldCallPermissionsClient: true,
 			expectErr:                   goframework.ErrInvalidEntity,
 		},
+		{
+			name:     "Error/InvalidLanguage",
+			tokenRaw: "token",
+			title:    "title",
+			content:  "content",
+			language: "klingon",
+			sourceID: goframework.NumberUUID(10),
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			expectErr:                   services.ErrInvalidLanguage,
+		},
+		{
+			name:     "Error/TooManyTags",
+			tokenRaw: "token",
+			title:    "title",
+			content:  "content",
+			tags:     []string{"a", "b", "c", "d", "e", "f"},
+			sourceID: goframework.NumberUUID(10),
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			expectErr:                   services.ErrInvalidTags,
+		},
+		{
+			name:     "Error/InvalidTag",
+			tokenRaw: "token",
+			title:    "title",
+			content:  "content",
+			tags:     []string{"sci_fi"},
+			sourceID: goframework.NumberUUID(10),
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			expectErr:                   services.ErrInvalidTags,
+		},
+		{
+			name:     "Error/TagTooLong",
+			tokenRaw: "token",
+			title:    "title",
+			content:  "content",
+			tags:     []string{strings.Repeat("a", services.MaxTagLength+1)},
+			sourceID: goframework.NumberUUID(10),
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			expectErr:                   services.ErrInvalidTags,
+		},
+		{
+			name:        "Error/RateLimited",
+			tokenRaw:    "token",
+			title:       "title",
+			content:     "content",
+			sourceID:    goframework.NumberUUID(10),
+			id:          goframework.NumberUUID(1),
+			now:         baseTime,
+			rateLimited: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGet:               true,
+			getErr:                      bunovel.ErrNotFound,
+			shouldCallFindDuplicate:     true,
+			findDuplicateErr:            bunovel.ErrNotFound,
+			expectErr:                   services.ErrRateLimited,
+		},
 		{
 			name:           "Error/NotAuthenticated",
 			tokenRaw:       "token",
@@ -359,14 +690,59 @@ func TestCreateImproveRequestService(t *testing.T) {
 				repository.On("Get", context.Background(), d.sourceID).Return(d.getResp, d.getErr)
 			}
 
-			if d.shouldCallCreateRevision {
+			if d.shouldCallFindDuplicate {
 				repository.
-					On("Create", context.Background(), d.authClientResp.Token.Payload.ID, d.title, d.content, d.sourceID, d.id, d.now).
-					Return(d.createRevisionResp, d.createRevisionErr)
+					On("FindDuplicate", context.Background(), dao.DuplicateQuery{
+						UserID:        d.authClientResp.Token.Payload.ID,
+						Content:       d.content,
+						Since:         d.now.Add(-services.DuplicateWindow),
+						MinSimilarity: services.DuplicateMinSimilarity,
+					}, d.sourceID).
+					Return(d.findDuplicateResp, d.findDuplicateErr)
+			}
+
+			if d.shouldCallCreateRevision {
+				args := []interface{}{
+					context.Background(),
+					&dao.ImproveRequestModelCore{
+						Title:    d.title,
+						Content:  d.content,
+						Language: dao.Language(d.language),
+						Tags:     d.createRevisionTags,
+					},
+					d.draft,
+					d.authClientResp.Token.Payload.ID,
+					d.sourceID,
+					d.id,
+					systemReport(d.createReport, d.now),
+					d.now,
+				}
+				// Drafts are created silently.
+				if d.createRevisionEventType != "" {
+					args = append(args, &dao.EventModelCore{
+						Type:     d.createRevisionEventType,
+						UserID:   d.authClientResp.Token.Payload.ID,
+						TargetID: d.id,
+						SourceID: d.sourceID,
+					})
+				}
+
+				repository.On("Create", args...).Return(d.createRevisionResp, d.createRevisionErr)
+			}
+
+			limiter := ratelimit.NewMemoryLimiter(ratelimit.Quotas{
+				ratelimit.ActionCreateImproveRequest: {Limit: 1, Window: time.Hour},
+			})
+			if d.rateLimited {
+				_, err := limiter.Allow(context.Background(), d.authClientResp.Token.Payload.ID, ratelimit.ActionCreateImproveRequest, d.now)
+				require.NoError(t, err)
 			}
 
-			service := services.NewCreateImproveRequestService(repository, authClient, permissionsClient)
-			res, err := service.Create(context.Background(), d.tokenRaw, d.title, d.content, d.sourceID, d.id, d.now)
+			service := services.NewCreateImproveRequestService(repository, authClient, permissionsClient, limiter)
+			res, err := service.Create(
+				context.Background(), d.tokenRaw, d.title, d.content, d.language, d.tags, d.draft, d.sourceID, d.id,
+				d.now,
+			)
 
 			require.ErrorIs(t, err, d.expectErr)
 			require.Equal(t, d.expect, res)
@@ -3,16 +3,24 @@ package services
 import (
 	"context"
 	goerrors "errors"
+	"github.com/a-novel/bunovel"
 	"github.com/a-novel/forum-service/pkg/adapters"
 	"github.com/a-novel/forum-service/pkg/dao"
 	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/ratelimit"
 	apiclients "github.com/a-novel/go-apis/clients"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
+	"strings"
 	"time"
 )
 
 type CreateImproveSuggestionService interface {
+	// Create posts a suggestion on a revision. Drafts are only visible to their author, until they are published.
+	// Suggestions are only accepted while the improvement request is open. Users are throttled, according to the
+	// quota of ratelimit.ActionCreateImproveSuggestion. A suggestion identical to its revision is rejected. A
+	// suggestion that barely changes its revision, or that resembles a recent suggestion of the same user, is reported
+	// to the moderators. The edit stats of the suggestion are measured against the content of its revision.
 	Create(ctx context.Context, tokenRaw string, suggestion *models.ImproveSuggestionForm, id uuid.UUID, now time.Time) (*models.ImproveSuggestion, error)
 }
 
@@ -21,12 +29,14 @@ func NewCreateImproveSuggestionService(
 	requestRepository dao.ImproveRequestRepository,
 	authClient apiclients.AuthClient,
 	permissionsClient apiclients.PermissionsClient,
+	limiter ratelimit.Limiter,
 ) CreateImproveSuggestionService {
 	return &createImproveSuggestionServiceImpl{
 		repository:        repository,
 		requestRepository: requestRepository,
 		authClient:        authClient,
 		permissionsClient: permissionsClient,
+		limiter:           limiter,
 	}
 }
 
@@ -35,6 +45,7 @@ type createImproveSuggestionServiceImpl struct {
 	requestRepository dao.ImproveRequestRepository
 	authClient        apiclients.AuthClient
 	permissionsClient apiclients.PermissionsClient
+	limiter           ratelimit.Limiter
 }
 
 func (s *createImproveSuggestionServiceImpl) Create(ctx context.Context, tokenRaw string, form *models.ImproveSuggestionForm, id uuid.UUID, now time.Time) (*models.ImproveSuggestion, error) {
@@ -67,8 +78,61 @@ func (s *createImproveSuggestionServiceImpl) Create(ctx context.Context, tokenRa
 	if err != nil {
 		return nil, goerrors.Join(ErrGetImproveRequestRevision, err)
 	}
+	if !canView(revision.Draft, revision.UserID, &token.Token.Payload.ID) {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, bunovel.ErrNotFound)
+	}
+
+	request, err := s.requestRepository.Get(ctx, revision.SourceID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequest, err)
+	}
+	if request.Status != dao.RequestStatusOpen {
+		return nil, ErrRequestNotOpen
+	}
+
+	if sameAsRevision(form, revision) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrIdenticalSuggestion)
+	}
+
+	duplicate, err := s.repository.FindDuplicate(ctx, duplicateQuery(token.Token.Payload.ID, form.Content, now))
+	if err != nil && !goerrors.Is(err, bunovel.ErrNotFound) {
+		return nil, goerrors.Join(ErrFindDuplicate, err)
+	}
+
+	if err := throttle(ctx, s.limiter, token.Token.Payload.ID, ratelimit.ActionCreateImproveSuggestion, now); err != nil {
+		return nil, err
+	}
+
+	// Drafts are announced when they are published.
+	var events []*dao.EventModelCore
+	if !form.Draft {
+		events = append(events, &dao.EventModelCore{
+			Type:     dao.EventTypeSuggestionCreated,
+			UserID:   token.Token.Payload.ID,
+			TargetID: id,
+			SourceID: revision.SourceID,
+		})
+	}
+
+	data := improveSuggestionToDAO(form, revision)
+
+	var report *dao.ReportModel
+	// A suggestion that only renames the revision is not a copy: edit stats only measure the content.
+	similarity := 1 - *data.ChangedRatio
+	if similarity >= RevisionCopyMinSimilarity && strings.TrimSpace(form.Title) == strings.TrimSpace(revision.Title) {
+		report = duplicateReport(
+			dao.ReportTargetImproveSuggestion, id, &dao.Duplicate{ID: revision.ID, Similarity: similarity},
+			"the revision it was suggested on", now,
+		)
+	} else if duplicate != nil {
+		report = duplicateReport(
+			dao.ReportTargetImproveSuggestion, id, duplicate, "posted recently by the same author", now,
+		)
+	}
 
-	suggestion, err := s.repository.Create(ctx, adapters.ImproveSuggestionFormToDAO(form), token.Token.Payload.ID, revision.SourceID, id, now)
+	suggestion, err := s.repository.Create(
+		ctx, data, form.Draft, token.Token.Payload.ID, revision.SourceID, id, report, now, events...,
+	)
 	if err != nil {
 		return nil, goerrors.Join(ErrCreateImproveSuggestion, err)
 	}
@@ -6,11 +6,13 @@ import (
 	"github.com/a-novel/forum-service/pkg/dao"
 	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
 	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/ratelimit"
 	"github.com/a-novel/forum-service/pkg/services"
 	apiclients "github.com/a-novel/go-apis/clients"
 	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"strings"
@@ -27,6 +29,8 @@ func TestCreateImproveSuggestionService(t *testing.T) {
 		id         uuid.UUID
 		now        time.Time
 
+		rateLimited bool
+
 		authClientResp *apiclients.UserTokenStatus
 		authClientErr  error
 
@@ -37,10 +41,21 @@ func TestCreateImproveSuggestionService(t *testing.T) {
 		getRevisionResp       *dao.ImproveRequestRevisionModel
 		getRevisionErr        error
 
+		shouldCallGetRequest bool
+		getRequestResp       *dao.ImproveRequestPreview
+		getRequestErr        error
+
+		shouldCallFindDuplicate bool
+		findDuplicateResp       *dao.Duplicate
+		findDuplicateErr        error
+
 		shouldCallCreateSuggestion bool
+		createSuggestionData       *dao.ImproveSuggestionModelCore
 		createSuggestionResp       *dao.ImproveSuggestionModel
 		createSuggestionErr        error
 
+		createReport *dao.ReportModelCore
+
 		expect    *models.ImproveSuggestion
 		expectErr error
 	}{
@@ -65,11 +80,63 @@ func TestCreateImproveSuggestionService(t *testing.T) {
 			getRevisionResp: &dao.ImproveRequestRevisionModel{
 				SourceID: goframework.NumberUUID(10),
 			},
+			shouldCallGetRequest:       true,
+			getRequestResp:             &dao.ImproveRequestPreview{Status: dao.RequestStatusOpen},
+			shouldCallFindDuplicate:    true,
+			findDuplicateErr:           bunovel.ErrNotFound,
+			shouldCallCreateSuggestion: true,
+			createSuggestionResp: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(1),
+					Title:     "title",
+					Content:   "content",
+				},
+			},
+			expect: &models.ImproveSuggestion{
+				ID:        goframework.NumberUUID(1),
+				CreatedAt: baseTime,
+				SourceID:  goframework.NumberUUID(10),
+				UserID:    goframework.NumberUUID(100),
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		{
+			name: "Success/Draft",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+				Draft:     true,
+			},
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+			},
+			shouldCallGetRequest:       true,
+			getRequestResp:             &dao.ImproveRequestPreview{Status: dao.RequestStatusOpen},
+			shouldCallFindDuplicate:    true,
+			findDuplicateErr:           bunovel.ErrNotFound,
 			shouldCallCreateSuggestion: true,
 			createSuggestionResp: &dao.ImproveSuggestionModel{
 				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
 				SourceID: goframework.NumberUUID(10),
 				UserID:   goframework.NumberUUID(100),
+				Draft:    true,
 				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
 					RequestID: goframework.NumberUUID(1),
 					Title:     "title",
@@ -81,11 +148,331 @@ func TestCreateImproveSuggestionService(t *testing.T) {
 				CreatedAt: baseTime,
 				SourceID:  goframework.NumberUUID(10),
 				UserID:    goframework.NumberUUID(100),
+				Draft:     true,
 				RequestID: goframework.NumberUUID(1),
 				Title:     "title",
 				Content:   "content",
 			},
 		},
+		{
+			name: "Error/DraftRevision",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(200),
+				Draft:    true,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name: "Success/EditStats",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "The slow brown fox.",
+			},
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				Title:    "title",
+				Content:  "The quick brown fox.",
+			},
+			shouldCallGetRequest:       true,
+			getRequestResp:             &dao.ImproveRequestPreview{Status: dao.RequestStatusOpen},
+			shouldCallFindDuplicate:    true,
+			findDuplicateErr:           bunovel.ErrNotFound,
+			shouldCallCreateSuggestion: true,
+			createSuggestionData: &dao.ImproveSuggestionModelCore{
+				RequestID:    goframework.NumberUUID(1),
+				Title:        "title",
+				Content:      "The slow brown fox.",
+				AddedChars:   lo.ToPtr(4),
+				RemovedChars: lo.ToPtr(5),
+				ChangedRatio: lo.ToPtr(9.0 / 39.0),
+			},
+			createSuggestionResp: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID:    goframework.NumberUUID(1),
+					Title:        "title",
+					Content:      "The slow brown fox.",
+					AddedChars:   lo.ToPtr(4),
+					RemovedChars: lo.ToPtr(5),
+					ChangedRatio: lo.ToPtr(9.0 / 39.0),
+				},
+			},
+			expect: &models.ImproveSuggestion{
+				ID:           goframework.NumberUUID(1),
+				CreatedAt:    baseTime,
+				SourceID:     goframework.NumberUUID(10),
+				UserID:       goframework.NumberUUID(100),
+				RequestID:    goframework.NumberUUID(1),
+				Title:        "title",
+				Content:      "The slow brown fox.",
+				AddedChars:   lo.ToPtr(4),
+				RemovedChars: lo.ToPtr(5),
+				ChangedRatio: lo.ToPtr(9.0 / 39.0),
+			},
+		},
+		{
+			name: "Success/Duplicate",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+			},
+			shouldCallGetRequest:    true,
+			getRequestResp:          &dao.ImproveRequestPreview{Status: dao.RequestStatusOpen},
+			shouldCallFindDuplicate: true,
+			findDuplicateResp: &dao.Duplicate{
+				ID:         goframework.NumberUUID(2),
+				Similarity: 0.93,
+			},
+			shouldCallCreateSuggestion: true,
+			createSuggestionResp: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(1),
+					Title:     "title",
+					Content:   "content",
+				},
+			},
+			createReport: &dao.ReportModelCore{
+				TargetType: dao.ReportTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(1),
+				Reason:     dao.ReportReasonDuplicate,
+				Content:    "93% similar to " + goframework.NumberUUID(2).String() + ", posted recently by the same author.",
+			},
+			expect: &models.ImproveSuggestion{
+				ID:        goframework.NumberUUID(1),
+				CreatedAt: baseTime,
+				SourceID:  goframework.NumberUUID(10),
+				UserID:    goframework.NumberUUID(100),
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		{
+			name: "Success/CopyOfRevision",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "The quick brown fox jumps over the lazy dog, while the old cat sleeps peacefully in the warm afternoon sun!",
+			},
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				Title:    "title",
+				Content:  "The quick brown fox jumps over the lazy dog, while the old cat sleeps peacefully in the warm afternoon sun.",
+			},
+			shouldCallGetRequest:    true,
+			getRequestResp:          &dao.ImproveRequestPreview{Status: dao.RequestStatusOpen},
+			shouldCallFindDuplicate: true,
+			// The copy of the revision takes precedence over the recent duplicate.
+			findDuplicateResp: &dao.Duplicate{
+				ID:         goframework.NumberUUID(2),
+				Similarity: 0.93,
+			},
+			shouldCallCreateSuggestion: true,
+			createSuggestionData: &dao.ImproveSuggestionModelCore{
+				RequestID:    goframework.NumberUUID(1),
+				Title:        "title",
+				Content:      "The quick brown fox jumps over the lazy dog, while the old cat sleeps peacefully in the warm afternoon sun!",
+				AddedChars:   lo.ToPtr(1),
+				RemovedChars: lo.ToPtr(1),
+				ChangedRatio: lo.ToPtr(2.0 / 214.0),
+			},
+			createSuggestionResp: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(1),
+					Title:     "title",
+					Content:   "The quick brown fox jumps over the lazy dog, while the old cat sleeps peacefully in the warm afternoon sun!",
+				},
+			},
+			createReport: &dao.ReportModelCore{
+				TargetType: dao.ReportTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(1),
+				Reason:     dao.ReportReasonDuplicate,
+				Content:    "99% similar to " + goframework.NumberUUID(1).String() + ", the revision it was suggested on.",
+			},
+			expect: &models.ImproveSuggestion{
+				ID:        goframework.NumberUUID(1),
+				CreatedAt: baseTime,
+				SourceID:  goframework.NumberUUID(10),
+				UserID:    goframework.NumberUUID(100),
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "The quick brown fox jumps over the lazy dog, while the old cat sleeps peacefully in the warm afternoon sun!",
+			},
+		},
+		{
+			name: "Success/RenamedRevision",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my new title",
+				Content:   "The quick brown fox jumps over the lazy dog, while the old cat sleeps peacefully in the warm afternoon sun!",
+			},
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				Title:    "title",
+				Content:  "The quick brown fox jumps over the lazy dog, while the old cat sleeps peacefully in the warm afternoon sun.",
+			},
+			shouldCallGetRequest:       true,
+			getRequestResp:             &dao.ImproveRequestPreview{Status: dao.RequestStatusOpen},
+			shouldCallFindDuplicate:    true,
+			shouldCallCreateSuggestion: true,
+			createSuggestionData: &dao.ImproveSuggestionModelCore{
+				RequestID:    goframework.NumberUUID(1),
+				Title:        "my new title",
+				Content:      "The quick brown fox jumps over the lazy dog, while the old cat sleeps peacefully in the warm afternoon sun!",
+				AddedChars:   lo.ToPtr(1),
+				RemovedChars: lo.ToPtr(1),
+				ChangedRatio: lo.ToPtr(2.0 / 214.0),
+			},
+			createSuggestionResp: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(1),
+					Title:     "my new title",
+					Content:   "The quick brown fox jumps over the lazy dog, while the old cat sleeps peacefully in the warm afternoon sun!",
+				},
+			},
+			expect: &models.ImproveSuggestion{
+				ID:        goframework.NumberUUID(1),
+				CreatedAt: baseTime,
+				SourceID:  goframework.NumberUUID(10),
+				UserID:    goframework.NumberUUID(100),
+				RequestID: goframework.NumberUUID(1),
+				Title:     "my new title",
+				Content:   "The quick brown fox jumps over the lazy dog, while the old cat sleeps peacefully in the warm afternoon sun!",
+			},
+		},
+		{
+			name: "Error/FindDuplicateFailure",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+			},
+			shouldCallGetRequest:    true,
+			getRequestResp:          &dao.ImproveRequestPreview{Status: dao.RequestStatusOpen},
+			shouldCallFindDuplicate: true,
+			findDuplicateErr:        fooErr,
+			expectErr:               fooErr,
+		},
+		{
+			name: "Error/IdenticalSuggestion",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				Title:    "title",
+				Content:  "content\n",
+			},
+			shouldCallGetRequest: true,
+			getRequestResp:       &dao.ImproveRequestPreview{Status: dao.RequestStatusOpen},
+			expectErr:            services.ErrIdenticalSuggestion,
+		},
 		{
 			name: "Error/CreateSuggestionFailure",
 			suggestion: &models.ImproveSuggestionForm{
@@ -107,10 +494,64 @@ func TestCreateImproveSuggestionService(t *testing.T) {
 			getRevisionResp: &dao.ImproveRequestRevisionModel{
 				SourceID: goframework.NumberUUID(10),
 			},
+			shouldCallGetRequest:       true,
+			getRequestResp:             &dao.ImproveRequestPreview{Status: dao.RequestStatusOpen},
+			shouldCallFindDuplicate:    true,
+			findDuplicateErr:           bunovel.ErrNotFound,
 			shouldCallCreateSuggestion: true,
 			createSuggestionErr:        fooErr,
 			expectErr:                  fooErr,
 		},
+		{
+			name: "Error/RequestNotOpen",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+			},
+			shouldCallGetRequest: true,
+			getRequestResp:       &dao.ImproveRequestPreview{Status: dao.RequestStatusResolved},
+			expectErr:            services.ErrRequestNotOpen,
+		},
+		{
+			name: "Error/GetRequestError",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+			},
+			shouldCallGetRequest: true,
+			getRequestErr:        fooErr,
+			expectErr:            fooErr,
+		},
 		{
 			name: "Error/GetRevisionError",
 			suggestion: &models.ImproveSuggestionForm{
@@ -132,6 +573,34 @@ func TestCreateImproveSuggestionService(t *testing.T) {
 			getRevisionErr:              fooErr,
 			expectErr:                   fooErr,
 		},
+		{
+			name: "Error/RateLimited",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+			tokenRaw:    "token",
+			id:          goframework.NumberUUID(1),
+			now:         baseTime,
+			rateLimited: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+			},
+			shouldCallGetRequest:    true,
+			getRequestResp:          &dao.ImproveRequestPreview{Status: dao.RequestStatusOpen},
+			shouldCallFindDuplicate: true,
+			findDuplicateErr:        bunovel.ErrNotFound,
+			expectErr:               services.ErrRateLimited,
+		},
 		{
 			name:     "Error/BadTitle",
 			tokenRaw: "token",
@@ -335,13 +804,61 @@ func TestCreateImproveSuggestionService(t *testing.T) {
 					Return(d.getRevisionResp, d.getRevisionErr)
 			}
 
-			if d.shouldCallCreateSuggestion {
+			if d.shouldCallGetRequest {
+				requestsRepository.
+					On("Get", context.Background(), d.getRevisionResp.SourceID).
+					Return(d.getRequestResp, d.getRequestErr)
+			}
+
+			if d.shouldCallFindDuplicate {
 				repository.
-					On("Create", context.Background(), mock.Anything, d.authClientResp.Token.Payload.ID, d.getRevisionResp.SourceID, d.id, d.now).
-					Return(d.createSuggestionResp, d.createSuggestionErr)
+					On("FindDuplicate", context.Background(), dao.DuplicateQuery{
+						UserID:        d.authClientResp.Token.Payload.ID,
+						Content:       d.suggestion.Content,
+						Since:         d.now.Add(-services.DuplicateWindow),
+						MinSimilarity: services.DuplicateMinSimilarity,
+					}).
+					Return(d.findDuplicateResp, d.findDuplicateErr)
+			}
+
+			if d.shouldCallCreateSuggestion {
+				var data interface{} = mock.Anything
+				if d.createSuggestionData != nil {
+					data = d.createSuggestionData
+				}
+
+				args := []interface{}{
+					context.Background(),
+					data,
+					d.suggestion.Draft,
+					d.authClientResp.Token.Payload.ID,
+					d.getRevisionResp.SourceID,
+					d.id,
+					systemReport(d.createReport, d.now),
+					d.now,
+				}
+				// Drafts are created silently.
+				if !d.suggestion.Draft {
+					args = append(args, &dao.EventModelCore{
+						Type:     dao.EventTypeSuggestionCreated,
+						UserID:   d.authClientResp.Token.Payload.ID,
+						TargetID: d.id,
+						SourceID: d.getRevisionResp.SourceID,
+					})
+				}
+
+				repository.On("Create", args...).Return(d.createSuggestionResp, d.createSuggestionErr)
+			}
+
+			limiter := ratelimit.NewMemoryLimiter(ratelimit.Quotas{
+				ratelimit.ActionCreateImproveSuggestion: {Limit: 1, Window: time.Hour},
+			})
+			if d.rateLimited {
+				_, err := limiter.Allow(context.Background(), d.authClientResp.Token.Payload.ID, ratelimit.ActionCreateImproveSuggestion, d.now)
+				require.NoError(t, err)
 			}
 
-			service := services.NewCreateImproveSuggestionService(repository, requestsRepository, authClient, permissionsClient)
+			service := services.NewCreateImproveSuggestionService(repository, requestsRepository, authClient, permissionsClient, limiter)
 			resp, err := service.Create(context.Background(), d.tokenRaw, d.suggestion, d.id, d.now)
 
 			require.ErrorIs(t, err, d.expectErr)
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"time"
+)
+
+type CreateReportService interface {
+	Create(ctx context.Context, tokenRaw string, form *models.ReportForm, id uuid.UUID, now time.Time) (*models.Report, error)
+}
+
+func NewCreateReportService(
+	repository dao.ReportRepository,
+	requestRepository dao.ImproveRequestRepository,
+	suggestionRepository dao.ImproveSuggestionRepository,
+	commentRepository dao.CommentRepository,
+	authClient apiclients.AuthClient,
+) CreateReportService {
+	return &createReportServiceImpl{
+		repository:           repository,
+		requestRepository:    requestRepository,
+		suggestionRepository: suggestionRepository,
+		commentRepository:    commentRepository,
+		authClient:           authClient,
+	}
+}
+
+type createReportServiceImpl struct {
+	repository           dao.ReportRepository
+	requestRepository    dao.ImproveRequestRepository
+	suggestionRepository dao.ImproveSuggestionRepository
+	commentRepository    dao.CommentRepository
+	authClient           apiclients.AuthClient
+}
+
+func (s *createReportServiceImpl) Create(ctx context.Context, tokenRaw string, form *models.ReportForm, id uuid.UUID, now time.Time) (*models.Report, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	switch form.Reason {
+	case models.ReportReasonSpam, models.ReportReasonAbuse, models.ReportReasonPlagiarism, models.ReportReasonOther:
+	default:
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidReason)
+	}
+
+	if err := goframework.CheckMinMax(form.Content, 0, MaxReportLength); err != nil {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidContent, err)
+	}
+
+	// Make sure the reported content exists, and is visible to the user.
+	switch form.TargetType {
+	case models.ReportTargetImproveRequestRevision:
+		revision, err := s.requestRepository.GetRevision(ctx, form.TargetID)
+		if err != nil {
+			return nil, goerrors.Join(ErrGetImproveRequestRevision, err)
+		}
+		if !canView(revision.Draft, revision.UserID, &token.Token.Payload.ID) {
+			return nil, goerrors.Join(ErrGetImproveRequestRevision, bunovel.ErrNotFound)
+		}
+	case models.ReportTargetImproveSuggestion:
+		suggestion, err := s.suggestionRepository.Get(ctx, form.TargetID)
+		if err != nil {
+			return nil, goerrors.Join(ErrGetImproveSuggestion, err)
+		}
+		if !canView(suggestion.Draft, suggestion.UserID, &token.Token.Payload.ID) {
+			return nil, goerrors.Join(ErrGetImproveSuggestion, bunovel.ErrNotFound)
+		}
+	case models.ReportTargetComment:
+		if _, err := s.commentRepository.Get(ctx, form.TargetID); err != nil {
+			return nil, goerrors.Join(ErrGetComment, err)
+		}
+	default:
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidTargetType)
+	}
+
+	report, err := s.repository.Create(ctx, adapters.ReportFormToDAO(form), token.Token.Payload.ID, id, now)
+	if err != nil {
+		return nil, goerrors.Join(ErrCreateReport, err)
+	}
+
+	return adapters.ReportToModel(report), nil
+}
@@ -0,0 +1,428 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateReportService(t *testing.T) {
+	data := []struct {
+		name string
+
+		tokenRaw string
+		form     *models.ReportForm
+		id       uuid.UUID
+		now      time.Time
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		// targetDraft makes the reported content a draft of another user.
+		targetDraft bool
+
+		shouldCallGetRevision bool
+		getRevisionErr        error
+
+		shouldCallGetSuggestion bool
+		getSuggestionErr        error
+
+		shouldCallGetComment bool
+		getCommentErr        error
+
+		shouldCallCreate bool
+		createResp       *dao.ReportModel
+		createErr        error
+
+		expect    *models.Report
+		expectErr error
+	}{
+		{
+			name:     "Success/ImproveRequestRevision",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: models.ReportTargetImproveRequestRevision,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGetRevision: true,
+			shouldCallCreate:      true,
+			createResp: &dao.ReportModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				Status:   dao.ReportStatusOpen,
+				ReportModelCore: dao.ReportModelCore{
+					TargetType: dao.ReportTargetImproveRequestRevision,
+					TargetID:   goframework.NumberUUID(10),
+					Reason:     dao.ReportReasonSpam,
+					Content:    "content",
+				},
+			},
+			expect: &models.Report{
+				ID:         goframework.NumberUUID(1),
+				CreatedAt:  baseTime,
+				UserID:     goframework.NumberUUID(100),
+				TargetType: models.ReportTargetImproveRequestRevision,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+				Content:    "content",
+				Status:     models.ReportStatusOpen,
+			},
+		},
+		{
+			name:     "Success/ImproveSuggestion",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: models.ReportTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonPlagiarism,
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGetSuggestion: true,
+			shouldCallCreate:        true,
+			createResp: &dao.ReportModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				Status:   dao.ReportStatusOpen,
+				ReportModelCore: dao.ReportModelCore{
+					TargetType: dao.ReportTargetImproveSuggestion,
+					TargetID:   goframework.NumberUUID(10),
+					Reason:     dao.ReportReasonPlagiarism,
+				},
+			},
+			expect: &models.Report{
+				ID:         goframework.NumberUUID(1),
+				CreatedAt:  baseTime,
+				UserID:     goframework.NumberUUID(100),
+				TargetType: models.ReportTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonPlagiarism,
+				Status:     models.ReportStatusOpen,
+			},
+		},
+		{
+			name:     "Success/Comment",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonAbuse,
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGetComment: true,
+			shouldCallCreate:     true,
+			createResp: &dao.ReportModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				Status:   dao.ReportStatusOpen,
+				ReportModelCore: dao.ReportModelCore{
+					TargetType: dao.ReportTargetComment,
+					TargetID:   goframework.NumberUUID(10),
+					Reason:     dao.ReportReasonAbuse,
+					Content:    "content",
+				},
+			},
+			expect: &models.Report{
+				ID:         goframework.NumberUUID(1),
+				CreatedAt:  baseTime,
+				UserID:     goframework.NumberUUID(100),
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonAbuse,
+				Content:    "content",
+				Status:     models.ReportStatusOpen,
+			},
+		},
+		{
+			name:     "Error/CreateFailure",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonAbuse,
+				Content:    "content",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGetComment: true,
+			shouldCallCreate:     true,
+			createErr:            fooErr,
+			expectErr:            fooErr,
+		},
+		{
+			name:     "Error/GetRevisionFailure",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: models.ReportTargetImproveRequestRevision,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGetRevision: true,
+			getRevisionErr:        bunovel.ErrNotFound,
+			expectErr:             bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/GetSuggestionFailure",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: models.ReportTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGetSuggestion: true,
+			getSuggestionErr:        fooErr,
+			expectErr:               fooErr,
+		},
+		{
+			name:     "Error/RevisionDraftOfAnotherUser",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: models.ReportTargetImproveRequestRevision,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGetRevision: true,
+			targetDraft:           true,
+			expectErr:             bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/SuggestionDraftOfAnotherUser",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: models.ReportTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGetSuggestion: true,
+			targetDraft:             true,
+			expectErr:               bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/GetCommentFailure",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGetComment: true,
+			getCommentErr:        fooErr,
+			expectErr:            fooErr,
+		},
+		{
+			name:     "Error/InvalidTargetType",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: "fake",
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:     "Error/ContentTooLong",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonOther,
+				Content:    strings.Repeat("a", services.MaxReportLength+1),
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:     "Error/InvalidReason",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     "fake",
+			},
+			id:  goframework.NumberUUID(1),
+			now: baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:     "Error/NotAuthenticated",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+			},
+			id:             goframework.NumberUUID(1),
+			now:            baseTime,
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:     "Error/AuthClientFailure",
+			tokenRaw: "token",
+			form: &models.ReportForm{
+				TargetType: models.ReportTargetComment,
+				TargetID:   goframework.NumberUUID(10),
+				Reason:     models.ReportReasonSpam,
+			},
+			id:            goframework.NumberUUID(1),
+			now:           baseTime,
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewReportRepository(t)
+			requestRepository := daomocks.NewImproveRequestRepository(t)
+			suggestionRepository := daomocks.NewImproveSuggestionRepository(t)
+			commentRepository := daomocks.NewCommentRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallGetRevision {
+				requestRepository.
+					On("GetRevision", context.Background(), d.form.TargetID).
+					Return(&dao.ImproveRequestRevisionModel{UserID: goframework.NumberUUID(200), Draft: d.targetDraft}, d.getRevisionErr)
+			}
+
+			if d.shouldCallGetSuggestion {
+				suggestionRepository.
+					On("Get", context.Background(), d.form.TargetID).
+					Return(&dao.ImproveSuggestionModel{UserID: goframework.NumberUUID(200), Draft: d.targetDraft}, d.getSuggestionErr)
+			}
+
+			if d.shouldCallGetComment {
+				commentRepository.
+					On("Get", context.Background(), d.form.TargetID).
+					Return(&dao.CommentModel{}, d.getCommentErr)
+			}
+
+			if d.shouldCallCreate {
+				repository.
+					On("Create", context.Background(), &dao.ReportModelCore{
+						TargetType: dao.ReportTarget(d.form.TargetType),
+						TargetID:   d.form.TargetID,
+						Reason:     dao.ReportReason(d.form.Reason),
+						Content:    d.form.Content,
+					}, d.authClientResp.Token.Payload.ID, d.id, d.now).
+					Return(d.createResp, d.createErr)
+			}
+
+			service := services.NewCreateReportService(repository, requestRepository, suggestionRepository, commentRepository, authClient)
+			res, err := service.Create(context.Background(), d.tokenRaw, d.form, d.id, d.now)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			requestRepository.AssertExpectations(t)
+			suggestionRepository.AssertExpectations(t)
+			commentRepository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+		})
+	}
+}
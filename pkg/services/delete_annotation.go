@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/dao"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+)
+
+type DeleteAnnotationService interface {
+	Delete(ctx context.Context, tokenRaw string, id uuid.UUID) error
+}
+
+func NewDeleteAnnotationService(repository dao.AnnotationRepository, authClient apiclients.AuthClient) DeleteAnnotationService {
+	return &deleteAnnotationServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type deleteAnnotationServiceImpl struct {
+	repository dao.AnnotationRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *deleteAnnotationServiceImpl) Delete(ctx context.Context, tokenRaw string, id uuid.UUID) error {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	annotation, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return goerrors.Join(ErrGetAnnotation, err)
+	}
+	if annotation.UserID != token.Token.Payload.ID {
+		return goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
+	}
+
+	if err := s.repository.Delete(ctx, id); err != nil {
+		return goerrors.Join(ErrDeleteAnnotation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/dao"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+)
+
+type DeleteCommentService interface {
+	Delete(ctx context.Context, tokenRaw string, id uuid.UUID) error
+}
+
+func NewDeleteCommentService(repository dao.CommentRepository, authClient apiclients.AuthClient) DeleteCommentService {
+	return &deleteCommentServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type deleteCommentServiceImpl struct {
+	repository dao.CommentRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *deleteCommentServiceImpl) Delete(ctx context.Context, tokenRaw string, id uuid.UUID) error {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	comment, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return goerrors.Join(ErrGetComment, err)
+	}
+	if comment.UserID != token.Token.Payload.ID {
+		return goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
+	}
+
+	if err := s.repository.Delete(ctx, id); err != nil {
+		return goerrors.Join(ErrDeleteComment, err)
+	}
+
+	return nil
+}
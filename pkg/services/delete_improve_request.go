@@ -7,10 +7,11 @@ import (
 	apiclients "github.com/a-novel/go-apis/clients"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
+	"time"
 )
 
 type DeleteImproveRequestService interface {
-	Delete(ctx context.Context, tokenRaw string, id uuid.UUID) error
+	Delete(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) error
 }
 
 func NewDeleteImproveRequestService(repository dao.ImproveRequestRepository, authClient apiclients.AuthClient) DeleteImproveRequestService {
@@ -25,7 +26,7 @@ type deleteImproveRequestServiceImpl struct {
 	authClient apiclients.AuthClient
 }
 
-func (s *deleteImproveRequestServiceImpl) Delete(ctx context.Context, tokenRaw string, id uuid.UUID) error {
+func (s *deleteImproveRequestServiceImpl) Delete(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) error {
 	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
 	if err != nil {
 		return goerrors.Join(ErrIntrospectToken, err)
@@ -42,7 +43,7 @@ func (s *deleteImproveRequestServiceImpl) Delete(ctx context.Context, tokenRaw s
 		return goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
 	}
 
-	if err := s.repository.Delete(ctx, id); err != nil {
+	if err := s.repository.Delete(ctx, id, now); err != nil {
 		return goerrors.Join(ErrDeleteImproveRequest, err)
 	}
 
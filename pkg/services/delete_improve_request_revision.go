@@ -4,13 +4,17 @@ import (
 	"context"
 	goerrors "errors"
 	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
 	apiclients "github.com/a-novel/go-apis/clients"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
+	"time"
 )
 
 type DeleteImproveRequestRevisionService interface {
-	Delete(ctx context.Context, tokenRaw string, id uuid.UUID) error
+	// Delete deletes a revision. The policy decides whether the suggestions made on the revision are moved to the
+	// nearest surviving revision (the default), or deleted along with it.
+	Delete(ctx context.Context, tokenRaw string, id uuid.UUID, policy string, now time.Time) error
 }
 
 func NewDeleteImproveRequestRevisionService(repository dao.ImproveRequestRepository, authClient apiclients.AuthClient) DeleteImproveRequestRevisionService {
@@ -25,7 +29,7 @@ type deleteImproveRequestRevisionServiceImpl struct {
 	authClient apiclients.AuthClient
 }
 
-func (s *deleteImproveRequestRevisionServiceImpl) Delete(ctx context.Context, tokenRaw string, id uuid.UUID) error {
+func (s *deleteImproveRequestRevisionServiceImpl) Delete(ctx context.Context, tokenRaw string, id uuid.UUID, policy string, now time.Time) error {
 	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
 	if err != nil {
 		return goerrors.Join(ErrIntrospectToken, err)
@@ -34,6 +38,14 @@ func (s *deleteImproveRequestRevisionServiceImpl) Delete(ctx context.Context, to
 		return goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
 	}
 
+	switch policy {
+	case "":
+		policy = models.SuggestionOrphanPolicyReattach
+	case models.SuggestionOrphanPolicyReattach, models.SuggestionOrphanPolicyCascade:
+	default:
+		return goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidPolicy)
+	}
+
 	revision, err := s.repository.GetRevision(ctx, id)
 	if err != nil {
 		return goerrors.Join(ErrGetImproveRequestRevision, err)
@@ -42,7 +54,7 @@ func (s *deleteImproveRequestRevisionServiceImpl) Delete(ctx context.Context, to
 		return goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
 	}
 
-	if err := s.repository.DeleteRevision(ctx, id); err != nil {
+	if err := s.repository.DeleteRevision(ctx, id, dao.SuggestionOrphanPolicy(policy), now); err != nil {
 		return goerrors.Join(ErrDeleteImproveRequestRevision, err)
 	}
 
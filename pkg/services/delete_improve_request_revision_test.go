@@ -17,8 +17,9 @@ func TestDeleteImproveRequestRevisionService(t *testing.T) {
 	data := []struct {
 		name string
 
-		token string
-		id    uuid.UUID
+		token  string
+		id     uuid.UUID
+		policy string
 
 		authClientResp *apiclients.UserTokenStatus
 		authClientErr  error
@@ -27,8 +28,9 @@ func TestDeleteImproveRequestRevisionService(t *testing.T) {
 		getRevisionResp       *dao.ImproveRequestRevisionModel
 		getRevisionErr        error
 
-		shouldCallDeleteRevision bool
-		deleteRevisionErr        error
+		shouldCallDeleteRevision   bool
+		shouldCallDeleteRevisionAs dao.SuggestionOrphanPolicy
+		deleteRevisionErr          error
 
 		expectErr error
 	}{
@@ -44,7 +46,24 @@ func TestDeleteImproveRequestRevisionService(t *testing.T) {
 			getRevisionResp: &dao.ImproveRequestRevisionModel{
 				UserID: goframework.NumberUUID(100),
 			},
-			shouldCallDeleteRevision: true,
+			shouldCallDeleteRevision:   true,
+			shouldCallDeleteRevisionAs: dao.SuggestionOrphanPolicyReattach,
+		},
+		{
+			name:   "Success/Cascade",
+			token:  "tokenRaw",
+			id:     goframework.NumberUUID(1),
+			policy: "cascade",
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGetRevision: true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallDeleteRevision:   true,
+			shouldCallDeleteRevisionAs: dao.SuggestionOrphanPolicyCascade,
 		},
 		{
 			name:  "Error/DeleteRevisionFailure",
@@ -58,9 +77,10 @@ func TestDeleteImproveRequestRevisionService(t *testing.T) {
 			getRevisionResp: &dao.ImproveRequestRevisionModel{
 				UserID: goframework.NumberUUID(100),
 			},
-			shouldCallDeleteRevision: true,
-			deleteRevisionErr:        fooErr,
-			expectErr:                fooErr,
+			shouldCallDeleteRevision:   true,
+			shouldCallDeleteRevisionAs: dao.SuggestionOrphanPolicyReattach,
+			deleteRevisionErr:          fooErr,
+			expectErr:                  fooErr,
 		},
 		{
 			name:  "Error/NotTheCreator",
@@ -88,6 +108,17 @@ func TestDeleteImproveRequestRevisionService(t *testing.T) {
 			getRevisionErr:        fooErr,
 			expectErr:             fooErr,
 		},
+		{
+			name:   "Error/InvalidPolicy",
+			token:  "tokenRaw",
+			id:     goframework.NumberUUID(1),
+			policy: "fake policy",
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
 		{
 			name:           "Error/NotAuthenticated",
 			token:          "tokenRaw",
@@ -117,12 +148,12 @@ func TestDeleteImproveRequestRevisionService(t *testing.T) {
 
 			if d.shouldCallDeleteRevision {
 				repository.
-					On("DeleteRevision", context.Background(), d.id).
+					On("DeleteRevision", context.Background(), d.id, d.shouldCallDeleteRevisionAs, baseTime).
 					Return(d.deleteRevisionErr)
 			}
 
 			service := services.NewDeleteImproveRequestRevisionService(repository, authClient)
-			err := service.Delete(context.Background(), d.token, d.id)
+			err := service.Delete(context.Background(), d.token, d.id, d.policy, baseTime)
 
 			require.ErrorIs(t, err, d.expectErr)
 
@@ -117,12 +117,12 @@ func TestDeleteImproveRequestService(t *testing.T) {
 
 			if d.shouldCallDeleteRevision {
 				repository.
-					On("Delete", context.Background(), d.id).
+					On("Delete", context.Background(), d.id, baseTime).
 					Return(d.deleteRevisionErr)
 			}
 
 			service := services.NewDeleteImproveRequestService(repository, authClient)
-			err := service.Delete(context.Background(), d.token, d.id)
+			err := service.Delete(context.Background(), d.token, d.id, baseTime)
 
 			require.ErrorIs(t, err, d.expectErr)
 
@@ -7,10 +7,11 @@ import (
 	apiclients "github.com/a-novel/go-apis/clients"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
+	"time"
 )
 
 type DeleteImproveSuggestionService interface {
-	Delete(ctx context.Context, tokenRaw string, id uuid.UUID) error
+	Delete(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) error
 }
 
 func NewDeleteImproveSuggestionService(repository dao.ImproveSuggestionRepository, authClient apiclients.AuthClient) DeleteImproveSuggestionService {
@@ -25,7 +26,7 @@ type deleteImproveSuggestionServiceImpl struct {
 	authClient apiclients.AuthClient
 }
 
-func (s *deleteImproveSuggestionServiceImpl) Delete(ctx context.Context, tokenRaw string, id uuid.UUID) error {
+func (s *deleteImproveSuggestionServiceImpl) Delete(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) error {
 	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
 	if err != nil {
 		return goerrors.Join(ErrIntrospectToken, err)
@@ -42,7 +43,7 @@ func (s *deleteImproveSuggestionServiceImpl) Delete(ctx context.Context, tokenRa
 		return goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
 	}
 
-	if err := s.repository.Delete(ctx, id); err != nil {
+	if err := s.repository.Delete(ctx, id, now); err != nil {
 		return goerrors.Join(ErrDeleteImproveSuggestion, err)
 	}
 
@@ -117,12 +117,12 @@ func TestDeleteImproveSuggestionService(t *testing.T) {
 
 			if d.shouldCallDelete {
 				repository.
-					On("Delete", context.Background(), d.id).
+					On("Delete", context.Background(), d.id, baseTime).
 					Return(d.deleteErr)
 			}
 
 			service := services.NewDeleteImproveSuggestionService(repository, authClient)
-			err := service.Delete(context.Background(), d.token, d.id)
+			err := service.Delete(context.Background(), d.token, d.id, baseTime)
 
 			require.ErrorIs(t, err, d.expectErr)
 
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+)
+
+type DiffImproveRequestRevisionsService interface {
+	// Diff compares two revisions of the same improvement request. Drafts are only compared for their author. The
+	// token is optional.
+	Diff(ctx context.Context, tokenRaw string, fromID, toID uuid.UUID) (*models.Diff, error)
+}
+
+func NewDiffImproveRequestRevisionsService(repository dao.ImproveRequestRepository, authClient apiclients.AuthClient) DiffImproveRequestRevisionsService {
+	return &diffImproveRequestRevisionsServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type diffImproveRequestRevisionsServiceImpl struct {
+	repository dao.ImproveRequestRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *diffImproveRequestRevisionsServiceImpl) Diff(ctx context.Context, tokenRaw string, fromID, toID uuid.UUID) (*models.Diff, error) {
+	viewer, err := viewerID(ctx, s.authClient, tokenRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := s.repository.GetRevision(ctx, fromID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, err)
+	}
+	if !canView(from.Draft, from.UserID, viewer) {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, bunovel.ErrNotFound)
+	}
+
+	to, err := s.repository.GetRevision(ctx, toID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, err)
+	}
+	if !canView(to.Draft, to.UserID, viewer) {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, bunovel.ErrNotFound)
+	}
+
+	if from.SourceID != to.SourceID {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrSourceMismatch)
+	}
+
+	return &models.Diff{
+		Title:   diffTexts(from.Title, to.Title),
+		Content: diffTexts(from.Content, to.Content),
+	}, nil
+}
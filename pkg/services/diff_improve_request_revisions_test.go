@@ -0,0 +1,263 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDiffImproveRequestRevisionsService(t *testing.T) {
+	data := []struct {
+		name string
+
+		tokenRaw string
+		fromID   uuid.UUID
+		toID     uuid.UUID
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		getFromResp *dao.ImproveRequestRevisionModel
+		getFromErr  error
+
+		shouldCallGetTo bool
+		getToResp       *dao.ImproveRequestRevisionModel
+		getToErr        error
+
+		expect    *models.Diff
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			fromID: goframework.NumberUUID(1),
+			toID:   goframework.NumberUUID(2),
+			getFromResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				Title:    "my title",
+				Content:  "The fox.",
+			},
+			shouldCallGetTo: true,
+			getToResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				Title:    "my new title",
+				Content:  "The fox. It jumps.",
+			},
+			expect: &models.Diff{
+				Title: &models.TextDiff{
+					Words: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "my "},
+						{Operation: models.DiffOperationInsert, Text: "new "},
+						{Operation: models.DiffOperationEqual, Text: "title"},
+					},
+					Sentences: []*models.DiffChunk{
+						{Operation: models.DiffOperationDelete, Text: "my title"},
+						{Operation: models.DiffOperationInsert, Text: "my new title"},
+					},
+				},
+				Content: &models.TextDiff{
+					Words: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "The fox."},
+						{Operation: models.DiffOperationInsert, Text: " It jumps."},
+					},
+					Sentences: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "The fox."},
+						{Operation: models.DiffOperationInsert, Text: " It jumps."},
+					},
+				},
+			},
+		},
+		{
+			name:     "Success/DraftOfViewer",
+			tokenRaw: "token",
+			fromID:   goframework.NumberUUID(1),
+			toID:     goframework.NumberUUID(2),
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			getFromResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The fox.",
+			},
+			shouldCallGetTo: true,
+			getToResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The fox.",
+				Draft:    true,
+			},
+			expect: &models.Diff{
+				Title: &models.TextDiff{
+					Words: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "my title"},
+					},
+					Sentences: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "my title"},
+					},
+				},
+				Content: &models.TextDiff{
+					Words: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "The fox."},
+					},
+					Sentences: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "The fox."},
+					},
+				},
+			},
+		},
+		{
+			name:     "Error/FromDraftOfOtherUser",
+			tokenRaw: "token",
+			fromID:   goframework.NumberUUID(1),
+			toID:     goframework.NumberUUID(2),
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(200)},
+				},
+			},
+			getFromResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The fox.",
+				Draft:    true,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/ToDraftOfOtherUser",
+			tokenRaw: "token",
+			fromID:   goframework.NumberUUID(1),
+			toID:     goframework.NumberUUID(2),
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(200)},
+				},
+			},
+			getFromResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The fox.",
+			},
+			shouldCallGetTo: true,
+			getToResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The fox.",
+				Draft:    true,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:   "Error/DraftAnonymous",
+			fromID: goframework.NumberUUID(1),
+			toID:   goframework.NumberUUID(2),
+			getFromResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The fox.",
+			},
+			shouldCallGetTo: true,
+			getToResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The fox.",
+				Draft:    true,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:          "Error/IntrospectTokenFailure",
+			tokenRaw:      "token",
+			fromID:        goframework.NumberUUID(1),
+			toID:          goframework.NumberUUID(2),
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+		{
+			name:   "Error/DifferentSources",
+			fromID: goframework.NumberUUID(1),
+			toID:   goframework.NumberUUID(2),
+			getFromResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				Title:    "my title",
+				Content:  "The fox.",
+			},
+			shouldCallGetTo: true,
+			getToResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(20),
+				Title:    "my new title",
+				Content:  "The fox. It jumps.",
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:   "Error/GetToFailure",
+			fromID: goframework.NumberUUID(1),
+			toID:   goframework.NumberUUID(2),
+			getFromResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				Title:    "my title",
+				Content:  "The fox.",
+			},
+			shouldCallGetTo: true,
+			getToErr:        fooErr,
+			expectErr:       fooErr,
+		},
+		{
+			name:       "Error/GetFromFailure",
+			fromID:     goframework.NumberUUID(1),
+			toID:       goframework.NumberUUID(2),
+			getFromErr: fooErr,
+			expectErr:  fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			if d.tokenRaw != "" {
+				authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+			}
+
+			if d.authClientErr == nil {
+				repository.On("GetRevision", context.Background(), d.fromID).Return(d.getFromResp, d.getFromErr)
+			}
+
+			if d.shouldCallGetTo {
+				repository.On("GetRevision", context.Background(), d.toID).Return(d.getToResp, d.getToErr)
+			}
+
+			service := services.NewDiffImproveRequestRevisionsService(repository, authClient)
+			res, err := service.Diff(context.Background(), d.tokenRaw, d.fromID, d.toID)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+		})
+	}
+}
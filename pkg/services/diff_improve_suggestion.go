@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	"github.com/google/uuid"
+)
+
+type DiffImproveSuggestionService interface {
+	// Diff compares a suggestion with the revision it was made on. Drafts are only compared for their author. The
+	// token is optional.
+	Diff(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.Diff, error)
+}
+
+func NewDiffImproveSuggestionService(
+	repository dao.ImproveSuggestionRepository,
+	requestRepository dao.ImproveRequestRepository,
+	authClient apiclients.AuthClient,
+) DiffImproveSuggestionService {
+	return &diffImproveSuggestionServiceImpl{
+		repository:        repository,
+		requestRepository: requestRepository,
+		authClient:        authClient,
+	}
+}
+
+type diffImproveSuggestionServiceImpl struct {
+	repository        dao.ImproveSuggestionRepository
+	requestRepository dao.ImproveRequestRepository
+	authClient        apiclients.AuthClient
+}
+
+func (s *diffImproveSuggestionServiceImpl) Diff(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.Diff, error) {
+	viewer, err := viewerID(ctx, s.authClient, tokenRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestion, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveSuggestion, err)
+	}
+	if !canView(suggestion.Draft, suggestion.UserID, viewer) {
+		return nil, goerrors.Join(ErrGetImproveSuggestion, bunovel.ErrNotFound)
+	}
+
+	revision, err := s.requestRepository.GetRevision(ctx, suggestion.RequestID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, err)
+	}
+	if !canView(revision.Draft, revision.UserID, viewer) {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, bunovel.ErrNotFound)
+	}
+
+	return &models.Diff{
+		Title:   diffTexts(revision.Title, suggestion.Title),
+		Content: diffTexts(revision.Content, suggestion.Content),
+	}, nil
+}
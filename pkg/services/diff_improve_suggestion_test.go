@@ -0,0 +1,255 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDiffImproveSuggestionService(t *testing.T) {
+	data := []struct {
+		name string
+
+		tokenRaw string
+		id       uuid.UUID
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallGetSuggestion bool
+		getSuggestionResp       *dao.ImproveSuggestionModel
+		getSuggestionErr        error
+
+		shouldCallGetRevision bool
+		getRevisionResp       *dao.ImproveRequestRevisionModel
+		getRevisionErr        error
+
+		expect    *models.Diff
+		expectErr error
+	}{
+		{
+			name:                    "Success",
+			id:                      goframework.NumberUUID(1),
+			shouldCallGetSuggestion: true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(10),
+					Title:     "my title",
+					Content:   "The slow brown fox. It jumps.",
+				},
+			},
+			shouldCallGetRevision: true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Title:   "my title",
+				Content: "The quick brown fox. It jumps.",
+			},
+			expect: &models.Diff{
+				Title: &models.TextDiff{
+					Words: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "my title"},
+					},
+					Sentences: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "my title"},
+					},
+				},
+				Content: &models.TextDiff{
+					Words: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "The "},
+						{Operation: models.DiffOperationDelete, Text: "quick"},
+						{Operation: models.DiffOperationInsert, Text: "slow"},
+						{Operation: models.DiffOperationEqual, Text: " brown fox. It jumps."},
+					},
+					Sentences: []*models.DiffChunk{
+						{Operation: models.DiffOperationDelete, Text: "The quick brown fox."},
+						{Operation: models.DiffOperationInsert, Text: "The slow brown fox."},
+						{Operation: models.DiffOperationEqual, Text: " It jumps."},
+					},
+				},
+			},
+		},
+		{
+			name:     "Success/DraftOfViewer",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGetSuggestion: true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				UserID: goframework.NumberUUID(100),
+				Draft:  true,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(10),
+					Title:     "my title",
+					Content:   "The fox.",
+				},
+			},
+			shouldCallGetRevision: true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The fox.",
+				Draft:    true,
+			},
+			expect: &models.Diff{
+				Title: &models.TextDiff{
+					Words: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "my title"},
+					},
+					Sentences: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "my title"},
+					},
+				},
+				Content: &models.TextDiff{
+					Words: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "The fox."},
+					},
+					Sentences: []*models.DiffChunk{
+						{Operation: models.DiffOperationEqual, Text: "The fox."},
+					},
+				},
+			},
+		},
+		{
+			name:     "Error/DraftOfOtherUser",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(200)},
+				},
+			},
+			shouldCallGetSuggestion: true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				UserID: goframework.NumberUUID(100),
+				Draft:  true,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(10),
+					Title:     "my title",
+					Content:   "The fox.",
+				},
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/RevisionDraftOfOtherUser",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(200)},
+				},
+			},
+			shouldCallGetSuggestion: true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				UserID: goframework.NumberUUID(200),
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(10),
+					Title:     "my title",
+					Content:   "The fox.",
+				},
+			},
+			shouldCallGetRevision: true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The fox.",
+				Draft:    true,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:                    "Error/DraftAnonymous",
+			id:                      goframework.NumberUUID(1),
+			shouldCallGetSuggestion: true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				UserID: goframework.NumberUUID(100),
+				Draft:  true,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(10),
+					Title:     "my title",
+					Content:   "The fox.",
+				},
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:          "Error/IntrospectTokenFailure",
+			tokenRaw:      "token",
+			id:            goframework.NumberUUID(1),
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+		{
+			name:                    "Error/GetRevisionFailure",
+			id:                      goframework.NumberUUID(1),
+			shouldCallGetSuggestion: true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(10),
+					Title:     "my title",
+					Content:   "The slow brown fox. It jumps.",
+				},
+			},
+			shouldCallGetRevision: true,
+			getRevisionErr:        fooErr,
+			expectErr:             fooErr,
+		},
+		{
+			name:                    "Error/GetSuggestionFailure",
+			id:                      goframework.NumberUUID(1),
+			shouldCallGetSuggestion: true,
+			getSuggestionErr:        fooErr,
+			expectErr:               fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewImproveSuggestionRepository(t)
+			requestRepository := daomocks.NewImproveRequestRepository(t)
+
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			if d.tokenRaw != "" {
+				authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+			}
+
+			if d.shouldCallGetSuggestion {
+				repository.On("Get", context.Background(), d.id).Return(d.getSuggestionResp, d.getSuggestionErr)
+			}
+
+			if d.shouldCallGetRevision {
+				requestRepository.
+					On("GetRevision", context.Background(), d.getSuggestionResp.RequestID).
+					Return(d.getRevisionResp, d.getRevisionErr)
+			}
+
+			service := services.NewDiffImproveSuggestionService(repository, requestRepository, authClient)
+			res, err := service.Diff(context.Background(), d.tokenRaw, d.id)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			requestRepository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/sinks"
+	"time"
+)
+
+type DispatchEventsService interface {
+	// Dispatch sends the pending events of the outbox to every sink, and returns the number of events delivered.
+	// An event is only marked as delivered once every sink accepted it; otherwise, the failure is recorded and the
+	// event is sent again on a later call, until it reaches MaxEventAttempts.
+	Dispatch(ctx context.Context, now time.Time) (int, error)
+}
+
+func NewDispatchEventsService(repository dao.EventRepository, sinks []sinks.Sink) DispatchEventsService {
+	return &dispatchEventsServiceImpl{
+		repository: repository,
+		sinks:      sinks,
+	}
+}
+
+type dispatchEventsServiceImpl struct {
+	repository dao.EventRepository
+	sinks      []sinks.Sink
+}
+
+func (s *dispatchEventsServiceImpl) Dispatch(ctx context.Context, now time.Time) (int, error) {
+	events, err := s.repository.ClaimPending(ctx, MaxEventAttempts, DispatchBatchSize, now, EventClaimLease)
+	if err != nil {
+		return 0, goerrors.Join(ErrListEvents, err)
+	}
+
+	delivered := 0
+	for _, event := range events {
+		model := adapters.EventToModel(event)
+
+		var sendErrs []error
+		for _, sink := range s.sinks {
+			if err := sink.Send(ctx, model); err != nil {
+				sendErrs = append(sendErrs, err)
+			}
+		}
+
+		if len(sendErrs) > 0 {
+			if err := s.repository.MarkFailed(ctx, event.ID, goerrors.Join(sendErrs...).Error(), now); err != nil {
+				return delivered, goerrors.Join(ErrMarkEvent, err)
+			}
+
+			continue
+		}
+
+		if err := s.repository.MarkDelivered(ctx, event.ID, now); err != nil {
+			return delivered, goerrors.Join(ErrMarkEvent, err)
+		}
+
+		delivered++
+	}
+
+	return delivered, nil
+}
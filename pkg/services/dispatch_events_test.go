@@ -0,0 +1,168 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/forum-service/pkg/sinks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// failingSink rejects every event it receives.
+type failingSink struct{}
+
+func (failingSink) Send(context.Context, *models.Event) error {
+	return fooErr
+}
+
+func TestDispatchEventsService(t *testing.T) {
+	pending := []*dao.EventModel{
+		{
+			ID:        1,
+			CreatedAt: baseTime,
+			EventModelCore: dao.EventModelCore{
+				Type:     dao.EventTypeSuggestionCreated,
+				UserID:   goframework.NumberUUID(200),
+				TargetID: goframework.NumberUUID(20),
+				SourceID: goframework.NumberUUID(10),
+			},
+		},
+		{
+			ID:        2,
+			CreatedAt: baseTime,
+			EventModelCore: dao.EventModelCore{
+				Type:     dao.EventTypeSuggestionValidated,
+				UserID:   goframework.NumberUUID(100),
+				TargetID: goframework.NumberUUID(20),
+				SourceID: goframework.NumberUUID(10),
+			},
+		},
+	}
+
+	expectEvents := []*models.Event{
+		{
+			ID:        1,
+			CreatedAt: baseTime,
+			Type:      models.EventTypeSuggestionCreated,
+			UserID:    goframework.NumberUUID(200),
+			TargetID:  goframework.NumberUUID(20),
+			SourceID:  goframework.NumberUUID(10),
+		},
+		{
+			ID:        2,
+			CreatedAt: baseTime,
+			Type:      models.EventTypeSuggestionValidated,
+			UserID:    goframework.NumberUUID(100),
+			TargetID:  goframework.NumberUUID(20),
+			SourceID:  goframework.NumberUUID(10),
+		},
+	}
+
+	data := []struct {
+		name string
+
+		now        time.Time
+		withFailed bool
+
+		listResp []*dao.EventModel
+		listErr  error
+
+		shouldCallMarkDelivered []int64
+		markDeliveredErr        error
+
+		shouldCallMarkFailed []int64
+		markFailedErr        error
+
+		expect       int
+		expectEvents []*models.Event
+		expectErr    error
+	}{
+		{
+			name:                    "Success",
+			now:                     updateTime,
+			listResp:                pending,
+			shouldCallMarkDelivered: []int64{1, 2},
+			expect:                  2,
+			expectEvents:            expectEvents,
+		},
+		{
+			name:     "Success/NoEvents",
+			now:      updateTime,
+			listResp: []*dao.EventModel{},
+		},
+		{
+			name:                 "Success/SinkFailure",
+			now:                  updateTime,
+			withFailed:           true,
+			listResp:             pending,
+			shouldCallMarkFailed: []int64{1, 2},
+			expectEvents:         expectEvents,
+		},
+		{
+			name:                    "Error/MarkDeliveredFailure",
+			now:                     updateTime,
+			listResp:                pending,
+			shouldCallMarkDelivered: []int64{1},
+			markDeliveredErr:        fooErr,
+			expectEvents:            expectEvents[:1],
+			expectErr:               fooErr,
+		},
+		{
+			name:                 "Error/MarkFailedFailure",
+			now:                  updateTime,
+			withFailed:           true,
+			listResp:             pending,
+			shouldCallMarkFailed: []int64{1},
+			markFailedErr:        fooErr,
+			expectEvents:         expectEvents[:1],
+			expectErr:            fooErr,
+		},
+		{
+			name:      "Error/ListFailure",
+			now:       updateTime,
+			listErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewEventRepository(t)
+			memorySink := sinks.NewMemorySink()
+
+			eventSinks := []sinks.Sink{memorySink}
+			if d.withFailed {
+				eventSinks = append(eventSinks, failingSink{})
+			}
+
+			repository.
+				On(
+					"ClaimPending", context.Background(), services.MaxEventAttempts, services.DispatchBatchSize, d.now,
+					services.EventClaimLease,
+				).
+				Return(d.listResp, d.listErr)
+
+			for _, id := range d.shouldCallMarkDelivered {
+				repository.On("MarkDelivered", context.Background(), id, d.now).Return(d.markDeliveredErr)
+			}
+
+			for _, id := range d.shouldCallMarkFailed {
+				repository.On("MarkFailed", context.Background(), id, fooErr.Error(), d.now).Return(d.markFailedErr)
+			}
+
+			service := services.NewDispatchEventsService(repository, eventSinks)
+			res, err := service.Dispatch(context.Background(), d.now)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectEvents, memorySink.Events())
+
+			repository.AssertExpectations(t)
+		})
+	}
+}
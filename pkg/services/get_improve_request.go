@@ -3,31 +3,45 @@ package services
 import (
 	"context"
 	goerrors "errors"
+	"github.com/a-novel/bunovel"
 	"github.com/a-novel/forum-service/pkg/adapters"
 	"github.com/a-novel/forum-service/pkg/dao"
 	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
 	"github.com/google/uuid"
 )
 
 type GetImproveRequestService interface {
-	Get(ctx context.Context, id uuid.UUID) (*models.ImproveRequestPreview, error)
+	// Get returns an improvement request. Requests that were never published are only returned to their author. The
+	// token is optional.
+	Get(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveRequestPreview, error)
 }
 
-func NewGetImproveRequestService(repository dao.ImproveRequestRepository) GetImproveRequestService {
+func NewGetImproveRequestService(repository dao.ImproveRequestRepository, authClient apiclients.AuthClient) GetImproveRequestService {
 	return &getImproveRequestServiceImpl{
 		repository: repository,
+		authClient: authClient,
 	}
 }
 
 type getImproveRequestServiceImpl struct {
 	repository dao.ImproveRequestRepository
+	authClient apiclients.AuthClient
 }
 
-func (s *getImproveRequestServiceImpl) Get(ctx context.Context, id uuid.UUID) (*models.ImproveRequestPreview, error) {
+func (s *getImproveRequestServiceImpl) Get(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveRequestPreview, error) {
 	data, err := s.repository.Get(ctx, id)
 	if err != nil {
 		return nil, goerrors.Join(ErrGetImproveRequest, err)
 	}
 
+	viewer, err := viewerID(ctx, s.authClient, tokenRaw)
+	if err != nil {
+		return nil, err
+	}
+	if !canView(data.Draft, data.UserID, viewer) {
+		return nil, goerrors.Join(ErrGetImproveRequest, bunovel.ErrNotFound)
+	}
+
 	return adapters.ImproveRequestPreviewToModel(data), nil
 }
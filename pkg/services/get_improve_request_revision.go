@@ -3,31 +3,45 @@ package services
 import (
 	"context"
 	goerrors "errors"
+	"github.com/a-novel/bunovel"
 	"github.com/a-novel/forum-service/pkg/adapters"
 	"github.com/a-novel/forum-service/pkg/dao"
 	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
 	"github.com/google/uuid"
 )
 
 type GetImproveRequestRevisionService interface {
-	Get(ctx context.Context, id uuid.UUID) (*models.ImproveRequestRevision, error)
+	// Get returns a revision of an improvement request. Drafts are only returned to their author. The token is
+	// optional.
+	Get(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveRequestRevision, error)
 }
 
-func NewGetImproveRequestRevisionService(repository dao.ImproveRequestRepository) GetImproveRequestRevisionService {
+func NewGetImproveRequestRevisionService(repository dao.ImproveRequestRepository, authClient apiclients.AuthClient) GetImproveRequestRevisionService {
 	return &getImproveRequestRevisionServiceImpl{
 		repository: repository,
+		authClient: authClient,
 	}
 }
 
 type getImproveRequestRevisionServiceImpl struct {
 	repository dao.ImproveRequestRepository
+	authClient apiclients.AuthClient
 }
 
-func (s *getImproveRequestRevisionServiceImpl) Get(ctx context.Context, id uuid.UUID) (*models.ImproveRequestRevision, error) {
+func (s *getImproveRequestRevisionServiceImpl) Get(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveRequestRevision, error) {
 	data, err := s.repository.GetRevision(ctx, id)
 	if err != nil {
 		return nil, goerrors.Join(ErrGetImproveRequestRevision, err)
 	}
 
+	viewer, err := viewerID(ctx, s.authClient, tokenRaw)
+	if err != nil {
+		return nil, err
+	}
+	if !canView(data.Draft, data.UserID, viewer) {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, bunovel.ErrNotFound)
+	}
+
 	return adapters.ImproveRequestRevisionToModel(data), nil
 }
@@ -7,6 +7,8 @@ import (
 	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
 	"github.com/a-novel/forum-service/pkg/models"
 	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -18,11 +20,16 @@ func TestGetImproveRequestRevisionService(t *testing.T) {
 	data := []struct {
 		name string
 
-		id uuid.UUID
+		tokenRaw string
+		id       uuid.UUID
 
 		daoResp *dao.ImproveRequestRevisionModel
 		daoErr  error
 
+		shouldCallAuthClient bool
+		authClientResp       *apiclients.UserTokenStatus
+		authClientErr        error
+
 		expect    *models.ImproveRequestRevision
 		expectErr error
 	}{
@@ -45,6 +52,84 @@ func TestGetImproveRequestRevisionService(t *testing.T) {
 				Content:   "content",
 			},
 		},
+		{
+			name:     "Success/DraftOfViewer",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			daoResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(22), baseTime.Add(time.Hour), &updateTime),
+				SourceID: goframework.NumberUUID(20),
+				UserID:   goframework.NumberUUID(201),
+				Title:    "title",
+				Content:  "content",
+				Draft:    true,
+			},
+			shouldCallAuthClient: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(201)},
+				},
+			},
+			expect: &models.ImproveRequestRevision{
+				ID:        goframework.NumberUUID(22),
+				CreatedAt: baseTime.Add(time.Hour),
+				SourceID:  goframework.NumberUUID(20),
+				UserID:    goframework.NumberUUID(201),
+				Title:     "title",
+				Content:   "content",
+				Draft:     true,
+			},
+		},
+		{
+			name:     "Error/DraftOfOtherUser",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			daoResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(22), baseTime.Add(time.Hour), &updateTime),
+				SourceID: goframework.NumberUUID(20),
+				UserID:   goframework.NumberUUID(201),
+				Title:    "title",
+				Content:  "content",
+				Draft:    true,
+			},
+			shouldCallAuthClient: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name: "Error/DraftAnonymous",
+			id:   goframework.NumberUUID(1),
+			daoResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(22), baseTime.Add(time.Hour), &updateTime),
+				SourceID: goframework.NumberUUID(20),
+				UserID:   goframework.NumberUUID(201),
+				Title:    "title",
+				Content:  "content",
+				Draft:    true,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/IntrospectTokenFailure",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			daoResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(22), baseTime.Add(time.Hour), &updateTime),
+				SourceID: goframework.NumberUUID(20),
+				UserID:   goframework.NumberUUID(201),
+				Title:    "title",
+				Content:  "content",
+			},
+			shouldCallAuthClient: true,
+			authClientErr:        fooErr,
+			expectErr:            fooErr,
+		},
 		{
 			name:      "Error/DAOFailure",
 			id:        goframework.NumberUUID(1),
@@ -56,17 +141,23 @@ func TestGetImproveRequestRevisionService(t *testing.T) {
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
 			repository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
 
 			repository.On("GetRevision", context.Background(), d.id).Return(d.daoResp, d.daoErr)
 
-			service := services.NewGetImproveRequestRevisionService(repository)
+			if d.shouldCallAuthClient {
+				authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+			}
+
+			service := services.NewGetImproveRequestRevisionService(repository, authClient)
 
-			resp, err := service.Get(context.Background(), d.id)
+			resp, err := service.Get(context.Background(), d.tokenRaw, d.id)
 
 			require.ErrorIs(t, err, d.expectErr)
 			require.Equal(t, d.expect, resp)
 
 			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
 		})
 	}
 }
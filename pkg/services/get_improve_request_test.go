@@ -7,6 +7,8 @@ import (
 	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
 	"github.com/a-novel/forum-service/pkg/models"
 	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -18,11 +20,16 @@ func TestGetImproveRequestService(t *testing.T) {
 	data := []struct {
 		name string
 
-		id uuid.UUID
+		tokenRaw string
+		id       uuid.UUID
 
 		daoResp *dao.ImproveRequestPreview
 		daoErr  error
 
+		shouldCallAuthClient bool
+		authClientResp       *apiclients.UserTokenStatus
+		authClientErr        error
+
 		expect    *models.ImproveRequestPreview
 		expectErr error
 	}{
@@ -53,6 +60,80 @@ func TestGetImproveRequestService(t *testing.T) {
 				AcceptedSuggestionsCount: 5,
 			},
 		},
+		{
+			name:     "Success/DraftOfViewer",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			daoResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime.Add(time.Hour), &updateTime),
+				UserID:   goframework.NumberUUID(201),
+				Title:    "title",
+				Content:  "content",
+				Draft:    true,
+			},
+			shouldCallAuthClient: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(201)},
+				},
+			},
+			expect: &models.ImproveRequestPreview{
+				ID:        goframework.NumberUUID(21),
+				CreatedAt: baseTime.Add(time.Hour),
+				UserID:    goframework.NumberUUID(201),
+				Title:     "title",
+				Content:   "content",
+				Draft:     true,
+			},
+		},
+		{
+			name:     "Error/DraftOfOtherUser",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			daoResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime.Add(time.Hour), &updateTime),
+				UserID:   goframework.NumberUUID(201),
+				Title:    "title",
+				Content:  "content",
+				Draft:    true,
+			},
+			shouldCallAuthClient: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name: "Error/DraftAnonymous",
+			id:   goframework.NumberUUID(1),
+			daoResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime.Add(time.Hour), &updateTime),
+				UserID:   goframework.NumberUUID(201),
+				Title:    "title",
+				Content:  "content",
+				Draft:    true,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/IntrospectTokenFailure",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			daoResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime.Add(time.Hour), &updateTime),
+				UserID:   goframework.NumberUUID(201),
+				Title:    "title",
+				Content:  "content",
+				Draft:    true,
+			},
+			shouldCallAuthClient: true,
+			authClientErr:        fooErr,
+			expectErr:            fooErr,
+		},
 		{
 			name:      "Error/DAOFailure",
 			id:        goframework.NumberUUID(1),
@@ -64,17 +145,23 @@ func TestGetImproveRequestService(t *testing.T) {
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
 			repository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
 
 			repository.On("Get", context.Background(), d.id).Return(d.daoResp, d.daoErr)
 
-			service := services.NewGetImproveRequestService(repository)
+			if d.shouldCallAuthClient {
+				authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+			}
+
+			service := services.NewGetImproveRequestService(repository, authClient)
 
-			resp, err := service.Get(context.Background(), d.id)
+			resp, err := service.Get(context.Background(), d.tokenRaw, d.id)
 
 			require.ErrorIs(t, err, d.expectErr)
 			require.Equal(t, d.expect, resp)
 
 			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
 		})
 	}
 }
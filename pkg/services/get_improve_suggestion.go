@@ -3,31 +3,44 @@ package services
 import (
 	"context"
 	goerrors "errors"
+	"github.com/a-novel/bunovel"
 	"github.com/a-novel/forum-service/pkg/adapters"
 	"github.com/a-novel/forum-service/pkg/dao"
 	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
 	"github.com/google/uuid"
 )
 
 type GetImproveSuggestionService interface {
-	Get(ctx context.Context, id uuid.UUID) (*models.ImproveSuggestion, error)
+	// Get returns an improvement suggestion. Drafts are only returned to their author. The token is optional.
+	Get(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveSuggestion, error)
 }
 
-func NewGetImproveSuggestionService(service dao.ImproveSuggestionRepository) GetImproveSuggestionService {
+func NewGetImproveSuggestionService(service dao.ImproveSuggestionRepository, authClient apiclients.AuthClient) GetImproveSuggestionService {
 	return &getImproveSuggestionServiceImpl{
-		service: service,
+		service:    service,
+		authClient: authClient,
 	}
 }
 
 type getImproveSuggestionServiceImpl struct {
-	service dao.ImproveSuggestionRepository
+	service    dao.ImproveSuggestionRepository
+	authClient apiclients.AuthClient
 }
 
-func (s *getImproveSuggestionServiceImpl) Get(ctx context.Context, id uuid.UUID) (*models.ImproveSuggestion, error) {
+func (s *getImproveSuggestionServiceImpl) Get(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveSuggestion, error) {
 	suggestion, err := s.service.Get(ctx, id)
 	if err != nil {
 		return nil, goerrors.Join(ErrGetImproveSuggestion, err)
 	}
 
+	viewer, err := viewerID(ctx, s.authClient, tokenRaw)
+	if err != nil {
+		return nil, err
+	}
+	if !canView(suggestion.Draft, suggestion.UserID, viewer) {
+		return nil, goerrors.Join(ErrGetImproveSuggestion, bunovel.ErrNotFound)
+	}
+
 	return adapters.ImproveSuggestionToModel(suggestion), nil
 }
@@ -7,6 +7,8 @@ import (
 	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
 	"github.com/a-novel/forum-service/pkg/models"
 	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -17,11 +19,16 @@ func TestGetImproveSuggestionService(t *testing.T) {
 	data := []struct {
 		name string
 
-		id uuid.UUID
+		tokenRaw string
+		id       uuid.UUID
 
 		daoResp *dao.ImproveSuggestionModel
 		daoErr  error
 
+		shouldCallAuthClient bool
+		authClientResp       *apiclients.UserTokenStatus
+		authClientErr        error
+
 		expect    *models.ImproveSuggestion
 		expectErr error
 	}{
@@ -55,6 +62,98 @@ func TestGetImproveSuggestionService(t *testing.T) {
 				Content:   "suggestion content",
 			},
 		},
+		{
+			name:     "Success/DraftOfViewer",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			daoResp: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Draft:    true,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(1),
+					Title:     "suggestion title",
+					Content:   "suggestion content",
+				},
+			},
+			shouldCallAuthClient: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			expect: &models.ImproveSuggestion{
+				ID:        goframework.NumberUUID(1),
+				CreatedAt: baseTime,
+				SourceID:  goframework.NumberUUID(10),
+				UserID:    goframework.NumberUUID(100),
+				Draft:     true,
+				RequestID: goframework.NumberUUID(1),
+				Title:     "suggestion title",
+				Content:   "suggestion content",
+			},
+		},
+		{
+			name:     "Error/DraftOfOtherUser",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			daoResp: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Draft:    true,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(1),
+					Title:     "suggestion title",
+					Content:   "suggestion content",
+				},
+			},
+			shouldCallAuthClient: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(200)},
+				},
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name: "Error/DraftAnonymous",
+			id:   goframework.NumberUUID(1),
+			daoResp: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Draft:    true,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(1),
+					Title:     "suggestion title",
+					Content:   "suggestion content",
+				},
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/IntrospectTokenFailure",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			daoResp: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Draft:    true,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(1),
+					Title:     "suggestion title",
+					Content:   "suggestion content",
+				},
+			},
+			shouldCallAuthClient: true,
+			authClientErr:        fooErr,
+			expectErr:            fooErr,
+		},
 		{
 			name:      "Error/DAOFailure",
 			id:        goframework.NumberUUID(1),
@@ -66,16 +165,22 @@ func TestGetImproveSuggestionService(t *testing.T) {
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
 			repository := daomocks.NewImproveSuggestionRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
 
 			repository.On("Get", context.Background(), d.id).Return(d.daoResp, d.daoErr)
 
-			service := services.NewGetImproveSuggestionService(repository)
-			result, err := service.Get(context.Background(), d.id)
+			if d.shouldCallAuthClient {
+				authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+			}
+
+			service := services.NewGetImproveSuggestionService(repository, authClient)
+			result, err := service.Get(context.Background(), d.tokenRaw, d.id)
 
 			require.ErrorIs(t, err, d.expectErr)
 			require.Equal(t, d.expect, result)
 
 			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/samber/lo"
+)
+
+type ListAnnotationsService interface {
+	List(ctx context.Context, query models.ListAnnotationsQuery) ([]*models.Annotation, error)
+}
+
+func NewListAnnotationsService(repository dao.AnnotationRepository) ListAnnotationsService {
+	return &listAnnotationsServiceImpl{
+		repository: repository,
+	}
+}
+
+type listAnnotationsServiceImpl struct {
+	repository dao.AnnotationRepository
+}
+
+func (s *listAnnotationsServiceImpl) List(ctx context.Context, query models.ListAnnotationsQuery) ([]*models.Annotation, error) {
+	res, err := s.repository.List(ctx, dao.AnnotationListQuery{
+		RevisionID: query.RevisionID.Value(),
+		Orphaned:   query.Orphaned,
+	})
+	if err != nil {
+		return nil, goerrors.Join(ErrListAnnotations, err)
+	}
+
+	return lo.Map(res, func(item *dao.AnnotationModel, _ int) *models.Annotation {
+		return adapters.AnnotationToModel(item)
+	}), nil
+}
@@ -0,0 +1,136 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestListAnnotationsService(t *testing.T) {
+	data := []struct {
+		name string
+
+		query models.ListAnnotationsQuery
+
+		shouldCallDAOWithForm dao.AnnotationListQuery
+		queryResults          []*dao.AnnotationModel
+		queryErr              error
+
+		expectedResults []*models.Annotation
+		expectedErr     error
+	}{
+		{
+			name: "Success",
+			query: models.ListAnnotationsQuery{
+				RevisionID: apis.StringUUID(goframework.NumberUUID(10).String()),
+			},
+			shouldCallDAOWithForm: dao.AnnotationListQuery{
+				RevisionID: goframework.NumberUUID(10),
+			},
+			queryResults: []*dao.AnnotationModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, lo.ToPtr(baseTime.Add(3*time.Hour))),
+					UserID:   goframework.NumberUUID(100),
+					SourceID: goframework.NumberUUID(20),
+					AnnotationModelCore: dao.AnnotationModelCore{
+						RevisionID:  goframework.NumberUUID(10),
+						StartOffset: 4,
+						EndOffset:   9,
+						Quote:       "quick",
+						Content:     "content",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+					UserID:   goframework.NumberUUID(200),
+					SourceID: goframework.NumberUUID(20),
+					Orphaned: true,
+					AnnotationModelCore: dao.AnnotationModelCore{
+						RevisionID:  goframework.NumberUUID(10),
+						StartOffset: 10,
+						EndOffset:   15,
+						Quote:       "brown",
+						Content:     "other content",
+					},
+				},
+			},
+			expectedResults: []*models.Annotation{
+				{
+					ID:          goframework.NumberUUID(1),
+					CreatedAt:   baseTime,
+					UpdatedAt:   lo.ToPtr(baseTime.Add(3 * time.Hour)),
+					UserID:      goframework.NumberUUID(100),
+					SourceID:    goframework.NumberUUID(20),
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 4,
+					EndOffset:   9,
+					Quote:       "quick",
+					Content:     "content",
+				},
+				{
+					ID:          goframework.NumberUUID(2),
+					CreatedAt:   baseTime,
+					UserID:      goframework.NumberUUID(200),
+					SourceID:    goframework.NumberUUID(20),
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 10,
+					EndOffset:   15,
+					Quote:       "brown",
+					Content:     "other content",
+					Orphaned:    true,
+				},
+			},
+		},
+		{
+			name: "Success/Orphaned",
+			query: models.ListAnnotationsQuery{
+				RevisionID: apis.StringUUID(goframework.NumberUUID(10).String()),
+				Orphaned:   lo.ToPtr(true),
+			},
+			shouldCallDAOWithForm: dao.AnnotationListQuery{
+				RevisionID: goframework.NumberUUID(10),
+				Orphaned:   lo.ToPtr(true),
+			},
+			queryResults:    []*dao.AnnotationModel{},
+			expectedResults: []*models.Annotation{},
+		},
+		{
+			name: "Error/DAOFailure",
+			query: models.ListAnnotationsQuery{
+				RevisionID: apis.StringUUID(goframework.NumberUUID(10).String()),
+			},
+			shouldCallDAOWithForm: dao.AnnotationListQuery{
+				RevisionID: goframework.NumberUUID(10),
+			},
+			queryErr:    fooErr,
+			expectedErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewAnnotationRepository(t)
+
+			repository.
+				On("List", context.Background(), d.shouldCallDAOWithForm).
+				Return(d.queryResults, d.queryErr)
+
+			service := services.NewListAnnotationsService(repository)
+			results, err := service.List(context.Background(), d.query)
+
+			require.ErrorIs(t, err, d.expectedErr)
+			require.Equal(t, d.expectedResults, results)
+
+			repository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/samber/lo"
+)
+
+type ListCommentsService interface {
+	List(ctx context.Context, query models.ListCommentsQuery) ([]*models.Comment, int, error)
+}
+
+func NewListCommentsService(repository dao.CommentRepository) ListCommentsService {
+	return &listCommentsServiceImpl{
+		repository: repository,
+	}
+}
+
+type listCommentsServiceImpl struct {
+	repository dao.CommentRepository
+}
+
+func (s *listCommentsServiceImpl) List(ctx context.Context, query models.ListCommentsQuery) ([]*models.Comment, int, error) {
+	if err := goframework.CheckMinMax(query.Limit, 1, MaxSearchLimit); err != nil {
+		return nil, 0, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchLimit, err)
+	}
+
+	res, total, err := s.repository.List(ctx, adapters.CommentListQueryToDAO(query), query.Limit, query.Offset)
+	if err != nil {
+		return nil, 0, goerrors.Join(ErrListComments, err)
+	}
+
+	return lo.Map(res, func(item *dao.CommentModel, _ int) *models.Comment {
+		return adapters.CommentToModel(item)
+	}), total, nil
+}
@@ -0,0 +1,154 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestListCommentsService(t *testing.T) {
+	data := []struct {
+		name string
+
+		query models.ListCommentsQuery
+
+		shouldCallDAO         bool
+		shouldCallDAOWithForm dao.CommentListQuery
+		queryResults          []*dao.CommentModel
+		queryTotal            int
+		queryErr              error
+
+		expectedResults []*models.Comment
+		expectedTotal   int
+		expectedErr     error
+	}{
+		{
+			name: "Success",
+			query: models.ListCommentsQuery{
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   apis.StringUUID(goframework.NumberUUID(10).String()),
+				Limit:      10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.CommentListQuery{
+				TargetType: dao.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+			},
+			queryResults: []*dao.CommentModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, lo.ToPtr(baseTime.Add(3*time.Hour))),
+					UserID:   goframework.NumberUUID(100),
+					CommentModelCore: dao.CommentModelCore{
+						TargetType: dao.CommentTargetImproveRequest,
+						TargetID:   goframework.NumberUUID(10),
+						Content:    "content",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+					UserID:   goframework.NumberUUID(200),
+					CommentModelCore: dao.CommentModelCore{
+						TargetType: dao.CommentTargetImproveRequest,
+						TargetID:   goframework.NumberUUID(10),
+						Content:    "other content",
+					},
+				},
+			},
+			queryTotal: 20,
+			expectedResults: []*models.Comment{
+				{
+					ID:         goframework.NumberUUID(1),
+					CreatedAt:  baseTime,
+					UpdatedAt:  lo.ToPtr(baseTime.Add(3 * time.Hour)),
+					UserID:     goframework.NumberUUID(100),
+					TargetType: models.CommentTargetImproveRequest,
+					TargetID:   goframework.NumberUUID(10),
+					Content:    "content",
+				},
+				{
+					ID:         goframework.NumberUUID(2),
+					CreatedAt:  baseTime,
+					UserID:     goframework.NumberUUID(200),
+					TargetType: models.CommentTargetImproveRequest,
+					TargetID:   goframework.NumberUUID(10),
+					Content:    "other content",
+				},
+			},
+			expectedTotal: 20,
+		},
+		{
+			name: "Success/Replies",
+			query: models.ListCommentsQuery{
+				TargetType: models.CommentTargetImproveSuggestion,
+				TargetID:   apis.StringUUID(goframework.NumberUUID(10).String()),
+				ParentID:   apis.StringUUID(goframework.NumberUUID(1).String()),
+				Limit:      10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.CommentListQuery{
+				TargetType: dao.CommentTargetImproveSuggestion,
+				TargetID:   goframework.NumberUUID(10),
+				ParentID:   lo.ToPtr(goframework.NumberUUID(1)),
+			},
+			queryTotal:      20,
+			expectedResults: []*models.Comment{},
+			expectedTotal:   20,
+		},
+		{
+			name: "Error/DAOFailure",
+			query: models.ListCommentsQuery{
+				TargetType: models.CommentTargetImproveRequest,
+				TargetID:   apis.StringUUID(goframework.NumberUUID(10).String()),
+				Limit:      10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.CommentListQuery{
+				TargetType: dao.CommentTargetImproveRequest,
+				TargetID:   goframework.NumberUUID(10),
+			},
+			queryErr:    fooErr,
+			expectedErr: fooErr,
+		},
+		{
+			name:        "Error/NoLimit",
+			expectedErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name: "Error/LimitTooHigh",
+			query: models.ListCommentsQuery{
+				Limit: services.MaxSearchLimit + 1,
+			},
+			expectedErr: goframework.ErrInvalidEntity,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewCommentRepository(t)
+
+			if d.shouldCallDAO {
+				repository.
+					On("List", context.Background(), d.shouldCallDAOWithForm, d.query.Limit, d.query.Offset).
+					Return(d.queryResults, d.queryTotal, d.queryErr)
+			}
+
+			service := services.NewListCommentsService(repository)
+			results, total, err := service.List(context.Background(), d.query)
+
+			require.ErrorIs(t, err, d.expectedErr)
+			require.Equal(t, d.expectedResults, results)
+			require.Equal(t, d.expectedTotal, total)
+
+			repository.AssertExpectations(t)
+		})
+	}
+}
@@ -3,34 +3,53 @@ package services
 import (
 	"context"
 	goerrors "errors"
+	"github.com/a-novel/bunovel"
 	"github.com/a-novel/forum-service/pkg/adapters"
 	"github.com/a-novel/forum-service/pkg/dao"
 	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 )
 
 type ListImproveRequestRevisionsService interface {
-	List(ctx context.Context, id uuid.UUID) ([]*models.ImproveRequestRevisionPreview, error)
+	// List returns the revisions of an improvement request. Drafts are only returned to their author. The token is
+	// optional.
+	List(ctx context.Context, tokenRaw string, id uuid.UUID) ([]*models.ImproveRequestRevisionPreview, error)
 }
 
-func NewListImproveRequestRevisionsService(repository dao.ImproveRequestRepository) ListImproveRequestRevisionsService {
+func NewListImproveRequestRevisionsService(repository dao.ImproveRequestRepository, authClient apiclients.AuthClient) ListImproveRequestRevisionsService {
 	return &listImproveRequestRevisionServiceImpl{
 		repository: repository,
+		authClient: authClient,
 	}
 }
 
 type listImproveRequestRevisionServiceImpl struct {
 	repository dao.ImproveRequestRepository
+	authClient apiclients.AuthClient
 }
 
-func (s *listImproveRequestRevisionServiceImpl) List(ctx context.Context, id uuid.UUID) ([]*models.ImproveRequestRevisionPreview, error) {
+func (s *listImproveRequestRevisionServiceImpl) List(ctx context.Context, tokenRaw string, id uuid.UUID) ([]*models.ImproveRequestRevisionPreview, error) {
 	data, err := s.repository.ListRevisions(ctx, id)
 	if err != nil {
 		return nil, goerrors.Join(ErrListImproveRequestRevisions, err)
 	}
 
-	return lo.Map(data, func(item *dao.ImproveRequestRevisionPreview, _ int) *models.ImproveRequestRevisionPreview {
+	viewer, err := viewerID(ctx, s.authClient, tokenRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := lo.Filter(data, func(item *dao.ImproveRequestRevisionPreview, _ int) bool {
+		return canView(item.Draft, item.UserID, viewer)
+	})
+	// A request that was never published does not exist, for anyone but its author.
+	if len(data) > 0 && len(visible) == 0 {
+		return nil, goerrors.Join(ErrListImproveRequestRevisions, bunovel.ErrNotFound)
+	}
+
+	return lo.Map(visible, func(item *dao.ImproveRequestRevisionPreview, _ int) *models.ImproveRequestRevisionPreview {
 		return adapters.ImproveRequestRevisionPreviewToModel(item)
 	}), nil
 }
@@ -7,6 +7,8 @@ import (
 	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
 	"github.com/a-novel/forum-service/pkg/models"
 	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -18,11 +20,16 @@ func TestListImproveRequestRevisionService(t *testing.T) {
 	data := []struct {
 		name string
 
-		id uuid.UUID
+		tokenRaw string
+		id       uuid.UUID
 
 		daoResp []*dao.ImproveRequestRevisionPreview
 		daoErr  error
 
+		shouldCallAuthClient bool
+		authClientResp       *apiclients.UserTokenStatus
+		authClientErr        error
+
 		expect    []*models.ImproveRequestRevisionPreview
 		expectErr error
 	}{
@@ -56,6 +63,105 @@ func TestListImproveRequestRevisionService(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "Success/DraftsOfViewer",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			daoResp: []*dao.ImproveRequestRevisionPreview{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime.Add(time.Hour), nil),
+					UserID:   goframework.NumberUUID(100),
+					Draft:    true,
+				},
+				{
+					Metadata:         bunovel.NewMetadata(goframework.NumberUUID(22), baseTime, nil),
+					UserID:           goframework.NumberUUID(100),
+					SuggestionsCount: 2,
+				},
+			},
+			shouldCallAuthClient: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			expect: []*models.ImproveRequestRevisionPreview{
+				{
+					ID:        goframework.NumberUUID(21),
+					CreatedAt: baseTime.Add(time.Hour),
+					Draft:     true,
+				},
+				{
+					ID:               goframework.NumberUUID(22),
+					CreatedAt:        baseTime,
+					SuggestionsCount: 2,
+				},
+			},
+		},
+		{
+			name:     "Success/DraftsOfOtherUser",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			daoResp: []*dao.ImproveRequestRevisionPreview{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime.Add(time.Hour), nil),
+					UserID:   goframework.NumberUUID(100),
+					Draft:    true,
+				},
+				{
+					Metadata:         bunovel.NewMetadata(goframework.NumberUUID(22), baseTime, nil),
+					UserID:           goframework.NumberUUID(100),
+					SuggestionsCount: 2,
+				},
+			},
+			shouldCallAuthClient: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(200)},
+				},
+			},
+			expect: []*models.ImproveRequestRevisionPreview{
+				{
+					ID:               goframework.NumberUUID(22),
+					CreatedAt:        baseTime,
+					SuggestionsCount: 2,
+				},
+			},
+		},
+		{
+			name: "Error/OnlyDrafts",
+			id:   goframework.NumberUUID(1),
+			daoResp: []*dao.ImproveRequestRevisionPreview{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime.Add(time.Hour), nil),
+					UserID:   goframework.NumberUUID(100),
+					Draft:    true,
+				},
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/IntrospectTokenFailure",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			daoResp: []*dao.ImproveRequestRevisionPreview{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime.Add(time.Hour), nil),
+					UserID:   goframework.NumberUUID(100),
+					Draft:    true,
+				},
+				{
+					Metadata:         bunovel.NewMetadata(goframework.NumberUUID(22), baseTime, nil),
+					UserID:           goframework.NumberUUID(100),
+					SuggestionsCount: 2,
+				},
+			},
+			shouldCallAuthClient: true,
+			authClientErr:        fooErr,
+			expectErr:            fooErr,
+		},
 		{
 			name:   "Error/DAOFailure",
 			id:     goframework.NumberUUID(1),
@@ -66,17 +172,23 @@ func TestListImproveRequestRevisionService(t *testing.T) {
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
 			repository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
 
 			repository.On("ListRevisions", context.Background(), d.id).Return(d.daoResp, d.daoErr)
 
-			service := services.NewListImproveRequestRevisionsService(repository)
+			if d.shouldCallAuthClient {
+				authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+			}
+
+			service := services.NewListImproveRequestRevisionsService(repository, authClient)
 
-			resp, err := service.List(context.Background(), d.id)
+			resp, err := service.List(context.Background(), d.tokenRaw, d.id)
 
 			require.ErrorIs(t, err, d.expectErr)
 			require.Equal(t, d.expect, resp)
 
 			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
 		})
 	}
 }
@@ -6,30 +6,44 @@ import (
 	"github.com/a-novel/forum-service/pkg/adapters"
 	"github.com/a-novel/forum-service/pkg/dao"
 	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 )
 
 type ListImproveRequestsService interface {
-	List(ctx context.Context, ids []uuid.UUID) ([]*models.ImproveRequestPreview, error)
+	// List returns the improvement requests with the given IDs. Requests that were never published are only returned
+	// to their author. The token is optional.
+	List(ctx context.Context, tokenRaw string, ids []uuid.UUID) ([]*models.ImproveRequestPreview, error)
 }
 
-func NewListImproveRequestsService(repository dao.ImproveRequestRepository) ListImproveRequestsService {
+func NewListImproveRequestsService(repository dao.ImproveRequestRepository, authClient apiclients.AuthClient) ListImproveRequestsService {
 	return &listImproveRequestsServiceImpl{
 		repository: repository,
+		authClient: authClient,
 	}
 }
 
 type listImproveRequestsServiceImpl struct {
 	repository dao.ImproveRequestRepository
+	authClient apiclients.AuthClient
 }
 
-func (s *listImproveRequestsServiceImpl) List(ctx context.Context, ids []uuid.UUID) ([]*models.ImproveRequestPreview, error) {
+func (s *listImproveRequestsServiceImpl) List(ctx context.Context, tokenRaw string, ids []uuid.UUID) ([]*models.ImproveRequestPreview, error) {
 	res, err := s.repository.List(ctx, ids)
 	if err != nil {
 		return nil, goerrors.Join(ErrListImproveRequests, err)
 	}
 
+	viewer, err := viewerID(ctx, s.authClient, tokenRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	res = lo.Filter(res, func(item *dao.ImproveRequestPreview, _ int) bool {
+		return canView(item.Draft, item.UserID, viewer)
+	})
+
 	return lo.Map(res, func(item *dao.ImproveRequestPreview, _ int) *models.ImproveRequestPreview {
 		return adapters.ImproveRequestPreviewToModel(item)
 	}), nil
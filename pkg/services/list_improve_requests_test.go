@@ -7,6 +7,8 @@ import (
 	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
 	"github.com/a-novel/forum-service/pkg/models"
 	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -17,11 +19,16 @@ func TestListImproveRequestsService(t *testing.T) {
 	data := []struct {
 		name string
 
-		ids []uuid.UUID
+		tokenRaw string
+		ids      []uuid.UUID
 
 		daoResp []*dao.ImproveRequestPreview
 		daoErr  error
 
+		shouldCallAuthClient bool
+		authClientResp       *apiclients.UserTokenStatus
+		authClientErr        error
+
 		expected    []*models.ImproveRequestPreview
 		expectedErr error
 	}{
@@ -84,6 +91,101 @@ func TestListImproveRequestsService(t *testing.T) {
 			ids:      []uuid.UUID{goframework.NumberUUID(1), goframework.NumberUUID(2)},
 			expected: []*models.ImproveRequestPreview{},
 		},
+		{
+			name:     "Success/DraftOfViewer",
+			tokenRaw: "token",
+			ids:      []uuid.UUID{goframework.NumberUUID(1), goframework.NumberUUID(2)},
+			daoResp: []*dao.ImproveRequestPreview{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+					UserID:   goframework.NumberUUID(100),
+					Title:    "title",
+					Content:  "content",
+					Draft:    true,
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					UserID:   goframework.NumberUUID(200),
+					Title:    "title",
+					Content:  "content",
+				},
+			},
+			shouldCallAuthClient: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			expected: []*models.ImproveRequestPreview{
+				{
+					ID:        goframework.NumberUUID(10),
+					CreatedAt: baseTime,
+					UserID:    goframework.NumberUUID(100),
+					Title:     "title",
+					Content:   "content",
+					Draft:     true,
+				},
+				{
+					ID:        goframework.NumberUUID(20),
+					CreatedAt: baseTime,
+					UserID:    goframework.NumberUUID(200),
+					Title:     "title",
+					Content:   "content",
+				},
+			},
+		},
+		{
+			name: "Success/DraftOfOtherUser",
+			ids:  []uuid.UUID{goframework.NumberUUID(1), goframework.NumberUUID(2)},
+			daoResp: []*dao.ImproveRequestPreview{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+					UserID:   goframework.NumberUUID(100),
+					Title:    "title",
+					Content:  "content",
+					Draft:    true,
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					UserID:   goframework.NumberUUID(200),
+					Title:    "title",
+					Content:  "content",
+				},
+			},
+			expected: []*models.ImproveRequestPreview{
+				{
+					ID:        goframework.NumberUUID(20),
+					CreatedAt: baseTime,
+					UserID:    goframework.NumberUUID(200),
+					Title:     "title",
+					Content:   "content",
+				},
+			},
+		},
+		{
+			name:     "Error/IntrospectTokenFailure",
+			tokenRaw: "token",
+			ids:      []uuid.UUID{goframework.NumberUUID(1), goframework.NumberUUID(2)},
+			daoResp: []*dao.ImproveRequestPreview{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+					UserID:   goframework.NumberUUID(100),
+					Title:    "title",
+					Content:  "content",
+					Draft:    true,
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					UserID:   goframework.NumberUUID(200),
+					Title:    "title",
+					Content:  "content",
+				},
+			},
+			shouldCallAuthClient: true,
+			authClientErr:        fooErr,
+			expectedErr:          fooErr,
+		},
 		{
 			name:        "Error/DAOFailure",
 			ids:         []uuid.UUID{goframework.NumberUUID(1), goframework.NumberUUID(2)},
@@ -95,15 +197,22 @@ func TestListImproveRequestsService(t *testing.T) {
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
 			repository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
 			repository.On("List", context.Background(), d.ids).Return(d.daoResp, d.daoErr)
 
-			service := services.NewListImproveRequestsService(repository)
-			resp, err := service.List(context.Background(), d.ids)
+			if d.shouldCallAuthClient {
+				authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+			}
+
+			service := services.NewListImproveRequestsService(repository, authClient)
+			resp, err := service.List(context.Background(), d.tokenRaw, d.ids)
 
 			require.ErrorIs(t, err, d.expectedErr)
 			require.Equal(t, d.expected, resp)
 
 			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
 		})
 	}
 }
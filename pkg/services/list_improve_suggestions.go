@@ -6,30 +6,44 @@ import (
 	"github.com/a-novel/forum-service/pkg/adapters"
 	"github.com/a-novel/forum-service/pkg/dao"
 	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 )
 
 type ListImproveSuggestionsService interface {
-	List(ctx context.Context, ids []uuid.UUID) ([]*models.ImproveSuggestion, error)
+	// List returns the improvement suggestions with the given IDs. Drafts are only returned to their author. The token
+	// is optional.
+	List(ctx context.Context, tokenRaw string, ids []uuid.UUID) ([]*models.ImproveSuggestion, error)
 }
 
-func NewListImproveSuggestionsService(repository dao.ImproveSuggestionRepository) ListImproveSuggestionsService {
+func NewListImproveSuggestionsService(repository dao.ImproveSuggestionRepository, authClient apiclients.AuthClient) ListImproveSuggestionsService {
 	return &listImproveSuggestionsServiceImpl{
 		repository: repository,
+		authClient: authClient,
 	}
 }
 
 type listImproveSuggestionsServiceImpl struct {
 	repository dao.ImproveSuggestionRepository
+	authClient apiclients.AuthClient
 }
 
-func (s *listImproveSuggestionsServiceImpl) List(ctx context.Context, ids []uuid.UUID) ([]*models.ImproveSuggestion, error) {
+func (s *listImproveSuggestionsServiceImpl) List(ctx context.Context, tokenRaw string, ids []uuid.UUID) ([]*models.ImproveSuggestion, error) {
 	res, err := s.repository.List(ctx, ids)
 	if err != nil {
 		return nil, goerrors.Join(ErrListImproveSuggestions, err)
 	}
 
+	viewer, err := viewerID(ctx, s.authClient, tokenRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	res = lo.Filter(res, func(item *dao.ImproveSuggestionModel, _ int) bool {
+		return canView(item.Draft, item.UserID, viewer)
+	})
+
 	return lo.Map(res, func(item *dao.ImproveSuggestionModel, _ int) *models.ImproveSuggestion {
 		return adapters.ImproveSuggestionToModel(item)
 	}), nil
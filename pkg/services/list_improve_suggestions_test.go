@@ -7,6 +7,8 @@ import (
 	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
 	"github.com/a-novel/forum-service/pkg/models"
 	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -19,11 +21,16 @@ func TestListImproveSuggestionsService(t *testing.T) {
 	data := []struct {
 		name string
 
-		ids []uuid.UUID
+		tokenRaw string
+		ids      []uuid.UUID
 
 		daoResp []*dao.ImproveSuggestionModel
 		daoErr  error
 
+		shouldCallAuthClient bool
+		authClientResp       *apiclients.UserTokenStatus
+		authClientErr        error
+
 		expected    []*models.ImproveSuggestion
 		expectedErr error
 	}{
@@ -92,6 +99,131 @@ func TestListImproveSuggestionsService(t *testing.T) {
 			ids:      []uuid.UUID{goframework.NumberUUID(1), goframework.NumberUUID(2)},
 			expected: []*models.ImproveSuggestion{},
 		},
+		{
+			name:     "Success/DraftOfViewer",
+			tokenRaw: "token",
+			ids:      []uuid.UUID{goframework.NumberUUID(1), goframework.NumberUUID(2)},
+			daoResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(100),
+					Draft:    true,
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "title",
+						Content:   "content",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "title",
+						Content:   "content",
+					},
+				},
+			},
+			shouldCallAuthClient: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			expected: []*models.ImproveSuggestion{
+				{
+					ID:        goframework.NumberUUID(1),
+					CreatedAt: baseTime,
+					SourceID:  goframework.NumberUUID(10),
+					UserID:    goframework.NumberUUID(100),
+					Draft:     true,
+					RequestID: goframework.NumberUUID(1),
+					Title:     "title",
+					Content:   "content",
+				},
+				{
+					ID:        goframework.NumberUUID(2),
+					CreatedAt: baseTime,
+					SourceID:  goframework.NumberUUID(10),
+					UserID:    goframework.NumberUUID(200),
+					RequestID: goframework.NumberUUID(1),
+					Title:     "title",
+					Content:   "content",
+				},
+			},
+		},
+		{
+			name: "Success/DraftOfOtherUser",
+			ids:  []uuid.UUID{goframework.NumberUUID(1), goframework.NumberUUID(2)},
+			daoResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(100),
+					Draft:    true,
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "title",
+						Content:   "content",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "title",
+						Content:   "content",
+					},
+				},
+			},
+			expected: []*models.ImproveSuggestion{
+				{
+					ID:        goframework.NumberUUID(2),
+					CreatedAt: baseTime,
+					SourceID:  goframework.NumberUUID(10),
+					UserID:    goframework.NumberUUID(200),
+					RequestID: goframework.NumberUUID(1),
+					Title:     "title",
+					Content:   "content",
+				},
+			},
+		},
+		{
+			name:     "Error/IntrospectTokenFailure",
+			tokenRaw: "token",
+			ids:      []uuid.UUID{goframework.NumberUUID(1), goframework.NumberUUID(2)},
+			daoResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(100),
+					Draft:    true,
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "title",
+						Content:   "content",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "title",
+						Content:   "content",
+					},
+				},
+			},
+			shouldCallAuthClient: true,
+			authClientErr:        fooErr,
+			expectedErr:          fooErr,
+		},
 		{
 			name:        "Error/DAOFailure",
 			ids:         []uuid.UUID{goframework.NumberUUID(1), goframework.NumberUUID(2)},
@@ -103,15 +235,22 @@ func TestListImproveSuggestionsService(t *testing.T) {
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
 			repository := daomocks.NewImproveSuggestionRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
 			repository.On("List", context.Background(), d.ids).Return(d.daoResp, d.daoErr)
 
-			service := services.NewListImproveSuggestionsService(repository)
-			resp, err := service.List(context.Background(), d.ids)
+			if d.shouldCallAuthClient {
+				authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+			}
+
+			service := services.NewListImproveSuggestionsService(repository, authClient)
+			resp, err := service.List(context.Background(), d.tokenRaw, d.ids)
 
 			require.ErrorIs(t, err, d.expectedErr)
 			require.Equal(t, d.expected, resp)
 
 			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/samber/lo"
+)
+
+type ListNotificationsService interface {
+	List(ctx context.Context, tokenRaw string, query models.ListNotificationsQuery) ([]*models.Notification, int, error)
+}
+
+func NewListNotificationsService(repository dao.NotificationRepository, authClient apiclients.AuthClient) ListNotificationsService {
+	return &listNotificationsServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type listNotificationsServiceImpl struct {
+	repository dao.NotificationRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *listNotificationsServiceImpl) List(ctx context.Context, tokenRaw string, query models.ListNotificationsQuery) ([]*models.Notification, int, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, 0, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, 0, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	if err := goframework.CheckMinMax(query.Limit, 1, MaxSearchLimit); err != nil {
+		return nil, 0, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchLimit, err)
+	}
+
+	res, total, err := s.repository.List(
+		ctx, token.Token.Payload.ID, dao.NotificationListQuery{Unread: query.Unread}, query.Limit, query.Offset,
+	)
+	if err != nil {
+		return nil, 0, goerrors.Join(ErrListNotifications, err)
+	}
+
+	return lo.Map(res, func(item *dao.NotificationModel, _ int) *models.Notification {
+		return adapters.NotificationToModel(item)
+	}), total, nil
+}
@@ -0,0 +1,168 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListNotificationsService(t *testing.T) {
+	data := []struct {
+		name string
+
+		token string
+		query models.ListNotificationsQuery
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallDAO bool
+		daoQuery      dao.NotificationListQuery
+		daoResp       []*dao.NotificationModel
+		daoTotal      int
+		daoErr        error
+
+		expect      []*models.Notification
+		expectTotal int
+		expectErr   error
+	}{
+		{
+			name:  "Success",
+			token: "tokenRaw",
+			query: models.ListNotificationsQuery{
+				Unread: true,
+				Limit:  10,
+				Offset: 20,
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallDAO: true,
+			daoQuery:      dao.NotificationListQuery{Unread: true},
+			daoResp: []*dao.NotificationModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+					UserID:   goframework.NumberUUID(100),
+					EventID:  1,
+					Type:     dao.EventTypeSuggestionCreated,
+					ActorID:  goframework.NumberUUID(200),
+					TargetID: goframework.NumberUUID(20),
+					SourceID: goframework.NumberUUID(10),
+				},
+			},
+			daoTotal: 30,
+			expect: []*models.Notification{
+				{
+					ID:        goframework.NumberUUID(1),
+					CreatedAt: baseTime,
+					Type:      models.EventTypeSuggestionCreated,
+					ActorID:   goframework.NumberUUID(200),
+					TargetID:  goframework.NumberUUID(20),
+					SourceID:  goframework.NumberUUID(10),
+				},
+			},
+			expectTotal: 30,
+		},
+		{
+			name:  "Success/NoResults",
+			token: "tokenRaw",
+			query: models.ListNotificationsQuery{
+				Limit: 10,
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallDAO: true,
+			daoResp:       []*dao.NotificationModel{},
+			expect:        []*models.Notification{},
+		},
+		{
+			name:  "Error/DAOFailure",
+			token: "tokenRaw",
+			query: models.ListNotificationsQuery{
+				Limit: 10,
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallDAO: true,
+			daoErr:        fooErr,
+			expectErr:     fooErr,
+		},
+		{
+			name:  "Error/LimitTooHigh",
+			token: "tokenRaw",
+			query: models.ListNotificationsQuery{
+				Limit: services.MaxSearchLimit + 1,
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:  "Error/NoLimit",
+			token: "tokenRaw",
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:  "Error/NotAuthenticated",
+			token: "tokenRaw",
+			query: models.ListNotificationsQuery{
+				Limit: 10,
+			},
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:  "Error/AuthClientFailure",
+			token: "tokenRaw",
+			query: models.ListNotificationsQuery{
+				Limit: 10,
+			},
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewNotificationRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.token).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallDAO {
+				repository.
+					On("List", context.Background(), goframework.NumberUUID(100), d.daoQuery, d.query.Limit, d.query.Offset).
+					Return(d.daoResp, d.daoTotal, d.daoErr)
+			}
+
+			service := services.NewListNotificationsService(repository, authClient)
+			res, total, err := service.List(context.Background(), d.token, d.query)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectTotal, total)
+
+			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+		})
+	}
+}
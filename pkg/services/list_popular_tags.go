@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/samber/lo"
+)
+
+type ListPopularTagsService interface {
+	// List returns the most used tags, with the number of improvement requests labeled with them.
+	List(ctx context.Context, limit int) ([]*models.Tag, error)
+}
+
+func NewListPopularTagsService(repository dao.TagRepository) ListPopularTagsService {
+	return &listPopularTagsServiceImpl{
+		repository: repository,
+	}
+}
+
+type listPopularTagsServiceImpl struct {
+	repository dao.TagRepository
+}
+
+func (s *listPopularTagsServiceImpl) List(ctx context.Context, limit int) ([]*models.Tag, error) {
+	if err := goframework.CheckMinMax(limit, 1, MaxSearchLimit); err != nil {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchLimit, err)
+	}
+
+	res, err := s.repository.ListPopular(ctx, limit)
+	if err != nil {
+		return nil, goerrors.Join(ErrListTags, err)
+	}
+
+	return lo.Map(res, func(item *dao.TagUsage, _ int) *models.Tag {
+		return adapters.TagUsageToModel(item)
+	}), nil
+}
@@ -0,0 +1,82 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListPopularTagsService(t *testing.T) {
+	data := []struct {
+		name string
+
+		limit int
+
+		shouldCallDAO bool
+		daoResp       []*dao.TagUsage
+		daoErr        error
+
+		expect    []*models.Tag
+		expectErr error
+	}{
+		{
+			name:          "Success",
+			limit:         10,
+			shouldCallDAO: true,
+			daoResp: []*dao.TagUsage{
+				{Name: "dialogue", Count: 3},
+				{Name: "horror", Count: 1},
+			},
+			expect: []*models.Tag{
+				{Name: "dialogue", Count: 3},
+				{Name: "horror", Count: 1},
+			},
+		},
+		{
+			name:          "Success/NoResults",
+			limit:         10,
+			shouldCallDAO: true,
+			daoResp:       []*dao.TagUsage{},
+			expect:        []*models.Tag{},
+		},
+		{
+			name:          "Error/DAOFailure",
+			limit:         10,
+			shouldCallDAO: true,
+			daoErr:        fooErr,
+			expectErr:     fooErr,
+		},
+		{
+			name:      "Error/NoLimit",
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/LimitTooHigh",
+			limit:     services.MaxSearchLimit + 1,
+			expectErr: services.ErrInvalidSearchLimit,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewTagRepository(t)
+
+			if d.shouldCallDAO {
+				repository.On("ListPopular", context.Background(), d.limit).Return(d.daoResp, d.daoErr)
+			}
+
+			service := services.NewListPopularTagsService(repository)
+			res, err := service.List(context.Background(), d.limit)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/samber/lo"
+)
+
+type ListReportsService interface {
+	List(ctx context.Context, tokenRaw string, query models.ListReportsQuery) ([]*models.Report, int, error)
+}
+
+func NewListReportsService(
+	repository dao.ReportRepository,
+	authClient apiclients.AuthClient,
+	permissionsClient apiclients.PermissionsClient,
+) ListReportsService {
+	return &listReportsServiceImpl{
+		repository:        repository,
+		authClient:        authClient,
+		permissionsClient: permissionsClient,
+	}
+}
+
+type listReportsServiceImpl struct {
+	repository        dao.ReportRepository
+	authClient        apiclients.AuthClient
+	permissionsClient apiclients.PermissionsClient
+}
+
+func (s *listReportsServiceImpl) List(ctx context.Context, tokenRaw string, query models.ListReportsQuery) ([]*models.Report, int, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, 0, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, 0, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	if err := s.permissionsClient.HasUserScope(ctx, moderatorScopeQuery(token.Token.Payload.ID)); err != nil {
+		return nil, 0, goerrors.Join(ErrGetScopes, err)
+	}
+
+	if err := goframework.CheckMinMax(query.Limit, 1, MaxSearchLimit); err != nil {
+		return nil, 0, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchLimit, err)
+	}
+
+	switch query.Status {
+	case "", models.ReportStatusOpen, models.ReportStatusClaimed, models.ReportStatusResolved:
+	default:
+		return nil, 0, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidStatus)
+	}
+
+	switch query.TargetType {
+	case "", models.ReportTargetImproveRequestRevision, models.ReportTargetImproveSuggestion, models.ReportTargetComment:
+	default:
+		return nil, 0, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidTargetType)
+	}
+
+	res, total, err := s.repository.List(ctx, adapters.ReportListQueryToDAO(query), query.Limit, query.Offset)
+	if err != nil {
+		return nil, 0, goerrors.Join(ErrListReports, err)
+	}
+
+	return lo.Map(res, func(item *dao.ReportModel, _ int) *models.Report {
+		return adapters.ReportToModel(item)
+	}), total, nil
+}
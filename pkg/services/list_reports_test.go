@@ -0,0 +1,236 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	"github.com/a-novel/go-apis"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListReportsService(t *testing.T) {
+	data := []struct {
+		name string
+
+		token string
+		query models.ListReportsQuery
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallPermissionsClient bool
+		permissionsClientErr        error
+
+		shouldCallDAO bool
+		daoQuery      dao.ReportListQuery
+		daoResp       []*dao.ReportModel
+		daoTotal      int
+		daoErr        error
+
+		expect      []*models.Report
+		expectTotal int
+		expectErr   error
+	}{
+		{
+			name:  "Success",
+			token: "tokenRaw",
+			query: models.ListReportsQuery{
+				Status:      models.ReportStatusClaimed,
+				TargetType:  models.ReportTargetComment,
+				ModeratorID: apis.StringUUID(goframework.NumberUUID(100).String()),
+				Limit:       10,
+				Offset:      20,
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallDAO:               true,
+			daoQuery: dao.ReportListQuery{
+				Status:      lo.ToPtr(dao.ReportStatusClaimed),
+				TargetType:  lo.ToPtr(dao.ReportTargetComment),
+				ModeratorID: lo.ToPtr(goframework.NumberUUID(100)),
+			},
+			daoResp: []*dao.ReportModel{
+				{
+					Metadata:    bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+					UserID:      goframework.NumberUUID(200),
+					Status:      dao.ReportStatusClaimed,
+					ModeratorID: lo.ToPtr(goframework.NumberUUID(100)),
+					ClaimedAt:   &updateTime,
+					ReportModelCore: dao.ReportModelCore{
+						TargetType: dao.ReportTargetComment,
+						TargetID:   goframework.NumberUUID(10),
+						Reason:     dao.ReportReasonAbuse,
+						Content:    "content",
+					},
+				},
+			},
+			daoTotal: 30,
+			expect: []*models.Report{
+				{
+					ID:          goframework.NumberUUID(1),
+					CreatedAt:   baseTime,
+					UpdatedAt:   &updateTime,
+					UserID:      goframework.NumberUUID(200),
+					TargetType:  models.ReportTargetComment,
+					TargetID:    goframework.NumberUUID(10),
+					Reason:      models.ReportReasonAbuse,
+					Content:     "content",
+					Status:      models.ReportStatusClaimed,
+					ModeratorID: lo.ToPtr(goframework.NumberUUID(100)),
+					ClaimedAt:   &updateTime,
+				},
+			},
+			expectTotal: 30,
+		},
+		{
+			name:  "Success/NoResults",
+			token: "tokenRaw",
+			query: models.ListReportsQuery{
+				Limit: 10,
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallDAO:               true,
+			daoResp:                     []*dao.ReportModel{},
+			expect:                      []*models.Report{},
+		},
+		{
+			name:  "Error/DAOFailure",
+			token: "tokenRaw",
+			query: models.ListReportsQuery{
+				Limit: 10,
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallDAO:               true,
+			daoErr:                      fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:  "Error/InvalidTargetType",
+			token: "tokenRaw",
+			query: models.ListReportsQuery{
+				TargetType: "fake",
+				Limit:      10,
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			expectErr:                   goframework.ErrInvalidEntity,
+		},
+		{
+			name:  "Error/InvalidStatus",
+			token: "tokenRaw",
+			query: models.ListReportsQuery{
+				Status: "fake",
+				Limit:  10,
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			expectErr:                   goframework.ErrInvalidEntity,
+		},
+		{
+			name:  "Error/LimitTooHigh",
+			token: "tokenRaw",
+			query: models.ListReportsQuery{
+				Limit: services.MaxSearchLimit + 1,
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			expectErr:                   goframework.ErrInvalidEntity,
+		},
+		{
+			name:  "Error/NotModerator",
+			token: "tokenRaw",
+			query: models.ListReportsQuery{
+				Limit: 10,
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientErr:        fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:  "Error/NotAuthenticated",
+			token: "tokenRaw",
+			query: models.ListReportsQuery{
+				Limit: 10,
+			},
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:  "Error/AuthClientFailure",
+			token: "tokenRaw",
+			query: models.ListReportsQuery{
+				Limit: 10,
+			},
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewReportRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+			permissionsClient := apiclientsmocks.NewPermissionsClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.token).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallPermissionsClient {
+				permissionsClient.
+					On("HasUserScope", context.Background(), apiclients.HasUserScopeQuery{
+						UserID: goframework.NumberUUID(100),
+						Scope:  services.CanModerate,
+					}).
+					Return(d.permissionsClientErr)
+			}
+
+			if d.shouldCallDAO {
+				repository.
+					On("List", context.Background(), d.daoQuery, d.query.Limit, d.query.Offset).
+					Return(d.daoResp, d.daoTotal, d.daoErr)
+			}
+
+			service := services.NewListReportsService(repository, authClient, permissionsClient)
+			res, total, err := service.List(context.Background(), d.token, d.query)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectTotal, total)
+
+			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+			permissionsClient.AssertExpectations(t)
+		})
+	}
+}
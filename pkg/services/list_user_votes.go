@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type ListUserVotesService interface {
+	List(ctx context.Context, tokenRaw string, targetType string, ids []uuid.UUID) ([]*models.Vote, error)
+}
+
+func NewListUserVotesService(repository dao.VoteRepository, authClient apiclients.AuthClient) ListUserVotesService {
+	return &listUserVotesServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type listUserVotesServiceImpl struct {
+	repository dao.VoteRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *listUserVotesServiceImpl) List(ctx context.Context, tokenRaw string, targetType string, ids []uuid.UUID) ([]*models.Vote, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	if targetType != models.VoteTargetImproveRequest && targetType != models.VoteTargetImproveSuggestion {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidTargetType)
+	}
+
+	if err := goframework.CheckMinMax(len(ids), 0, MaxSearchLimit); err != nil {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchLimit, err)
+	}
+
+	if len(ids) == 0 {
+		return []*models.Vote{}, nil
+	}
+
+	res, err := s.repository.ListUserVotes(ctx, dao.VoteTarget(targetType), token.Token.Payload.ID, ids)
+	if err != nil {
+		return nil, goerrors.Join(ErrListUserVotes, err)
+	}
+
+	return lo.Map(res, func(item *dao.VoteModel, _ int) *models.Vote {
+		return adapters.VoteToModel(item)
+	}), nil
+}
@@ -0,0 +1,151 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListUserVotesService(t *testing.T) {
+	data := []struct {
+		name string
+
+		tokenRaw   string
+		targetType string
+		ids        []uuid.UUID
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallDAO bool
+		daoResp       []*dao.VoteModel
+		daoErr        error
+
+		expect    []*models.Vote
+		expectErr error
+	}{
+		{
+			name:       "Success",
+			tokenRaw:   "token",
+			targetType: models.VoteTargetImproveRequest,
+			ids:        []uuid.UUID{goframework.NumberUUID(10), goframework.NumberUUID(11)},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallDAO: true,
+			daoResp: []*dao.VoteModel{
+				{
+					CreatedAt:  baseTime,
+					UpdatedAt:  &updateTime,
+					TargetType: dao.VoteTargetImproveRequest,
+					TargetID:   goframework.NumberUUID(10),
+					UserID:     goframework.NumberUUID(100),
+					Vote:       -1,
+				},
+			},
+			expect: []*models.Vote{
+				{
+					CreatedAt:  baseTime,
+					UpdatedAt:  &updateTime,
+					TargetType: models.VoteTargetImproveRequest,
+					TargetID:   goframework.NumberUUID(10),
+					Vote:       -1,
+				},
+			},
+		},
+		{
+			name:       "Success/NoIDs",
+			tokenRaw:   "token",
+			targetType: models.VoteTargetImproveSuggestion,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			expect: []*models.Vote{},
+		},
+		{
+			name:       "Error/DAOFailure",
+			tokenRaw:   "token",
+			targetType: models.VoteTargetImproveSuggestion,
+			ids:        []uuid.UUID{goframework.NumberUUID(10)},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallDAO: true,
+			daoErr:        fooErr,
+			expectErr:     fooErr,
+		},
+		{
+			name:       "Error/TooManyIDs",
+			tokenRaw:   "token",
+			targetType: models.VoteTargetImproveRequest,
+			ids:        make([]uuid.UUID, services.MaxSearchLimit+1),
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:       "Error/InvalidTargetType",
+			tokenRaw:   "token",
+			targetType: "fake target",
+			ids:        []uuid.UUID{goframework.NumberUUID(10)},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:           "Error/NotAuthenticated",
+			tokenRaw:       "token",
+			targetType:     models.VoteTargetImproveRequest,
+			ids:            []uuid.UUID{goframework.NumberUUID(10)},
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:          "Error/AuthClientFailure",
+			tokenRaw:      "token",
+			targetType:    models.VoteTargetImproveRequest,
+			ids:           []uuid.UUID{goframework.NumberUUID(10)},
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewVoteRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallDAO {
+				repository.
+					On("ListUserVotes", context.Background(), dao.VoteTarget(d.targetType), d.authClientResp.Token.Payload.ID, d.ids).
+					Return(d.daoResp, d.daoErr)
+			}
+
+			service := services.NewListUserVotesService(repository, authClient)
+			res, err := service.List(context.Background(), d.tokenRaw, d.targetType, d.ids)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+		})
+	}
+}
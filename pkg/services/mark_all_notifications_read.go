@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/dao"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"time"
+)
+
+type MarkAllNotificationsReadService interface {
+	// MarkAllRead flags every unread notification of the user as read, and returns how many were updated.
+	MarkAllRead(ctx context.Context, tokenRaw string, now time.Time) (int, error)
+}
+
+func NewMarkAllNotificationsReadService(repository dao.NotificationRepository, authClient apiclients.AuthClient) MarkAllNotificationsReadService {
+	return &markAllNotificationsReadServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type markAllNotificationsReadServiceImpl struct {
+	repository dao.NotificationRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *markAllNotificationsReadServiceImpl) MarkAllRead(ctx context.Context, tokenRaw string, now time.Time) (int, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return 0, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return 0, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	updated, err := s.repository.MarkAllRead(ctx, token.Token.Payload.ID, now)
+	if err != nil {
+		return 0, goerrors.Join(ErrMarkAllNotificationsRead, err)
+	}
+
+	return updated, nil
+}
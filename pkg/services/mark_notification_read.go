@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"time"
+)
+
+type MarkNotificationReadService interface {
+	MarkRead(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) (*models.Notification, error)
+}
+
+func NewMarkNotificationReadService(repository dao.NotificationRepository, authClient apiclients.AuthClient) MarkNotificationReadService {
+	return &markNotificationReadServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type markNotificationReadServiceImpl struct {
+	repository dao.NotificationRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *markNotificationReadServiceImpl) MarkRead(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) (*models.Notification, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	// The repository only updates notifications of the given user, so users cannot read each other's inbox.
+	res, err := s.repository.MarkRead(ctx, token.Token.Payload.ID, id, now)
+	if err != nil {
+		return nil, goerrors.Join(ErrMarkNotificationRead, err)
+	}
+
+	return adapters.NotificationToModel(res), nil
+}
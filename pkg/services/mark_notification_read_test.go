@@ -0,0 +1,121 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestMarkNotificationReadService(t *testing.T) {
+	data := []struct {
+		name string
+
+		token string
+		id    uuid.UUID
+		now   time.Time
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallDAO bool
+		daoResp       *dao.NotificationModel
+		daoErr        error
+
+		expect    *models.Notification
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallDAO: true,
+			daoResp: &dao.NotificationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+				UserID:   goframework.NumberUUID(100),
+				EventID:  1,
+				Type:     dao.EventTypeSuggestionValidated,
+				ActorID:  goframework.NumberUUID(200),
+				TargetID: goframework.NumberUUID(20),
+				SourceID: goframework.NumberUUID(10),
+				ReadAt:   &updateTime,
+			},
+			expect: &models.Notification{
+				ID:        goframework.NumberUUID(1),
+				CreatedAt: baseTime,
+				Type:      models.EventTypeSuggestionValidated,
+				ActorID:   goframework.NumberUUID(200),
+				TargetID:  goframework.NumberUUID(20),
+				SourceID:  goframework.NumberUUID(10),
+				ReadAt:    &updateTime,
+			},
+		},
+		{
+			name:  "Error/DAOFailure",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallDAO: true,
+			daoErr:        fooErr,
+			expectErr:     fooErr,
+		},
+		{
+			name:           "Error/NotAuthenticated",
+			token:          "tokenRaw",
+			id:             goframework.NumberUUID(1),
+			now:            updateTime,
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:          "Error/AuthClientFailure",
+			token:         "tokenRaw",
+			id:            goframework.NumberUUID(1),
+			now:           updateTime,
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewNotificationRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.token).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallDAO {
+				repository.
+					On("MarkRead", context.Background(), goframework.NumberUUID(100), d.id, d.now).
+					Return(d.daoResp, d.daoErr)
+			}
+
+			service := services.NewMarkNotificationReadService(repository, authClient)
+			res, err := service.MarkRead(context.Background(), d.token, d.id, d.now)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+		})
+	}
+}
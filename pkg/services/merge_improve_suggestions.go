@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/diff"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"time"
+)
+
+type MergeImproveSuggestionsService interface {
+	// Merge combines the changes of several suggestions into a new revision. If some suggestions conflict, and the
+	// conflicts are not resolved by the form, no revision is created and the conflicts are returned instead.
+	Merge(ctx context.Context, tokenRaw string, form *models.MergeImproveSuggestionsForm, id uuid.UUID, now time.Time) (*models.MergeResult, error)
+}
+
+func NewMergeImproveSuggestionsService(
+	repository dao.ImproveSuggestionRepository,
+	requestRepository dao.ImproveRequestRepository,
+	authClient apiclients.AuthClient,
+	permissionsClient apiclients.PermissionsClient,
+) MergeImproveSuggestionsService {
+	return &mergeImproveSuggestionsServiceImpl{
+		repository:        repository,
+		requestRepository: requestRepository,
+		authClient:        authClient,
+		permissionsClient: permissionsClient,
+	}
+}
+
+type mergeImproveSuggestionsServiceImpl struct {
+	repository        dao.ImproveSuggestionRepository
+	requestRepository dao.ImproveRequestRepository
+	authClient        apiclients.AuthClient
+	permissionsClient apiclients.PermissionsClient
+}
+
+func (s *mergeImproveSuggestionsServiceImpl) Merge(ctx context.Context, tokenRaw string, form *models.MergeImproveSuggestionsForm, id uuid.UUID, now time.Time) (*models.MergeResult, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	if err := s.permissionsClient.HasUserScope(ctx, apiclients.HasUserScopeQuery{
+		UserID: token.Token.Payload.ID,
+		Scope:  apiclients.CanPostImproveRequest,
+	}); err != nil {
+		return nil, goerrors.Join(ErrGetScopes, err)
+	}
+
+	if len(form.SuggestionIDs) == 0 || len(form.SuggestionIDs) > MaxMergedSuggestions {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSuggestions)
+	}
+	if len(lo.Uniq(form.SuggestionIDs)) != len(form.SuggestionIDs) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSuggestions)
+	}
+
+	revision, err := s.requestRepository.GetRevision(ctx, form.RevisionID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, err)
+	}
+
+	request, err := s.requestRepository.Get(ctx, revision.SourceID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequest, err)
+	}
+
+	// Merging suggestions creates a new revision, so only the creator of the request is allowed to do it.
+	if request.UserID != token.Token.Payload.ID {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
+	}
+
+	suggestions, err := s.repository.List(ctx, form.SuggestionIDs)
+	if err != nil {
+		return nil, goerrors.Join(ErrListImproveSuggestions, err)
+	}
+	suggestions = lo.Filter(suggestions, func(item *dao.ImproveSuggestionModel, _ int) bool {
+		return canView(item.Draft, item.UserID, &token.Token.Payload.ID)
+	})
+	if len(suggestions) != len(form.SuggestionIDs) {
+		return nil, goerrors.Join(ErrListImproveSuggestions, bunovel.ErrNotFound)
+	}
+
+	suggestionsByID := lo.KeyBy(suggestions, func(item *dao.ImproveSuggestionModel) uuid.UUID {
+		return item.ID
+	})
+
+	// Keep the order of the form, so changes are applied in the order chosen by the creator.
+	titles := make([]string, len(form.SuggestionIDs))
+	contents := make([]string, len(form.SuggestionIDs))
+	for i, suggestionID := range form.SuggestionIDs {
+		suggestion := suggestionsByID[suggestionID]
+		if suggestion.SourceID != revision.SourceID {
+			return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrSuggestionSourceMismatch)
+		}
+		// Changes are merged on top of the given revision, so they must have been written against it.
+		if suggestion.RequestID != form.RevisionID {
+			return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrSuggestionRevisionMismatch)
+		}
+		if suggestion.Validated {
+			return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrSuggestionAlreadyApplied)
+		}
+
+		titles[i] = suggestion.Title
+		contents[i] = suggestion.Content
+	}
+
+	title, titleConflicts := diff.Merge(revision.Title, titles, mergeResolver(models.MergeFieldTitle, form.Resolutions))
+	content, contentConflicts := diff.Merge(revision.Content, contents, mergeResolver(models.MergeFieldContent, form.Resolutions))
+
+	if len(titleConflicts)+len(contentConflicts) > 0 {
+		conflicts := make([]*models.MergeConflict, 0, len(titleConflicts)+len(contentConflicts))
+		for _, conflict := range titleConflicts {
+			conflicts = append(conflicts, adapters.MergeConflictToModel(models.MergeFieldTitle, conflict, form.SuggestionIDs))
+		}
+		for _, conflict := range contentConflicts {
+			conflicts = append(conflicts, adapters.MergeConflictToModel(models.MergeFieldContent, conflict, form.SuggestionIDs))
+		}
+
+		return &models.MergeResult{Conflicts: conflicts}, nil
+	}
+
+	// Resolutions are free text, so the merged result has to be checked like any other revision.
+	if err := goframework.CheckMinMax(title, MinTitleLength, MaxTitleLength); err != nil {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidTitle, err)
+	}
+	if err := goframework.CheckMinMax(content, MinContentLength, MaxContentLength); err != nil {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidContent, err)
+	}
+	if err := goframework.CheckRegexp(title, titleRegexp); err != nil {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidTitle, err)
+	}
+
+	events := []*dao.EventModelCore{{
+		Type:     dao.EventTypeRequestRevised,
+		UserID:   token.Token.Payload.ID,
+		TargetID: id,
+		SourceID: revision.SourceID,
+	}}
+	for _, suggestionID := range form.SuggestionIDs {
+		events = append(events, &dao.EventModelCore{
+			Type:     dao.EventTypeSuggestionValidated,
+			UserID:   token.Token.Payload.ID,
+			TargetID: suggestionID,
+			SourceID: revision.SourceID,
+		})
+	}
+
+	res, err := s.requestRepository.Create(
+		ctx,
+		&dao.ImproveRequestModelCore{
+			Title:         title,
+			Content:       content,
+			Language:      revision.Language,
+			SuggestionIDs: form.SuggestionIDs,
+		},
+		false, token.Token.Payload.ID, revision.SourceID, id, nil, now, events...,
+	)
+	// A suggestion may have been applied since it was read.
+	if goerrors.Is(err, dao.ErrSuggestionAlreadyApplied) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrSuggestionAlreadyApplied, err)
+	}
+	if err != nil {
+		return nil, goerrors.Join(ErrCreateImproveRequest, err)
+	}
+
+	return &models.MergeResult{Revision: adapters.ImproveRequestPreviewToModel(res)}, nil
+}
+
+// mergeResolver resolves the conflicts of a field, using the resolutions that target the exact same area.
+func mergeResolver(field string, resolutions []*models.MergeResolutionForm) diff.Resolver {
+	return func(conflict diff.Conflict) (string, bool) {
+		for _, resolution := range resolutions {
+			if resolution.Field == field && resolution.Start == conflict.Start && resolution.End == conflict.End {
+				return resolution.Text, true
+			}
+		}
+
+		return "", false
+	}
+}
@@ -0,0 +1,968 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestMergeImproveSuggestionsService(t *testing.T) {
+	data := []struct {
+		name string
+
+		tokenRaw string
+		form     *models.MergeImproveSuggestionsForm
+		id       uuid.UUID
+		now      time.Time
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallPermissionsClient bool
+		permissionsClientErr        error
+
+		shouldCallGetRevision bool
+		getRevisionResp       *dao.ImproveRequestRevisionModel
+		getRevisionErr        error
+
+		shouldCallGetRequest bool
+		getRequestResp       *dao.ImproveRequestPreview
+		getRequestErr        error
+
+		shouldCallList bool
+		listResp       []*dao.ImproveSuggestionModel
+		listErr        error
+
+		shouldCallCreate bool
+		createTitle      string
+		createContent    string
+		createResp       *dao.ImproveRequestPreview
+		createErr        error
+
+		expect    *models.MergeResult
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+				Language: dao.LanguageEnglish,
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallList: true,
+			listResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my title",
+						Content:   "The slow brown fox jumps.",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my new title",
+						Content:   "The quick brown fox leaps.",
+					},
+				},
+			},
+			shouldCallCreate: true,
+			createTitle:      "my new title",
+			createContent:    "The slow brown fox leaps.",
+			createResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my new title",
+				Content:  "The slow brown fox leaps.",
+			},
+			expect: &models.MergeResult{
+				Revision: &models.ImproveRequestPreview{
+					ID:        goframework.NumberUUID(10),
+					CreatedAt: baseTime,
+					UserID:    goframework.NumberUUID(100),
+					Title:     "my new title",
+					Content:   "The slow brown fox leaps.",
+				},
+			},
+		},
+		{
+			name:     "Success/Resolved",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+				Resolutions: []*models.MergeResolutionForm{
+					{Field: models.MergeFieldContent, Start: 4, End: 9, Text: "lazy"},
+				},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallList: true,
+			listResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my title",
+						Content:   "The slow brown fox jumps.",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my title",
+						Content:   "The fast brown fox jumps.",
+					},
+				},
+			},
+			shouldCallCreate: true,
+			createTitle:      "my title",
+			createContent:    "The lazy brown fox jumps.",
+			createResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The lazy brown fox jumps.",
+			},
+			expect: &models.MergeResult{
+				Revision: &models.ImproveRequestPreview{
+					ID:        goframework.NumberUUID(10),
+					CreatedAt: baseTime,
+					UserID:    goframework.NumberUUID(100),
+					Title:     "my title",
+					Content:   "The lazy brown fox jumps.",
+				},
+			},
+		},
+		{
+			name:     "Success/Conflicts",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallList: true,
+			listResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my title",
+						Content:   "The slow brown fox jumps.",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my title",
+						Content:   "The fast brown fox jumps.",
+					},
+				},
+			},
+			expect: &models.MergeResult{
+				Conflicts: []*models.MergeConflict{
+					{
+						Field: models.MergeFieldContent,
+						Start: 4,
+						End:   9,
+						Base:  "quick",
+						Hunks: []*models.MergeHunk{
+							{SuggestionID: goframework.NumberUUID(20), Text: "slow"},
+							{SuggestionID: goframework.NumberUUID(21), Text: "fast"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:     "Error/InvalidResolution",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+				Resolutions: []*models.MergeResolutionForm{
+					{Field: models.MergeFieldTitle, Start: 3, End: 3, Text: "\n"},
+				},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallList: true,
+			listResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my new title",
+						Content:   "The quick brown fox jumps.",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my old title",
+						Content:   "The quick brown fox jumps.",
+					},
+				},
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:     "Error/CreateFailure",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallList: true,
+			listResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my title",
+						Content:   "The slow brown fox jumps.",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my new title",
+						Content:   "The quick brown fox leaps.",
+					},
+				},
+			},
+			shouldCallCreate: true,
+			createTitle:      "my new title",
+			createContent:    "The slow brown fox leaps.",
+			createErr:        fooErr,
+			expectErr:        fooErr,
+		},
+		{
+			name:     "Error/AppliedConcurrently",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallList: true,
+			listResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my title",
+						Content:   "The slow brown fox jumps.",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my new title",
+						Content:   "The quick brown fox leaps.",
+					},
+				},
+			},
+			shouldCallCreate: true,
+			createTitle:      "my new title",
+			createContent:    "The slow brown fox leaps.",
+			createErr:        dao.ErrSuggestionAlreadyApplied,
+			expectErr:        services.ErrSuggestionAlreadyApplied,
+		},
+		{
+			name:     "Error/AlreadyApplied",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallList: true,
+			listResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my title",
+						Content:   "The slow brown fox jumps.",
+					},
+				},
+				{
+					Metadata:  bunovel.NewMetadata(goframework.NumberUUID(21), baseTime, nil),
+					SourceID:  goframework.NumberUUID(10),
+					UserID:    goframework.NumberUUID(200),
+					Validated: true,
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my new title",
+						Content:   "The quick brown fox leaps.",
+					},
+				},
+			},
+			expectErr: services.ErrSuggestionAlreadyApplied,
+		},
+		{
+			name:     "Error/SourceMismatch",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallList: true,
+			listResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my title",
+						Content:   "The slow brown fox jumps.",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime, nil),
+					SourceID: goframework.NumberUUID(11),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my new title",
+						Content:   "The quick brown fox leaps.",
+					},
+				},
+			},
+			expectErr: services.ErrSuggestionSourceMismatch,
+		},
+		{
+			name:     "Error/RevisionMismatch",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallList: true,
+			listResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my title",
+						Content:   "The slow brown fox jumps.",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(3),
+						Title:     "my new title",
+						Content:   "The quick brown fox leaps.",
+					},
+				},
+			},
+			expectErr: services.ErrSuggestionRevisionMismatch,
+		},
+		{
+			name:     "Error/SuggestionNotFound",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallList: true,
+			listResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my title",
+						Content:   "The slow brown fox jumps.",
+					},
+				},
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/DraftSuggestion",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallList: true,
+			listResp: []*dao.ImproveSuggestionModel{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(20), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my title",
+						Content:   "The slow brown fox jumps.",
+					},
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(21), baseTime, nil),
+					SourceID: goframework.NumberUUID(10),
+					UserID:   goframework.NumberUUID(200),
+					Draft:    true,
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "my title",
+						Content:   "The quick brown cat jumps.",
+					},
+				},
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:     "Error/ListFailure",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallList: true,
+			listErr:        fooErr,
+			expectErr:      fooErr,
+		},
+		{
+			name:     "Error/NotTheCreator",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(200),
+			},
+			expectErr: services.ErrNotTheCreator,
+		},
+		{
+			name:     "Error/GetRequestFailure",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				Title:    "my title",
+				Content:  "The quick brown fox jumps.",
+			},
+			shouldCallGetRequest: true,
+			getRequestErr:        fooErr,
+			expectErr:            fooErr,
+		},
+		{
+			name:     "Error/GetRevisionFailure",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionErr:              fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:     "Error/DuplicateSuggestions",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(20)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			expectErr:                   services.ErrInvalidSuggestions,
+		},
+		{
+			name:     "Error/NoSuggestions",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			expectErr:                   services.ErrInvalidSuggestions,
+		},
+		{
+			name:     "Error/PermissionsClientFailure",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientErr:        fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:     "Error/NotAuthenticated",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:     "Error/AuthClientFailure",
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(2),
+			now:      baseTime,
+			form: &models.MergeImproveSuggestionsForm{
+				RevisionID:    goframework.NumberUUID(1),
+				SuggestionIDs: []uuid.UUID{goframework.NumberUUID(20), goframework.NumberUUID(21)},
+			},
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewImproveSuggestionRepository(t)
+			requestRepository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+			permissionsClient := apiclientsmocks.NewPermissionsClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallPermissionsClient {
+				permissionsClient.
+					On("HasUserScope", context.Background(), apiclients.HasUserScopeQuery{
+						UserID: d.authClientResp.Token.Payload.ID,
+						Scope:  apiclients.CanPostImproveRequest,
+					}).
+					Return(d.permissionsClientErr)
+			}
+
+			if d.shouldCallGetRevision {
+				requestRepository.
+					On("GetRevision", context.Background(), d.form.RevisionID).
+					Return(d.getRevisionResp, d.getRevisionErr)
+			}
+
+			if d.shouldCallGetRequest {
+				requestRepository.
+					On("Get", context.Background(), d.getRevisionResp.SourceID).
+					Return(d.getRequestResp, d.getRequestErr)
+			}
+
+			if d.shouldCallList {
+				repository.On("List", context.Background(), d.form.SuggestionIDs).Return(d.listResp, d.listErr)
+			}
+
+			if d.shouldCallCreate {
+				requestRepository.
+					On(
+						"Create",
+						context.Background(),
+						&dao.ImproveRequestModelCore{
+							Title:         d.createTitle,
+							Content:       d.createContent,
+							Language:      d.getRevisionResp.Language,
+							SuggestionIDs: d.form.SuggestionIDs,
+						},
+						false,
+						d.authClientResp.Token.Payload.ID,
+						d.getRevisionResp.SourceID,
+						d.id,
+						(*dao.ReportModel)(nil),
+						d.now,
+						&dao.EventModelCore{
+							Type:     dao.EventTypeRequestRevised,
+							UserID:   d.authClientResp.Token.Payload.ID,
+							TargetID: d.id,
+							SourceID: d.getRevisionResp.SourceID,
+						},
+						&dao.EventModelCore{
+							Type:     dao.EventTypeSuggestionValidated,
+							UserID:   d.authClientResp.Token.Payload.ID,
+							TargetID: d.form.SuggestionIDs[0],
+							SourceID: d.getRevisionResp.SourceID,
+						},
+						&dao.EventModelCore{
+							Type:     dao.EventTypeSuggestionValidated,
+							UserID:   d.authClientResp.Token.Payload.ID,
+							TargetID: d.form.SuggestionIDs[1],
+							SourceID: d.getRevisionResp.SourceID,
+						},
+					).
+					Return(d.createResp, d.createErr)
+			}
+
+			service := services.NewMergeImproveSuggestionsService(repository, requestRepository, authClient, permissionsClient)
+			res, err := service.Merge(context.Background(), d.tokenRaw, d.form, d.id, d.now)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			requestRepository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+			permissionsClient.AssertExpectations(t)
+		})
+	}
+}
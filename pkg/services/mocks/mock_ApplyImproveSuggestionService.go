@@ -0,0 +1,99 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// ApplyImproveSuggestionService is an autogenerated mock type for the ApplyImproveSuggestionService type
+type ApplyImproveSuggestionService struct {
+	mock.Mock
+}
+
+type ApplyImproveSuggestionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ApplyImproveSuggestionService) EXPECT() *ApplyImproveSuggestionService_Expecter {
+	return &ApplyImproveSuggestionService_Expecter{mock: &_m.Mock}
+}
+
+// Apply provides a mock function with given fields: ctx, tokenRaw, suggestionID, id, now
+func (_m *ApplyImproveSuggestionService) Apply(ctx context.Context, tokenRaw string, suggestionID uuid.UUID, id uuid.UUID, now time.Time) (*models.ImproveRequestPreview, error) {
+	ret := _m.Called(ctx, tokenRaw, suggestionID, id, now)
+
+	var r0 *models.ImproveRequestPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestPreview, error)); ok {
+		return rf(ctx, tokenRaw, suggestionID, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, uuid.UUID, time.Time) *models.ImproveRequestPreview); ok {
+		r0 = rf(ctx, tokenRaw, suggestionID, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, tokenRaw, suggestionID, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyImproveSuggestionService_Apply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Apply'
+type ApplyImproveSuggestionService_Apply_Call struct {
+	*mock.Call
+}
+
+// Apply is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - suggestionID uuid.UUID
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *ApplyImproveSuggestionService_Expecter) Apply(ctx interface{}, tokenRaw interface{}, suggestionID interface{}, id interface{}, now interface{}) *ApplyImproveSuggestionService_Apply_Call {
+	return &ApplyImproveSuggestionService_Apply_Call{Call: _e.mock.On("Apply", ctx, tokenRaw, suggestionID, id, now)}
+}
+
+func (_c *ApplyImproveSuggestionService_Apply_Call) Run(run func(ctx context.Context, tokenRaw string, suggestionID uuid.UUID, id uuid.UUID, now time.Time)) *ApplyImproveSuggestionService_Apply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ApplyImproveSuggestionService_Apply_Call) Return(_a0 *models.ImproveRequestPreview, _a1 error) *ApplyImproveSuggestionService_Apply_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApplyImproveSuggestionService_Apply_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestPreview, error)) *ApplyImproveSuggestionService_Apply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewApplyImproveSuggestionService creates a new instance of ApplyImproveSuggestionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewApplyImproveSuggestionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ApplyImproveSuggestionService {
+	mock := &ApplyImproveSuggestionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,91 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// AutoCloseImproveRequestsService is an autogenerated mock type for the AutoCloseImproveRequestsService type
+type AutoCloseImproveRequestsService struct {
+	mock.Mock
+}
+
+type AutoCloseImproveRequestsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AutoCloseImproveRequestsService) EXPECT() *AutoCloseImproveRequestsService_Expecter {
+	return &AutoCloseImproveRequestsService_Expecter{mock: &_m.Mock}
+}
+
+// AutoClose provides a mock function with given fields: ctx, now
+func (_m *AutoCloseImproveRequestsService) AutoClose(ctx context.Context, now time.Time) (int, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AutoCloseImproveRequestsService_AutoClose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoClose'
+type AutoCloseImproveRequestsService_AutoClose_Call struct {
+	*mock.Call
+}
+
+// AutoClose is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *AutoCloseImproveRequestsService_Expecter) AutoClose(ctx interface{}, now interface{}) *AutoCloseImproveRequestsService_AutoClose_Call {
+	return &AutoCloseImproveRequestsService_AutoClose_Call{Call: _e.mock.On("AutoClose", ctx, now)}
+}
+
+func (_c *AutoCloseImproveRequestsService_AutoClose_Call) Run(run func(ctx context.Context, now time.Time)) *AutoCloseImproveRequestsService_AutoClose_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *AutoCloseImproveRequestsService_AutoClose_Call) Return(_a0 int, _a1 error) *AutoCloseImproveRequestsService_AutoClose_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AutoCloseImproveRequestsService_AutoClose_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *AutoCloseImproveRequestsService_AutoClose_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAutoCloseImproveRequestsService creates a new instance of AutoCloseImproveRequestsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAutoCloseImproveRequestsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AutoCloseImproveRequestsService {
+	mock := &AutoCloseImproveRequestsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
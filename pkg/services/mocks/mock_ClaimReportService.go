@@ -0,0 +1,98 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// ClaimReportService is an autogenerated mock type for the ClaimReportService type
+type ClaimReportService struct {
+	mock.Mock
+}
+
+type ClaimReportService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ClaimReportService) EXPECT() *ClaimReportService_Expecter {
+	return &ClaimReportService_Expecter{mock: &_m.Mock}
+}
+
+// Claim provides a mock function with given fields: ctx, tokenRaw, id, now
+func (_m *ClaimReportService) Claim(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) (*models.Report, error) {
+	ret := _m.Called(ctx, tokenRaw, id, now)
+
+	var r0 *models.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) (*models.Report, error)); ok {
+		return rf(ctx, tokenRaw, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) *models.Report); ok {
+		r0 = rf(ctx, tokenRaw, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, tokenRaw, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimReportService_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type ClaimReportService_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *ClaimReportService_Expecter) Claim(ctx interface{}, tokenRaw interface{}, id interface{}, now interface{}) *ClaimReportService_Claim_Call {
+	return &ClaimReportService_Claim_Call{Call: _e.mock.On("Claim", ctx, tokenRaw, id, now)}
+}
+
+func (_c *ClaimReportService_Claim_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time)) *ClaimReportService_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ClaimReportService_Claim_Call) Return(_a0 *models.Report, _a1 error) *ClaimReportService_Claim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimReportService_Claim_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, time.Time) (*models.Report, error)) *ClaimReportService_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewClaimReportService creates a new instance of ClaimReportService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClaimReportService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClaimReportService {
+	mock := &ClaimReportService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
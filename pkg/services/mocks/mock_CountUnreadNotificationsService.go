@@ -0,0 +1,89 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CountUnreadNotificationsService is an autogenerated mock type for the CountUnreadNotificationsService type
+type CountUnreadNotificationsService struct {
+	mock.Mock
+}
+
+type CountUnreadNotificationsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CountUnreadNotificationsService) EXPECT() *CountUnreadNotificationsService_Expecter {
+	return &CountUnreadNotificationsService_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function with given fields: ctx, tokenRaw
+func (_m *CountUnreadNotificationsService) Count(ctx context.Context, tokenRaw string) (int, error) {
+	ret := _m.Called(ctx, tokenRaw)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, tokenRaw)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, tokenRaw)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenRaw)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountUnreadNotificationsService_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type CountUnreadNotificationsService_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+func (_e *CountUnreadNotificationsService_Expecter) Count(ctx interface{}, tokenRaw interface{}) *CountUnreadNotificationsService_Count_Call {
+	return &CountUnreadNotificationsService_Count_Call{Call: _e.mock.On("Count", ctx, tokenRaw)}
+}
+
+func (_c *CountUnreadNotificationsService_Count_Call) Run(run func(ctx context.Context, tokenRaw string)) *CountUnreadNotificationsService_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CountUnreadNotificationsService_Count_Call) Return(_a0 int, _a1 error) *CountUnreadNotificationsService_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CountUnreadNotificationsService_Count_Call) RunAndReturn(run func(context.Context, string) (int, error)) *CountUnreadNotificationsService_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCountUnreadNotificationsService creates a new instance of CountUnreadNotificationsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCountUnreadNotificationsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CountUnreadNotificationsService {
+	mock := &CountUnreadNotificationsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -26,25 +26,25 @@ func (_m *CreateImproveRequestService) EXPECT() *CreateImproveRequestService_Exp
 	return &CreateImproveRequestService_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, tokenRaw, title, content, sourceID, id, now
-func (_m *CreateImproveRequestService) Create(ctx context.Context, tokenRaw string, title string, content string, sourceID uuid.UUID, id uuid.UUID, now time.Time) (*models.ImproveRequestPreview, error) {
-	ret := _m.Called(ctx, tokenRaw, title, content, sourceID, id, now)
+// Create provides a mock function with given fields: ctx, tokenRaw, title, content, language, tags, draft, sourceID, id, now
+func (_m *CreateImproveRequestService) Create(ctx context.Context, tokenRaw string, title string, content string, language string, tags []string, draft bool, sourceID uuid.UUID, id uuid.UUID, now time.Time) (*models.ImproveRequestPreview, error) {
+	ret := _m.Called(ctx, tokenRaw, title, content, language, tags, draft, sourceID, id, now)
 
 	var r0 *models.ImproveRequestPreview
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestPreview, error)); ok {
-		return rf(ctx, tokenRaw, title, content, sourceID, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, []string, bool, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestPreview, error)); ok {
+		return rf(ctx, tokenRaw, title, content, language, tags, draft, sourceID, id, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uuid.UUID, uuid.UUID, time.Time) *models.ImproveRequestPreview); ok {
-		r0 = rf(ctx, tokenRaw, title, content, sourceID, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, []string, bool, uuid.UUID, uuid.UUID, time.Time) *models.ImproveRequestPreview); ok {
+		r0 = rf(ctx, tokenRaw, title, content, language, tags, draft, sourceID, id, now)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.ImproveRequestPreview)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, uuid.UUID, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, tokenRaw, title, content, sourceID, id, now)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, []string, bool, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, tokenRaw, title, content, language, tags, draft, sourceID, id, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,16 +62,19 @@ type CreateImproveRequestService_Create_Call struct {
 //   - tokenRaw string
 //   - title string
 //   - content string
+//   - language string
+//   - tags []string
+//   - draft bool
 //   - sourceID uuid.UUID
 //   - id uuid.UUID
 //   - now time.Time
-func (_e *CreateImproveRequestService_Expecter) Create(ctx interface{}, tokenRaw interface{}, title interface{}, content interface{}, sourceID interface{}, id interface{}, now interface{}) *CreateImproveRequestService_Create_Call {
-	return &CreateImproveRequestService_Create_Call{Call: _e.mock.On("Create", ctx, tokenRaw, title, content, sourceID, id, now)}
+func (_e *CreateImproveRequestService_Expecter) Create(ctx interface{}, tokenRaw interface{}, title interface{}, content interface{}, language interface{}, tags interface{}, draft interface{}, sourceID interface{}, id interface{}, now interface{}) *CreateImproveRequestService_Create_Call {
+	return &CreateImproveRequestService_Create_Call{Call: _e.mock.On("Create", ctx, tokenRaw, title, content, language, tags, draft, sourceID, id, now)}
 }
 
-func (_c *CreateImproveRequestService_Create_Call) Run(run func(ctx context.Context, tokenRaw string, title string, content string, sourceID uuid.UUID, id uuid.UUID, now time.Time)) *CreateImproveRequestService_Create_Call {
+func (_c *CreateImproveRequestService_Create_Call) Run(run func(ctx context.Context, tokenRaw string, title string, content string, language string, tags []string, draft bool, sourceID uuid.UUID, id uuid.UUID, now time.Time)) *CreateImproveRequestService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(uuid.UUID), args[5].(uuid.UUID), args[6].(time.Time))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].([]string), args[6].(bool), args[7].(uuid.UUID), args[8].(uuid.UUID), args[9].(time.Time))
 	})
 	return _c
 }
@@ -81,7 +84,7 @@ func (_c *CreateImproveRequestService_Create_Call) Return(_a0 *models.ImproveReq
 	return _c
 }
 
-func (_c *CreateImproveRequestService_Create_Call) RunAndReturn(run func(context.Context, string, string, string, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestPreview, error)) *CreateImproveRequestService_Create_Call {
+func (_c *CreateImproveRequestService_Create_Call) RunAndReturn(run func(context.Context, string, string, string, string, []string, bool, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestPreview, error)) *CreateImproveRequestService_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -23,13 +25,13 @@ func (_m *DeleteImproveRequestRevisionService) EXPECT() *DeleteImproveRequestRev
 	return &DeleteImproveRequestRevisionService_Expecter{mock: &_m.Mock}
 }
 
-// Delete provides a mock function with given fields: ctx, tokenRaw, id
-func (_m *DeleteImproveRequestRevisionService) Delete(ctx context.Context, tokenRaw string, id uuid.UUID) error {
-	ret := _m.Called(ctx, tokenRaw, id)
+// Delete provides a mock function with given fields: ctx, tokenRaw, id, policy, now
+func (_m *DeleteImproveRequestRevisionService) Delete(ctx context.Context, tokenRaw string, id uuid.UUID, policy string, now time.Time) error {
+	ret := _m.Called(ctx, tokenRaw, id, policy, now)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) error); ok {
-		r0 = rf(ctx, tokenRaw, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, string, time.Time) error); ok {
+		r0 = rf(ctx, tokenRaw, id, policy, now)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -46,13 +48,15 @@ type DeleteImproveRequestRevisionService_Delete_Call struct {
 //   - ctx context.Context
 //   - tokenRaw string
 //   - id uuid.UUID
-func (_e *DeleteImproveRequestRevisionService_Expecter) Delete(ctx interface{}, tokenRaw interface{}, id interface{}) *DeleteImproveRequestRevisionService_Delete_Call {
-	return &DeleteImproveRequestRevisionService_Delete_Call{Call: _e.mock.On("Delete", ctx, tokenRaw, id)}
+//   - policy string
+//   - now time.Time
+func (_e *DeleteImproveRequestRevisionService_Expecter) Delete(ctx interface{}, tokenRaw interface{}, id interface{}, policy interface{}, now interface{}) *DeleteImproveRequestRevisionService_Delete_Call {
+	return &DeleteImproveRequestRevisionService_Delete_Call{Call: _e.mock.On("Delete", ctx, tokenRaw, id, policy, now)}
 }
 
-func (_c *DeleteImproveRequestRevisionService_Delete_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID)) *DeleteImproveRequestRevisionService_Delete_Call {
+func (_c *DeleteImproveRequestRevisionService_Delete_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID, policy string, now time.Time)) *DeleteImproveRequestRevisionService_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(string), args[4].(time.Time))
 	})
 	return _c
 }
@@ -62,7 +66,7 @@ func (_c *DeleteImproveRequestRevisionService_Delete_Call) Return(_a0 error) *De
 	return _c
 }
 
-func (_c *DeleteImproveRequestRevisionService_Delete_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) error) *DeleteImproveRequestRevisionService_Delete_Call {
+func (_c *DeleteImproveRequestRevisionService_Delete_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, string, time.Time) error) *DeleteImproveRequestRevisionService_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
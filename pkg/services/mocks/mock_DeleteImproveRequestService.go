@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -23,13 +25,13 @@ func (_m *DeleteImproveRequestService) EXPECT() *DeleteImproveRequestService_Exp
 	return &DeleteImproveRequestService_Expecter{mock: &_m.Mock}
 }
 
-// Delete provides a mock function with given fields: ctx, tokenRaw, id
-func (_m *DeleteImproveRequestService) Delete(ctx context.Context, tokenRaw string, id uuid.UUID) error {
-	ret := _m.Called(ctx, tokenRaw, id)
+// Delete provides a mock function with given fields: ctx, tokenRaw, id, now
+func (_m *DeleteImproveRequestService) Delete(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) error {
+	ret := _m.Called(ctx, tokenRaw, id, now)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) error); ok {
-		r0 = rf(ctx, tokenRaw, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, tokenRaw, id, now)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -46,13 +48,14 @@ type DeleteImproveRequestService_Delete_Call struct {
 //   - ctx context.Context
 //   - tokenRaw string
 //   - id uuid.UUID
-func (_e *DeleteImproveRequestService_Expecter) Delete(ctx interface{}, tokenRaw interface{}, id interface{}) *DeleteImproveRequestService_Delete_Call {
-	return &DeleteImproveRequestService_Delete_Call{Call: _e.mock.On("Delete", ctx, tokenRaw, id)}
+//   - now time.Time
+func (_e *DeleteImproveRequestService_Expecter) Delete(ctx interface{}, tokenRaw interface{}, id interface{}, now interface{}) *DeleteImproveRequestService_Delete_Call {
+	return &DeleteImproveRequestService_Delete_Call{Call: _e.mock.On("Delete", ctx, tokenRaw, id, now)}
 }
 
-func (_c *DeleteImproveRequestService_Delete_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID)) *DeleteImproveRequestService_Delete_Call {
+func (_c *DeleteImproveRequestService_Delete_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time)) *DeleteImproveRequestService_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(time.Time))
 	})
 	return _c
 }
@@ -62,7 +65,7 @@ func (_c *DeleteImproveRequestService_Delete_Call) Return(_a0 error) *DeleteImpr
 	return _c
 }
 
-func (_c *DeleteImproveRequestService_Delete_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) error) *DeleteImproveRequestService_Delete_Call {
+func (_c *DeleteImproveRequestService_Delete_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, time.Time) error) *DeleteImproveRequestService_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
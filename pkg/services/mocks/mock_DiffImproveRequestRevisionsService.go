@@ -0,0 +1,96 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// DiffImproveRequestRevisionsService is an autogenerated mock type for the DiffImproveRequestRevisionsService type
+type DiffImproveRequestRevisionsService struct {
+	mock.Mock
+}
+
+type DiffImproveRequestRevisionsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DiffImproveRequestRevisionsService) EXPECT() *DiffImproveRequestRevisionsService_Expecter {
+	return &DiffImproveRequestRevisionsService_Expecter{mock: &_m.Mock}
+}
+
+// Diff provides a mock function with given fields: ctx, tokenRaw, fromID, toID
+func (_m *DiffImproveRequestRevisionsService) Diff(ctx context.Context, tokenRaw string, fromID uuid.UUID, toID uuid.UUID) (*models.Diff, error) {
+	ret := _m.Called(ctx, tokenRaw, fromID, toID)
+
+	var r0 *models.Diff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, uuid.UUID) (*models.Diff, error)); ok {
+		return rf(ctx, tokenRaw, fromID, toID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, uuid.UUID) *models.Diff); ok {
+		r0 = rf(ctx, tokenRaw, fromID, toID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Diff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, tokenRaw, fromID, toID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiffImproveRequestRevisionsService_Diff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Diff'
+type DiffImproveRequestRevisionsService_Diff_Call struct {
+	*mock.Call
+}
+
+// Diff is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - fromID uuid.UUID
+//   - toID uuid.UUID
+func (_e *DiffImproveRequestRevisionsService_Expecter) Diff(ctx interface{}, tokenRaw interface{}, fromID interface{}, toID interface{}) *DiffImproveRequestRevisionsService_Diff_Call {
+	return &DiffImproveRequestRevisionsService_Diff_Call{Call: _e.mock.On("Diff", ctx, tokenRaw, fromID, toID)}
+}
+
+func (_c *DiffImproveRequestRevisionsService_Diff_Call) Run(run func(ctx context.Context, tokenRaw string, fromID uuid.UUID, toID uuid.UUID)) *DiffImproveRequestRevisionsService_Diff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DiffImproveRequestRevisionsService_Diff_Call) Return(_a0 *models.Diff, _a1 error) *DiffImproveRequestRevisionsService_Diff_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiffImproveRequestRevisionsService_Diff_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, uuid.UUID) (*models.Diff, error)) *DiffImproveRequestRevisionsService_Diff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDiffImproveRequestRevisionsService creates a new instance of DiffImproveRequestRevisionsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDiffImproveRequestRevisionsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DiffImproveRequestRevisionsService {
+	mock := &DiffImproveRequestRevisionsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// DiffImproveSuggestionService is an autogenerated mock type for the DiffImproveSuggestionService type
+type DiffImproveSuggestionService struct {
+	mock.Mock
+}
+
+type DiffImproveSuggestionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DiffImproveSuggestionService) EXPECT() *DiffImproveSuggestionService_Expecter {
+	return &DiffImproveSuggestionService_Expecter{mock: &_m.Mock}
+}
+
+// Diff provides a mock function with given fields: ctx, tokenRaw, id
+func (_m *DiffImproveSuggestionService) Diff(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.Diff, error) {
+	ret := _m.Called(ctx, tokenRaw, id)
+
+	var r0 *models.Diff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*models.Diff, error)); ok {
+		return rf(ctx, tokenRaw, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *models.Diff); ok {
+		r0 = rf(ctx, tokenRaw, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Diff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, tokenRaw, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiffImproveSuggestionService_Diff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Diff'
+type DiffImproveSuggestionService_Diff_Call struct {
+	*mock.Call
+}
+
+// Diff is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - id uuid.UUID
+func (_e *DiffImproveSuggestionService_Expecter) Diff(ctx interface{}, tokenRaw interface{}, id interface{}) *DiffImproveSuggestionService_Diff_Call {
+	return &DiffImproveSuggestionService_Diff_Call{Call: _e.mock.On("Diff", ctx, tokenRaw, id)}
+}
+
+func (_c *DiffImproveSuggestionService_Diff_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID)) *DiffImproveSuggestionService_Diff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DiffImproveSuggestionService_Diff_Call) Return(_a0 *models.Diff, _a1 error) *DiffImproveSuggestionService_Diff_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiffImproveSuggestionService_Diff_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) (*models.Diff, error)) *DiffImproveSuggestionService_Diff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDiffImproveSuggestionService creates a new instance of DiffImproveSuggestionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDiffImproveSuggestionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DiffImproveSuggestionService {
+	mock := &DiffImproveSuggestionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
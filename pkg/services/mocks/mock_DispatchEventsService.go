@@ -0,0 +1,91 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// DispatchEventsService is an autogenerated mock type for the DispatchEventsService type
+type DispatchEventsService struct {
+	mock.Mock
+}
+
+type DispatchEventsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DispatchEventsService) EXPECT() *DispatchEventsService_Expecter {
+	return &DispatchEventsService_Expecter{mock: &_m.Mock}
+}
+
+// Dispatch provides a mock function with given fields: ctx, now
+func (_m *DispatchEventsService) Dispatch(ctx context.Context, now time.Time) (int, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DispatchEventsService_Dispatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dispatch'
+type DispatchEventsService_Dispatch_Call struct {
+	*mock.Call
+}
+
+// Dispatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *DispatchEventsService_Expecter) Dispatch(ctx interface{}, now interface{}) *DispatchEventsService_Dispatch_Call {
+	return &DispatchEventsService_Dispatch_Call{Call: _e.mock.On("Dispatch", ctx, now)}
+}
+
+func (_c *DispatchEventsService_Dispatch_Call) Run(run func(ctx context.Context, now time.Time)) *DispatchEventsService_Dispatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *DispatchEventsService_Dispatch_Call) Return(_a0 int, _a1 error) *DispatchEventsService_Dispatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DispatchEventsService_Dispatch_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *DispatchEventsService_Dispatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDispatchEventsService creates a new instance of DispatchEventsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDispatchEventsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DispatchEventsService {
+	mock := &DispatchEventsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -24,25 +24,25 @@ func (_m *GetImproveRequestService) EXPECT() *GetImproveRequestService_Expecter
 	return &GetImproveRequestService_Expecter{mock: &_m.Mock}
 }
 
-// Get provides a mock function with given fields: ctx, id
-func (_m *GetImproveRequestService) Get(ctx context.Context, id uuid.UUID) (*models.ImproveRequestPreview, error) {
-	ret := _m.Called(ctx, id)
+// Get provides a mock function with given fields: ctx, tokenRaw, id
+func (_m *GetImproveRequestService) Get(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveRequestPreview, error) {
+	ret := _m.Called(ctx, tokenRaw, id)
 
 	var r0 *models.ImproveRequestPreview
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.ImproveRequestPreview, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*models.ImproveRequestPreview, error)); ok {
+		return rf(ctx, tokenRaw, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.ImproveRequestPreview); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *models.ImproveRequestPreview); ok {
+		r0 = rf(ctx, tokenRaw, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.ImproveRequestPreview)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, tokenRaw, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,14 +57,15 @@ type GetImproveRequestService_Get_Call struct {
 
 // Get is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tokenRaw string
 //   - id uuid.UUID
-func (_e *GetImproveRequestService_Expecter) Get(ctx interface{}, id interface{}) *GetImproveRequestService_Get_Call {
-	return &GetImproveRequestService_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+func (_e *GetImproveRequestService_Expecter) Get(ctx interface{}, tokenRaw interface{}, id interface{}) *GetImproveRequestService_Get_Call {
+	return &GetImproveRequestService_Get_Call{Call: _e.mock.On("Get", ctx, tokenRaw, id)}
 }
 
-func (_c *GetImproveRequestService_Get_Call) Run(run func(ctx context.Context, id uuid.UUID)) *GetImproveRequestService_Get_Call {
+func (_c *GetImproveRequestService_Get_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID)) *GetImproveRequestService_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
 	})
 	return _c
 }
@@ -74,7 +75,7 @@ func (_c *GetImproveRequestService_Get_Call) Return(_a0 *models.ImproveRequestPr
 	return _c
 }
 
-func (_c *GetImproveRequestService_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.ImproveRequestPreview, error)) *GetImproveRequestService_Get_Call {
+func (_c *GetImproveRequestService_Get_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) (*models.ImproveRequestPreview, error)) *GetImproveRequestService_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
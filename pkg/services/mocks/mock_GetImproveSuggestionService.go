@@ -24,25 +24,25 @@ func (_m *GetImproveSuggestionService) EXPECT() *GetImproveSuggestionService_Exp
 	return &GetImproveSuggestionService_Expecter{mock: &_m.Mock}
 }
 
-// Get provides a mock function with given fields: ctx, id
-func (_m *GetImproveSuggestionService) Get(ctx context.Context, id uuid.UUID) (*models.ImproveSuggestion, error) {
-	ret := _m.Called(ctx, id)
+// Get provides a mock function with given fields: ctx, tokenRaw, id
+func (_m *GetImproveSuggestionService) Get(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveSuggestion, error) {
+	ret := _m.Called(ctx, tokenRaw, id)
 
 	var r0 *models.ImproveSuggestion
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.ImproveSuggestion, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*models.ImproveSuggestion, error)); ok {
+		return rf(ctx, tokenRaw, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.ImproveSuggestion); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *models.ImproveSuggestion); ok {
+		r0 = rf(ctx, tokenRaw, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.ImproveSuggestion)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, tokenRaw, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,14 +57,15 @@ type GetImproveSuggestionService_Get_Call struct {
 
 // Get is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tokenRaw string
 //   - id uuid.UUID
-func (_e *GetImproveSuggestionService_Expecter) Get(ctx interface{}, id interface{}) *GetImproveSuggestionService_Get_Call {
-	return &GetImproveSuggestionService_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+func (_e *GetImproveSuggestionService_Expecter) Get(ctx interface{}, tokenRaw interface{}, id interface{}) *GetImproveSuggestionService_Get_Call {
+	return &GetImproveSuggestionService_Get_Call{Call: _e.mock.On("Get", ctx, tokenRaw, id)}
 }
 
-func (_c *GetImproveSuggestionService_Get_Call) Run(run func(ctx context.Context, id uuid.UUID)) *GetImproveSuggestionService_Get_Call {
+func (_c *GetImproveSuggestionService_Get_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID)) *GetImproveSuggestionService_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
 	})
 	return _c
 }
@@ -74,7 +75,7 @@ func (_c *GetImproveSuggestionService_Get_Call) Return(_a0 *models.ImproveSugges
 	return _c
 }
 
-func (_c *GetImproveSuggestionService_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.ImproveSuggestion, error)) *GetImproveSuggestionService_Get_Call {
+func (_c *GetImproveSuggestionService_Get_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) (*models.ImproveSuggestion, error)) *GetImproveSuggestionService_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
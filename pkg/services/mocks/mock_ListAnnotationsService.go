@@ -0,0 +1,92 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ListAnnotationsService is an autogenerated mock type for the ListAnnotationsService type
+type ListAnnotationsService struct {
+	mock.Mock
+}
+
+type ListAnnotationsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ListAnnotationsService) EXPECT() *ListAnnotationsService_Expecter {
+	return &ListAnnotationsService_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, query
+func (_m *ListAnnotationsService) List(ctx context.Context, query models.ListAnnotationsQuery) ([]*models.Annotation, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []*models.Annotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListAnnotationsQuery) ([]*models.Annotation, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListAnnotationsQuery) []*models.Annotation); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Annotation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ListAnnotationsQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAnnotationsService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ListAnnotationsService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query models.ListAnnotationsQuery
+func (_e *ListAnnotationsService_Expecter) List(ctx interface{}, query interface{}) *ListAnnotationsService_List_Call {
+	return &ListAnnotationsService_List_Call{Call: _e.mock.On("List", ctx, query)}
+}
+
+func (_c *ListAnnotationsService_List_Call) Run(run func(ctx context.Context, query models.ListAnnotationsQuery)) *ListAnnotationsService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ListAnnotationsQuery))
+	})
+	return _c
+}
+
+func (_c *ListAnnotationsService_List_Call) Return(_a0 []*models.Annotation, _a1 error) *ListAnnotationsService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListAnnotationsService_List_Call) RunAndReturn(run func(context.Context, models.ListAnnotationsQuery) ([]*models.Annotation, error)) *ListAnnotationsService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewListAnnotationsService creates a new instance of ListAnnotationsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewListAnnotationsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ListAnnotationsService {
+	mock := &ListAnnotationsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ListCommentsService is an autogenerated mock type for the ListCommentsService type
+type ListCommentsService struct {
+	mock.Mock
+}
+
+type ListCommentsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ListCommentsService) EXPECT() *ListCommentsService_Expecter {
+	return &ListCommentsService_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, query
+func (_m *ListCommentsService) List(ctx context.Context, query models.ListCommentsQuery) ([]*models.Comment, int, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []*models.Comment
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListCommentsQuery) ([]*models.Comment, int, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListCommentsQuery) []*models.Comment); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ListCommentsQuery) int); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.ListCommentsQuery) error); ok {
+		r2 = rf(ctx, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListCommentsService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ListCommentsService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query models.ListCommentsQuery
+func (_e *ListCommentsService_Expecter) List(ctx interface{}, query interface{}) *ListCommentsService_List_Call {
+	return &ListCommentsService_List_Call{Call: _e.mock.On("List", ctx, query)}
+}
+
+func (_c *ListCommentsService_List_Call) Run(run func(ctx context.Context, query models.ListCommentsQuery)) *ListCommentsService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ListCommentsQuery))
+	})
+	return _c
+}
+
+func (_c *ListCommentsService_List_Call) Return(_a0 []*models.Comment, _a1 int, _a2 error) *ListCommentsService_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ListCommentsService_List_Call) RunAndReturn(run func(context.Context, models.ListCommentsQuery) ([]*models.Comment, int, error)) *ListCommentsService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewListCommentsService creates a new instance of ListCommentsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewListCommentsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ListCommentsService {
+	mock := &ListCommentsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
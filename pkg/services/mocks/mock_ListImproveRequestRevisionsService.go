@@ -24,25 +24,25 @@ func (_m *ListImproveRequestRevisionsService) EXPECT() *ListImproveRequestRevisi
 	return &ListImproveRequestRevisionsService_Expecter{mock: &_m.Mock}
 }
 
-// List provides a mock function with given fields: ctx, id
-func (_m *ListImproveRequestRevisionsService) List(ctx context.Context, id uuid.UUID) ([]*models.ImproveRequestRevisionPreview, error) {
-	ret := _m.Called(ctx, id)
+// List provides a mock function with given fields: ctx, tokenRaw, id
+func (_m *ListImproveRequestRevisionsService) List(ctx context.Context, tokenRaw string, id uuid.UUID) ([]*models.ImproveRequestRevisionPreview, error) {
+	ret := _m.Called(ctx, tokenRaw, id)
 
 	var r0 []*models.ImproveRequestRevisionPreview
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*models.ImproveRequestRevisionPreview, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) ([]*models.ImproveRequestRevisionPreview, error)); ok {
+		return rf(ctx, tokenRaw, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*models.ImproveRequestRevisionPreview); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) []*models.ImproveRequestRevisionPreview); ok {
+		r0 = rf(ctx, tokenRaw, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.ImproveRequestRevisionPreview)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, tokenRaw, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,14 +57,15 @@ type ListImproveRequestRevisionsService_List_Call struct {
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tokenRaw string
 //   - id uuid.UUID
-func (_e *ListImproveRequestRevisionsService_Expecter) List(ctx interface{}, id interface{}) *ListImproveRequestRevisionsService_List_Call {
-	return &ListImproveRequestRevisionsService_List_Call{Call: _e.mock.On("List", ctx, id)}
+func (_e *ListImproveRequestRevisionsService_Expecter) List(ctx interface{}, tokenRaw interface{}, id interface{}) *ListImproveRequestRevisionsService_List_Call {
+	return &ListImproveRequestRevisionsService_List_Call{Call: _e.mock.On("List", ctx, tokenRaw, id)}
 }
 
-func (_c *ListImproveRequestRevisionsService_List_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ListImproveRequestRevisionsService_List_Call {
+func (_c *ListImproveRequestRevisionsService_List_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID)) *ListImproveRequestRevisionsService_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
 	})
 	return _c
 }
@@ -74,7 +75,7 @@ func (_c *ListImproveRequestRevisionsService_List_Call) Return(_a0 []*models.Imp
 	return _c
 }
 
-func (_c *ListImproveRequestRevisionsService_List_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*models.ImproveRequestRevisionPreview, error)) *ListImproveRequestRevisionsService_List_Call {
+func (_c *ListImproveRequestRevisionsService_List_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) ([]*models.ImproveRequestRevisionPreview, error)) *ListImproveRequestRevisionsService_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
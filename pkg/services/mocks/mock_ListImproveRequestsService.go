@@ -24,25 +24,25 @@ func (_m *ListImproveRequestsService) EXPECT() *ListImproveRequestsService_Expec
 	return &ListImproveRequestsService_Expecter{mock: &_m.Mock}
 }
 
-// List provides a mock function with given fields: ctx, ids
-func (_m *ListImproveRequestsService) List(ctx context.Context, ids []uuid.UUID) ([]*models.ImproveRequestPreview, error) {
-	ret := _m.Called(ctx, ids)
+// List provides a mock function with given fields: ctx, tokenRaw, ids
+func (_m *ListImproveRequestsService) List(ctx context.Context, tokenRaw string, ids []uuid.UUID) ([]*models.ImproveRequestPreview, error) {
+	ret := _m.Called(ctx, tokenRaw, ids)
 
 	var r0 []*models.ImproveRequestPreview
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*models.ImproveRequestPreview, error)); ok {
-		return rf(ctx, ids)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) ([]*models.ImproveRequestPreview, error)); ok {
+		return rf(ctx, tokenRaw, ids)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*models.ImproveRequestPreview); ok {
-		r0 = rf(ctx, ids)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) []*models.ImproveRequestPreview); ok {
+		r0 = rf(ctx, tokenRaw, ids)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.ImproveRequestPreview)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
-		r1 = rf(ctx, ids)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []uuid.UUID) error); ok {
+		r1 = rf(ctx, tokenRaw, ids)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,14 +57,15 @@ type ListImproveRequestsService_List_Call struct {
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tokenRaw string
 //   - ids []uuid.UUID
-func (_e *ListImproveRequestsService_Expecter) List(ctx interface{}, ids interface{}) *ListImproveRequestsService_List_Call {
-	return &ListImproveRequestsService_List_Call{Call: _e.mock.On("List", ctx, ids)}
+func (_e *ListImproveRequestsService_Expecter) List(ctx interface{}, tokenRaw interface{}, ids interface{}) *ListImproveRequestsService_List_Call {
+	return &ListImproveRequestsService_List_Call{Call: _e.mock.On("List", ctx, tokenRaw, ids)}
 }
 
-func (_c *ListImproveRequestsService_List_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *ListImproveRequestsService_List_Call {
+func (_c *ListImproveRequestsService_List_Call) Run(run func(ctx context.Context, tokenRaw string, ids []uuid.UUID)) *ListImproveRequestsService_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]uuid.UUID))
+		run(args[0].(context.Context), args[1].(string), args[2].([]uuid.UUID))
 	})
 	return _c
 }
@@ -74,7 +75,7 @@ func (_c *ListImproveRequestsService_List_Call) Return(_a0 []*models.ImproveRequ
 	return _c
 }
 
-func (_c *ListImproveRequestsService_List_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]*models.ImproveRequestPreview, error)) *ListImproveRequestsService_List_Call {
+func (_c *ListImproveRequestsService_List_Call) RunAndReturn(run func(context.Context, string, []uuid.UUID) ([]*models.ImproveRequestPreview, error)) *ListImproveRequestsService_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
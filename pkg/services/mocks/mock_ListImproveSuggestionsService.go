@@ -24,25 +24,25 @@ func (_m *ListImproveSuggestionsService) EXPECT() *ListImproveSuggestionsService
 	return &ListImproveSuggestionsService_Expecter{mock: &_m.Mock}
 }
 
-// List provides a mock function with given fields: ctx, ids
-func (_m *ListImproveSuggestionsService) List(ctx context.Context, ids []uuid.UUID) ([]*models.ImproveSuggestion, error) {
-	ret := _m.Called(ctx, ids)
+// List provides a mock function with given fields: ctx, tokenRaw, ids
+func (_m *ListImproveSuggestionsService) List(ctx context.Context, tokenRaw string, ids []uuid.UUID) ([]*models.ImproveSuggestion, error) {
+	ret := _m.Called(ctx, tokenRaw, ids)
 
 	var r0 []*models.ImproveSuggestion
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*models.ImproveSuggestion, error)); ok {
-		return rf(ctx, ids)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) ([]*models.ImproveSuggestion, error)); ok {
+		return rf(ctx, tokenRaw, ids)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*models.ImproveSuggestion); ok {
-		r0 = rf(ctx, ids)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) []*models.ImproveSuggestion); ok {
+		r0 = rf(ctx, tokenRaw, ids)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.ImproveSuggestion)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
-		r1 = rf(ctx, ids)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []uuid.UUID) error); ok {
+		r1 = rf(ctx, tokenRaw, ids)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,14 +57,15 @@ type ListImproveSuggestionsService_List_Call struct {
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tokenRaw string
 //   - ids []uuid.UUID
-func (_e *ListImproveSuggestionsService_Expecter) List(ctx interface{}, ids interface{}) *ListImproveSuggestionsService_List_Call {
-	return &ListImproveSuggestionsService_List_Call{Call: _e.mock.On("List", ctx, ids)}
+func (_e *ListImproveSuggestionsService_Expecter) List(ctx interface{}, tokenRaw interface{}, ids interface{}) *ListImproveSuggestionsService_List_Call {
+	return &ListImproveSuggestionsService_List_Call{Call: _e.mock.On("List", ctx, tokenRaw, ids)}
 }
 
-func (_c *ListImproveSuggestionsService_List_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *ListImproveSuggestionsService_List_Call {
+func (_c *ListImproveSuggestionsService_List_Call) Run(run func(ctx context.Context, tokenRaw string, ids []uuid.UUID)) *ListImproveSuggestionsService_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]uuid.UUID))
+		run(args[0].(context.Context), args[1].(string), args[2].([]uuid.UUID))
 	})
 	return _c
 }
@@ -74,7 +75,7 @@ func (_c *ListImproveSuggestionsService_List_Call) Return(_a0 []*models.ImproveS
 	return _c
 }
 
-func (_c *ListImproveSuggestionsService_List_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]*models.ImproveSuggestion, error)) *ListImproveSuggestionsService_List_Call {
+func (_c *ListImproveSuggestionsService_List_Call) RunAndReturn(run func(context.Context, string, []uuid.UUID) ([]*models.ImproveSuggestion, error)) *ListImproveSuggestionsService_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
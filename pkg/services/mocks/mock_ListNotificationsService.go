@@ -0,0 +1,100 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ListNotificationsService is an autogenerated mock type for the ListNotificationsService type
+type ListNotificationsService struct {
+	mock.Mock
+}
+
+type ListNotificationsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ListNotificationsService) EXPECT() *ListNotificationsService_Expecter {
+	return &ListNotificationsService_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, tokenRaw, query
+func (_m *ListNotificationsService) List(ctx context.Context, tokenRaw string, query models.ListNotificationsQuery) ([]*models.Notification, int, error) {
+	ret := _m.Called(ctx, tokenRaw, query)
+
+	var r0 []*models.Notification
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.ListNotificationsQuery) ([]*models.Notification, int, error)); ok {
+		return rf(ctx, tokenRaw, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.ListNotificationsQuery) []*models.Notification); ok {
+		r0 = rf(ctx, tokenRaw, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.ListNotificationsQuery) int); ok {
+		r1 = rf(ctx, tokenRaw, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, models.ListNotificationsQuery) error); ok {
+		r2 = rf(ctx, tokenRaw, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListNotificationsService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ListNotificationsService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - query models.ListNotificationsQuery
+func (_e *ListNotificationsService_Expecter) List(ctx interface{}, tokenRaw interface{}, query interface{}) *ListNotificationsService_List_Call {
+	return &ListNotificationsService_List_Call{Call: _e.mock.On("List", ctx, tokenRaw, query)}
+}
+
+func (_c *ListNotificationsService_List_Call) Run(run func(ctx context.Context, tokenRaw string, query models.ListNotificationsQuery)) *ListNotificationsService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.ListNotificationsQuery))
+	})
+	return _c
+}
+
+func (_c *ListNotificationsService_List_Call) Return(_a0 []*models.Notification, _a1 int, _a2 error) *ListNotificationsService_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ListNotificationsService_List_Call) RunAndReturn(run func(context.Context, string, models.ListNotificationsQuery) ([]*models.Notification, int, error)) *ListNotificationsService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewListNotificationsService creates a new instance of ListNotificationsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewListNotificationsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ListNotificationsService {
+	mock := &ListNotificationsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ListPopularTagsService is an autogenerated mock type for the ListPopularTagsService type
+type ListPopularTagsService struct {
+	mock.Mock
+}
+
+type ListPopularTagsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ListPopularTagsService) EXPECT() *ListPopularTagsService_Expecter {
+	return &ListPopularTagsService_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, limit
+func (_m *ListPopularTagsService) List(ctx context.Context, limit int) ([]*models.Tag, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 []*models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*models.Tag, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*models.Tag); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPopularTagsService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ListPopularTagsService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *ListPopularTagsService_Expecter) List(ctx interface{}, limit interface{}) *ListPopularTagsService_List_Call {
+	return &ListPopularTagsService_List_Call{Call: _e.mock.On("List", ctx, limit)}
+}
+
+func (_c *ListPopularTagsService_List_Call) Run(run func(ctx context.Context, limit int)) *ListPopularTagsService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ListPopularTagsService_List_Call) Return(_a0 []*models.Tag, _a1 error) *ListPopularTagsService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListPopularTagsService_List_Call) RunAndReturn(run func(context.Context, int) ([]*models.Tag, error)) *ListPopularTagsService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewListPopularTagsService creates a new instance of ListPopularTagsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewListPopularTagsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ListPopularTagsService {
+	mock := &ListPopularTagsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
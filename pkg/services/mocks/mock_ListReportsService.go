@@ -0,0 +1,100 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ListReportsService is an autogenerated mock type for the ListReportsService type
+type ListReportsService struct {
+	mock.Mock
+}
+
+type ListReportsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ListReportsService) EXPECT() *ListReportsService_Expecter {
+	return &ListReportsService_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, tokenRaw, query
+func (_m *ListReportsService) List(ctx context.Context, tokenRaw string, query models.ListReportsQuery) ([]*models.Report, int, error) {
+	ret := _m.Called(ctx, tokenRaw, query)
+
+	var r0 []*models.Report
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.ListReportsQuery) ([]*models.Report, int, error)); ok {
+		return rf(ctx, tokenRaw, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.ListReportsQuery) []*models.Report); ok {
+		r0 = rf(ctx, tokenRaw, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.ListReportsQuery) int); ok {
+		r1 = rf(ctx, tokenRaw, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, models.ListReportsQuery) error); ok {
+		r2 = rf(ctx, tokenRaw, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListReportsService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ListReportsService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - query models.ListReportsQuery
+func (_e *ListReportsService_Expecter) List(ctx interface{}, tokenRaw interface{}, query interface{}) *ListReportsService_List_Call {
+	return &ListReportsService_List_Call{Call: _e.mock.On("List", ctx, tokenRaw, query)}
+}
+
+func (_c *ListReportsService_List_Call) Run(run func(ctx context.Context, tokenRaw string, query models.ListReportsQuery)) *ListReportsService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.ListReportsQuery))
+	})
+	return _c
+}
+
+func (_c *ListReportsService_List_Call) Return(_a0 []*models.Report, _a1 int, _a2 error) *ListReportsService_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ListReportsService_List_Call) RunAndReturn(run func(context.Context, string, models.ListReportsQuery) ([]*models.Report, int, error)) *ListReportsService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewListReportsService creates a new instance of ListReportsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewListReportsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ListReportsService {
+	mock := &ListReportsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MarkAllNotificationsReadService is an autogenerated mock type for the MarkAllNotificationsReadService type
+type MarkAllNotificationsReadService struct {
+	mock.Mock
+}
+
+type MarkAllNotificationsReadService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MarkAllNotificationsReadService) EXPECT() *MarkAllNotificationsReadService_Expecter {
+	return &MarkAllNotificationsReadService_Expecter{mock: &_m.Mock}
+}
+
+// MarkAllRead provides a mock function with given fields: ctx, tokenRaw, now
+func (_m *MarkAllNotificationsReadService) MarkAllRead(ctx context.Context, tokenRaw string, now time.Time) (int, error) {
+	ret := _m.Called(ctx, tokenRaw, now)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (int, error)); ok {
+		return rf(ctx, tokenRaw, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) int); ok {
+		r0 = rf(ctx, tokenRaw, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, tokenRaw, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkAllNotificationsReadService_MarkAllRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllRead'
+type MarkAllNotificationsReadService_MarkAllRead_Call struct {
+	*mock.Call
+}
+
+// MarkAllRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - now time.Time
+func (_e *MarkAllNotificationsReadService_Expecter) MarkAllRead(ctx interface{}, tokenRaw interface{}, now interface{}) *MarkAllNotificationsReadService_MarkAllRead_Call {
+	return &MarkAllNotificationsReadService_MarkAllRead_Call{Call: _e.mock.On("MarkAllRead", ctx, tokenRaw, now)}
+}
+
+func (_c *MarkAllNotificationsReadService_MarkAllRead_Call) Run(run func(ctx context.Context, tokenRaw string, now time.Time)) *MarkAllNotificationsReadService_MarkAllRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MarkAllNotificationsReadService_MarkAllRead_Call) Return(_a0 int, _a1 error) *MarkAllNotificationsReadService_MarkAllRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarkAllNotificationsReadService_MarkAllRead_Call) RunAndReturn(run func(context.Context, string, time.Time) (int, error)) *MarkAllNotificationsReadService_MarkAllRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMarkAllNotificationsReadService creates a new instance of MarkAllNotificationsReadService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarkAllNotificationsReadService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarkAllNotificationsReadService {
+	mock := &MarkAllNotificationsReadService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
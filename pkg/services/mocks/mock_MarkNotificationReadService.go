@@ -0,0 +1,98 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MarkNotificationReadService is an autogenerated mock type for the MarkNotificationReadService type
+type MarkNotificationReadService struct {
+	mock.Mock
+}
+
+type MarkNotificationReadService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MarkNotificationReadService) EXPECT() *MarkNotificationReadService_Expecter {
+	return &MarkNotificationReadService_Expecter{mock: &_m.Mock}
+}
+
+// MarkRead provides a mock function with given fields: ctx, tokenRaw, id, now
+func (_m *MarkNotificationReadService) MarkRead(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) (*models.Notification, error) {
+	ret := _m.Called(ctx, tokenRaw, id, now)
+
+	var r0 *models.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) (*models.Notification, error)); ok {
+		return rf(ctx, tokenRaw, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) *models.Notification); ok {
+		r0 = rf(ctx, tokenRaw, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, tokenRaw, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkNotificationReadService_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type MarkNotificationReadService_MarkRead_Call struct {
+	*mock.Call
+}
+
+// MarkRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MarkNotificationReadService_Expecter) MarkRead(ctx interface{}, tokenRaw interface{}, id interface{}, now interface{}) *MarkNotificationReadService_MarkRead_Call {
+	return &MarkNotificationReadService_MarkRead_Call{Call: _e.mock.On("MarkRead", ctx, tokenRaw, id, now)}
+}
+
+func (_c *MarkNotificationReadService_MarkRead_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time)) *MarkNotificationReadService_MarkRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MarkNotificationReadService_MarkRead_Call) Return(_a0 *models.Notification, _a1 error) *MarkNotificationReadService_MarkRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarkNotificationReadService_MarkRead_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, time.Time) (*models.Notification, error)) *MarkNotificationReadService_MarkRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMarkNotificationReadService creates a new instance of MarkNotificationReadService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarkNotificationReadService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarkNotificationReadService {
+	mock := &MarkNotificationReadService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MergeImproveSuggestionsService is an autogenerated mock type for the MergeImproveSuggestionsService type
+type MergeImproveSuggestionsService struct {
+	mock.Mock
+}
+
+type MergeImproveSuggestionsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MergeImproveSuggestionsService) EXPECT() *MergeImproveSuggestionsService_Expecter {
+	return &MergeImproveSuggestionsService_Expecter{mock: &_m.Mock}
+}
+
+// Merge provides a mock function with given fields: ctx, tokenRaw, form, id, now
+func (_m *MergeImproveSuggestionsService) Merge(ctx context.Context, tokenRaw string, form *models.MergeImproveSuggestionsForm, id uuid.UUID, now time.Time) (*models.MergeResult, error) {
+	ret := _m.Called(ctx, tokenRaw, form, id, now)
+
+	var r0 *models.MergeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.MergeImproveSuggestionsForm, uuid.UUID, time.Time) (*models.MergeResult, error)); ok {
+		return rf(ctx, tokenRaw, form, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.MergeImproveSuggestionsForm, uuid.UUID, time.Time) *models.MergeResult); ok {
+		r0 = rf(ctx, tokenRaw, form, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.MergeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.MergeImproveSuggestionsForm, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, tokenRaw, form, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeImproveSuggestionsService_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
+type MergeImproveSuggestionsService_Merge_Call struct {
+	*mock.Call
+}
+
+// Merge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - form *models.MergeImproveSuggestionsForm
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MergeImproveSuggestionsService_Expecter) Merge(ctx interface{}, tokenRaw interface{}, form interface{}, id interface{}, now interface{}) *MergeImproveSuggestionsService_Merge_Call {
+	return &MergeImproveSuggestionsService_Merge_Call{Call: _e.mock.On("Merge", ctx, tokenRaw, form, id, now)}
+}
+
+func (_c *MergeImproveSuggestionsService_Merge_Call) Run(run func(ctx context.Context, tokenRaw string, form *models.MergeImproveSuggestionsForm, id uuid.UUID, now time.Time)) *MergeImproveSuggestionsService_Merge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.MergeImproveSuggestionsForm), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MergeImproveSuggestionsService_Merge_Call) Return(_a0 *models.MergeResult, _a1 error) *MergeImproveSuggestionsService_Merge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MergeImproveSuggestionsService_Merge_Call) RunAndReturn(run func(context.Context, string, *models.MergeImproveSuggestionsForm, uuid.UUID, time.Time) (*models.MergeResult, error)) *MergeImproveSuggestionsService_Merge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMergeImproveSuggestionsService creates a new instance of MergeImproveSuggestionsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMergeImproveSuggestionsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MergeImproveSuggestionsService {
+	mock := &MergeImproveSuggestionsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
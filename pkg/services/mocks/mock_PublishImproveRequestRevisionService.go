@@ -0,0 +1,98 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// PublishImproveRequestRevisionService is an autogenerated mock type for the PublishImproveRequestRevisionService type
+type PublishImproveRequestRevisionService struct {
+	mock.Mock
+}
+
+type PublishImproveRequestRevisionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PublishImproveRequestRevisionService) EXPECT() *PublishImproveRequestRevisionService_Expecter {
+	return &PublishImproveRequestRevisionService_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: ctx, tokenRaw, id, now
+func (_m *PublishImproveRequestRevisionService) Publish(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) (*models.ImproveRequestRevision, error) {
+	ret := _m.Called(ctx, tokenRaw, id, now)
+
+	var r0 *models.ImproveRequestRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) (*models.ImproveRequestRevision, error)); ok {
+		return rf(ctx, tokenRaw, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) *models.ImproveRequestRevision); ok {
+		r0 = rf(ctx, tokenRaw, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, tokenRaw, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublishImproveRequestRevisionService_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type PublishImproveRequestRevisionService_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *PublishImproveRequestRevisionService_Expecter) Publish(ctx interface{}, tokenRaw interface{}, id interface{}, now interface{}) *PublishImproveRequestRevisionService_Publish_Call {
+	return &PublishImproveRequestRevisionService_Publish_Call{Call: _e.mock.On("Publish", ctx, tokenRaw, id, now)}
+}
+
+func (_c *PublishImproveRequestRevisionService_Publish_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time)) *PublishImproveRequestRevisionService_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PublishImproveRequestRevisionService_Publish_Call) Return(_a0 *models.ImproveRequestRevision, _a1 error) *PublishImproveRequestRevisionService_Publish_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PublishImproveRequestRevisionService_Publish_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, time.Time) (*models.ImproveRequestRevision, error)) *PublishImproveRequestRevisionService_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPublishImproveRequestRevisionService creates a new instance of PublishImproveRequestRevisionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPublishImproveRequestRevisionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PublishImproveRequestRevisionService {
+	mock := &PublishImproveRequestRevisionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// PublishImproveSuggestionService is an autogenerated mock type for the PublishImproveSuggestionService type
+type PublishImproveSuggestionService struct {
+	mock.Mock
+}
+
+type PublishImproveSuggestionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PublishImproveSuggestionService) EXPECT() *PublishImproveSuggestionService_Expecter {
+	return &PublishImproveSuggestionService_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: ctx, tokenRaw, id, now
+func (_m *PublishImproveSuggestionService) Publish(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) (*models.ImproveSuggestion, error) {
+	ret := _m.Called(ctx, tokenRaw, id, now)
+
+	var r0 *models.ImproveSuggestion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) (*models.ImproveSuggestion, error)); ok {
+		return rf(ctx, tokenRaw, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) *models.ImproveSuggestion); ok {
+		r0 = rf(ctx, tokenRaw, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveSuggestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, tokenRaw, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublishImproveSuggestionService_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type PublishImproveSuggestionService_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *PublishImproveSuggestionService_Expecter) Publish(ctx interface{}, tokenRaw interface{}, id interface{}, now interface{}) *PublishImproveSuggestionService_Publish_Call {
+	return &PublishImproveSuggestionService_Publish_Call{Call: _e.mock.On("Publish", ctx, tokenRaw, id, now)}
+}
+
+func (_c *PublishImproveSuggestionService_Publish_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time)) *PublishImproveSuggestionService_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PublishImproveSuggestionService_Publish_Call) Return(_a0 *models.ImproveSuggestion, _a1 error) *PublishImproveSuggestionService_Publish_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PublishImproveSuggestionService_Publish_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, time.Time) (*models.ImproveSuggestion, error)) *PublishImproveSuggestionService_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPublishImproveSuggestionService creates a new instance of PublishImproveSuggestionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPublishImproveSuggestionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PublishImproveSuggestionService {
+	mock := &PublishImproveSuggestionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PurgeDeletedService is an autogenerated mock type for the PurgeDeletedService type
+type PurgeDeletedService struct {
+	mock.Mock
+}
+
+type PurgeDeletedService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PurgeDeletedService) EXPECT() *PurgeDeletedService_Expecter {
+	return &PurgeDeletedService_Expecter{mock: &_m.Mock}
+}
+
+// Purge provides a mock function with given fields: ctx, retention, now
+func (_m *PurgeDeletedService) Purge(ctx context.Context, retention time.Duration, now time.Time) (int, error) {
+	ret := _m.Called(ctx, retention, now)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, time.Time) (int, error)); ok {
+		return rf(ctx, retention, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, time.Time) int); ok {
+		r0 = rf(ctx, retention, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration, time.Time) error); ok {
+		r1 = rf(ctx, retention, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeDeletedService_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type PurgeDeletedService_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retention time.Duration
+//   - now time.Time
+func (_e *PurgeDeletedService_Expecter) Purge(ctx interface{}, retention interface{}, now interface{}) *PurgeDeletedService_Purge_Call {
+	return &PurgeDeletedService_Purge_Call{Call: _e.mock.On("Purge", ctx, retention, now)}
+}
+
+func (_c *PurgeDeletedService_Purge_Call) Run(run func(ctx context.Context, retention time.Duration, now time.Time)) *PurgeDeletedService_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PurgeDeletedService_Purge_Call) Return(_a0 int, _a1 error) *PurgeDeletedService_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PurgeDeletedService_Purge_Call) RunAndReturn(run func(context.Context, time.Duration, time.Time) (int, error)) *PurgeDeletedService_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPurgeDeletedService creates a new instance of PurgeDeletedService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPurgeDeletedService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PurgeDeletedService {
+	mock := &PurgeDeletedService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RepairOrphansService is an autogenerated mock type for the RepairOrphansService type
+type RepairOrphansService struct {
+	mock.Mock
+}
+
+type RepairOrphansService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepairOrphansService) EXPECT() *RepairOrphansService_Expecter {
+	return &RepairOrphansService_Expecter{mock: &_m.Mock}
+}
+
+// Repair provides a mock function with given fields: ctx, policy, dryRun, now
+func (_m *RepairOrphansService) Repair(ctx context.Context, policy string, dryRun bool, now time.Time) (*models.Orphans, error) {
+	ret := _m.Called(ctx, policy, dryRun, now)
+
+	var r0 *models.Orphans
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, time.Time) (*models.Orphans, error)); ok {
+		return rf(ctx, policy, dryRun, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, time.Time) *models.Orphans); ok {
+		r0 = rf(ctx, policy, dryRun, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Orphans)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, time.Time) error); ok {
+		r1 = rf(ctx, policy, dryRun, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepairOrphansService_Repair_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Repair'
+type RepairOrphansService_Repair_Call struct {
+	*mock.Call
+}
+
+// Repair is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy string
+//   - dryRun bool
+//   - now time.Time
+func (_e *RepairOrphansService_Expecter) Repair(ctx interface{}, policy interface{}, dryRun interface{}, now interface{}) *RepairOrphansService_Repair_Call {
+	return &RepairOrphansService_Repair_Call{Call: _e.mock.On("Repair", ctx, policy, dryRun, now)}
+}
+
+func (_c *RepairOrphansService_Repair_Call) Run(run func(ctx context.Context, policy string, dryRun bool, now time.Time)) *RepairOrphansService_Repair_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *RepairOrphansService_Repair_Call) Return(_a0 *models.Orphans, _a1 error) *RepairOrphansService_Repair_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepairOrphansService_Repair_Call) RunAndReturn(run func(context.Context, string, bool, time.Time) (*models.Orphans, error)) *RepairOrphansService_Repair_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepairOrphansService creates a new instance of RepairOrphansService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepairOrphansService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepairOrphansService {
+	mock := &RepairOrphansService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
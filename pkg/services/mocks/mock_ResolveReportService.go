@@ -0,0 +1,96 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ResolveReportService is an autogenerated mock type for the ResolveReportService type
+type ResolveReportService struct {
+	mock.Mock
+}
+
+type ResolveReportService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ResolveReportService) EXPECT() *ResolveReportService_Expecter {
+	return &ResolveReportService_Expecter{mock: &_m.Mock}
+}
+
+// Resolve provides a mock function with given fields: ctx, tokenRaw, form, now
+func (_m *ResolveReportService) Resolve(ctx context.Context, tokenRaw string, form *models.ResolveReportForm, now time.Time) (*models.Report, error) {
+	ret := _m.Called(ctx, tokenRaw, form, now)
+
+	var r0 *models.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.ResolveReportForm, time.Time) (*models.Report, error)); ok {
+		return rf(ctx, tokenRaw, form, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.ResolveReportForm, time.Time) *models.Report); ok {
+		r0 = rf(ctx, tokenRaw, form, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.ResolveReportForm, time.Time) error); ok {
+		r1 = rf(ctx, tokenRaw, form, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResolveReportService_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type ResolveReportService_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - form *models.ResolveReportForm
+//   - now time.Time
+func (_e *ResolveReportService_Expecter) Resolve(ctx interface{}, tokenRaw interface{}, form interface{}, now interface{}) *ResolveReportService_Resolve_Call {
+	return &ResolveReportService_Resolve_Call{Call: _e.mock.On("Resolve", ctx, tokenRaw, form, now)}
+}
+
+func (_c *ResolveReportService_Resolve_Call) Run(run func(ctx context.Context, tokenRaw string, form *models.ResolveReportForm, now time.Time)) *ResolveReportService_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.ResolveReportForm), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ResolveReportService_Resolve_Call) Return(_a0 *models.Report, _a1 error) *ResolveReportService_Resolve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResolveReportService_Resolve_Call) RunAndReturn(run func(context.Context, string, *models.ResolveReportForm, time.Time) (*models.Report, error)) *ResolveReportService_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewResolveReportService creates a new instance of ResolveReportService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResolveReportService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResolveReportService {
+	mock := &ResolveReportService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RestoreImproveRequestRevisionService is an autogenerated mock type for the RestoreImproveRequestRevisionService type
+type RestoreImproveRequestRevisionService struct {
+	mock.Mock
+}
+
+type RestoreImproveRequestRevisionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RestoreImproveRequestRevisionService) EXPECT() *RestoreImproveRequestRevisionService_Expecter {
+	return &RestoreImproveRequestRevisionService_Expecter{mock: &_m.Mock}
+}
+
+// Restore provides a mock function with given fields: ctx, tokenRaw, id
+func (_m *RestoreImproveRequestRevisionService) Restore(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveRequestRevision, error) {
+	ret := _m.Called(ctx, tokenRaw, id)
+
+	var r0 *models.ImproveRequestRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*models.ImproveRequestRevision, error)); ok {
+		return rf(ctx, tokenRaw, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *models.ImproveRequestRevision); ok {
+		r0 = rf(ctx, tokenRaw, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, tokenRaw, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreImproveRequestRevisionService_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type RestoreImproveRequestRevisionService_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - id uuid.UUID
+func (_e *RestoreImproveRequestRevisionService_Expecter) Restore(ctx interface{}, tokenRaw interface{}, id interface{}) *RestoreImproveRequestRevisionService_Restore_Call {
+	return &RestoreImproveRequestRevisionService_Restore_Call{Call: _e.mock.On("Restore", ctx, tokenRaw, id)}
+}
+
+func (_c *RestoreImproveRequestRevisionService_Restore_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID)) *RestoreImproveRequestRevisionService_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RestoreImproveRequestRevisionService_Restore_Call) Return(_a0 *models.ImproveRequestRevision, _a1 error) *RestoreImproveRequestRevisionService_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RestoreImproveRequestRevisionService_Restore_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) (*models.ImproveRequestRevision, error)) *RestoreImproveRequestRevisionService_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRestoreImproveRequestRevisionService creates a new instance of RestoreImproveRequestRevisionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRestoreImproveRequestRevisionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RestoreImproveRequestRevisionService {
+	mock := &RestoreImproveRequestRevisionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
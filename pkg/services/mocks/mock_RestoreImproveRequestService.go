@@ -0,0 +1,95 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RestoreImproveRequestService is an autogenerated mock type for the RestoreImproveRequestService type
+type RestoreImproveRequestService struct {
+	mock.Mock
+}
+
+type RestoreImproveRequestService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RestoreImproveRequestService) EXPECT() *RestoreImproveRequestService_Expecter {
+	return &RestoreImproveRequestService_Expecter{mock: &_m.Mock}
+}
+
+// Restore provides a mock function with given fields: ctx, tokenRaw, id
+func (_m *RestoreImproveRequestService) Restore(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveRequestPreview, error) {
+	ret := _m.Called(ctx, tokenRaw, id)
+
+	var r0 *models.ImproveRequestPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*models.ImproveRequestPreview, error)); ok {
+		return rf(ctx, tokenRaw, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *models.ImproveRequestPreview); ok {
+		r0 = rf(ctx, tokenRaw, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, tokenRaw, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreImproveRequestService_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type RestoreImproveRequestService_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - id uuid.UUID
+func (_e *RestoreImproveRequestService_Expecter) Restore(ctx interface{}, tokenRaw interface{}, id interface{}) *RestoreImproveRequestService_Restore_Call {
+	return &RestoreImproveRequestService_Restore_Call{Call: _e.mock.On("Restore", ctx, tokenRaw, id)}
+}
+
+func (_c *RestoreImproveRequestService_Restore_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID)) *RestoreImproveRequestService_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RestoreImproveRequestService_Restore_Call) Return(_a0 *models.ImproveRequestPreview, _a1 error) *RestoreImproveRequestService_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RestoreImproveRequestService_Restore_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) (*models.ImproveRequestPreview, error)) *RestoreImproveRequestService_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRestoreImproveRequestService creates a new instance of RestoreImproveRequestService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRestoreImproveRequestService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RestoreImproveRequestService {
+	mock := &RestoreImproveRequestService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RestoreImproveSuggestionService is an autogenerated mock type for the RestoreImproveSuggestionService type
+type RestoreImproveSuggestionService struct {
+	mock.Mock
+}
+
+type RestoreImproveSuggestionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RestoreImproveSuggestionService) EXPECT() *RestoreImproveSuggestionService_Expecter {
+	return &RestoreImproveSuggestionService_Expecter{mock: &_m.Mock}
+}
+
+// Restore provides a mock function with given fields: ctx, tokenRaw, id
+func (_m *RestoreImproveSuggestionService) Restore(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveSuggestion, error) {
+	ret := _m.Called(ctx, tokenRaw, id)
+
+	var r0 *models.ImproveSuggestion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*models.ImproveSuggestion, error)); ok {
+		return rf(ctx, tokenRaw, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *models.ImproveSuggestion); ok {
+		r0 = rf(ctx, tokenRaw, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveSuggestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, tokenRaw, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreImproveSuggestionService_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type RestoreImproveSuggestionService_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - id uuid.UUID
+func (_e *RestoreImproveSuggestionService_Expecter) Restore(ctx interface{}, tokenRaw interface{}, id interface{}) *RestoreImproveSuggestionService_Restore_Call {
+	return &RestoreImproveSuggestionService_Restore_Call{Call: _e.mock.On("Restore", ctx, tokenRaw, id)}
+}
+
+func (_c *RestoreImproveSuggestionService_Restore_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID)) *RestoreImproveSuggestionService_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RestoreImproveSuggestionService_Restore_Call) Return(_a0 *models.ImproveSuggestion, _a1 error) *RestoreImproveSuggestionService_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RestoreImproveSuggestionService_Restore_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) (*models.ImproveSuggestion, error)) *RestoreImproveSuggestionService_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRestoreImproveSuggestionService creates a new instance of RestoreImproveSuggestionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRestoreImproveSuggestionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RestoreImproveSuggestionService {
+	mock := &RestoreImproveSuggestionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
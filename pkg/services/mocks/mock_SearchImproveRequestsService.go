@@ -22,37 +22,30 @@ func (_m *SearchImproveRequestsService) EXPECT() *SearchImproveRequestsService_E
 	return &SearchImproveRequestsService_Expecter{mock: &_m.Mock}
 }
 
-// Search provides a mock function with given fields: ctx, query
-func (_m *SearchImproveRequestsService) Search(ctx context.Context, query models.SearchImproveRequestsQuery) ([]*models.ImproveRequestPreview, int, error) {
-	ret := _m.Called(ctx, query)
-
-	var r0 []*models.ImproveRequestPreview
-	var r1 int
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.SearchImproveRequestsQuery) ([]*models.ImproveRequestPreview, int, error)); ok {
-		return rf(ctx, query)
+// Search provides a mock function with given fields: ctx, tokenRaw, query
+func (_m *SearchImproveRequestsService) Search(ctx context.Context, tokenRaw string, query models.SearchImproveRequestsQuery) (*models.SearchImproveRequestsResult, error) {
+	ret := _m.Called(ctx, tokenRaw, query)
+
+	var r0 *models.SearchImproveRequestsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.SearchImproveRequestsQuery) (*models.SearchImproveRequestsResult, error)); ok {
+		return rf(ctx, tokenRaw, query)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.SearchImproveRequestsQuery) []*models.ImproveRequestPreview); ok {
-		r0 = rf(ctx, query)
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.SearchImproveRequestsQuery) *models.SearchImproveRequestsResult); ok {
+		r0 = rf(ctx, tokenRaw, query)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.ImproveRequestPreview)
+			r0 = ret.Get(0).(*models.SearchImproveRequestsResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.SearchImproveRequestsQuery) int); ok {
-		r1 = rf(ctx, query)
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.SearchImproveRequestsQuery) error); ok {
+		r1 = rf(ctx, tokenRaw, query)
 	} else {
-		r1 = ret.Get(1).(int)
+		r1 = ret.Error(1)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, models.SearchImproveRequestsQuery) error); ok {
-		r2 = rf(ctx, query)
-	} else {
-		r2 = ret.Error(2)
-	}
-
-	return r0, r1, r2
+	return r0, r1
 }
 
 // SearchImproveRequestsService_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
@@ -62,24 +55,25 @@ type SearchImproveRequestsService_Search_Call struct {
 
 // Search is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tokenRaw string
 //   - query models.SearchImproveRequestsQuery
-func (_e *SearchImproveRequestsService_Expecter) Search(ctx interface{}, query interface{}) *SearchImproveRequestsService_Search_Call {
-	return &SearchImproveRequestsService_Search_Call{Call: _e.mock.On("Search", ctx, query)}
+func (_e *SearchImproveRequestsService_Expecter) Search(ctx interface{}, tokenRaw interface{}, query interface{}) *SearchImproveRequestsService_Search_Call {
+	return &SearchImproveRequestsService_Search_Call{Call: _e.mock.On("Search", ctx, tokenRaw, query)}
 }
 
-func (_c *SearchImproveRequestsService_Search_Call) Run(run func(ctx context.Context, query models.SearchImproveRequestsQuery)) *SearchImproveRequestsService_Search_Call {
+func (_c *SearchImproveRequestsService_Search_Call) Run(run func(ctx context.Context, tokenRaw string, query models.SearchImproveRequestsQuery)) *SearchImproveRequestsService_Search_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.SearchImproveRequestsQuery))
+		run(args[0].(context.Context), args[1].(string), args[2].(models.SearchImproveRequestsQuery))
 	})
 	return _c
 }
 
-func (_c *SearchImproveRequestsService_Search_Call) Return(_a0 []*models.ImproveRequestPreview, _a1 int, _a2 error) *SearchImproveRequestsService_Search_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *SearchImproveRequestsService_Search_Call) Return(_a0 *models.SearchImproveRequestsResult, _a1 error) *SearchImproveRequestsService_Search_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *SearchImproveRequestsService_Search_Call) RunAndReturn(run func(context.Context, models.SearchImproveRequestsQuery) ([]*models.ImproveRequestPreview, int, error)) *SearchImproveRequestsService_Search_Call {
+func (_c *SearchImproveRequestsService_Search_Call) RunAndReturn(run func(context.Context, string, models.SearchImproveRequestsQuery) (*models.SearchImproveRequestsResult, error)) *SearchImproveRequestsService_Search_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -22,37 +22,30 @@ func (_m *SearchImproveSuggestionsService) EXPECT() *SearchImproveSuggestionsSer
 	return &SearchImproveSuggestionsService_Expecter{mock: &_m.Mock}
 }
 
-// Search provides a mock function with given fields: ctx, query
-func (_m *SearchImproveSuggestionsService) Search(ctx context.Context, query models.SearchImproveSuggestionsQuery) ([]*models.ImproveSuggestion, int, error) {
-	ret := _m.Called(ctx, query)
-
-	var r0 []*models.ImproveSuggestion
-	var r1 int
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.SearchImproveSuggestionsQuery) ([]*models.ImproveSuggestion, int, error)); ok {
-		return rf(ctx, query)
+// Search provides a mock function with given fields: ctx, tokenRaw, query
+func (_m *SearchImproveSuggestionsService) Search(ctx context.Context, tokenRaw string, query models.SearchImproveSuggestionsQuery) (*models.SearchImproveSuggestionsResult, error) {
+	ret := _m.Called(ctx, tokenRaw, query)
+
+	var r0 *models.SearchImproveSuggestionsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.SearchImproveSuggestionsQuery) (*models.SearchImproveSuggestionsResult, error)); ok {
+		return rf(ctx, tokenRaw, query)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.SearchImproveSuggestionsQuery) []*models.ImproveSuggestion); ok {
-		r0 = rf(ctx, query)
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.SearchImproveSuggestionsQuery) *models.SearchImproveSuggestionsResult); ok {
+		r0 = rf(ctx, tokenRaw, query)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.ImproveSuggestion)
+			r0 = ret.Get(0).(*models.SearchImproveSuggestionsResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.SearchImproveSuggestionsQuery) int); ok {
-		r1 = rf(ctx, query)
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.SearchImproveSuggestionsQuery) error); ok {
+		r1 = rf(ctx, tokenRaw, query)
 	} else {
-		r1 = ret.Get(1).(int)
+		r1 = ret.Error(1)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, models.SearchImproveSuggestionsQuery) error); ok {
-		r2 = rf(ctx, query)
-	} else {
-		r2 = ret.Error(2)
-	}
-
-	return r0, r1, r2
+	return r0, r1
 }
 
 // SearchImproveSuggestionsService_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
@@ -62,24 +55,25 @@ type SearchImproveSuggestionsService_Search_Call struct {
 
 // Search is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tokenRaw string
 //   - query models.SearchImproveSuggestionsQuery
-func (_e *SearchImproveSuggestionsService_Expecter) Search(ctx interface{}, query interface{}) *SearchImproveSuggestionsService_Search_Call {
-	return &SearchImproveSuggestionsService_Search_Call{Call: _e.mock.On("Search", ctx, query)}
+func (_e *SearchImproveSuggestionsService_Expecter) Search(ctx interface{}, tokenRaw interface{}, query interface{}) *SearchImproveSuggestionsService_Search_Call {
+	return &SearchImproveSuggestionsService_Search_Call{Call: _e.mock.On("Search", ctx, tokenRaw, query)}
 }
 
-func (_c *SearchImproveSuggestionsService_Search_Call) Run(run func(ctx context.Context, query models.SearchImproveSuggestionsQuery)) *SearchImproveSuggestionsService_Search_Call {
+func (_c *SearchImproveSuggestionsService_Search_Call) Run(run func(ctx context.Context, tokenRaw string, query models.SearchImproveSuggestionsQuery)) *SearchImproveSuggestionsService_Search_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.SearchImproveSuggestionsQuery))
+		run(args[0].(context.Context), args[1].(string), args[2].(models.SearchImproveSuggestionsQuery))
 	})
 	return _c
 }
 
-func (_c *SearchImproveSuggestionsService_Search_Call) Return(_a0 []*models.ImproveSuggestion, _a1 int, _a2 error) *SearchImproveSuggestionsService_Search_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *SearchImproveSuggestionsService_Search_Call) Return(_a0 *models.SearchImproveSuggestionsResult, _a1 error) *SearchImproveSuggestionsService_Search_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *SearchImproveSuggestionsService_Search_Call) RunAndReturn(run func(context.Context, models.SearchImproveSuggestionsQuery) ([]*models.ImproveSuggestion, int, error)) *SearchImproveSuggestionsService_Search_Call {
+func (_c *SearchImproveSuggestionsService_Search_Call) RunAndReturn(run func(context.Context, string, models.SearchImproveSuggestionsQuery) (*models.SearchImproveSuggestionsResult, error)) *SearchImproveSuggestionsService_Search_Call {
 	_c.Call.Return(run)
 	return _c
 }
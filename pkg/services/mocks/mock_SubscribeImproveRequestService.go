@@ -0,0 +1,85 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// SubscribeImproveRequestService is an autogenerated mock type for the SubscribeImproveRequestService type
+type SubscribeImproveRequestService struct {
+	mock.Mock
+}
+
+type SubscribeImproveRequestService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SubscribeImproveRequestService) EXPECT() *SubscribeImproveRequestService_Expecter {
+	return &SubscribeImproveRequestService_Expecter{mock: &_m.Mock}
+}
+
+// Subscribe provides a mock function with given fields: ctx, tokenRaw, id, now
+func (_m *SubscribeImproveRequestService) Subscribe(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) error {
+	ret := _m.Called(ctx, tokenRaw, id, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, tokenRaw, id, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubscribeImproveRequestService_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type SubscribeImproveRequestService_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *SubscribeImproveRequestService_Expecter) Subscribe(ctx interface{}, tokenRaw interface{}, id interface{}, now interface{}) *SubscribeImproveRequestService_Subscribe_Call {
+	return &SubscribeImproveRequestService_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, tokenRaw, id, now)}
+}
+
+func (_c *SubscribeImproveRequestService_Subscribe_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time)) *SubscribeImproveRequestService_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *SubscribeImproveRequestService_Subscribe_Call) Return(_a0 error) *SubscribeImproveRequestService_Subscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscribeImproveRequestService_Subscribe_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, time.Time) error) *SubscribeImproveRequestService_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSubscribeImproveRequestService creates a new instance of SubscribeImproveRequestService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubscribeImproveRequestService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SubscribeImproveRequestService {
+	mock := &SubscribeImproveRequestService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,82 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// UnsubscribeImproveRequestService is an autogenerated mock type for the UnsubscribeImproveRequestService type
+type UnsubscribeImproveRequestService struct {
+	mock.Mock
+}
+
+type UnsubscribeImproveRequestService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UnsubscribeImproveRequestService) EXPECT() *UnsubscribeImproveRequestService_Expecter {
+	return &UnsubscribeImproveRequestService_Expecter{mock: &_m.Mock}
+}
+
+// Unsubscribe provides a mock function with given fields: ctx, tokenRaw, id
+func (_m *UnsubscribeImproveRequestService) Unsubscribe(ctx context.Context, tokenRaw string, id uuid.UUID) error {
+	ret := _m.Called(ctx, tokenRaw, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) error); ok {
+		r0 = rf(ctx, tokenRaw, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnsubscribeImproveRequestService_Unsubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unsubscribe'
+type UnsubscribeImproveRequestService_Unsubscribe_Call struct {
+	*mock.Call
+}
+
+// Unsubscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - id uuid.UUID
+func (_e *UnsubscribeImproveRequestService_Expecter) Unsubscribe(ctx interface{}, tokenRaw interface{}, id interface{}) *UnsubscribeImproveRequestService_Unsubscribe_Call {
+	return &UnsubscribeImproveRequestService_Unsubscribe_Call{Call: _e.mock.On("Unsubscribe", ctx, tokenRaw, id)}
+}
+
+func (_c *UnsubscribeImproveRequestService_Unsubscribe_Call) Run(run func(ctx context.Context, tokenRaw string, id uuid.UUID)) *UnsubscribeImproveRequestService_Unsubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UnsubscribeImproveRequestService_Unsubscribe_Call) Return(_a0 error) *UnsubscribeImproveRequestService_Unsubscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnsubscribeImproveRequestService_Unsubscribe_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) error) *UnsubscribeImproveRequestService_Unsubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUnsubscribeImproveRequestService creates a new instance of UnsubscribeImproveRequestService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUnsubscribeImproveRequestService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UnsubscribeImproveRequestService {
+	mock := &UnsubscribeImproveRequestService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
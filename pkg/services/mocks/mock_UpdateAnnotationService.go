@@ -0,0 +1,96 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// UpdateAnnotationService is an autogenerated mock type for the UpdateAnnotationService type
+type UpdateAnnotationService struct {
+	mock.Mock
+}
+
+type UpdateAnnotationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UpdateAnnotationService) EXPECT() *UpdateAnnotationService_Expecter {
+	return &UpdateAnnotationService_Expecter{mock: &_m.Mock}
+}
+
+// Update provides a mock function with given fields: ctx, tokenRaw, form, now
+func (_m *UpdateAnnotationService) Update(ctx context.Context, tokenRaw string, form *models.UpdateAnnotationForm, now time.Time) (*models.Annotation, error) {
+	ret := _m.Called(ctx, tokenRaw, form, now)
+
+	var r0 *models.Annotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.UpdateAnnotationForm, time.Time) (*models.Annotation, error)); ok {
+		return rf(ctx, tokenRaw, form, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.UpdateAnnotationForm, time.Time) *models.Annotation); ok {
+		r0 = rf(ctx, tokenRaw, form, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Annotation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.UpdateAnnotationForm, time.Time) error); ok {
+		r1 = rf(ctx, tokenRaw, form, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAnnotationService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type UpdateAnnotationService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - form *models.UpdateAnnotationForm
+//   - now time.Time
+func (_e *UpdateAnnotationService_Expecter) Update(ctx interface{}, tokenRaw interface{}, form interface{}, now interface{}) *UpdateAnnotationService_Update_Call {
+	return &UpdateAnnotationService_Update_Call{Call: _e.mock.On("Update", ctx, tokenRaw, form, now)}
+}
+
+func (_c *UpdateAnnotationService_Update_Call) Run(run func(ctx context.Context, tokenRaw string, form *models.UpdateAnnotationForm, now time.Time)) *UpdateAnnotationService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.UpdateAnnotationForm), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *UpdateAnnotationService_Update_Call) Return(_a0 *models.Annotation, _a1 error) *UpdateAnnotationService_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UpdateAnnotationService_Update_Call) RunAndReturn(run func(context.Context, string, *models.UpdateAnnotationForm, time.Time) (*models.Annotation, error)) *UpdateAnnotationService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUpdateAnnotationService creates a new instance of UpdateAnnotationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUpdateAnnotationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UpdateAnnotationService {
+	mock := &UpdateAnnotationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,93 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UpdateClosePolicyService is an autogenerated mock type for the UpdateClosePolicyService type
+type UpdateClosePolicyService struct {
+	mock.Mock
+}
+
+type UpdateClosePolicyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UpdateClosePolicyService) EXPECT() *UpdateClosePolicyService_Expecter {
+	return &UpdateClosePolicyService_Expecter{mock: &_m.Mock}
+}
+
+// Update provides a mock function with given fields: ctx, tokenRaw, form
+func (_m *UpdateClosePolicyService) Update(ctx context.Context, tokenRaw string, form *models.UpdateClosePolicyForm) (*models.ImproveRequestPreview, error) {
+	ret := _m.Called(ctx, tokenRaw, form)
+
+	var r0 *models.ImproveRequestPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.UpdateClosePolicyForm) (*models.ImproveRequestPreview, error)); ok {
+		return rf(ctx, tokenRaw, form)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.UpdateClosePolicyForm) *models.ImproveRequestPreview); ok {
+		r0 = rf(ctx, tokenRaw, form)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.UpdateClosePolicyForm) error); ok {
+		r1 = rf(ctx, tokenRaw, form)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateClosePolicyService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type UpdateClosePolicyService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - form *models.UpdateClosePolicyForm
+func (_e *UpdateClosePolicyService_Expecter) Update(ctx interface{}, tokenRaw interface{}, form interface{}) *UpdateClosePolicyService_Update_Call {
+	return &UpdateClosePolicyService_Update_Call{Call: _e.mock.On("Update", ctx, tokenRaw, form)}
+}
+
+func (_c *UpdateClosePolicyService_Update_Call) Run(run func(ctx context.Context, tokenRaw string, form *models.UpdateClosePolicyForm)) *UpdateClosePolicyService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.UpdateClosePolicyForm))
+	})
+	return _c
+}
+
+func (_c *UpdateClosePolicyService_Update_Call) Return(_a0 *models.ImproveRequestPreview, _a1 error) *UpdateClosePolicyService_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UpdateClosePolicyService_Update_Call) RunAndReturn(run func(context.Context, string, *models.UpdateClosePolicyForm) (*models.ImproveRequestPreview, error)) *UpdateClosePolicyService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUpdateClosePolicyService creates a new instance of UpdateClosePolicyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUpdateClosePolicyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UpdateClosePolicyService {
+	mock := &UpdateClosePolicyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// UpdateCommentService is an autogenerated mock type for the UpdateCommentService type
+type UpdateCommentService struct {
+	mock.Mock
+}
+
+type UpdateCommentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UpdateCommentService) EXPECT() *UpdateCommentService_Expecter {
+	return &UpdateCommentService_Expecter{mock: &_m.Mock}
+}
+
+// Update provides a mock function with given fields: ctx, tokenRaw, form, now
+func (_m *UpdateCommentService) Update(ctx context.Context, tokenRaw string, form *models.UpdateCommentForm, now time.Time) (*models.Comment, error) {
+	ret := _m.Called(ctx, tokenRaw, form, now)
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.UpdateCommentForm, time.Time) (*models.Comment, error)); ok {
+		return rf(ctx, tokenRaw, form, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.UpdateCommentForm, time.Time) *models.Comment); ok {
+		r0 = rf(ctx, tokenRaw, form, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.UpdateCommentForm, time.Time) error); ok {
+		r1 = rf(ctx, tokenRaw, form, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCommentService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type UpdateCommentService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - form *models.UpdateCommentForm
+//   - now time.Time
+func (_e *UpdateCommentService_Expecter) Update(ctx interface{}, tokenRaw interface{}, form interface{}, now interface{}) *UpdateCommentService_Update_Call {
+	return &UpdateCommentService_Update_Call{Call: _e.mock.On("Update", ctx, tokenRaw, form, now)}
+}
+
+func (_c *UpdateCommentService_Update_Call) Run(run func(ctx context.Context, tokenRaw string, form *models.UpdateCommentForm, now time.Time)) *UpdateCommentService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.UpdateCommentForm), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *UpdateCommentService_Update_Call) Return(_a0 *models.Comment, _a1 error) *UpdateCommentService_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UpdateCommentService_Update_Call) RunAndReturn(run func(context.Context, string, *models.UpdateCommentForm, time.Time) (*models.Comment, error)) *UpdateCommentService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUpdateCommentService creates a new instance of UpdateCommentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUpdateCommentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UpdateCommentService {
+	mock := &UpdateCommentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
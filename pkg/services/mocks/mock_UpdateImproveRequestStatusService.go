@@ -0,0 +1,96 @@
+// Code generated by mockery v2.33.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/a-novel/forum-service/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// UpdateImproveRequestStatusService is an autogenerated mock type for the UpdateImproveRequestStatusService type
+type UpdateImproveRequestStatusService struct {
+	mock.Mock
+}
+
+type UpdateImproveRequestStatusService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UpdateImproveRequestStatusService) EXPECT() *UpdateImproveRequestStatusService_Expecter {
+	return &UpdateImproveRequestStatusService_Expecter{mock: &_m.Mock}
+}
+
+// Update provides a mock function with given fields: ctx, tokenRaw, form, now
+func (_m *UpdateImproveRequestStatusService) Update(ctx context.Context, tokenRaw string, form *models.UpdateImproveRequestStatusForm, now time.Time) (*models.ImproveRequestPreview, error) {
+	ret := _m.Called(ctx, tokenRaw, form, now)
+
+	var r0 *models.ImproveRequestPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.UpdateImproveRequestStatusForm, time.Time) (*models.ImproveRequestPreview, error)); ok {
+		return rf(ctx, tokenRaw, form, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.UpdateImproveRequestStatusForm, time.Time) *models.ImproveRequestPreview); ok {
+		r0 = rf(ctx, tokenRaw, form, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.UpdateImproveRequestStatusForm, time.Time) error); ok {
+		r1 = rf(ctx, tokenRaw, form, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateImproveRequestStatusService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type UpdateImproveRequestStatusService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenRaw string
+//   - form *models.UpdateImproveRequestStatusForm
+//   - now time.Time
+func (_e *UpdateImproveRequestStatusService_Expecter) Update(ctx interface{}, tokenRaw interface{}, form interface{}, now interface{}) *UpdateImproveRequestStatusService_Update_Call {
+	return &UpdateImproveRequestStatusService_Update_Call{Call: _e.mock.On("Update", ctx, tokenRaw, form, now)}
+}
+
+func (_c *UpdateImproveRequestStatusService_Update_Call) Run(run func(ctx context.Context, tokenRaw string, form *models.UpdateImproveRequestStatusForm, now time.Time)) *UpdateImproveRequestStatusService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.UpdateImproveRequestStatusForm), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *UpdateImproveRequestStatusService_Update_Call) Return(_a0 *models.ImproveRequestPreview, _a1 error) *UpdateImproveRequestStatusService_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UpdateImproveRequestStatusService_Update_Call) RunAndReturn(run func(context.Context, string, *models.UpdateImproveRequestStatusForm, time.Time) (*models.ImproveRequestPreview, error)) *UpdateImproveRequestStatusService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUpdateImproveRequestStatusService creates a new instance of UpdateImproveRequestStatusService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUpdateImproveRequestStatusService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UpdateImproveRequestStatusService {
+	mock := &UpdateImproveRequestStatusService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
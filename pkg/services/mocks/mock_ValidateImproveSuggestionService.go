@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -23,13 +25,13 @@ func (_m *ValidateImproveSuggestionService) EXPECT() *ValidateImproveSuggestionS
 	return &ValidateImproveSuggestionService_Expecter{mock: &_m.Mock}
 }
 
-// Validate provides a mock function with given fields: ctx, tokenRaw, validated, id
-func (_m *ValidateImproveSuggestionService) Validate(ctx context.Context, tokenRaw string, validated bool, id uuid.UUID) error {
-	ret := _m.Called(ctx, tokenRaw, validated, id)
+// Validate provides a mock function with given fields: ctx, tokenRaw, validated, id, now
+func (_m *ValidateImproveSuggestionService) Validate(ctx context.Context, tokenRaw string, validated bool, id uuid.UUID, now time.Time) error {
+	ret := _m.Called(ctx, tokenRaw, validated, id, now)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, bool, uuid.UUID) error); ok {
-		r0 = rf(ctx, tokenRaw, validated, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, tokenRaw, validated, id, now)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -47,13 +49,14 @@ type ValidateImproveSuggestionService_Validate_Call struct {
 //   - tokenRaw string
 //   - validated bool
 //   - id uuid.UUID
-func (_e *ValidateImproveSuggestionService_Expecter) Validate(ctx interface{}, tokenRaw interface{}, validated interface{}, id interface{}) *ValidateImproveSuggestionService_Validate_Call {
-	return &ValidateImproveSuggestionService_Validate_Call{Call: _e.mock.On("Validate", ctx, tokenRaw, validated, id)}
+//   - now time.Time
+func (_e *ValidateImproveSuggestionService_Expecter) Validate(ctx interface{}, tokenRaw interface{}, validated interface{}, id interface{}, now interface{}) *ValidateImproveSuggestionService_Validate_Call {
+	return &ValidateImproveSuggestionService_Validate_Call{Call: _e.mock.On("Validate", ctx, tokenRaw, validated, id, now)}
 }
 
-func (_c *ValidateImproveSuggestionService_Validate_Call) Run(run func(ctx context.Context, tokenRaw string, validated bool, id uuid.UUID)) *ValidateImproveSuggestionService_Validate_Call {
+func (_c *ValidateImproveSuggestionService_Validate_Call) Run(run func(ctx context.Context, tokenRaw string, validated bool, id uuid.UUID, now time.Time)) *ValidateImproveSuggestionService_Validate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(bool), args[3].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(string), args[2].(bool), args[3].(uuid.UUID), args[4].(time.Time))
 	})
 	return _c
 }
@@ -63,7 +66,7 @@ func (_c *ValidateImproveSuggestionService_Validate_Call) Return(_a0 error) *Val
 	return _c
 }
 
-func (_c *ValidateImproveSuggestionService_Validate_Call) RunAndReturn(run func(context.Context, string, bool, uuid.UUID) error) *ValidateImproveSuggestionService_Validate_Call {
+func (_c *ValidateImproveSuggestionService_Validate_Call) RunAndReturn(run func(context.Context, string, bool, uuid.UUID, time.Time) error) *ValidateImproveSuggestionService_Validate_Call {
 	_c.Call.Return(run)
 	return _c
 }
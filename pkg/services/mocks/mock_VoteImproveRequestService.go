@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -23,13 +25,13 @@ func (_m *VoteImproveRequestService) EXPECT() *VoteImproveRequestService_Expecte
 	return &VoteImproveRequestService_Expecter{mock: &_m.Mock}
 }
 
-// Vote provides a mock function with given fields: ctx, id, userID, upVotes, downVotes
-func (_m *VoteImproveRequestService) Vote(ctx context.Context, id uuid.UUID, userID uuid.UUID, upVotes int, downVotes int) error {
-	ret := _m.Called(ctx, id, userID, upVotes, downVotes)
+// Vote provides a mock function with given fields: ctx, id, userID, vote, now
+func (_m *VoteImproveRequestService) Vote(ctx context.Context, id uuid.UUID, userID uuid.UUID, vote int, now time.Time) error {
+	ret := _m.Called(ctx, id, userID, vote, now)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int, int) error); ok {
-		r0 = rf(ctx, id, userID, upVotes, downVotes)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int, time.Time) error); ok {
+		r0 = rf(ctx, id, userID, vote, now)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -46,15 +48,15 @@ type VoteImproveRequestService_Vote_Call struct {
 //   - ctx context.Context
 //   - id uuid.UUID
 //   - userID uuid.UUID
-//   - upVotes int
-//   - downVotes int
-func (_e *VoteImproveRequestService_Expecter) Vote(ctx interface{}, id interface{}, userID interface{}, upVotes interface{}, downVotes interface{}) *VoteImproveRequestService_Vote_Call {
-	return &VoteImproveRequestService_Vote_Call{Call: _e.mock.On("Vote", ctx, id, userID, upVotes, downVotes)}
+//   - vote int
+//   - now time.Time
+func (_e *VoteImproveRequestService_Expecter) Vote(ctx interface{}, id interface{}, userID interface{}, vote interface{}, now interface{}) *VoteImproveRequestService_Vote_Call {
+	return &VoteImproveRequestService_Vote_Call{Call: _e.mock.On("Vote", ctx, id, userID, vote, now)}
 }
 
-func (_c *VoteImproveRequestService_Vote_Call) Run(run func(ctx context.Context, id uuid.UUID, userID uuid.UUID, upVotes int, downVotes int)) *VoteImproveRequestService_Vote_Call {
+func (_c *VoteImproveRequestService_Vote_Call) Run(run func(ctx context.Context, id uuid.UUID, userID uuid.UUID, vote int, now time.Time)) *VoteImproveRequestService_Vote_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(int), args[4].(int))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(int), args[4].(time.Time))
 	})
 	return _c
 }
@@ -64,7 +66,7 @@ func (_c *VoteImproveRequestService_Vote_Call) Return(_a0 error) *VoteImproveReq
 	return _c
 }
 
-func (_c *VoteImproveRequestService_Vote_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, int, int) error) *VoteImproveRequestService_Vote_Call {
+func (_c *VoteImproveRequestService_Vote_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, int, time.Time) error) *VoteImproveRequestService_Vote_Call {
 	_c.Call.Return(run)
 	return _c
 }
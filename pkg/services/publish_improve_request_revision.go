@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"time"
+)
+
+type PublishImproveRequestRevisionService interface {
+	// Publish makes a draft revision visible to everyone. Only its author is allowed to publish it.
+	Publish(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) (*models.ImproveRequestRevision, error)
+}
+
+func NewPublishImproveRequestRevisionService(repository dao.ImproveRequestRepository, authClient apiclients.AuthClient) PublishImproveRequestRevisionService {
+	return &publishImproveRequestRevisionServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type publishImproveRequestRevisionServiceImpl struct {
+	repository dao.ImproveRequestRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *publishImproveRequestRevisionServiceImpl) Publish(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) (*models.ImproveRequestRevision, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	revision, err := s.repository.GetRevision(ctx, id)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, err)
+	}
+	if revision.UserID != token.Token.Payload.ID {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
+	}
+	if !revision.Draft {
+		return nil, ErrNotDraft
+	}
+
+	request, err := s.repository.Get(ctx, revision.SourceID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequest, err)
+	}
+
+	event := &dao.EventModelCore{
+		Type:     dao.EventTypeRequestRevised,
+		UserID:   token.Token.Payload.ID,
+		TargetID: id,
+		SourceID: revision.SourceID,
+	}
+	// A request that was never published is new for everyone else.
+	if request.Draft {
+		event.Type = dao.EventTypeRequestCreated
+	}
+
+	published, err := s.repository.Publish(ctx, id, now, event)
+	if err != nil {
+		return nil, goerrors.Join(ErrPublishImproveRequestRevision, err)
+	}
+
+	return adapters.ImproveRequestRevisionToModel(published), nil
+}
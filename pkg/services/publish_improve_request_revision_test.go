@@ -0,0 +1,269 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestPublishImproveRequestRevisionService(t *testing.T) {
+	data := []struct {
+		name string
+
+		token string
+		id    uuid.UUID
+		now   time.Time
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallGetRevision bool
+		getRevisionResp       *dao.ImproveRequestRevisionModel
+		getRevisionErr        error
+
+		shouldCallGetRequest bool
+		getRequestResp       *dao.ImproveRequestPreview
+		getRequestErr        error
+
+		shouldCallPublish bool
+		publishEventType  dao.EventType
+		publishResp       *dao.ImproveRequestRevisionModel
+		publishErr        error
+
+		expect    *models.ImproveRequestRevision
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGetRevision: true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(10),
+				Draft:    true,
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallPublish: true,
+			publishEventType:  dao.EventTypeRequestRevised,
+			publishResp: &dao.ImproveRequestRevisionModel{
+				Metadata:    bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:      goframework.NumberUUID(100),
+				SourceID:    goframework.NumberUUID(10),
+				Title:       "title",
+				Content:     "content",
+				PublishedAt: &updateTime,
+			},
+			expect: &models.ImproveRequestRevision{
+				ID:          goframework.NumberUUID(1),
+				CreatedAt:   baseTime,
+				UserID:      goframework.NumberUUID(100),
+				SourceID:    goframework.NumberUUID(10),
+				Title:       "title",
+				Content:     "content",
+				PublishedAt: &updateTime,
+			},
+		},
+		{
+			name:  "Success/FirstRevision",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGetRevision: true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(10),
+				Draft:    true,
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+				Draft:  true,
+			},
+			shouldCallPublish: true,
+			// The request is announced when its first revision is published.
+			publishEventType: dao.EventTypeRequestCreated,
+			publishResp: &dao.ImproveRequestRevisionModel{
+				Metadata:    bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:      goframework.NumberUUID(100),
+				SourceID:    goframework.NumberUUID(10),
+				Title:       "title",
+				Content:     "content",
+				PublishedAt: &updateTime,
+			},
+			expect: &models.ImproveRequestRevision{
+				ID:          goframework.NumberUUID(1),
+				CreatedAt:   baseTime,
+				UserID:      goframework.NumberUUID(100),
+				SourceID:    goframework.NumberUUID(10),
+				Title:       "title",
+				Content:     "content",
+				PublishedAt: &updateTime,
+			},
+		},
+		{
+			name:  "Error/PublishFailure",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGetRevision: true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(10),
+				Draft:    true,
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallPublish: true,
+			publishEventType:  dao.EventTypeRequestRevised,
+			publishErr:        fooErr,
+			expectErr:         fooErr,
+		},
+		{
+			name:  "Error/GetRequestFailure",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGetRevision: true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(10),
+				Draft:    true,
+			},
+			shouldCallGetRequest: true,
+			getRequestErr:        fooErr,
+			expectErr:            fooErr,
+		},
+		{
+			name:  "Error/NotDraft",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGetRevision: true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(10),
+			},
+			expectErr: services.ErrNotDraft,
+		},
+		{
+			name:  "Error/NotTheCreator",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGetRevision: true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				UserID:   goframework.NumberUUID(101),
+				SourceID: goframework.NumberUUID(10),
+				Draft:    true,
+			},
+			expectErr: services.ErrNotTheCreator,
+		},
+		{
+			name:  "Error/GetRevisionFailure",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGetRevision: true,
+			getRevisionErr:        bunovel.ErrNotFound,
+			expectErr:             bunovel.ErrNotFound,
+		},
+		{
+			name:           "Error/NotAuthenticated",
+			token:          "tokenRaw",
+			id:             goframework.NumberUUID(1),
+			now:            updateTime,
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:          "Error/AuthClientFailure",
+			token:         "tokenRaw",
+			id:            goframework.NumberUUID(1),
+			now:           updateTime,
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.token).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallGetRevision {
+				repository.On("GetRevision", context.Background(), d.id).Return(d.getRevisionResp, d.getRevisionErr)
+			}
+
+			if d.shouldCallGetRequest {
+				repository.On("Get", context.Background(), d.getRevisionResp.SourceID).Return(d.getRequestResp, d.getRequestErr)
+			}
+
+			if d.shouldCallPublish {
+				repository.
+					On("Publish", context.Background(), d.id, d.now, &dao.EventModelCore{
+						Type:     d.publishEventType,
+						UserID:   d.authClientResp.Token.Payload.ID,
+						TargetID: d.id,
+						SourceID: d.getRevisionResp.SourceID,
+					}).
+					Return(d.publishResp, d.publishErr)
+			}
+
+			service := services.NewPublishImproveRequestRevisionService(repository, authClient)
+			res, err := service.Publish(context.Background(), d.token, d.id, d.now)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"time"
+)
+
+type PublishImproveSuggestionService interface {
+	// Publish makes a draft suggestion visible to everyone. Only its author is allowed to publish it, while the
+	// improvement request is open.
+	Publish(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) (*models.ImproveSuggestion, error)
+}
+
+func NewPublishImproveSuggestionService(
+	repository dao.ImproveSuggestionRepository,
+	requestRepository dao.ImproveRequestRepository,
+	authClient apiclients.AuthClient,
+) PublishImproveSuggestionService {
+	return &publishImproveSuggestionServiceImpl{
+		repository:        repository,
+		requestRepository: requestRepository,
+		authClient:        authClient,
+	}
+}
+
+type publishImproveSuggestionServiceImpl struct {
+	repository        dao.ImproveSuggestionRepository
+	requestRepository dao.ImproveRequestRepository
+	authClient        apiclients.AuthClient
+}
+
+func (s *publishImproveSuggestionServiceImpl) Publish(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) (*models.ImproveSuggestion, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	suggestion, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveSuggestion, err)
+	}
+	if suggestion.UserID != token.Token.Payload.ID {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
+	}
+	if !suggestion.Draft {
+		return nil, ErrNotDraft
+	}
+
+	request, err := s.requestRepository.Get(ctx, suggestion.SourceID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequest, err)
+	}
+	if request.Status != dao.RequestStatusOpen {
+		return nil, ErrRequestNotOpen
+	}
+
+	published, err := s.repository.Publish(ctx, id, now, &dao.EventModelCore{
+		Type:     dao.EventTypeSuggestionCreated,
+		UserID:   token.Token.Payload.ID,
+		TargetID: id,
+		SourceID: suggestion.SourceID,
+	})
+	if err != nil {
+		return nil, goerrors.Join(ErrPublishImproveSuggestion, err)
+	}
+
+	return adapters.ImproveSuggestionToModel(published), nil
+}
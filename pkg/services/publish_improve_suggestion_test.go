@@ -0,0 +1,246 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestPublishImproveSuggestionService(t *testing.T) {
+	data := []struct {
+		name string
+
+		token string
+		id    uuid.UUID
+		now   time.Time
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallGet bool
+		getResp       *dao.ImproveSuggestionModel
+		getErr        error
+
+		shouldCallGetRequest bool
+		getRequestResp       *dao.ImproveRequestPreview
+		getRequestErr        error
+
+		shouldCallPublish bool
+		publishResp       *dao.ImproveSuggestionModel
+		publishErr        error
+
+		expect    *models.ImproveSuggestion
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp: &dao.ImproveSuggestionModel{
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(10),
+				Draft:    true,
+			},
+			shouldCallGetRequest: true,
+			getRequestResp:       &dao.ImproveRequestPreview{Status: dao.RequestStatusOpen},
+			shouldCallPublish:    true,
+			publishResp: &dao.ImproveSuggestionModel{
+				Metadata:    bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:      goframework.NumberUUID(100),
+				SourceID:    goframework.NumberUUID(10),
+				PublishedAt: &updateTime,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(2),
+					Title:     "title",
+					Content:   "content",
+				},
+			},
+			expect: &models.ImproveSuggestion{
+				ID:          goframework.NumberUUID(1),
+				CreatedAt:   baseTime,
+				UserID:      goframework.NumberUUID(100),
+				SourceID:    goframework.NumberUUID(10),
+				PublishedAt: &updateTime,
+				RequestID:   goframework.NumberUUID(2),
+				Title:       "title",
+				Content:     "content",
+			},
+		},
+		{
+			name:  "Error/PublishFailure",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp: &dao.ImproveSuggestionModel{
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(10),
+				Draft:    true,
+			},
+			shouldCallGetRequest: true,
+			getRequestResp:       &dao.ImproveRequestPreview{Status: dao.RequestStatusOpen},
+			shouldCallPublish:    true,
+			publishErr:           fooErr,
+			expectErr:            fooErr,
+		},
+		{
+			name:  "Error/RequestNotOpen",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp: &dao.ImproveSuggestionModel{
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(10),
+				Draft:    true,
+			},
+			shouldCallGetRequest: true,
+			getRequestResp:       &dao.ImproveRequestPreview{Status: dao.RequestStatusClosed},
+			expectErr:            services.ErrRequestNotOpen,
+		},
+		{
+			name:  "Error/GetRequestFailure",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp: &dao.ImproveSuggestionModel{
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(10),
+				Draft:    true,
+			},
+			shouldCallGetRequest: true,
+			getRequestErr:        fooErr,
+			expectErr:            fooErr,
+		},
+		{
+			name:  "Error/NotDraft",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp: &dao.ImproveSuggestionModel{
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(10),
+			},
+			expectErr: services.ErrNotDraft,
+		},
+		{
+			name:  "Error/NotTheCreator",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp: &dao.ImproveSuggestionModel{
+				UserID:   goframework.NumberUUID(101),
+				SourceID: goframework.NumberUUID(10),
+				Draft:    true,
+			},
+			expectErr: services.ErrNotTheCreator,
+		},
+		{
+			name:  "Error/GetFailure",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			now:   updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getErr:        bunovel.ErrNotFound,
+			expectErr:     bunovel.ErrNotFound,
+		},
+		{
+			name:           "Error/NotAuthenticated",
+			token:          "tokenRaw",
+			id:             goframework.NumberUUID(1),
+			now:            updateTime,
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:          "Error/AuthClientFailure",
+			token:         "tokenRaw",
+			id:            goframework.NumberUUID(1),
+			now:           updateTime,
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewImproveSuggestionRepository(t)
+			requestRepository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.token).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallGet {
+				repository.On("Get", context.Background(), d.id).Return(d.getResp, d.getErr)
+			}
+
+			if d.shouldCallGetRequest {
+				requestRepository.On("Get", context.Background(), d.getResp.SourceID).Return(d.getRequestResp, d.getRequestErr)
+			}
+
+			if d.shouldCallPublish {
+				repository.
+					On("Publish", context.Background(), d.id, d.now, &dao.EventModelCore{
+						Type:     dao.EventTypeSuggestionCreated,
+						UserID:   d.authClientResp.Token.Payload.ID,
+						TargetID: d.id,
+						SourceID: d.getResp.SourceID,
+					}).
+					Return(d.publishResp, d.publishErr)
+			}
+
+			service := services.NewPublishImproveSuggestionService(repository, requestRepository, authClient)
+			res, err := service.Publish(context.Background(), d.token, d.id, d.now)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			requestRepository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/dao"
+	goframework "github.com/a-novel/go-framework"
+	"time"
+)
+
+type PurgeDeletedService interface {
+	// Purge permanently deletes the improvement requests, revisions and suggestions that have been soft deleted for
+	// longer than the retention period. It returns the number of purged rows.
+	Purge(ctx context.Context, retention time.Duration, now time.Time) (int, error)
+}
+
+func NewPurgeDeletedService(
+	improveRequestRepository dao.ImproveRequestRepository,
+	improveSuggestionRepository dao.ImproveSuggestionRepository,
+) PurgeDeletedService {
+	return &purgeDeletedServiceImpl{
+		improveRequestRepository:    improveRequestRepository,
+		improveSuggestionRepository: improveSuggestionRepository,
+	}
+}
+
+type purgeDeletedServiceImpl struct {
+	improveRequestRepository    dao.ImproveRequestRepository
+	improveSuggestionRepository dao.ImproveSuggestionRepository
+}
+
+func (s *purgeDeletedServiceImpl) Purge(ctx context.Context, retention time.Duration, now time.Time) (int, error) {
+	if retention <= 0 {
+		return 0, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidRetention)
+	}
+
+	before := now.Add(-retention)
+
+	suggestions, err := s.improveSuggestionRepository.Purge(ctx, before)
+	if err != nil {
+		return 0, goerrors.Join(ErrPurgeImproveSuggestions, err)
+	}
+
+	requests, err := s.improveRequestRepository.Purge(ctx, before)
+	if err != nil {
+		return suggestions, goerrors.Join(ErrPurgeImproveRequests, err)
+	}
+
+	return suggestions + requests, nil
+}
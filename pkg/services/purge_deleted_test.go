@@ -0,0 +1,90 @@
+package services_test
+
+import (
+	"context"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/services"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestPurgeDeletedService(t *testing.T) {
+	data := []struct {
+		name string
+
+		retention time.Duration
+
+		shouldCallPurgeSuggestions bool
+		purgeSuggestionsResp       int
+		purgeSuggestionsErr        error
+
+		shouldCallPurgeRequests bool
+		purgeRequestsResp       int
+		purgeRequestsErr        error
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name:                       "Success",
+			retention:                  time.Hour,
+			shouldCallPurgeSuggestions: true,
+			purgeSuggestionsResp:       2,
+			shouldCallPurgeRequests:    true,
+			purgeRequestsResp:          3,
+			expect:                     5,
+		},
+		{
+			name:                       "Error/PurgeRequestsFailure",
+			retention:                  time.Hour,
+			shouldCallPurgeSuggestions: true,
+			purgeSuggestionsResp:       2,
+			shouldCallPurgeRequests:    true,
+			purgeRequestsErr:           fooErr,
+			expect:                     2,
+			expectErr:                  fooErr,
+		},
+		{
+			name:                       "Error/PurgeSuggestionsFailure",
+			retention:                  time.Hour,
+			shouldCallPurgeSuggestions: true,
+			purgeSuggestionsErr:        fooErr,
+			expectErr:                  fooErr,
+		},
+		{
+			name:      "Error/InvalidRetention",
+			retention: 0,
+			expectErr: goframework.ErrInvalidEntity,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestRepository := daomocks.NewImproveRequestRepository(t)
+			improveSuggestionRepository := daomocks.NewImproveSuggestionRepository(t)
+
+			if d.shouldCallPurgeSuggestions {
+				improveSuggestionRepository.
+					On("Purge", context.Background(), updateTime.Add(-d.retention)).
+					Return(d.purgeSuggestionsResp, d.purgeSuggestionsErr)
+			}
+
+			if d.shouldCallPurgeRequests {
+				improveRequestRepository.
+					On("Purge", context.Background(), updateTime.Add(-d.retention)).
+					Return(d.purgeRequestsResp, d.purgeRequestsErr)
+			}
+
+			service := services.NewPurgeDeletedService(improveRequestRepository, improveSuggestionRepository)
+			res, err := service.Purge(context.Background(), d.retention, updateTime)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			improveRequestRepository.AssertExpectations(t)
+			improveSuggestionRepository.AssertExpectations(t)
+		})
+	}
+}
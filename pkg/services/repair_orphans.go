@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	goframework "github.com/a-novel/go-framework"
+	"time"
+)
+
+type RepairOrphansService interface {
+	// Repair fixes the revisions and suggestions that point to a missing or deleted parent, then validates the
+	// foreign keys of the improvement tables. Suggestions that lost their revision are handled according to the
+	// policy. When dryRun is set, the orphans are only counted.
+	Repair(ctx context.Context, policy string, dryRun bool, now time.Time) (*models.Orphans, error)
+}
+
+func NewRepairOrphansService(repository dao.MaintenanceRepository) RepairOrphansService {
+	return &repairOrphansServiceImpl{
+		repository: repository,
+	}
+}
+
+type repairOrphansServiceImpl struct {
+	repository dao.MaintenanceRepository
+}
+
+func (s *repairOrphansServiceImpl) Repair(ctx context.Context, policy string, dryRun bool, now time.Time) (*models.Orphans, error) {
+	switch policy {
+	case models.SuggestionOrphanPolicyReattach, models.SuggestionOrphanPolicyCascade:
+	default:
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidPolicy)
+	}
+
+	if dryRun {
+		orphans, err := s.repository.FindOrphans(ctx)
+		if err != nil {
+			return nil, goerrors.Join(ErrFindOrphans, err)
+		}
+
+		return adapters.OrphansToModel(orphans), nil
+	}
+
+	orphans, err := s.repository.RepairOrphans(ctx, dao.SuggestionOrphanPolicy(policy), now)
+	if err != nil {
+		return nil, goerrors.Join(ErrRepairOrphans, err)
+	}
+
+	if err := s.repository.ValidateConstraints(ctx); err != nil {
+		return adapters.OrphansToModel(orphans), goerrors.Join(ErrValidateConstraints, err)
+	}
+
+	return adapters.OrphansToModel(orphans), nil
+}
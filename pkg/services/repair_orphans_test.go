@@ -0,0 +1,126 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRepairOrphansService(t *testing.T) {
+	data := []struct {
+		name string
+
+		policy string
+		dryRun bool
+
+		shouldCallFindOrphans bool
+		findOrphansResp       *dao.OrphansModel
+		findOrphansErr        error
+
+		shouldCallRepairOrphans bool
+		repairOrphansResp       *dao.OrphansModel
+		repairOrphansErr        error
+
+		shouldCallValidateConstraints bool
+		validateConstraintsErr        error
+
+		expect    *models.Orphans
+		expectErr error
+	}{
+		{
+			name:                    "Success",
+			policy:                  models.SuggestionOrphanPolicyReattach,
+			shouldCallRepairOrphans: true,
+			repairOrphansResp: &dao.OrphansModel{
+				Revisions:           1,
+				DetachedSuggestions: 2,
+				OrphanedSuggestions: 3,
+			},
+			shouldCallValidateConstraints: true,
+			expect: &models.Orphans{
+				Revisions:           1,
+				DetachedSuggestions: 2,
+				OrphanedSuggestions: 3,
+			},
+		},
+		{
+			name:                  "Success/DryRun",
+			policy:                models.SuggestionOrphanPolicyCascade,
+			dryRun:                true,
+			shouldCallFindOrphans: true,
+			findOrphansResp: &dao.OrphansModel{
+				Revisions:           1,
+				DetachedSuggestions: 2,
+				OrphanedSuggestions: 3,
+			},
+			expect: &models.Orphans{
+				Revisions:           1,
+				DetachedSuggestions: 2,
+				OrphanedSuggestions: 3,
+			},
+		},
+		{
+			name:                          "Error/ValidateConstraintsFailure",
+			policy:                        models.SuggestionOrphanPolicyReattach,
+			shouldCallRepairOrphans:       true,
+			repairOrphansResp:             &dao.OrphansModel{Revisions: 1},
+			shouldCallValidateConstraints: true,
+			validateConstraintsErr:        fooErr,
+			expect:                        &models.Orphans{Revisions: 1},
+			expectErr:                     fooErr,
+		},
+		{
+			name:                    "Error/RepairOrphansFailure",
+			policy:                  models.SuggestionOrphanPolicyReattach,
+			shouldCallRepairOrphans: true,
+			repairOrphansErr:        fooErr,
+			expectErr:               fooErr,
+		},
+		{
+			name:                  "Error/FindOrphansFailure",
+			policy:                models.SuggestionOrphanPolicyReattach,
+			dryRun:                true,
+			shouldCallFindOrphans: true,
+			findOrphansErr:        fooErr,
+			expectErr:             fooErr,
+		},
+		{
+			name:      "Error/InvalidPolicy",
+			policy:    "fake policy",
+			expectErr: goframework.ErrInvalidEntity,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewMaintenanceRepository(t)
+
+			if d.shouldCallFindOrphans {
+				repository.On("FindOrphans", context.Background()).Return(d.findOrphansResp, d.findOrphansErr)
+			}
+
+			if d.shouldCallRepairOrphans {
+				repository.
+					On("RepairOrphans", context.Background(), dao.SuggestionOrphanPolicy(d.policy), baseTime).
+					Return(d.repairOrphansResp, d.repairOrphansErr)
+			}
+
+			if d.shouldCallValidateConstraints {
+				repository.On("ValidateConstraints", context.Background()).Return(d.validateConstraintsErr)
+			}
+
+			service := services.NewRepairOrphansService(repository)
+			res, err := service.Repair(context.Background(), d.policy, d.dryRun, baseTime)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+		})
+	}
+}
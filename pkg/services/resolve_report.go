@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"time"
+)
+
+type ResolveReportService interface {
+	// Resolve closes a report, and applies the moderation action to the reported content.
+	Resolve(ctx context.Context, tokenRaw string, form *models.ResolveReportForm, now time.Time) (*models.Report, error)
+}
+
+func NewResolveReportService(
+	repository dao.ReportRepository,
+	authClient apiclients.AuthClient,
+	permissionsClient apiclients.PermissionsClient,
+) ResolveReportService {
+	return &resolveReportServiceImpl{
+		repository:        repository,
+		authClient:        authClient,
+		permissionsClient: permissionsClient,
+	}
+}
+
+type resolveReportServiceImpl struct {
+	repository        dao.ReportRepository
+	authClient        apiclients.AuthClient
+	permissionsClient apiclients.PermissionsClient
+}
+
+func (s *resolveReportServiceImpl) Resolve(ctx context.Context, tokenRaw string, form *models.ResolveReportForm, now time.Time) (*models.Report, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	if err := s.permissionsClient.HasUserScope(ctx, moderatorScopeQuery(token.Token.Payload.ID)); err != nil {
+		return nil, goerrors.Join(ErrGetScopes, err)
+	}
+
+	switch form.Action {
+	case models.ReportActionDismiss, models.ReportActionHide, models.ReportActionDelete:
+	default:
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidAction)
+	}
+
+	report, err := s.repository.Get(ctx, form.ID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetReport, err)
+	}
+
+	if report.Status == dao.ReportStatusResolved {
+		return nil, ErrReportResolved
+	}
+	// An unclaimed report can be resolved straight away, but a claimed one belongs to its moderator.
+	if report.Status == dao.ReportStatusClaimed && (report.ModeratorID == nil || *report.ModeratorID != token.Token.Payload.ID) {
+		return nil, ErrReportClaimed
+	}
+
+	report, err = s.repository.Resolve(ctx, token.Token.Payload.ID, dao.ReportAction(form.Action), form.ID, now)
+	if err != nil {
+		return nil, goerrors.Join(ErrResolveReport, err)
+	}
+
+	return adapters.ReportToModel(report), nil
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+)
+
+type RestoreImproveRequestService interface {
+	// Restore brings back a deleted improvement request, along with the revisions that were deleted with it. Only its author is allowed to restore it.
+	Restore(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveRequestPreview, error)
+}
+
+func NewRestoreImproveRequestService(repository dao.ImproveRequestRepository, authClient apiclients.AuthClient) RestoreImproveRequestService {
+	return &restoreImproveRequestServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type restoreImproveRequestServiceImpl struct {
+	repository dao.ImproveRequestRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *restoreImproveRequestServiceImpl) Restore(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveRequestPreview, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	deleted, err := s.repository.GetDeleted(ctx, id)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequest, err)
+	}
+	if deleted.UserID != token.Token.Payload.ID {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
+	}
+
+	restored, err := s.repository.Restore(ctx, id)
+	if err != nil {
+		return nil, goerrors.Join(ErrRestoreImproveRequest, err)
+	}
+
+	return adapters.ImproveRequestPreviewToModel(restored), nil
+}
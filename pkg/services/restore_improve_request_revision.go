@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+)
+
+type RestoreImproveRequestRevisionService interface {
+	// Restore brings back a deleted improvement request revision. Only its author is allowed to restore it.
+	Restore(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveRequestRevision, error)
+}
+
+func NewRestoreImproveRequestRevisionService(repository dao.ImproveRequestRepository, authClient apiclients.AuthClient) RestoreImproveRequestRevisionService {
+	return &restoreImproveRequestRevisionServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type restoreImproveRequestRevisionServiceImpl struct {
+	repository dao.ImproveRequestRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *restoreImproveRequestRevisionServiceImpl) Restore(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveRequestRevision, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	deleted, err := s.repository.GetDeletedRevision(ctx, id)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, err)
+	}
+	if deleted.UserID != token.Token.Payload.ID {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
+	}
+
+	restored, err := s.repository.RestoreRevision(ctx, id)
+	if err != nil {
+		return nil, goerrors.Join(ErrRestoreImproveRequestRevision, err)
+	}
+
+	return adapters.ImproveRequestRevisionToModel(restored), nil
+}
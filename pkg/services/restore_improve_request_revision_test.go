@@ -0,0 +1,154 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRestoreImproveRequestRevisionService(t *testing.T) {
+	data := []struct {
+		name string
+
+		token string
+		id    uuid.UUID
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallGet bool
+		getResp       *dao.ImproveRequestRevisionModel
+		getErr        error
+
+		shouldCallRestore bool
+		restoreResp       *dao.ImproveRequestRevisionModel
+		restoreErr        error
+
+		expect    *models.ImproveRequestRevision
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp: &dao.ImproveRequestRevisionModel{
+				UserID:    goframework.NumberUUID(100),
+				DeletedAt: &updateTime,
+			},
+			shouldCallRestore: true,
+			restoreResp: &dao.ImproveRequestRevisionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(10),
+				Title:    "title",
+				Content:  "content",
+			},
+			expect: &models.ImproveRequestRevision{
+				ID:        goframework.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    goframework.NumberUUID(100),
+				SourceID:  goframework.NumberUUID(10),
+				Title:     "title",
+				Content:   "content",
+			},
+		},
+		{
+			name:  "Error/RestoreFailure",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp: &dao.ImproveRequestRevisionModel{
+				UserID:    goframework.NumberUUID(100),
+				DeletedAt: &updateTime,
+			},
+			shouldCallRestore: true,
+			restoreErr:        fooErr,
+			expectErr:         fooErr,
+		},
+		{
+			name:  "Error/NotTheCreator",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp: &dao.ImproveRequestRevisionModel{
+				UserID:    goframework.NumberUUID(101),
+				DeletedAt: &updateTime,
+			},
+			expectErr: services.ErrNotTheCreator,
+		},
+		{
+			name:  "Error/GetFailure",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(1),
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getErr:        bunovel.ErrNotFound,
+			expectErr:     bunovel.ErrNotFound,
+		},
+		{
+			name:           "Error/NotAuthenticated",
+			token:          "tokenRaw",
+			id:             goframework.NumberUUID(1),
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:          "Error/AuthClientFailure",
+			token:         "tokenRaw",
+			id:            goframework.NumberUUID(1),
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.token).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallGet {
+				repository.On("GetDeletedRevision", context.Background(), d.id).Return(d.getResp, d.getErr)
+			}
+
+			if d.shouldCallRestore {
+				repository.On("RestoreRevision", context.Background(), d.id).Return(d.restoreResp, d.restoreErr)
+			}
+
+			service := services.NewRestoreImproveRequestRevisionService(repository, authClient)
+			res, err := service.Restore(context.Background(), d.token, d.id)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+		})
+	}
+}
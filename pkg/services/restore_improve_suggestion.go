@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+)
+
+type RestoreImproveSuggestionService interface {
+	// Restore brings back a deleted improvement suggestion. Only its author is allowed to restore it.
+	Restore(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveSuggestion, error)
+}
+
+func NewRestoreImproveSuggestionService(repository dao.ImproveSuggestionRepository, authClient apiclients.AuthClient) RestoreImproveSuggestionService {
+	return &restoreImproveSuggestionServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type restoreImproveSuggestionServiceImpl struct {
+	repository dao.ImproveSuggestionRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *restoreImproveSuggestionServiceImpl) Restore(ctx context.Context, tokenRaw string, id uuid.UUID) (*models.ImproveSuggestion, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	deleted, err := s.repository.GetDeleted(ctx, id)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveSuggestion, err)
+	}
+	if deleted.UserID != token.Token.Payload.ID {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
+	}
+
+	restored, err := s.repository.Restore(ctx, id)
+	if err != nil {
+		return nil, goerrors.Join(ErrRestoreImproveSuggestion, err)
+	}
+
+	return adapters.ImproveSuggestionToModel(restored), nil
+}
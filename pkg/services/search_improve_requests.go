@@ -6,35 +6,129 @@ import (
 	"github.com/a-novel/forum-service/pkg/adapters"
 	"github.com/a-novel/forum-service/pkg/dao"
 	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/samber/lo"
+	"strings"
 )
 
 type SearchImproveRequestsService interface {
-	Search(ctx context.Context, query models.SearchImproveRequestsQuery) ([]*models.ImproveRequestPreview, int, error)
+	// Search returns a page of results, along with the total number of results, the cursor to the next page and, when
+	// a text query has no results at all, a corrected query. Drafts are only returned to their author: the token is
+	// optional, unless the query only targets the requests followed by the user.
+	Search(ctx context.Context, tokenRaw string, query models.SearchImproveRequestsQuery) (*models.SearchImproveRequestsResult, error)
 }
 
-func NewSearchImproveRequestsService(repository dao.ImproveRequestRepository) SearchImproveRequestsService {
+func NewSearchImproveRequestsService(repository dao.ImproveRequestRepository, authClient apiclients.AuthClient) SearchImproveRequestsService {
 	return &searchImproveRequestsServiceImpl{
 		repository: repository,
+		authClient: authClient,
 	}
 }
 
 type searchImproveRequestsServiceImpl struct {
 	repository dao.ImproveRequestRepository
+	authClient apiclients.AuthClient
 }
 
-func (s *searchImproveRequestsServiceImpl) Search(ctx context.Context, query models.SearchImproveRequestsQuery) ([]*models.ImproveRequestPreview, int, error) {
+func (s *searchImproveRequestsServiceImpl) Search(ctx context.Context, tokenRaw string, query models.SearchImproveRequestsQuery) (*models.SearchImproveRequestsResult, error) {
 	if err := goframework.CheckMinMax(query.Limit, 1, MaxSearchLimit); err != nil {
-		return nil, 0, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchLimit, err)
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchLimit, err)
 	}
 
-	res, total, err := s.repository.Search(ctx, adapters.ImproveRequestSearchQueryToDAO(query), query.Limit, query.Offset)
+	if err := goframework.CheckMinMax(query.Query, 0, MaxSearchQueryLength); err != nil {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchQuery, err)
+	}
+	if len(strings.Fields(query.Query)) > MaxSearchQueryWords {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchQuery)
+	}
+
+	if !validLanguage(query.Language) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidLanguage)
+	}
+
+	if query.CreatedAfter != nil && query.CreatedBefore != nil && query.CreatedAfter.After(*query.CreatedBefore) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchFilters)
+	}
+
+	if !validSearchRange(query.MinScore, query.MaxScore, false) ||
+		!validSearchRange(query.MinSuggestions, query.MaxSuggestions, true) ||
+		!validSearchRange(query.MinRevisions, query.MaxRevisions, true) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchFilters)
+	}
+
+	if query.TagsMatch != "" && query.TagsMatch != models.TagsMatchAny && query.TagsMatch != models.TagsMatchAll {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchFilters)
+	}
+
+	query.Tags = normalizeTags(query.Tags)
+	if !validTags(query.Tags, MaxSearchTags) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidTags)
+	}
+
+	daoQuery := adapters.ImproveRequestSearchQueryToDAO(query)
+
+	if query.Cursor != "" {
+		if query.Offset != 0 {
+			return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchCursor)
+		}
+
+		cursor, err := adapters.ImproveRequestSearchCursorToDAO(query.Cursor)
+		if err != nil {
+			return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchCursor, err)
+		}
+
+		daoQuery.Cursor = cursor
+	}
+
+	viewer, err := viewerID(ctx, s.authClient, tokenRaw)
+	if err != nil {
+		return nil, err
+	}
+	if query.Followed && viewer == nil {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+	daoQuery.ViewerID = viewer
+
+	res, total, err := s.repository.Search(ctx, daoQuery, query.Limit, query.Offset)
 	if err != nil {
-		return nil, 0, goerrors.Join(ErrSearchImproveRequests, err)
+		return nil, goerrors.Join(ErrSearchImproveRequests, err)
+	}
+
+	var didYouMean string
+	if len(res) == 0 && query.Query != "" && query.Offset == 0 && query.Cursor == "" {
+		didYouMean, err = s.repository.SuggestQuery(ctx, query.Query)
+		if err != nil {
+			return nil, goerrors.Join(ErrSuggestSearchQuery, err)
+		}
+	}
+
+	// A partial page is the last one.
+	var nextCursor string
+	if len(res) == query.Limit {
+		nextCursor = adapters.ImproveRequestSearchCursorFromDAO(res[len(res)-1])
+	}
+
+	return &models.SearchImproveRequestsResult{
+		Res: lo.Map(res, func(item *dao.ImproveRequestPreview, _ int) *models.ImproveRequestPreview {
+			preview := adapters.ImproveRequestPreviewToModel(item)
+			if query.Excerpt {
+				preview.Content = excerpt(preview.Content)
+			}
+
+			return preview
+		}),
+		Total:      total,
+		NextCursor: nextCursor,
+		DidYouMean: didYouMean,
+	}, nil
+}
+
+// validSearchRange returns false when a range is empty, or when a count bound is negative.
+func validSearchRange(min, max *int, count bool) bool {
+	if count && ((min != nil && *min < 0) || (max != nil && *max < 0)) {
+		return false
 	}
 
-	return lo.Map(res, func(item *dao.ImproveRequestPreview, _ int) *models.ImproveRequestPreview {
-		return adapters.ImproveRequestPreviewToModel(item)
-	}), total, nil
+	return min == nil || max == nil || *min <= *max
 }
@@ -3,22 +3,32 @@ package services_test
 import (
 	"context"
 	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/adapters"
 	"github.com/a-novel/forum-service/pkg/dao"
 	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
 	"github.com/a-novel/forum-service/pkg/models"
 	"github.com/a-novel/forum-service/pkg/services"
 	"github.com/a-novel/go-apis"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestSearchImproveRequestsService(t *testing.T) {
 	data := []struct {
 		name string
 
-		query models.SearchImproveRequestsQuery
+		tokenRaw string
+		query    models.SearchImproveRequestsQuery
+
+		shouldCallAuthClient bool
+		authClientResp       *apiclients.UserTokenStatus
+		authClientErr        error
 
 		shouldCallDAO         bool
 		shouldCallDAOWithForm dao.ImproveRequestSearchQuery
@@ -26,9 +36,15 @@ func TestSearchImproveRequestsService(t *testing.T) {
 		queryTotal            int
 		queryErr              error
 
-		expectedResults []*models.ImproveRequestPreview
-		expectedTotal   int
-		expectedErr     error
+		shouldCallSuggestQuery bool
+		suggestQueryResp       string
+		suggestQueryErr        error
+
+		expectedResults    []*models.ImproveRequestPreview
+		expectedTotal      int
+		expectedNextCursor string
+		expectedDidYouMean string
+		expectedErr        error
 	}{
 		{
 			name: "Success",
@@ -113,10 +129,169 @@ func TestSearchImproveRequestsService(t *testing.T) {
 				Query:  "foo bar",
 				Order:  &dao.ImproveRequestSearchQueryOrder{Score: true},
 			},
+			queryTotal:             20,
+			expectedResults:        []*models.ImproveRequestPreview{},
+			expectedTotal:          20,
+			shouldCallSuggestQuery: true,
+		},
+		{
+			name: "Success/WithHighlight",
+			query: models.SearchImproveRequestsQuery{
+				Query:     "foo",
+				Highlight: true,
+				Limit:     10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				Query:     "foo",
+				Highlight: true,
+			},
+			queryResults: []*dao.ImproveRequestPreview{
+				{
+					Metadata:         bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+					Title:            "foo title",
+					Content:          "foo content",
+					TitleHighlight:   "<mark>foo</mark> title",
+					ContentHighlight: "<mark>foo</mark> content",
+				},
+			},
+			queryTotal: 1,
+			expectedResults: []*models.ImproveRequestPreview{
+				{
+					ID:               goframework.NumberUUID(1),
+					CreatedAt:        baseTime,
+					Title:            "foo title",
+					Content:          "foo content",
+					TitleHighlight:   "<mark>foo</mark> title",
+					ContentHighlight: "<mark>foo</mark> content",
+				},
+			},
+			expectedTotal: 1,
+		},
+		{
+			name: "Success/WithExcerpt",
+			query: models.SearchImproveRequestsQuery{
+				Excerpt: true,
+				Limit:   10,
+			},
+			shouldCallDAO: true,
+			queryResults: []*dao.ImproveRequestPreview{
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+					Content:  "short content",
+				},
+				{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(2), baseTime, nil),
+					Content:  strings.Repeat("word ", 100),
+				},
+			},
+			queryTotal: 2,
+			expectedResults: []*models.ImproveRequestPreview{
+				{
+					ID:        goframework.NumberUUID(1),
+					CreatedAt: baseTime,
+					Content:   "short content",
+				},
+				{
+					ID:        goframework.NumberUUID(2),
+					CreatedAt: baseTime,
+					// The excerpt is cut on the last word that fits, with room left for the ellipsis.
+					Content: strings.Repeat("word ", 54) + "word…",
+				},
+			},
+			expectedTotal: 2,
+		},
+		{
+			name: "Success/WithLanguage",
+			query: models.SearchImproveRequestsQuery{
+				Query:    "foo bar",
+				Language: models.LanguageSpanish,
+				Limit:    10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				Query:    "foo bar",
+				Language: dao.LanguageSpanish,
+			},
+			queryTotal:             20,
+			expectedResults:        []*models.ImproveRequestPreview{},
+			expectedTotal:          20,
+			shouldCallSuggestQuery: true,
+			suggestQueryResp:       "food bar",
+			expectedDidYouMean:     "food bar",
+		},
+		{
+			name: "Success/WithTags",
+			query: models.SearchImproveRequestsQuery{
+				Tags:  []string{"Science Fiction", "horror", "horror"},
+				Limit: 10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				Tags: []string{"science-fiction", "horror"},
+			},
+			queryTotal:      20,
+			expectedResults: []*models.ImproveRequestPreview{},
+			expectedTotal:   20,
+		},
+		{
+			name: "Success/WithAllTags",
+			query: models.SearchImproveRequestsQuery{
+				Tags:      []string{"dialogue", "horror"},
+				TagsMatch: models.TagsMatchAll,
+				Limit:     10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				Tags:    []string{"dialogue", "horror"},
+				AllTags: true,
+			},
+			queryTotal:      20,
+			expectedResults: []*models.ImproveRequestPreview{},
+			expectedTotal:   20,
+		},
+		{
+			name:     "Success/Followed",
+			tokenRaw: "token",
+			query: models.SearchImproveRequestsQuery{
+				Followed: true,
+				Limit:    10,
+			},
+			shouldCallAuthClient: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(2)},
+				},
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				Followed: true,
+				ViewerID: lo.ToPtr(goframework.NumberUUID(2)),
+			},
 			queryTotal:      20,
 			expectedResults: []*models.ImproveRequestPreview{},
 			expectedTotal:   20,
 		},
+		{
+			name: "Error/FollowedAnonymous",
+			query: models.SearchImproveRequestsQuery{
+				Followed: true,
+				Limit:    10,
+			},
+			expectedErr: goframework.ErrInvalidCredentials,
+		},
+		{
+			name:     "Error/FollowedInvalidToken",
+			tokenRaw: "token",
+			query: models.SearchImproveRequestsQuery{
+				Followed: true,
+				Limit:    10,
+			},
+			shouldCallAuthClient: true,
+			authClientResp:       &apiclients.UserTokenStatus{},
+			expectedErr:          goframework.ErrInvalidCredentials,
+		},
 		{
 			name: "Success/WithQueryInvalid",
 			query: models.SearchImproveRequestsQuery{
@@ -129,10 +304,275 @@ func TestSearchImproveRequestsService(t *testing.T) {
 			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
 				Query: "foo bar",
 			},
+			queryTotal:             20,
+			expectedResults:        []*models.ImproveRequestPreview{},
+			expectedTotal:          20,
+			shouldCallSuggestQuery: true,
+		},
+		{
+			name: "Success/WithOrderActivity",
+			query: models.SearchImproveRequestsQuery{
+				Order: models.OrderActivity,
+				Limit: 10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				Order: &dao.ImproveRequestSearchQueryOrder{Activity: true},
+			},
+			queryTotal:      20,
+			expectedResults: []*models.ImproveRequestPreview{},
+			expectedTotal:   20,
+		},
+		{
+			name: "Success/WithOrderSuggestions",
+			query: models.SearchImproveRequestsQuery{
+				Order: models.OrderSuggestions,
+				Limit: 10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				Order: &dao.ImproveRequestSearchQueryOrder{Suggestions: true},
+			},
 			queryTotal:      20,
 			expectedResults: []*models.ImproveRequestPreview{},
 			expectedTotal:   20,
 		},
+		{
+			name: "Success/WithOrderControversial",
+			query: models.SearchImproveRequestsQuery{
+				Order: models.OrderControversial,
+				Limit: 10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				Order: &dao.ImproveRequestSearchQueryOrder{Controversial: true},
+			},
+			queryTotal:      20,
+			expectedResults: []*models.ImproveRequestPreview{},
+			expectedTotal:   20,
+		},
+		{
+			name: "Success/WithOrderHot",
+			query: models.SearchImproveRequestsQuery{
+				Order: models.OrderHot,
+				Limit: 10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				Order: &dao.ImproveRequestSearchQueryOrder{Hot: true},
+			},
+			queryTotal:      20,
+			expectedResults: []*models.ImproveRequestPreview{},
+			expectedTotal:   20,
+		},
+		{
+			name: "Success/FullPage",
+			query: models.SearchImproveRequestsQuery{
+				Limit: 1,
+			},
+			shouldCallDAO: true,
+			queryResults: []*dao.ImproveRequestPreview{
+				{
+					Metadata:   bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+					UserID:     goframework.NumberUUID(100),
+					Title:      "title",
+					Content:    "content",
+					UpVotes:    10,
+					DownVotes:  5,
+					SearchRank: 0.5,
+				},
+			},
+			queryTotal: 20,
+			expectedResults: []*models.ImproveRequestPreview{
+				{
+					ID:        goframework.NumberUUID(10),
+					CreatedAt: baseTime,
+					UserID:    goframework.NumberUUID(100),
+					Title:     "title",
+					Content:   "content",
+					UpVotes:   10,
+					DownVotes: 5,
+				},
+			},
+			expectedTotal: 20,
+			expectedNextCursor: adapters.ImproveRequestSearchCursorFromDAO(&dao.ImproveRequestPreview{
+				Metadata:   bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				UpVotes:    10,
+				DownVotes:  5,
+				SearchRank: 0.5,
+			}),
+		},
+		{
+			name: "Success/WithCursor",
+			query: models.SearchImproveRequestsQuery{
+				Order: models.OrderScore,
+				Limit: 10,
+				Cursor: adapters.ImproveRequestSearchCursorFromDAO(&dao.ImproveRequestPreview{
+					Metadata:   bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+					UpVotes:    10,
+					DownVotes:  5,
+					SearchRank: 0.5,
+				}),
+				SkipTotal: true,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				Order: &dao.ImproveRequestSearchQueryOrder{Score: true},
+				Cursor: &dao.ImproveRequestSearchCursor{
+					Rank:      0.5,
+					Score:     5,
+					CreatedAt: baseTime,
+					ID:        goframework.NumberUUID(10),
+				},
+				SkipCount: true,
+			},
+			expectedResults: []*models.ImproveRequestPreview{},
+		},
+		{
+			name: "Success/WithFilters",
+			query: models.SearchImproveRequestsQuery{
+				CreatedAfter:           lo.ToPtr(baseTime),
+				CreatedBefore:          lo.ToPtr(baseTime),
+				MinScore:               lo.ToPtr(-5),
+				MaxScore:               lo.ToPtr(5),
+				MinSuggestions:         lo.ToPtr(0),
+				MaxSuggestions:         lo.ToPtr(0),
+				HasAcceptedSuggestions: lo.ToPtr(false),
+				MinRevisions:           lo.ToPtr(1),
+				MaxRevisions:           lo.ToPtr(3),
+				Limit:                  10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				CreatedAfter:           lo.ToPtr(baseTime),
+				CreatedBefore:          lo.ToPtr(baseTime),
+				MinScore:               lo.ToPtr(-5),
+				MaxScore:               lo.ToPtr(5),
+				MinSuggestions:         lo.ToPtr(0),
+				MaxSuggestions:         lo.ToPtr(0),
+				HasAcceptedSuggestions: lo.ToPtr(false),
+				MinRevisions:           lo.ToPtr(1),
+				MaxRevisions:           lo.ToPtr(3),
+			},
+			queryTotal:      20,
+			expectedResults: []*models.ImproveRequestPreview{},
+			expectedTotal:   20,
+		},
+		{
+			name: "Error/InvalidCreatedRange",
+			query: models.SearchImproveRequestsQuery{
+				CreatedAfter:  lo.ToPtr(baseTime.Add(time.Hour)),
+				CreatedBefore: lo.ToPtr(baseTime),
+				Limit:         10,
+			},
+			expectedErr: services.ErrInvalidSearchFilters,
+		},
+		{
+			name: "Error/InvalidTagsMatch",
+			query: models.SearchImproveRequestsQuery{
+				Tags:      []string{"horror"},
+				TagsMatch: "some",
+				Limit:     10,
+			},
+			expectedErr: services.ErrInvalidSearchFilters,
+		},
+		{
+			name: "Error/InvalidTags",
+			query: models.SearchImproveRequestsQuery{
+				Tags:  []string{"sci_fi"},
+				Limit: 10,
+			},
+			expectedErr: services.ErrInvalidTags,
+		},
+		{
+			name: "Error/InvalidScoreRange",
+			query: models.SearchImproveRequestsQuery{
+				MinScore: lo.ToPtr(5),
+				MaxScore: lo.ToPtr(-5),
+				Limit:    10,
+			},
+			expectedErr: services.ErrInvalidSearchFilters,
+		},
+		{
+			name: "Error/NegativeSuggestionsCount",
+			query: models.SearchImproveRequestsQuery{
+				MaxSuggestions: lo.ToPtr(-1),
+				Limit:          10,
+			},
+			expectedErr: services.ErrInvalidSearchFilters,
+		},
+		{
+			name: "Error/InvalidRevisionsRange",
+			query: models.SearchImproveRequestsQuery{
+				MinRevisions: lo.ToPtr(3),
+				MaxRevisions: lo.ToPtr(1),
+				Limit:        10,
+			},
+			expectedErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name: "Error/InvalidCursor",
+			query: models.SearchImproveRequestsQuery{
+				Limit:  10,
+				Cursor: "not a cursor",
+			},
+			expectedErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name: "Error/CursorWithOffset",
+			query: models.SearchImproveRequestsQuery{
+				Limit:  10,
+				Offset: 10,
+				Cursor: adapters.ImproveRequestSearchCursorFromDAO(&dao.ImproveRequestPreview{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(10), baseTime, nil),
+				}),
+			},
+			expectedErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:     "Success/WithViewer",
+			tokenRaw: "token",
+			query: models.SearchImproveRequestsQuery{
+				Limit: 10,
+			},
+			shouldCallAuthClient: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				ViewerID: lo.ToPtr(goframework.NumberUUID(100)),
+			},
+			queryTotal:      20,
+			expectedResults: []*models.ImproveRequestPreview{},
+			expectedTotal:   20,
+		},
+		{
+			name:     "Success/WithInvalidToken",
+			tokenRaw: "token",
+			query: models.SearchImproveRequestsQuery{
+				Limit: 10,
+			},
+			shouldCallAuthClient: true,
+			authClientResp:       &apiclients.UserTokenStatus{},
+			shouldCallDAO:        true,
+			queryTotal:           20,
+			expectedResults:      []*models.ImproveRequestPreview{},
+			expectedTotal:        20,
+		},
+		{
+			name:     "Error/IntrospectTokenFailure",
+			tokenRaw: "token",
+			query: models.SearchImproveRequestsQuery{
+				Limit: 10,
+			},
+			shouldCallAuthClient: true,
+			authClientErr:        fooErr,
+			expectedErr:          fooErr,
+		},
 		{
 			name: "Error/DAOFailure",
 			query: models.SearchImproveRequestsQuery{
@@ -142,6 +582,63 @@ func TestSearchImproveRequestsService(t *testing.T) {
 			queryErr:      fooErr,
 			expectedErr:   fooErr,
 		},
+		{
+			name: "Success/WithQueryAndResults",
+			query: models.SearchImproveRequestsQuery{
+				Query: "foo bar",
+				Limit: 10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				Query: "foo bar",
+			},
+			queryResults: []*dao.ImproveRequestPreview{
+				{Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil)},
+			},
+			queryTotal: 1,
+			expectedResults: []*models.ImproveRequestPreview{
+				{ID: goframework.NumberUUID(1), CreatedAt: baseTime},
+			},
+			expectedTotal: 1,
+		},
+		{
+			name: "Error/SuggestQueryFailure",
+			query: models.SearchImproveRequestsQuery{
+				Query: "foo bar",
+				Limit: 10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveRequestSearchQuery{
+				Query: "foo bar",
+			},
+			shouldCallSuggestQuery: true,
+			suggestQueryErr:        fooErr,
+			expectedErr:            fooErr,
+		},
+		{
+			name: "Error/QueryTooLong",
+			query: models.SearchImproveRequestsQuery{
+				Query: strings.Repeat("a", services.MaxSearchQueryLength+1),
+				Limit: 10,
+			},
+			expectedErr: services.ErrInvalidSearchQuery,
+		},
+		{
+			name: "Error/TooManyWords",
+			query: models.SearchImproveRequestsQuery{
+				Query: strings.Repeat("foo ", services.MaxSearchQueryWords+1),
+				Limit: 10,
+			},
+			expectedErr: services.ErrInvalidSearchQuery,
+		},
+		{
+			name: "Error/InvalidLanguage",
+			query: models.SearchImproveRequestsQuery{
+				Language: "klingon",
+				Limit:    10,
+			},
+			expectedErr: services.ErrInvalidLanguage,
+		},
 		{
 			name:        "Error/NoLimit",
 			expectedErr: goframework.ErrInvalidEntity,
@@ -158,6 +655,11 @@ func TestSearchImproveRequestsService(t *testing.T) {
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
 			repository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			if d.shouldCallAuthClient {
+				authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+			}
 
 			if d.shouldCallDAO {
 				repository.
@@ -165,14 +667,27 @@ func TestSearchImproveRequestsService(t *testing.T) {
 					Return(d.queryResults, d.queryTotal, d.queryErr)
 			}
 
-			service := services.NewSearchImproveRequestsService(repository)
-			results, total, err := service.Search(context.Background(), d.query)
+			if d.shouldCallSuggestQuery {
+				repository.On("SuggestQuery", context.Background(), d.query.Query).Return(d.suggestQueryResp, d.suggestQueryErr)
+			}
+
+			service := services.NewSearchImproveRequestsService(repository, authClient)
+			res, err := service.Search(context.Background(), d.tokenRaw, d.query)
 
 			require.ErrorIs(t, err, d.expectedErr)
-			require.Equal(t, d.expectedResults, results)
-			require.Equal(t, d.expectedTotal, total)
+			if d.expectedErr != nil {
+				require.Nil(t, res)
+			} else {
+				require.Equal(t, &models.SearchImproveRequestsResult{
+					Res:        d.expectedResults,
+					Total:      d.expectedTotal,
+					NextCursor: d.expectedNextCursor,
+					DidYouMean: d.expectedDidYouMean,
+				}, res)
+			}
 
 			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
 		})
 	}
 }
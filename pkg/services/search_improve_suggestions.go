@@ -6,35 +6,93 @@ import (
 	"github.com/a-novel/forum-service/pkg/adapters"
 	"github.com/a-novel/forum-service/pkg/dao"
 	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/samber/lo"
+	"strings"
 )
 
 type SearchImproveSuggestionsService interface {
-	Search(ctx context.Context, query models.SearchImproveSuggestionsQuery) ([]*models.ImproveSuggestion, int, error)
+	// Search returns a page of results, along with the total number of results, the cursor to the next page and, when
+	// a text query has no results at all, a corrected query. Drafts are only returned to their author: the token is
+	// optional.
+	Search(ctx context.Context, tokenRaw string, query models.SearchImproveSuggestionsQuery) (*models.SearchImproveSuggestionsResult, error)
 }
 
-func NewSearchImproveSuggestionsService(repository dao.ImproveSuggestionRepository) SearchImproveSuggestionsService {
+func NewSearchImproveSuggestionsService(repository dao.ImproveSuggestionRepository, authClient apiclients.AuthClient) SearchImproveSuggestionsService {
 	return &searchImproveSuggestionsServiceImpl{
 		repository: repository,
+		authClient: authClient,
 	}
 }
 
 type searchImproveSuggestionsServiceImpl struct {
 	repository dao.ImproveSuggestionRepository
+	authClient apiclients.AuthClient
 }
 
-func (s *searchImproveSuggestionsServiceImpl) Search(ctx context.Context, query models.SearchImproveSuggestionsQuery) ([]*models.ImproveSuggestion, int, error) {
+func (s *searchImproveSuggestionsServiceImpl) Search(ctx context.Context, tokenRaw string, query models.SearchImproveSuggestionsQuery) (*models.SearchImproveSuggestionsResult, error) {
 	if err := goframework.CheckMinMax(query.Limit, 1, MaxSearchLimit); err != nil {
-		return nil, 0, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchLimit, err)
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchLimit, err)
 	}
 
-	res, total, err := s.repository.Search(ctx, adapters.ImproveSuggestionSearchQueryToDAO(query), query.Limit, query.Offset)
+	if err := goframework.CheckMinMax(query.Query, 0, MaxSearchQueryLength); err != nil {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchQuery, err)
+	}
+	if len(strings.Fields(query.Query)) > MaxSearchQueryWords {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchQuery)
+	}
+
+	if !validLanguage(query.Language) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidLanguage)
+	}
+
+	daoQuery := adapters.ImproveSuggestionSearchQueryToDAO(query)
+
+	if query.Cursor != "" {
+		if query.Offset != 0 {
+			return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchCursor)
+		}
+
+		cursor, err := adapters.ImproveSuggestionSearchCursorToDAO(query.Cursor)
+		if err != nil {
+			return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidSearchCursor, err)
+		}
+
+		daoQuery.Cursor = cursor
+	}
+
+	viewer, err := viewerID(ctx, s.authClient, tokenRaw)
+	if err != nil {
+		return nil, err
+	}
+	daoQuery.ViewerID = viewer
+
+	res, total, err := s.repository.Search(ctx, daoQuery, query.Limit, query.Offset)
 	if err != nil {
-		return nil, 0, goerrors.Join(ErrSearchImproveSuggestions, err)
+		return nil, goerrors.Join(ErrSearchImproveSuggestions, err)
+	}
+
+	var didYouMean string
+	if len(res) == 0 && query.Query != "" && query.Offset == 0 && query.Cursor == "" {
+		didYouMean, err = s.repository.SuggestQuery(ctx, query.Query)
+		if err != nil {
+			return nil, goerrors.Join(ErrSuggestSearchQuery, err)
+		}
+	}
+
+	// A partial page is the last one.
+	var nextCursor string
+	if len(res) == query.Limit {
+		nextCursor = adapters.ImproveSuggestionSearchCursorFromDAO(res[len(res)-1])
 	}
 
-	return lo.Map(res, func(item *dao.ImproveSuggestionModel, _ int) *models.ImproveSuggestion {
-		return adapters.ImproveSuggestionToModel(item)
-	}), total, nil
+	return &models.SearchImproveSuggestionsResult{
+		Res: lo.Map(res, func(item *dao.ImproveSuggestionModel, _ int) *models.ImproveSuggestion {
+			return adapters.ImproveSuggestionToModel(item)
+		}),
+		Total:      total,
+		NextCursor: nextCursor,
+		DidYouMean: didYouMean,
+	}, nil
 }
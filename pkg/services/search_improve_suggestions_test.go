@@ -3,14 +3,18 @@ package services_test
 import (
 	"context"
 	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/adapters"
 	"github.com/a-novel/forum-service/pkg/dao"
 	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
 	"github.com/a-novel/forum-service/pkg/models"
 	"github.com/a-novel/forum-service/pkg/services"
 	"github.com/a-novel/go-apis"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	"strings"
 	"testing"
 	"time"
 )
@@ -19,7 +23,12 @@ func TestSearchImproveSuggestionsService(t *testing.T) {
 	data := []struct {
 		name string
 
-		query models.SearchImproveSuggestionsQuery
+		tokenRaw string
+		query    models.SearchImproveSuggestionsQuery
+
+		shouldCallAuthClient bool
+		authClientResp       *apiclients.UserTokenStatus
+		authClientErr        error
 
 		shouldCallDAO         bool
 		shouldCallDAOWithForm dao.ImproveSuggestionSearchQuery
@@ -27,9 +36,15 @@ func TestSearchImproveSuggestionsService(t *testing.T) {
 		queryTotal            int
 		queryErr              error
 
-		expectedResults []*models.ImproveSuggestion
-		expectedTotal   int
-		expectedErr     error
+		shouldCallSuggestQuery bool
+		suggestQueryResp       string
+		suggestQueryErr        error
+
+		expectedResults    []*models.ImproveSuggestion
+		expectedTotal      int
+		expectedNextCursor string
+		expectedDidYouMean string
+		expectedErr        error
 	}{
 		{
 			name: "Success",
@@ -113,6 +128,7 @@ func TestSearchImproveSuggestionsService(t *testing.T) {
 				SourceID:  apis.StringUUID(goframework.NumberUUID(2).String()),
 				RequestID: apis.StringUUID(goframework.NumberUUID(3).String()),
 				Validated: lo.ToPtr(true),
+				Query:     "foo bar",
 				Order:     models.OrderScore,
 				Limit:     10,
 			},
@@ -122,11 +138,32 @@ func TestSearchImproveSuggestionsService(t *testing.T) {
 				SourceID:  lo.ToPtr(goframework.NumberUUID(2)),
 				RequestID: lo.ToPtr(goframework.NumberUUID(3)),
 				Validated: lo.ToPtr(true),
+				Query:     "foo bar",
 				Order:     &dao.ImproveSuggestionSearchQueryOrder{Score: true},
 			},
-			queryTotal:      20,
-			expectedResults: []*models.ImproveSuggestion{},
-			expectedTotal:   20,
+			queryTotal:             20,
+			expectedResults:        []*models.ImproveSuggestion{},
+			expectedTotal:          20,
+			shouldCallSuggestQuery: true,
+		},
+		{
+			name: "Success/WithLanguage",
+			query: models.SearchImproveSuggestionsQuery{
+				Query:    "foo bar",
+				Language: models.LanguageEnglish,
+				Limit:    10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveSuggestionSearchQuery{
+				Query:    "foo bar",
+				Language: dao.LanguageEnglish,
+			},
+			queryTotal:             20,
+			expectedResults:        []*models.ImproveSuggestion{},
+			expectedTotal:          20,
+			shouldCallSuggestQuery: true,
+			suggestQueryResp:       "food bar",
+			expectedDidYouMean:     "food bar",
 		},
 		{
 			name: "Success/WithQueryInvalid",
@@ -142,6 +179,190 @@ func TestSearchImproveSuggestionsService(t *testing.T) {
 			expectedResults: []*models.ImproveSuggestion{},
 			expectedTotal:   20,
 		},
+		{
+			name: "Success/WithOrderActivity",
+			query: models.SearchImproveSuggestionsQuery{
+				Order: models.OrderActivity,
+				Limit: 10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveSuggestionSearchQuery{
+				Order: &dao.ImproveSuggestionSearchQueryOrder{Activity: true},
+			},
+			queryTotal:      20,
+			expectedResults: []*models.ImproveSuggestion{},
+			expectedTotal:   20,
+		},
+		{
+			name: "Success/WithOrderComments",
+			query: models.SearchImproveSuggestionsQuery{
+				Order: models.OrderComments,
+				Limit: 10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveSuggestionSearchQuery{
+				Order: &dao.ImproveSuggestionSearchQueryOrder{Comments: true},
+			},
+			queryTotal:      20,
+			expectedResults: []*models.ImproveSuggestion{},
+			expectedTotal:   20,
+		},
+		{
+			name: "Success/WithOrderControversial",
+			query: models.SearchImproveSuggestionsQuery{
+				Order: models.OrderControversial,
+				Limit: 10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveSuggestionSearchQuery{
+				Order: &dao.ImproveSuggestionSearchQueryOrder{Controversial: true},
+			},
+			queryTotal:      20,
+			expectedResults: []*models.ImproveSuggestion{},
+			expectedTotal:   20,
+		},
+		{
+			name: "Success/WithOrderHot",
+			query: models.SearchImproveSuggestionsQuery{
+				Order: models.OrderHot,
+				Limit: 10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveSuggestionSearchQuery{
+				Order: &dao.ImproveSuggestionSearchQueryOrder{Hot: true},
+			},
+			queryTotal:      20,
+			expectedResults: []*models.ImproveSuggestion{},
+			expectedTotal:   20,
+		},
+		{
+			name: "Success/FullPage",
+			query: models.SearchImproveSuggestionsQuery{
+				Limit: 1,
+			},
+			shouldCallDAO: true,
+			queryResults: []*dao.ImproveSuggestionModel{
+				{
+					Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+					SourceID:  goframework.NumberUUID(10),
+					UserID:    goframework.NumberUUID(200),
+					UpVotes:   16,
+					DownVotes: 8,
+					ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+						RequestID: goframework.NumberUUID(1),
+						Title:     "title",
+						Content:   "content",
+					},
+				},
+			},
+			queryTotal: 20,
+			expectedResults: []*models.ImproveSuggestion{
+				{
+					ID:        goframework.NumberUUID(1),
+					CreatedAt: baseTime,
+					SourceID:  goframework.NumberUUID(10),
+					UserID:    goframework.NumberUUID(200),
+					UpVotes:   16,
+					DownVotes: 8,
+					RequestID: goframework.NumberUUID(1),
+					Title:     "title",
+					Content:   "content",
+				},
+			},
+			expectedTotal: 20,
+			expectedNextCursor: adapters.ImproveSuggestionSearchCursorFromDAO(&dao.ImproveSuggestionModel{
+				Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UpVotes:   16,
+				DownVotes: 8,
+			}),
+		},
+		{
+			name: "Success/WithCursor",
+			query: models.SearchImproveSuggestionsQuery{
+				Order: models.OrderScore,
+				Limit: 10,
+				Cursor: adapters.ImproveSuggestionSearchCursorFromDAO(&dao.ImproveSuggestionModel{
+					Metadata:  bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, lo.ToPtr(baseTime.Add(time.Hour))),
+					UpVotes:   16,
+					DownVotes: 8,
+				}),
+				SkipTotal: true,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveSuggestionSearchQuery{
+				Order: &dao.ImproveSuggestionSearchQueryOrder{Score: true},
+				Cursor: &dao.ImproveSuggestionSearchCursor{
+					Score:     8,
+					UpdatedAt: baseTime.Add(time.Hour),
+					ID:        goframework.NumberUUID(1),
+				},
+				SkipCount: true,
+			},
+			expectedResults: []*models.ImproveSuggestion{},
+		},
+		{
+			name: "Error/InvalidCursor",
+			query: models.SearchImproveSuggestionsQuery{
+				Limit:  10,
+				Cursor: "not a cursor",
+			},
+			expectedErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name: "Error/CursorWithOffset",
+			query: models.SearchImproveSuggestionsQuery{
+				Limit:  10,
+				Offset: 10,
+				Cursor: adapters.ImproveSuggestionSearchCursorFromDAO(&dao.ImproveSuggestionModel{
+					Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				}),
+			},
+			expectedErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:     "Success/WithViewer",
+			tokenRaw: "token",
+			query: models.SearchImproveSuggestionsQuery{
+				Limit: 10,
+			},
+			shouldCallAuthClient: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveSuggestionSearchQuery{
+				ViewerID: lo.ToPtr(goframework.NumberUUID(100)),
+			},
+			queryTotal:      20,
+			expectedResults: []*models.ImproveSuggestion{},
+			expectedTotal:   20,
+		},
+		{
+			name:     "Success/WithInvalidToken",
+			tokenRaw: "token",
+			query: models.SearchImproveSuggestionsQuery{
+				Limit: 10,
+			},
+			shouldCallAuthClient: true,
+			authClientResp:       &apiclients.UserTokenStatus{},
+			shouldCallDAO:        true,
+			queryTotal:           20,
+			expectedResults:      []*models.ImproveSuggestion{},
+			expectedTotal:        20,
+		},
+		{
+			name:     "Error/IntrospectTokenFailure",
+			tokenRaw: "token",
+			query: models.SearchImproveSuggestionsQuery{
+				Limit: 10,
+			},
+			shouldCallAuthClient: true,
+			authClientErr:        fooErr,
+			expectedErr:          fooErr,
+		},
 		{
 			name: "Error/DAOFailure",
 			query: models.SearchImproveSuggestionsQuery{
@@ -151,6 +372,63 @@ func TestSearchImproveSuggestionsService(t *testing.T) {
 			queryErr:      fooErr,
 			expectedErr:   fooErr,
 		},
+		{
+			name: "Success/WithQueryAndResults",
+			query: models.SearchImproveSuggestionsQuery{
+				Query: "foo bar",
+				Limit: 10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveSuggestionSearchQuery{
+				Query: "foo bar",
+			},
+			queryResults: []*dao.ImproveSuggestionModel{
+				{Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil)},
+			},
+			queryTotal: 1,
+			expectedResults: []*models.ImproveSuggestion{
+				{ID: goframework.NumberUUID(1), CreatedAt: baseTime},
+			},
+			expectedTotal: 1,
+		},
+		{
+			name: "Error/SuggestQueryFailure",
+			query: models.SearchImproveSuggestionsQuery{
+				Query: "foo bar",
+				Limit: 10,
+			},
+			shouldCallDAO: true,
+			shouldCallDAOWithForm: dao.ImproveSuggestionSearchQuery{
+				Query: "foo bar",
+			},
+			shouldCallSuggestQuery: true,
+			suggestQueryErr:        fooErr,
+			expectedErr:            fooErr,
+		},
+		{
+			name: "Error/QueryTooLong",
+			query: models.SearchImproveSuggestionsQuery{
+				Query: strings.Repeat("a", services.MaxSearchQueryLength+1),
+				Limit: 10,
+			},
+			expectedErr: services.ErrInvalidSearchQuery,
+		},
+		{
+			name: "Error/TooManyWords",
+			query: models.SearchImproveSuggestionsQuery{
+				Query: strings.Repeat("foo ", services.MaxSearchQueryWords+1),
+				Limit: 10,
+			},
+			expectedErr: services.ErrInvalidSearchQuery,
+		},
+		{
+			name: "Error/InvalidLanguage",
+			query: models.SearchImproveSuggestionsQuery{
+				Language: "klingon",
+				Limit:    10,
+			},
+			expectedErr: services.ErrInvalidLanguage,
+		},
 		{
 			name:        "Error/NoLimit",
 			expectedErr: goframework.ErrInvalidEntity,
@@ -167,6 +445,11 @@ func TestSearchImproveSuggestionsService(t *testing.T) {
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
 			repository := daomocks.NewImproveSuggestionRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			if d.shouldCallAuthClient {
+				authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+			}
 
 			if d.shouldCallDAO {
 				repository.
@@ -174,14 +457,27 @@ func TestSearchImproveSuggestionsService(t *testing.T) {
 					Return(d.queryResults, d.queryTotal, d.queryErr)
 			}
 
-			service := services.NewSearchImproveSuggestionsService(repository)
-			results, total, err := service.Search(context.Background(), d.query)
+			if d.shouldCallSuggestQuery {
+				repository.On("SuggestQuery", context.Background(), d.query.Query).Return(d.suggestQueryResp, d.suggestQueryErr)
+			}
+
+			service := services.NewSearchImproveSuggestionsService(repository, authClient)
+			res, err := service.Search(context.Background(), d.tokenRaw, d.query)
 
 			require.ErrorIs(t, err, d.expectedErr)
-			require.Equal(t, d.expectedResults, results)
-			require.Equal(t, d.expectedTotal, total)
+			if d.expectedErr != nil {
+				require.Nil(t, res)
+			} else {
+				require.Equal(t, &models.SearchImproveSuggestionsResult{
+					Res:        d.expectedResults,
+					Total:      d.expectedTotal,
+					NextCursor: d.expectedNextCursor,
+					DidYouMean: d.expectedDidYouMean,
+				}, res)
+			}
 
 			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
 		})
 	}
 }
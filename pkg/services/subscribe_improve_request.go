@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"time"
+)
+
+type SubscribeImproveRequestService interface {
+	// Subscribe makes the user follow an improvement request, given its source ID.
+	Subscribe(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) error
+}
+
+func NewSubscribeImproveRequestService(
+	repository dao.SubscriptionRepository,
+	requestRepository dao.ImproveRequestRepository,
+	authClient apiclients.AuthClient,
+) SubscribeImproveRequestService {
+	return &subscribeImproveRequestServiceImpl{
+		repository:        repository,
+		requestRepository: requestRepository,
+		authClient:        authClient,
+	}
+}
+
+type subscribeImproveRequestServiceImpl struct {
+	repository        dao.SubscriptionRepository
+	requestRepository dao.ImproveRequestRepository
+	authClient        apiclients.AuthClient
+}
+
+func (s *subscribeImproveRequestServiceImpl) Subscribe(ctx context.Context, tokenRaw string, id uuid.UUID, now time.Time) error {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	request, err := s.requestRepository.Get(ctx, id)
+	if err != nil {
+		return goerrors.Join(ErrGetImproveRequest, err)
+	}
+	if !canView(request.Draft, request.UserID, &token.Token.Payload.ID) {
+		return goerrors.Join(ErrGetImproveRequest, bunovel.ErrNotFound)
+	}
+
+	if err := s.repository.Subscribe(ctx, token.Token.Payload.ID, id, now); err != nil {
+		return goerrors.Join(ErrSubscribe, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestSubscribeImproveRequestService(t *testing.T) {
+	data := []struct {
+		name string
+
+		token string
+		id    uuid.UUID
+		now   time.Time
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallGet bool
+		getResp       *dao.ImproveRequestPreview
+		getErr        error
+
+		shouldCallSubscribe bool
+		subscribeErr        error
+
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(10),
+			now:   baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet:       true,
+			getResp:             &dao.ImproveRequestPreview{UserID: goframework.NumberUUID(200)},
+			shouldCallSubscribe: true,
+		},
+		{
+			name:  "Error/SubscribeFailure",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(10),
+			now:   baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet:       true,
+			getResp:             &dao.ImproveRequestPreview{UserID: goframework.NumberUUID(200)},
+			shouldCallSubscribe: true,
+			subscribeErr:        fooErr,
+			expectErr:           fooErr,
+		},
+		{
+			name:  "Error/RequestNotFound",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(10),
+			now:   baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getErr:        bunovel.ErrNotFound,
+			expectErr:     bunovel.ErrNotFound,
+		},
+		{
+			name:  "Error/DraftOfAnotherUser",
+			token: "tokenRaw",
+			id:    goframework.NumberUUID(10),
+			now:   baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp:       &dao.ImproveRequestPreview{UserID: goframework.NumberUUID(200), Draft: true},
+			expectErr:     bunovel.ErrNotFound,
+		},
+		{
+			name:           "Error/NotAuthenticated",
+			token:          "tokenRaw",
+			id:             goframework.NumberUUID(10),
+			now:            baseTime,
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:          "Error/AuthClientFailure",
+			token:         "tokenRaw",
+			id:            goframework.NumberUUID(10),
+			now:           baseTime,
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewSubscriptionRepository(t)
+			requestRepository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.token).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallGet {
+				requestRepository.On("Get", context.Background(), d.id).Return(d.getResp, d.getErr)
+			}
+
+			if d.shouldCallSubscribe {
+				repository.
+					On("Subscribe", context.Background(), goframework.NumberUUID(100), d.id, d.now).
+					Return(d.subscribeErr)
+			}
+
+			service := services.NewSubscribeImproveRequestService(repository, requestRepository, authClient)
+			err := service.Subscribe(context.Background(), d.token, d.id, d.now)
+
+			require.ErrorIs(t, err, d.expectErr)
+
+			repository.AssertExpectations(t)
+			requestRepository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/dao"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/google/uuid"
+)
+
+type UnsubscribeImproveRequestService interface {
+	// Unsubscribe stops the user from following an improvement request, given its source ID.
+	Unsubscribe(ctx context.Context, tokenRaw string, id uuid.UUID) error
+}
+
+func NewUnsubscribeImproveRequestService(repository dao.SubscriptionRepository, authClient apiclients.AuthClient) UnsubscribeImproveRequestService {
+	return &unsubscribeImproveRequestServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type unsubscribeImproveRequestServiceImpl struct {
+	repository dao.SubscriptionRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *unsubscribeImproveRequestServiceImpl) Unsubscribe(ctx context.Context, tokenRaw string, id uuid.UUID) error {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	if err := s.repository.Unsubscribe(ctx, token.Token.Payload.ID, id); err != nil {
+		return goerrors.Join(ErrUnsubscribe, err)
+	}
+
+	return nil
+}
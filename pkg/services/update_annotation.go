@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"time"
+)
+
+type UpdateAnnotationService interface {
+	Update(ctx context.Context, tokenRaw string, form *models.UpdateAnnotationForm, now time.Time) (*models.Annotation, error)
+}
+
+func NewUpdateAnnotationService(
+	repository dao.AnnotationRepository,
+	authClient apiclients.AuthClient,
+	permissionsClient apiclients.PermissionsClient,
+) UpdateAnnotationService {
+	return &updateAnnotationServiceImpl{
+		repository:        repository,
+		authClient:        authClient,
+		permissionsClient: permissionsClient,
+	}
+}
+
+type updateAnnotationServiceImpl struct {
+	repository        dao.AnnotationRepository
+	authClient        apiclients.AuthClient
+	permissionsClient apiclients.PermissionsClient
+}
+
+func (s *updateAnnotationServiceImpl) Update(ctx context.Context, tokenRaw string, form *models.UpdateAnnotationForm, now time.Time) (*models.Annotation, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	if err := goframework.CheckMinMax(form.Content, MinAnnotationLength, MaxAnnotationLength); err != nil {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidContent, err)
+	}
+
+	annotation, err := s.repository.Get(ctx, form.ID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetAnnotation, err)
+	}
+
+	if annotation.UserID != token.Token.Payload.ID {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
+	}
+
+	if err := s.permissionsClient.HasUserScope(ctx, apiclients.HasUserScopeQuery{
+		UserID: token.Token.Payload.ID,
+		Scope:  apiclients.CanPostImproveSuggestion,
+	}); err != nil {
+		return nil, goerrors.Join(ErrGetScopes, err)
+	}
+
+	annotation, err = s.repository.Update(ctx, form.Content, form.ID, now)
+	if err != nil {
+		return nil, goerrors.Join(ErrUpdateAnnotation, err)
+	}
+
+	return adapters.AnnotationToModel(annotation), nil
+}
@@ -0,0 +1,284 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestUpdateAnnotationService(t *testing.T) {
+	data := []struct {
+		name string
+
+		tokenRaw string
+		form     *models.UpdateAnnotationForm
+		now      time.Time
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallGet bool
+		getResp       *dao.AnnotationModel
+		getErr        error
+
+		shouldCallPermissionsClient bool
+		permissionsClientErr        error
+
+		shouldCallUpdate bool
+		updateResp       *dao.AnnotationModel
+		updateErr        error
+
+		expect    *models.Annotation
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			tokenRaw: "token",
+			form: &models.UpdateAnnotationForm{
+				ID:      goframework.NumberUUID(1),
+				Content: "new content",
+			},
+			now: updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGet: true,
+			getResp: &dao.AnnotationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(20),
+				AnnotationModelCore: dao.AnnotationModelCore{
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 4,
+					EndOffset:   9,
+					Quote:       "quick",
+					Content:     "content",
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallUpdate:            true,
+			updateResp: &dao.AnnotationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, &updateTime),
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(20),
+				AnnotationModelCore: dao.AnnotationModelCore{
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 4,
+					EndOffset:   9,
+					Quote:       "quick",
+					Content:     "new content",
+				},
+			},
+			expect: &models.Annotation{
+				ID:          goframework.NumberUUID(1),
+				CreatedAt:   baseTime,
+				UpdatedAt:   &updateTime,
+				UserID:      goframework.NumberUUID(100),
+				SourceID:    goframework.NumberUUID(20),
+				RevisionID:  goframework.NumberUUID(10),
+				StartOffset: 4,
+				EndOffset:   9,
+				Quote:       "quick",
+				Content:     "new content",
+			},
+		},
+		{
+			name:     "Error/UpdateFailure",
+			tokenRaw: "token",
+			form: &models.UpdateAnnotationForm{
+				ID:      goframework.NumberUUID(1),
+				Content: "new content",
+			},
+			now: updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGet: true,
+			getResp: &dao.AnnotationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(20),
+				AnnotationModelCore: dao.AnnotationModelCore{
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 4,
+					EndOffset:   9,
+					Quote:       "quick",
+					Content:     "content",
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallUpdate:            true,
+			updateErr:                   fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:     "Error/GetPermissions",
+			tokenRaw: "token",
+			form: &models.UpdateAnnotationForm{
+				ID:      goframework.NumberUUID(1),
+				Content: "new content",
+			},
+			now: updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGet: true,
+			getResp: &dao.AnnotationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				SourceID: goframework.NumberUUID(20),
+				AnnotationModelCore: dao.AnnotationModelCore{
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 4,
+					EndOffset:   9,
+					Quote:       "quick",
+					Content:     "content",
+				},
+			},
+			shouldCallPermissionsClient: true,
+			permissionsClientErr:        fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:     "Error/NotTheCreator",
+			tokenRaw: "token",
+			form: &models.UpdateAnnotationForm{
+				ID:      goframework.NumberUUID(1),
+				Content: "new content",
+			},
+			now: updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGet: true,
+			getResp: &dao.AnnotationModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(200),
+				SourceID: goframework.NumberUUID(20),
+				AnnotationModelCore: dao.AnnotationModelCore{
+					RevisionID:  goframework.NumberUUID(10),
+					StartOffset: 4,
+					EndOffset:   9,
+					Quote:       "quick",
+					Content:     "content",
+				},
+			},
+			expectErr: goframework.ErrInvalidCredentials,
+		},
+		{
+			name:     "Error/GetFailure",
+			tokenRaw: "token",
+			form: &models.UpdateAnnotationForm{
+				ID:      goframework.NumberUUID(1),
+				Content: "new content",
+			},
+			now: updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGet: true,
+			getErr:        fooErr,
+			expectErr:     fooErr,
+		},
+		{
+			name:     "Error/NoContent",
+			tokenRaw: "token",
+			form: &models.UpdateAnnotationForm{
+				ID: goframework.NumberUUID(1),
+			},
+			now: updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			expectErr: goframework.ErrInvalidEntity,
+		},
+		{
+			name:     "Error/NotAuthenticated",
+			tokenRaw: "token",
+			form: &models.UpdateAnnotationForm{
+				ID:      goframework.NumberUUID(1),
+				Content: "new content",
+			},
+			now:            updateTime,
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:     "Error/AuthClientFailure",
+			tokenRaw: "token",
+			form: &models.UpdateAnnotationForm{
+				ID:      goframework.NumberUUID(1),
+				Content: "new content",
+			},
+			now:           updateTime,
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewAnnotationRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+			permissionsClient := apiclientsmocks.NewPermissionsClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.tokenRaw).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallGet {
+				repository.On("Get", context.Background(), d.form.ID).Return(d.getResp, d.getErr)
+			}
+
+			if d.shouldCallPermissionsClient {
+				permissionsClient.
+					On("HasUserScope", context.Background(), apiclients.HasUserScopeQuery{
+						UserID: d.authClientResp.Token.Payload.ID,
+						Scope:  apiclients.CanPostImproveSuggestion,
+					}).
+					Return(d.permissionsClientErr)
+			}
+
+			if d.shouldCallUpdate {
+				repository.
+					On("Update", context.Background(), d.form.Content, d.form.ID, d.now).
+					Return(d.updateResp, d.updateErr)
+			}
+
+			service := services.NewUpdateAnnotationService(repository, authClient, permissionsClient)
+			res, err := service.Update(context.Background(), d.tokenRaw, d.form, d.now)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+			permissionsClient.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+)
+
+type UpdateClosePolicyService interface {
+	// Update sets the conditions under which an improvement request is closed automatically. Only the creator of the
+	// request is allowed to update them.
+	Update(ctx context.Context, tokenRaw string, form *models.UpdateClosePolicyForm) (*models.ImproveRequestPreview, error)
+}
+
+func NewUpdateClosePolicyService(repository dao.ImproveRequestRepository, authClient apiclients.AuthClient) UpdateClosePolicyService {
+	return &updateClosePolicyServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type updateClosePolicyServiceImpl struct {
+	repository dao.ImproveRequestRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *updateClosePolicyServiceImpl) Update(ctx context.Context, tokenRaw string, form *models.UpdateClosePolicyForm) (*models.ImproveRequestPreview, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	if form.CloseAfterDays != nil && (*form.CloseAfterDays < 1 || *form.CloseAfterDays > MaxCloseAfterDays) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidClosePolicy)
+	}
+	if form.CloseAfterAccepted != nil && (*form.CloseAfterAccepted < 1 || *form.CloseAfterAccepted > MaxCloseAfterAccepted) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidClosePolicy)
+	}
+
+	request, err := s.repository.Get(ctx, form.ID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequest, err)
+	}
+	if request.UserID != token.Token.Payload.ID {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
+	}
+
+	updated, err := s.repository.SetClosePolicy(ctx, form.ID, form.CloseAfterDays, form.CloseAfterAccepted)
+	if err != nil {
+		return nil, goerrors.Join(ErrUpdateClosePolicy, err)
+	}
+
+	return adapters.ImproveRequestPreviewToModel(updated), nil
+}
@@ -0,0 +1,234 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestUpdateClosePolicyService(t *testing.T) {
+	data := []struct {
+		name string
+
+		token string
+		form  *models.UpdateClosePolicyForm
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallGet bool
+		getResp       *dao.ImproveRequestPreview
+		getErr        error
+
+		shouldCallSetClosePolicy bool
+		setClosePolicyResp       *dao.ImproveRequestPreview
+		setClosePolicyErr        error
+
+		expect    *models.ImproveRequestPreview
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			token: "tokenRaw",
+			form: &models.UpdateClosePolicyForm{
+				ID:                 goframework.NumberUUID(1),
+				CloseAfterDays:     lo.ToPtr(30),
+				CloseAfterAccepted: lo.ToPtr(3),
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet:            true,
+			getResp:                  &dao.ImproveRequestPreview{UserID: goframework.NumberUUID(100)},
+			shouldCallSetClosePolicy: true,
+			setClosePolicyResp: &dao.ImproveRequestPreview{
+				Metadata:           bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:             goframework.NumberUUID(100),
+				Title:              "title",
+				Content:            "content",
+				RevisionCount:      1,
+				Status:             dao.RequestStatusOpen,
+				CloseAfterDays:     lo.ToPtr(30),
+				CloseAfterAccepted: lo.ToPtr(3),
+			},
+			expect: &models.ImproveRequestPreview{
+				ID:                 goframework.NumberUUID(1),
+				CreatedAt:          baseTime,
+				UserID:             goframework.NumberUUID(100),
+				Title:              "title",
+				Content:            "content",
+				RevisionCount:      1,
+				Status:             models.RequestStatusOpen,
+				CloseAfterDays:     lo.ToPtr(30),
+				CloseAfterAccepted: lo.ToPtr(3),
+			},
+		},
+		{
+			name:  "Success/Disable",
+			token: "tokenRaw",
+			form: &models.UpdateClosePolicyForm{
+				ID: goframework.NumberUUID(1),
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet:            true,
+			getResp:                  &dao.ImproveRequestPreview{UserID: goframework.NumberUUID(100)},
+			shouldCallSetClosePolicy: true,
+			setClosePolicyResp: &dao.ImproveRequestPreview{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:   goframework.NumberUUID(100),
+				Status:   dao.RequestStatusOpen,
+			},
+			expect: &models.ImproveRequestPreview{
+				ID:        goframework.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    goframework.NumberUUID(100),
+				Status:    models.RequestStatusOpen,
+			},
+		},
+		{
+			name:  "Error/SetClosePolicyFailure",
+			token: "tokenRaw",
+			form: &models.UpdateClosePolicyForm{
+				ID:             goframework.NumberUUID(1),
+				CloseAfterDays: lo.ToPtr(30),
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet:            true,
+			getResp:                  &dao.ImproveRequestPreview{UserID: goframework.NumberUUID(100)},
+			shouldCallSetClosePolicy: true,
+			setClosePolicyErr:        fooErr,
+			expectErr:                fooErr,
+		},
+		{
+			name:  "Error/NotTheCreator",
+			token: "tokenRaw",
+			form: &models.UpdateClosePolicyForm{
+				ID:             goframework.NumberUUID(1),
+				CloseAfterDays: lo.ToPtr(30),
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp:       &dao.ImproveRequestPreview{UserID: goframework.NumberUUID(101)},
+			expectErr:     services.ErrNotTheCreator,
+		},
+		{
+			name:  "Error/GetFailure",
+			token: "tokenRaw",
+			form: &models.UpdateClosePolicyForm{
+				ID:             goframework.NumberUUID(1),
+				CloseAfterDays: lo.ToPtr(30),
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getErr:        bunovel.ErrNotFound,
+			expectErr:     bunovel.ErrNotFound,
+		},
+		{
+			name:  "Error/InvalidDays",
+			token: "tokenRaw",
+			form: &models.UpdateClosePolicyForm{
+				ID:             goframework.NumberUUID(1),
+				CloseAfterDays: lo.ToPtr(0),
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			expectErr: services.ErrInvalidClosePolicy,
+		},
+		{
+			name:  "Error/TooManyDays",
+			token: "tokenRaw",
+			form: &models.UpdateClosePolicyForm{
+				ID:             goframework.NumberUUID(1),
+				CloseAfterDays: lo.ToPtr(services.MaxCloseAfterDays + 1),
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			expectErr: services.ErrInvalidClosePolicy,
+		},
+		{
+			name:  "Error/InvalidAccepted",
+			token: "tokenRaw",
+			form: &models.UpdateClosePolicyForm{
+				ID:                 goframework.NumberUUID(1),
+				CloseAfterAccepted: lo.ToPtr(-1),
+			},
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			expectErr: services.ErrInvalidClosePolicy,
+		},
+		{
+			name:  "Error/NotAuthenticated",
+			token: "tokenRaw",
+			form: &models.UpdateClosePolicyForm{
+				ID: goframework.NumberUUID(1),
+			},
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:  "Error/AuthClientFailure",
+			token: "tokenRaw",
+			form: &models.UpdateClosePolicyForm{
+				ID: goframework.NumberUUID(1),
+			},
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.token).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallGet {
+				repository.On("Get", context.Background(), d.form.ID).Return(d.getResp, d.getErr)
+			}
+
+			if d.shouldCallSetClosePolicy {
+				repository.
+					On("SetClosePolicy", context.Background(), d.form.ID, d.form.CloseAfterDays, d.form.CloseAfterAccepted).
+					Return(d.setClosePolicyResp, d.setClosePolicyErr)
+			}
+
+			service := services.NewUpdateClosePolicyService(repository, authClient)
+			res, err := service.Update(context.Background(), d.token, d.form)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+		})
+	}
+}
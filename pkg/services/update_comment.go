@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"time"
+)
+
+type UpdateCommentService interface {
+	Update(ctx context.Context, tokenRaw string, form *models.UpdateCommentForm, now time.Time) (*models.Comment, error)
+}
+
+func NewUpdateCommentService(
+	repository dao.CommentRepository,
+	authClient apiclients.AuthClient,
+	permissionsClient apiclients.PermissionsClient,
+) UpdateCommentService {
+	return &updateCommentServiceImpl{
+		repository:        repository,
+		authClient:        authClient,
+		permissionsClient: permissionsClient,
+	}
+}
+
+type updateCommentServiceImpl struct {
+	repository        dao.CommentRepository
+	authClient        apiclients.AuthClient
+	permissionsClient apiclients.PermissionsClient
+}
+
+func (s *updateCommentServiceImpl) Update(ctx context.Context, tokenRaw string, form *models.UpdateCommentForm, now time.Time) (*models.Comment, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	if err := goframework.CheckMinMax(form.Content, MinCommentLength, MaxCommentLength); err != nil {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidContent, err)
+	}
+
+	comment, err := s.repository.Get(ctx, form.ID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetComment, err)
+	}
+
+	if comment.UserID != token.Token.Payload.ID {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
+	}
+
+	if err := s.permissionsClient.HasUserScope(ctx, commentScopeQuery(token.Token.Payload.ID, string(comment.TargetType))); err != nil {
+		return nil, goerrors.Join(ErrGetScopes, err)
+	}
+
+	comment, err = s.repository.Update(ctx, form.Content, form.ID, now)
+	if err != nil {
+		return nil, goerrors.Join(ErrUpdateComment, err)
+	}
+
+	return adapters.CommentToModel(comment), nil
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+	apiclients "github.com/a-novel/go-apis/clients"
+	goframework "github.com/a-novel/go-framework"
+	"time"
+)
+
+type UpdateImproveRequestStatusService interface {
+	// Update moves an improvement request to a new lifecycle state, for example to close or reopen it. Only the
+	// creator of the request is allowed to update it.
+	Update(ctx context.Context, tokenRaw string, form *models.UpdateImproveRequestStatusForm, now time.Time) (*models.ImproveRequestPreview, error)
+}
+
+func NewUpdateImproveRequestStatusService(repository dao.ImproveRequestRepository, authClient apiclients.AuthClient) UpdateImproveRequestStatusService {
+	return &updateImproveRequestStatusServiceImpl{
+		repository: repository,
+		authClient: authClient,
+	}
+}
+
+type updateImproveRequestStatusServiceImpl struct {
+	repository dao.ImproveRequestRepository
+	authClient apiclients.AuthClient
+}
+
+func (s *updateImproveRequestStatusServiceImpl) Update(ctx context.Context, tokenRaw string, form *models.UpdateImproveRequestStatusForm, now time.Time) (*models.ImproveRequestPreview, error) {
+	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrInvalidToken)
+	}
+
+	if !validRequestStatus(form.Status) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidRequestStatus)
+	}
+
+	request, err := s.repository.Get(ctx, form.ID)
+	if err != nil {
+		return nil, goerrors.Join(ErrGetImproveRequest, err)
+	}
+	if request.UserID != token.Token.Payload.ID {
+		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
+	}
+
+	updated, err := s.repository.SetStatus(ctx, form.ID, dao.RequestStatus(form.Status), now)
+	if err != nil {
+		return nil, goerrors.Join(ErrUpdateImproveRequestStatus, err)
+	}
+
+	return adapters.ImproveRequestPreviewToModel(updated), nil
+}
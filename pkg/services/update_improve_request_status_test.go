@@ -0,0 +1,198 @@
+package services_test
+
+import (
+	"context"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/services"
+	apiclients "github.com/a-novel/go-apis/clients"
+	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestUpdateImproveRequestStatusService(t *testing.T) {
+	data := []struct {
+		name string
+
+		token string
+		form  *models.UpdateImproveRequestStatusForm
+		now   time.Time
+
+		authClientResp *apiclients.UserTokenStatus
+		authClientErr  error
+
+		shouldCallGet bool
+		getResp       *dao.ImproveRequestPreview
+		getErr        error
+
+		shouldCallSetStatus bool
+		setStatusResp       *dao.ImproveRequestPreview
+		setStatusErr        error
+
+		expect    *models.ImproveRequestPreview
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			token: "tokenRaw",
+			form: &models.UpdateImproveRequestStatusForm{
+				ID:     goframework.NumberUUID(1),
+				Status: models.RequestStatusClosed,
+			},
+			now: updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+				Status: dao.RequestStatusOpen,
+			},
+			shouldCallSetStatus: true,
+			setStatusResp: &dao.ImproveRequestPreview{
+				Metadata:        bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				UserID:          goframework.NumberUUID(100),
+				Title:           "title",
+				Content:         "content",
+				RevisionCount:   1,
+				Status:          dao.RequestStatusClosed,
+				StatusUpdatedAt: &updateTime,
+			},
+			expect: &models.ImproveRequestPreview{
+				ID:            goframework.NumberUUID(1),
+				CreatedAt:     baseTime,
+				UserID:        goframework.NumberUUID(100),
+				Title:         "title",
+				Content:       "content",
+				RevisionCount: 1,
+				Status:        models.RequestStatusClosed,
+			},
+		},
+		{
+			name:  "Error/SetStatusFailure",
+			token: "tokenRaw",
+			form: &models.UpdateImproveRequestStatusForm{
+				ID:     goframework.NumberUUID(1),
+				Status: models.RequestStatusOpen,
+			},
+			now: updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(100),
+				Status: dao.RequestStatusClosed,
+			},
+			shouldCallSetStatus: true,
+			setStatusErr:        fooErr,
+			expectErr:           fooErr,
+		},
+		{
+			name:  "Error/NotTheCreator",
+			token: "tokenRaw",
+			form: &models.UpdateImproveRequestStatusForm{
+				ID:     goframework.NumberUUID(1),
+				Status: models.RequestStatusClosed,
+			},
+			now: updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getResp: &dao.ImproveRequestPreview{
+				UserID: goframework.NumberUUID(101),
+				Status: dao.RequestStatusOpen,
+			},
+			expectErr: services.ErrNotTheCreator,
+		},
+		{
+			name:  "Error/GetFailure",
+			token: "tokenRaw",
+			form: &models.UpdateImproveRequestStatusForm{
+				ID:     goframework.NumberUUID(1),
+				Status: models.RequestStatusClosed,
+			},
+			now: updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			shouldCallGet: true,
+			getErr:        bunovel.ErrNotFound,
+			expectErr:     bunovel.ErrNotFound,
+		},
+		{
+			name:  "Error/InvalidStatus",
+			token: "tokenRaw",
+			form: &models.UpdateImproveRequestStatusForm{
+				ID:     goframework.NumberUUID(1),
+				Status: "paused",
+			},
+			now: updateTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK:    true,
+				Token: &apiclients.UserToken{Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)}},
+			},
+			expectErr: services.ErrInvalidRequestStatus,
+		},
+		{
+			name:  "Error/NotAuthenticated",
+			token: "tokenRaw",
+			form: &models.UpdateImproveRequestStatusForm{
+				ID:     goframework.NumberUUID(1),
+				Status: models.RequestStatusClosed,
+			},
+			now:            updateTime,
+			authClientResp: &apiclients.UserTokenStatus{},
+			expectErr:      goframework.ErrInvalidCredentials,
+		},
+		{
+			name:  "Error/AuthClientFailure",
+			token: "tokenRaw",
+			form: &models.UpdateImproveRequestStatusForm{
+				ID:     goframework.NumberUUID(1),
+				Status: models.RequestStatusClosed,
+			},
+			now:           updateTime,
+			authClientErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewImproveRequestRepository(t)
+			authClient := apiclientsmocks.NewAuthClient(t)
+
+			authClient.On("IntrospectToken", context.Background(), d.token).Return(d.authClientResp, d.authClientErr)
+
+			if d.shouldCallGet {
+				repository.On("Get", context.Background(), d.form.ID).Return(d.getResp, d.getErr)
+			}
+
+			if d.shouldCallSetStatus {
+				repository.
+					On("SetStatus", context.Background(), d.form.ID, dao.RequestStatus(d.form.Status), d.now).
+					Return(d.setStatusResp, d.setStatusErr)
+			}
+
+			service := services.NewUpdateImproveRequestStatusService(repository, authClient)
+			res, err := service.Update(context.Background(), d.token, d.form, d.now)
+
+			require.ErrorIs(t, err, d.expectErr)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+			authClient.AssertExpectations(t)
+		})
+	}
+}
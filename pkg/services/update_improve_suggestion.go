@@ -3,9 +3,11 @@ package services
 import (
 	"context"
 	goerrors "errors"
+	"github.com/a-novel/bunovel"
 	"github.com/a-novel/forum-service/pkg/adapters"
 	"github.com/a-novel/forum-service/pkg/dao"
 	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/ratelimit"
 	apiclients "github.com/a-novel/go-apis/clients"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
@@ -13,6 +15,9 @@ import (
 )
 
 type UpdateImproveSuggestionService interface {
+	// Update edits a suggestion. Users are throttled, according to the quota of
+	// ratelimit.ActionUpdateImproveSuggestion. A suggestion identical to its revision is rejected, and the edit stats
+	// of the suggestion are measured again.
 	Update(ctx context.Context, tokenRaw string, suggestion *models.ImproveSuggestionForm, id uuid.UUID, now time.Time) (*models.ImproveSuggestion, error)
 }
 
@@ -21,12 +26,14 @@ func NewUpdateImproveSuggestionService(
 	requestRepository dao.ImproveRequestRepository,
 	authClient apiclients.AuthClient,
 	permissionsClient apiclients.PermissionsClient,
+	limiter ratelimit.Limiter,
 ) UpdateImproveSuggestionService {
 	return &updateImproveSuggestionServiceImpl{
 		repository:        repository,
 		requestRepository: requestRepository,
 		authClient:        authClient,
 		permissionsClient: permissionsClient,
+		limiter:           limiter,
 	}
 }
 
@@ -35,6 +42,7 @@ type updateImproveSuggestionServiceImpl struct {
 	requestRepository dao.ImproveRequestRepository
 	authClient        apiclients.AuthClient
 	permissionsClient apiclients.PermissionsClient
+	limiter           ratelimit.Limiter
 }
 
 func (s *updateImproveSuggestionServiceImpl) Update(ctx context.Context, tokenRaw string, form *models.ImproveSuggestionForm, id uuid.UUID, now time.Time) (*models.ImproveSuggestion, error) {
@@ -67,8 +75,11 @@ func (s *updateImproveSuggestionServiceImpl) Update(ctx context.Context, tokenRa
 	if err != nil {
 		return nil, goerrors.Join(ErrGetImproveRequestRevision, err)
 	}
+	if !canView(revision.Draft, revision.UserID, &token.Token.Payload.ID) {
+		return nil, goerrors.Join(ErrGetImproveRequestRevision, bunovel.ErrNotFound)
+	}
 
-	suggestion, err := s.repository.Get(ctx, form.RequestID)
+	suggestion, err := s.repository.Get(ctx, id)
 	if err != nil {
 		return nil, goerrors.Join(ErrGetImproveSuggestion, err)
 	}
@@ -81,7 +92,15 @@ func (s *updateImproveSuggestionServiceImpl) Update(ctx context.Context, tokenRa
 		return nil, goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
 	}
 
-	suggestion, err = s.repository.Update(ctx, adapters.ImproveSuggestionFormToDAO(form), id, now)
+	if sameAsRevision(form, revision) {
+		return nil, goerrors.Join(goframework.ErrInvalidEntity, ErrIdenticalSuggestion)
+	}
+
+	if err := throttle(ctx, s.limiter, token.Token.Payload.ID, ratelimit.ActionUpdateImproveSuggestion, now); err != nil {
+		return nil, err
+	}
+
+	suggestion, err = s.repository.Update(ctx, improveSuggestionToDAO(form, revision), id, now)
 	if err != nil {
 		return nil, goerrors.Join(ErrUpdateImproveSuggestion, err)
 	}
@@ -6,11 +6,13 @@ import (
 	"github.com/a-novel/forum-service/pkg/dao"
 	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
 	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/ratelimit"
 	"github.com/a-novel/forum-service/pkg/services"
 	apiclients "github.com/a-novel/go-apis/clients"
 	apiclientsmocks "github.com/a-novel/go-apis/clients/mocks"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"strings"
@@ -27,6 +29,8 @@ func TestUpdateImproveSuggestionService(t *testing.T) {
 		id         uuid.UUID
 		now        time.Time
 
+		rateLimited bool
+
 		authClientResp *apiclients.UserTokenStatus
 		authClientErr  error
 
@@ -42,6 +46,7 @@ func TestUpdateImproveSuggestionService(t *testing.T) {
 		getSuggestionErr        error
 
 		shouldCallUpdateSuggestion bool
+		updateSuggestionData       *dao.ImproveSuggestionModelCore
 		updateSuggestionResp       *dao.ImproveSuggestionModel
 		updateSuggestionErr        error
 
@@ -101,6 +106,111 @@ func TestUpdateImproveSuggestionService(t *testing.T) {
 				Content:   "content",
 			},
 		},
+		{
+			name: "Success/EditStats",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "The slow brown fox.",
+			},
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				Title:    "title",
+				Content:  "The quick brown fox.",
+			},
+			shouldCallGetSuggestion: true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(2),
+					Title:     "old title",
+					Content:   "old content",
+				},
+			},
+			shouldCallUpdateSuggestion: true,
+			updateSuggestionData: &dao.ImproveSuggestionModelCore{
+				RequestID:    goframework.NumberUUID(1),
+				Title:        "title",
+				Content:      "The slow brown fox.",
+				AddedChars:   lo.ToPtr(4),
+				RemovedChars: lo.ToPtr(5),
+				ChangedRatio: lo.ToPtr(9.0 / 39.0),
+			},
+			updateSuggestionResp: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID:    goframework.NumberUUID(1),
+					Title:        "title",
+					Content:      "The slow brown fox.",
+					AddedChars:   lo.ToPtr(4),
+					RemovedChars: lo.ToPtr(5),
+					ChangedRatio: lo.ToPtr(9.0 / 39.0),
+				},
+			},
+			expect: &models.ImproveSuggestion{
+				ID:           goframework.NumberUUID(1),
+				CreatedAt:    baseTime,
+				SourceID:     goframework.NumberUUID(10),
+				UserID:       goframework.NumberUUID(100),
+				RequestID:    goframework.NumberUUID(1),
+				Title:        "title",
+				Content:      "The slow brown fox.",
+				AddedChars:   lo.ToPtr(4),
+				RemovedChars: lo.ToPtr(5),
+				ChangedRatio: lo.ToPtr(9.0 / 39.0),
+			},
+		},
+		{
+			name: "Error/IdenticalSuggestion",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "The quick brown fox.",
+			},
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				Title:    "title",
+				Content:  "The quick brown fox.",
+			},
+			shouldCallGetSuggestion: true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{
+					RequestID: goframework.NumberUUID(2),
+					Title:     "old title",
+					Content:   "old content",
+				},
+			},
+			expectErr: services.ErrIdenticalSuggestion,
+		},
 		{
 			name: "Error/UpdateSuggestionFailure",
 			suggestion: &models.ImproveSuggestionForm{
@@ -196,6 +306,31 @@ func TestUpdateImproveSuggestionService(t *testing.T) {
 			getSuggestionErr:        fooErr,
 			expectErr:               fooErr,
 		},
+		{
+			name: "Error/DraftRevision",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+			tokenRaw: "token",
+			id:       goframework.NumberUUID(1),
+			now:      baseTime,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(200),
+				Draft:    true,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
 		{
 			name: "Error/GetRevisionError",
 			suggestion: &models.ImproveSuggestionForm{
@@ -217,6 +352,36 @@ func TestUpdateImproveSuggestionService(t *testing.T) {
 			getRevisionErr:              fooErr,
 			expectErr:                   fooErr,
 		},
+		{
+			name: "Error/RateLimited",
+			suggestion: &models.ImproveSuggestionForm{
+				RequestID: goframework.NumberUUID(1),
+				Title:     "title",
+				Content:   "content",
+			},
+			tokenRaw:    "token",
+			id:          goframework.NumberUUID(1),
+			now:         baseTime,
+			rateLimited: true,
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallPermissionsClient: true,
+			shouldCallGetRevision:       true,
+			getRevisionResp: &dao.ImproveRequestRevisionModel{
+				SourceID: goframework.NumberUUID(10),
+			},
+			shouldCallGetSuggestion: true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				Metadata: bunovel.NewMetadata(goframework.NumberUUID(1), baseTime, nil),
+				SourceID: goframework.NumberUUID(10),
+				UserID:   goframework.NumberUUID(100),
+			},
+			expectErr: services.ErrRateLimited,
+		},
 		{
 			name:     "Error/BadTitle",
 			tokenRaw: "token",
@@ -422,17 +587,30 @@ func TestUpdateImproveSuggestionService(t *testing.T) {
 
 			if d.shouldCallGetSuggestion {
 				repository.
-					On("Get", context.Background(), d.suggestion.RequestID).
+					On("Get", context.Background(), d.id).
 					Return(d.getSuggestionResp, d.getSuggestionErr)
 			}
 
 			if d.shouldCallUpdateSuggestion {
+				var data interface{} = mock.Anything
+				if d.updateSuggestionData != nil {
+					data = d.updateSuggestionData
+				}
+
 				repository.
-					On("Update", context.Background(), mock.Anything, d.id, d.now).
+					On("Update", context.Background(), data, d.id, d.now).
 					Return(d.updateSuggestionResp, d.updateSuggestionErr)
 			}
 
-			service := services.NewUpdateImproveSuggestionService(repository, requestsRepository, authClient, permissionsClient)
+			limiter := ratelimit.NewMemoryLimiter(ratelimit.Quotas{
+				ratelimit.ActionUpdateImproveSuggestion: {Limit: 1, Window: time.Hour},
+			})
+			if d.rateLimited {
+				_, err := limiter.Allow(context.Background(), d.authClientResp.Token.Payload.ID, ratelimit.ActionUpdateImproveSuggestion, d.now)
+				require.NoError(t, err)
+			}
+
+			service := services.NewUpdateImproveSuggestionService(repository, requestsRepository, authClient, permissionsClient, limiter)
 			resp, err := service.Update(context.Background(), d.tokenRaw, d.suggestion, d.id, d.now)
 
 			require.ErrorIs(t, err, d.expectErr)
@@ -1,44 +1,136 @@
 package services
 
 import (
+	"context"
 	goerrors "errors"
+	"fmt"
+	"github.com/a-novel/bunovel"
+	"github.com/a-novel/forum-service/pkg/adapters"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/diff"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/ratelimit"
+	apiclients "github.com/a-novel/go-apis/clients"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"math"
 	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
+// CanModerate is the scope required to review reports, and to act on the reported content.
+const CanModerate apiclients.Scope = "can_moderate"
+
 var (
 	// Just prevents line breaks in title.
 	titleRegexp = regexp.MustCompile(`^[^\n\r]+$`)
+	// Lowercase words, joined by dashes.
+	tagRegexp = regexp.MustCompile(`^[\p{Ll}\p{Nd}]+(-[\p{Ll}\p{Nd}]+)*$`)
 )
 
 var (
-	ErrNotTheCreator = goerrors.New("only the source post creator is allowed to perform this action")
-	ErrTheCreator    = goerrors.New("the source post creator is not allowed to perform this action")
-	ErrSwitchSource  = goerrors.New("the new improve request id is on a different source than the original one")
+	ErrNotTheCreator              = goerrors.New("only the source post creator is allowed to perform this action")
+	ErrTheCreator                 = goerrors.New("the source post creator is not allowed to perform this action")
+	ErrSwitchSource               = goerrors.New("the new improve request id is on a different source than the original one")
+	ErrSwitchTarget               = goerrors.New("the parent comment is attached to a different target")
+	ErrSourceMismatch             = goerrors.New("the compared revisions belong to different improve requests")
+	ErrSuggestionSourceMismatch   = goerrors.New("the suggestion belongs to a different improve request")
+	ErrSuggestionRevisionMismatch = goerrors.New("the suggestion was made on a different revision")
+	ErrSuggestionAlreadyApplied   = goerrors.New("the suggestion was already applied")
+	ErrReportClaimed              = goerrors.New("the report is claimed by another moderator")
+	ErrReportResolved             = goerrors.New("the report is already resolved")
+	ErrNotDraft                   = goerrors.New("the content is already published")
+	ErrRequestNotOpen             = goerrors.New("the improve request is not open for suggestions")
+	ErrRateLimited                = goerrors.New("(data) action quota exhausted, try again later")
+	ErrIdenticalSuggestion        = goerrors.New("the suggestion is identical to the revision it improves")
 
 	ErrInvalidToken       = goerrors.New("(data) invalid tokenRaw")
 	ErrInvalidTitle       = goerrors.New("(data) invalid title")
 	ErrInvalidContent     = goerrors.New("(data) invalid content")
 	ErrInvalidSearchLimit = goerrors.New("(data) invalid search limit")
+	// ErrInvalidSearchCursor is also returned when a cursor is combined with an offset.
+	ErrInvalidSearchCursor  = goerrors.New("(data) invalid search cursor")
+	ErrInvalidSearchFilters = goerrors.New("(data) invalid search filters")
+	ErrInvalidSearchQuery   = goerrors.New("(data) invalid search query")
+	ErrInvalidTargetType    = goerrors.New("(data) invalid target type")
+	ErrInvalidVote          = goerrors.New("(data) invalid vote")
+	ErrInvalidAnchor        = goerrors.New("(data) invalid anchor")
+	ErrInvalidSuggestions   = goerrors.New("(data) invalid suggestions list")
+	ErrInvalidReason        = goerrors.New("(data) invalid report reason")
+	ErrInvalidAction        = goerrors.New("(data) invalid moderation action")
+	ErrInvalidStatus        = goerrors.New("(data) invalid report status")
+	ErrInvalidRetention     = goerrors.New("(data) invalid retention period")
+	ErrInvalidPolicy        = goerrors.New("(data) invalid suggestions policy")
+	ErrInvalidLanguage      = goerrors.New("(data) invalid language")
+	ErrInvalidTags          = goerrors.New("(data) invalid tags")
+	ErrInvalidRequestStatus = goerrors.New("(data) invalid improve request status")
+	ErrInvalidClosePolicy   = goerrors.New("(data) invalid close policy")
 
 	ErrIntrospectToken = goerrors.New("(dep) failed to introspect tokenRaw")
 	ErrGetScopes       = goerrors.New("(dep) failed to get scopes")
+	ErrCheckRateLimit  = goerrors.New("(dep) failed to check rate limit")
 
-	ErrListImproveRequestRevisions  = goerrors.New("(dao) failed to list improve request revisions")
-	ErrGetImproveRequestRevision    = goerrors.New("(dao) failed to get improve request revision")
-	ErrCreateImproveRequest         = goerrors.New("(dao) failed to create improve request")
-	ErrDeleteImproveRequest         = goerrors.New("(dao) failed to delete improve request")
-	ErrListImproveRequests          = goerrors.New("(dao) failed to list improve requests")
-	ErrSearchImproveRequests        = goerrors.New("(dao) failed to search improve requests")
-	ErrUpdateImproveRequestRevision = goerrors.New("(dao) failed to update improve request revision")
-	ErrGetImproveSuggestion         = goerrors.New("(dao) failed to get improve suggestion")
-	ErrCreateImproveSuggestion      = goerrors.New("(dao) failed to create improve suggestions")
-	ErrUpdateImproveSuggestion      = goerrors.New("(dao) failed to update improve suggestions")
-	ErrDeleteImproveSuggestion      = goerrors.New("(dao) failed to delete improve suggestions")
-	ErrSearchImproveSuggestions     = goerrors.New("(dao) failed to search improve suggestions")
-	ErrListImproveSuggestions       = goerrors.New("(dao) failed to list improve suggestions")
-	ErrValidateImproveSuggestion    = goerrors.New("(dao) failed to validate improve suggestions")
-	ErrGetImproveRequest            = goerrors.New("(dao) failed to get improve request")
-	ErrDeleteImproveRequestRevision = goerrors.New("(dao) failed to delete improve request revision")
+	ErrListImproveRequestRevisions   = goerrors.New("(dao) failed to list improve request revisions")
+	ErrGetImproveRequestRevision     = goerrors.New("(dao) failed to get improve request revision")
+	ErrCreateImproveRequest          = goerrors.New("(dao) failed to create improve request")
+	ErrDeleteImproveRequest          = goerrors.New("(dao) failed to delete improve request")
+	ErrListImproveRequests           = goerrors.New("(dao) failed to list improve requests")
+	ErrSearchImproveRequests         = goerrors.New("(dao) failed to search improve requests")
+	ErrUpdateImproveRequestRevision  = goerrors.New("(dao) failed to update improve request revision")
+	ErrGetImproveSuggestion          = goerrors.New("(dao) failed to get improve suggestion")
+	ErrCreateImproveSuggestion       = goerrors.New("(dao) failed to create improve suggestions")
+	ErrUpdateImproveSuggestion       = goerrors.New("(dao) failed to update improve suggestions")
+	ErrDeleteImproveSuggestion       = goerrors.New("(dao) failed to delete improve suggestions")
+	ErrSearchImproveSuggestions      = goerrors.New("(dao) failed to search improve suggestions")
+	ErrSuggestSearchQuery            = goerrors.New("(dao) failed to suggest search query")
+	ErrListImproveSuggestions        = goerrors.New("(dao) failed to list improve suggestions")
+	ErrValidateImproveSuggestion     = goerrors.New("(dao) failed to validate improve suggestions")
+	ErrApplyImproveSuggestion        = goerrors.New("(dao) failed to apply improve suggestion")
+	ErrGetImproveRequest             = goerrors.New("(dao) failed to get improve request")
+	ErrDeleteImproveRequestRevision  = goerrors.New("(dao) failed to delete improve request revision")
+	ErrGetComment                    = goerrors.New("(dao) failed to get comment")
+	ErrCreateComment                 = goerrors.New("(dao) failed to create comment")
+	ErrUpdateComment                 = goerrors.New("(dao) failed to update comment")
+	ErrDeleteComment                 = goerrors.New("(dao) failed to delete comment")
+	ErrListComments                  = goerrors.New("(dao) failed to list comments")
+	ErrVote                          = goerrors.New("(dao) failed to vote")
+	ErrListUserVotes                 = goerrors.New("(dao) failed to list user votes")
+	ErrGetAnnotation                 = goerrors.New("(dao) failed to get annotation")
+	ErrCreateAnnotation              = goerrors.New("(dao) failed to create annotation")
+	ErrUpdateAnnotation              = goerrors.New("(dao) failed to update annotation")
+	ErrDeleteAnnotation              = goerrors.New("(dao) failed to delete annotation")
+	ErrListAnnotations               = goerrors.New("(dao) failed to list annotations")
+	ErrListEvents                    = goerrors.New("(dao) failed to list events")
+	ErrMarkEvent                     = goerrors.New("(dao) failed to mark event")
+	ErrListNotifications             = goerrors.New("(dao) failed to list notifications")
+	ErrCountUnreadNotifications      = goerrors.New("(dao) failed to count unread notifications")
+	ErrMarkNotificationRead          = goerrors.New("(dao) failed to mark notification as read")
+	ErrMarkAllNotificationsRead      = goerrors.New("(dao) failed to mark all notifications as read")
+	ErrSubscribe                     = goerrors.New("(dao) failed to subscribe to improve request")
+	ErrUnsubscribe                   = goerrors.New("(dao) failed to unsubscribe from improve request")
+	ErrGetReport                     = goerrors.New("(dao) failed to get report")
+	ErrCreateReport                  = goerrors.New("(dao) failed to create report")
+	ErrListReports                   = goerrors.New("(dao) failed to list reports")
+	ErrClaimReport                   = goerrors.New("(dao) failed to claim report")
+	ErrResolveReport                 = goerrors.New("(dao) failed to resolve report")
+	ErrRestoreImproveRequest         = goerrors.New("(dao) failed to restore improve request")
+	ErrRestoreImproveRequestRevision = goerrors.New("(dao) failed to restore improve request revision")
+	ErrRestoreImproveSuggestion      = goerrors.New("(dao) failed to restore improve suggestion")
+	ErrPurgeImproveRequests          = goerrors.New("(dao) failed to purge improve requests")
+	ErrPurgeImproveSuggestions       = goerrors.New("(dao) failed to purge improve suggestions")
+	ErrFindOrphans                   = goerrors.New("(dao) failed to find orphans")
+	ErrRepairOrphans                 = goerrors.New("(dao) failed to repair orphans")
+	ErrValidateConstraints           = goerrors.New("(dao) failed to validate constraints")
+	ErrListTags                      = goerrors.New("(dao) failed to list tags")
+	ErrPublishImproveRequestRevision = goerrors.New("(dao) failed to publish improve request revision")
+	ErrPublishImproveSuggestion      = goerrors.New("(dao) failed to publish improve suggestion")
+	ErrUpdateImproveRequestStatus    = goerrors.New("(dao) failed to update improve request status")
+	ErrUpdateClosePolicy             = goerrors.New("(dao) failed to update improve request close policy")
+	ErrAutoCloseImproveRequests      = goerrors.New("(dao) failed to auto close improve requests")
+	ErrFindDuplicate                 = goerrors.New("(dao) failed to look for duplicates")
 )
 
 const (
@@ -46,6 +138,231 @@ const (
 	MaxTitleLength   = 128
 	MinContentLength = 4
 	MaxContentLength = 4096
+	MinCommentLength = 1
+	MaxCommentLength = 2048
+	MinVote          = -1
+	MaxVote          = 1
+
+	MinAnnotationLength = 1
+	MaxAnnotationLength = 2048
+
+	MaxMergedSuggestions = 20
+
+	MaxReportLength = 2048
+
+	// DispatchBatchSize is the maximum number of events sent by a single dispatch.
+	DispatchBatchSize = 100
+	// MaxEventAttempts is the number of failed deliveries after which an event is no longer sent.
+	MaxEventAttempts = 10
+	// EventClaimLease is how long a dispatcher holds the events it is delivering. Past this delay, the events it has
+	// not marked yet can be claimed by another dispatcher.
+	EventClaimLease = 5 * time.Minute
+
+	// MaxTags is the maximum number of tags of an improvement request.
+	MaxTags      = 5
+	MaxTagLength = 32
+	// MaxSearchTags is the maximum number of tags a search can filter on.
+	MaxSearchTags = 20
 
 	MaxSearchLimit = 100
+	// MaxSearchQueryLength and MaxSearchQueryWords bound the text of a search. Each word of a query without results is
+	// looked up on its own, to suggest a correction.
+	MaxSearchQueryLength = 256
+	MaxSearchQueryWords  = 8
+	// MaxCloseAfterDays and MaxCloseAfterAccepted bound the close policy of an improvement request.
+	MaxCloseAfterDays     = 365
+	MaxCloseAfterAccepted = 100
+	// ExcerptLength is the maximum number of characters of a content excerpt, ellipsis included.
+	ExcerptLength = 280
+	// DuplicateMinSimilarity is the trigram similarity above which a new post is flagged, when it resembles a post
+	// of the same author from the last DuplicateWindow.
+	DuplicateMinSimilarity = 0.8
+	DuplicateWindow        = 30 * 24 * time.Hour
+	// RevisionCopyMinSimilarity is the similarity to its revision above which a suggestion that keeps its title is
+	// flagged: it barely changes the content it is supposed to improve.
+	RevisionCopyMinSimilarity = 0.98
 )
+
+// duplicateQuery compares a new post with the recent posts of its author.
+func duplicateQuery(userID uuid.UUID, content string, now time.Time) dao.DuplicateQuery {
+	return dao.DuplicateQuery{
+		UserID:        userID,
+		Content:       content,
+		Since:         now.Add(-DuplicateWindow),
+		MinSimilarity: DuplicateMinSimilarity,
+	}
+}
+
+// duplicateReport flags a new post that resembles another post, for the moderators to review. The origin describes
+// the resembling post. The report is filed by the system, along with the new post.
+func duplicateReport(target dao.ReportTarget, id uuid.UUID, duplicate *dao.Duplicate, origin string, now time.Time) *dao.ReportModel {
+	return &dao.ReportModel{
+		Metadata: bunovel.NewMetadata(uuid.New(), now, nil),
+		UserID:   dao.ReportSystemUserID,
+		Status:   dao.ReportStatusOpen,
+		ReportModelCore: dao.ReportModelCore{
+			TargetType: target,
+			TargetID:   id,
+			Reason:     dao.ReportReasonDuplicate,
+			Content: fmt.Sprintf(
+				"%d%% similar to %s, %s.", int(math.Round(duplicate.Similarity*100)), duplicate.ID, origin,
+			),
+		},
+	}
+}
+
+// RetryAfterError is joined to ErrRateLimited. It tells how long the user has to wait before trying again.
+type RetryAfterError struct {
+	Delay time.Duration
+}
+
+func (err *RetryAfterError) Error() string {
+	return fmt.Sprintf("retry after %s", err.Delay)
+}
+
+// throttle records an action of a user, and fails with ErrRateLimited once the user has exhausted its quota.
+func throttle(ctx context.Context, limiter ratelimit.Limiter, userID uuid.UUID, action ratelimit.Action, now time.Time) error {
+	delay, err := limiter.Allow(ctx, userID, action, now)
+	if err != nil {
+		return goerrors.Join(ErrCheckRateLimit, err)
+	}
+	if delay > 0 {
+		return goerrors.Join(ErrRateLimited, &RetryAfterError{Delay: delay})
+	}
+
+	return nil
+}
+
+// commentScopeQuery returns the scope a user needs to post a comment on the given target: commenting on a suggestion
+// requires the same rights as suggesting, commenting on a request requires the same rights as requesting.
+func commentScopeQuery(userID uuid.UUID, targetType string) apiclients.HasUserScopeQuery {
+	if targetType == models.CommentTargetImproveSuggestion {
+		return apiclients.HasUserScopeQuery{UserID: userID, Scope: apiclients.CanPostImproveSuggestion}
+	}
+
+	return apiclients.HasUserScopeQuery{UserID: userID, Scope: apiclients.CanPostImproveRequest}
+}
+
+// moderatorScopeQuery returns the scope a user needs to access the review queue.
+func moderatorScopeQuery(userID uuid.UUID) apiclients.HasUserScopeQuery {
+	return apiclients.HasUserScopeQuery{UserID: userID, Scope: CanModerate}
+}
+
+// viewerID returns the ID of the user reading some content, so their drafts can be shown to them. Anonymous readers,
+// and readers whose token is not valid, get a nil ID: they only see published content.
+func viewerID(ctx context.Context, authClient apiclients.AuthClient, tokenRaw string) (*uuid.UUID, error) {
+	if tokenRaw == "" {
+		return nil, nil
+	}
+
+	token, err := authClient.IntrospectToken(ctx, tokenRaw)
+	if err != nil {
+		return nil, goerrors.Join(ErrIntrospectToken, err)
+	}
+	if !token.OK {
+		return nil, nil
+	}
+
+	return &token.Token.Payload.ID, nil
+}
+
+// canView returns false when the content is a draft, and the viewer is not its author.
+func canView(draft bool, authorID uuid.UUID, viewer *uuid.UUID) bool {
+	return !draft || (viewer != nil && *viewer == authorID)
+}
+
+// validLanguage returns false when a language is set, but not supported.
+func validLanguage(language string) bool {
+	switch language {
+	case "", models.LanguageFrench, models.LanguageEnglish, models.LanguageSpanish:
+		return true
+	default:
+		return false
+	}
+}
+
+// validRequestStatus returns false when a status is not a lifecycle state of the improvement requests.
+func validRequestStatus(status string) bool {
+	switch status {
+	case models.RequestStatusOpen, models.RequestStatusClosed, models.RequestStatusResolved, models.RequestStatusArchived:
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizeTags lowercases tags, and joins their words with dashes, so "Opening Chapter" becomes "opening-chapter".
+// Duplicates are removed. A nil list is kept nil, so it can be told apart from an empty one.
+func normalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	output := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(strings.ToLower(tag)), "-")
+		if !lo.Contains(output, tag) {
+			output = append(output, tag)
+		}
+	}
+
+	return output
+}
+
+// validTags returns false when a list holds too many tags, or a tag that is malformed. Tags must be normalized first.
+func validTags(tags []string, maxTags int) bool {
+	if len(tags) > maxTags {
+		return false
+	}
+
+	for _, tag := range tags {
+		if utf8.RuneCountInString(tag) > MaxTagLength || !tagRegexp.MatchString(tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// excerpt cuts a text after its last word that fits in ExcerptLength characters, and marks the cut with an ellipsis.
+func excerpt(text string) string {
+	runes := []rune(text)
+	if len(runes) <= ExcerptLength {
+		return text
+	}
+
+	cut := string(runes[:ExcerptLength-1])
+	if i := strings.LastIndexFunc(cut, unicode.IsSpace); i > 0 {
+		cut = cut[:i]
+	}
+
+	return strings.TrimRightFunc(cut, unicode.IsSpace) + "…"
+}
+
+// sameAsRevision is true when a suggestion changes neither the title nor the content of its revision. Leading and
+// trailing whitespaces are ignored.
+func sameAsRevision(form *models.ImproveSuggestionForm, revision *dao.ImproveRequestRevisionModel) bool {
+	return strings.TrimSpace(form.Title) == strings.TrimSpace(revision.Title) &&
+		strings.TrimSpace(form.Content) == strings.TrimSpace(revision.Content)
+}
+
+// improveSuggestionToDAO converts a suggestion form, along with the edit stats of its content against the content of
+// its revision.
+func improveSuggestionToDAO(form *models.ImproveSuggestionForm, revision *dao.ImproveRequestRevisionModel) *dao.ImproveSuggestionModelCore {
+	data := adapters.ImproveSuggestionFormToDAO(form)
+
+	stats := diff.Measure(revision.Content, form.Content)
+	data.AddedChars = lo.ToPtr(stats.Added)
+	data.RemovedChars = lo.ToPtr(stats.Removed)
+	data.ChangedRatio = lo.ToPtr(stats.ChangedRatio)
+
+	return data
+}
+
+// diffTexts compares two versions of a text, both word by word and sentence by sentence.
+func diffTexts(oldText, newText string) *models.TextDiff {
+	return &models.TextDiff{
+		Words:     adapters.DiffChunksToModel(diff.Compare(diff.Words(oldText), diff.Words(newText))),
+		Sentences: adapters.DiffChunksToModel(diff.Compare(diff.Sentences(oldText), diff.Sentences(newText))),
+	}
+}
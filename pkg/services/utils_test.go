@@ -2,6 +2,8 @@ package services_test
 
 import (
 	"fmt"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/stretchr/testify/mock"
 	"time"
 )
 
@@ -13,3 +15,19 @@ var (
 	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
 	updateTime = time.Date(2020, time.May, 4, 9, 0, 0, 0, time.UTC)
 )
+
+// systemReport matches the report the system files along with a new post, or no report at all when core is nil. The ID
+// of the report is random, so it is not compared.
+func systemReport(core *dao.ReportModelCore, now time.Time) interface{} {
+	if core == nil {
+		return (*dao.ReportModel)(nil)
+	}
+
+	return mock.MatchedBy(func(report *dao.ReportModel) bool {
+		return report != nil &&
+			report.UserID == dao.ReportSystemUserID &&
+			report.Status == dao.ReportStatusOpen &&
+			report.CreatedAt.Equal(now) &&
+			report.ReportModelCore == *core
+	})
+}
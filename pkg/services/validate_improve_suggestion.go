@@ -3,14 +3,16 @@ package services
 import (
 	"context"
 	goerrors "errors"
+	"github.com/a-novel/bunovel"
 	"github.com/a-novel/forum-service/pkg/dao"
 	apiclients "github.com/a-novel/go-apis/clients"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
+	"time"
 )
 
 type ValidateImproveSuggestionService interface {
-	Validate(ctx context.Context, tokenRaw string, validated bool, id uuid.UUID) error
+	Validate(ctx context.Context, tokenRaw string, validated bool, id uuid.UUID, now time.Time) error
 }
 
 func NewValidateImproveSuggestionService(
@@ -31,7 +33,7 @@ type validateImproveSuggestionServiceImpl struct {
 	authClient        apiclients.AuthClient
 }
 
-func (s *validateImproveSuggestionServiceImpl) Validate(ctx context.Context, tokenRaw string, validated bool, id uuid.UUID) error {
+func (s *validateImproveSuggestionServiceImpl) Validate(ctx context.Context, tokenRaw string, validated bool, id uuid.UUID, now time.Time) error {
 	token, err := s.authClient.IntrospectToken(ctx, tokenRaw)
 	if err != nil {
 		return goerrors.Join(ErrIntrospectToken, err)
@@ -44,6 +46,9 @@ func (s *validateImproveSuggestionServiceImpl) Validate(ctx context.Context, tok
 	if err != nil {
 		return goerrors.Join(ErrGetImproveSuggestion, err)
 	}
+	if !canView(suggestion.Draft, suggestion.UserID, &token.Token.Payload.ID) {
+		return goerrors.Join(ErrGetImproveSuggestion, bunovel.ErrNotFound)
+	}
 
 	request, err := s.requestRepository.GetRevision(ctx, suggestion.RequestID)
 	if err != nil {
@@ -54,7 +59,18 @@ func (s *validateImproveSuggestionServiceImpl) Validate(ctx context.Context, tok
 		return goerrors.Join(goframework.ErrInvalidCredentials, ErrNotTheCreator)
 	}
 
-	if _, err := s.repository.Validate(ctx, validated, id); err != nil {
+	// Only accepting a suggestion is worth notifying.
+	var events []*dao.EventModelCore
+	if validated {
+		events = append(events, &dao.EventModelCore{
+			Type:     dao.EventTypeSuggestionValidated,
+			UserID:   token.Token.Payload.ID,
+			TargetID: id,
+			SourceID: suggestion.SourceID,
+		})
+	}
+
+	if _, err := s.repository.Validate(ctx, validated, id, now, events...); err != nil {
 		return goerrors.Join(ErrValidateImproveSuggestion, err)
 	}
 
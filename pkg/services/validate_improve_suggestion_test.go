@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"context"
+	"github.com/a-novel/bunovel"
 	"github.com/a-novel/forum-service/pkg/dao"
 	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
 	"github.com/a-novel/forum-service/pkg/services"
@@ -33,6 +34,7 @@ func TestValidateImproveSuggestionService(t *testing.T) {
 		getRequestErr        error
 
 		shouldCallValidateSuggestion bool
+		validateSuggestionEvents     []interface{}
 		validateSuggestionErr        error
 
 		expectErr error
@@ -50,6 +52,37 @@ func TestValidateImproveSuggestionService(t *testing.T) {
 			},
 			shouldCallGetSuggestion: true,
 			getSuggestionResp: &dao.ImproveSuggestionModel{
+				SourceID:                   goframework.NumberUUID(20),
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{RequestID: goframework.NumberUUID(10)},
+			},
+			shouldCallGetRequest: true,
+			getRequestResp: &dao.ImproveRequestRevisionModel{
+				UserID: goframework.NumberUUID(100),
+			},
+			shouldCallValidateSuggestion: true,
+			validateSuggestionEvents: []interface{}{
+				&dao.EventModelCore{
+					Type:     dao.EventTypeSuggestionValidated,
+					UserID:   goframework.NumberUUID(100),
+					TargetID: goframework.NumberUUID(1),
+					SourceID: goframework.NumberUUID(20),
+				},
+			},
+		},
+		{
+			name:      "Success/Invalidate",
+			tokenRaw:  "token",
+			validated: false,
+			id:        goframework.NumberUUID(1),
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGetSuggestion: true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				SourceID:                   goframework.NumberUUID(20),
 				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{RequestID: goframework.NumberUUID(10)},
 			},
 			shouldCallGetRequest: true,
@@ -71,6 +104,7 @@ func TestValidateImproveSuggestionService(t *testing.T) {
 			},
 			shouldCallGetSuggestion: true,
 			getSuggestionResp: &dao.ImproveSuggestionModel{
+				SourceID:                   goframework.NumberUUID(20),
 				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{RequestID: goframework.NumberUUID(10)},
 			},
 			shouldCallGetRequest: true,
@@ -78,8 +112,16 @@ func TestValidateImproveSuggestionService(t *testing.T) {
 				UserID: goframework.NumberUUID(100),
 			},
 			shouldCallValidateSuggestion: true,
-			validateSuggestionErr:        fooErr,
-			expectErr:                    fooErr,
+			validateSuggestionEvents: []interface{}{
+				&dao.EventModelCore{
+					Type:     dao.EventTypeSuggestionValidated,
+					UserID:   goframework.NumberUUID(100),
+					TargetID: goframework.NumberUUID(1),
+					SourceID: goframework.NumberUUID(20),
+				},
+			},
+			validateSuggestionErr: fooErr,
+			expectErr:             fooErr,
 		},
 		{
 			name:      "Error/NotTheRequestOwner",
@@ -94,6 +136,7 @@ func TestValidateImproveSuggestionService(t *testing.T) {
 			},
 			shouldCallGetSuggestion: true,
 			getSuggestionResp: &dao.ImproveSuggestionModel{
+				SourceID:                   goframework.NumberUUID(20),
 				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{RequestID: goframework.NumberUUID(10)},
 			},
 			shouldCallGetRequest: true,
@@ -115,12 +158,33 @@ func TestValidateImproveSuggestionService(t *testing.T) {
 			},
 			shouldCallGetSuggestion: true,
 			getSuggestionResp: &dao.ImproveSuggestionModel{
+				SourceID:                   goframework.NumberUUID(20),
 				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{RequestID: goframework.NumberUUID(10)},
 			},
 			shouldCallGetRequest: true,
 			getRequestErr:        fooErr,
 			expectErr:            fooErr,
 		},
+		{
+			name:      "Error/DraftSuggestion",
+			tokenRaw:  "token",
+			validated: true,
+			id:        goframework.NumberUUID(1),
+			authClientResp: &apiclients.UserTokenStatus{
+				OK: true,
+				Token: &apiclients.UserToken{
+					Payload: apiclients.UserTokenPayload{ID: goframework.NumberUUID(100)},
+				},
+			},
+			shouldCallGetSuggestion: true,
+			getSuggestionResp: &dao.ImproveSuggestionModel{
+				SourceID:                   goframework.NumberUUID(20),
+				UserID:                     goframework.NumberUUID(200),
+				Draft:                      true,
+				ImproveSuggestionModelCore: dao.ImproveSuggestionModelCore{RequestID: goframework.NumberUUID(10)},
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
 		{
 			name:      "Error/GetSuggestionFailure",
 			tokenRaw:  "token",
@@ -174,12 +238,12 @@ func TestValidateImproveSuggestionService(t *testing.T) {
 
 			if d.shouldCallValidateSuggestion {
 				repository.
-					On("Validate", context.Background(), d.validated, d.id).
+					On("Validate", append([]interface{}{context.Background(), d.validated, d.id, baseTime}, d.validateSuggestionEvents...)...).
 					Return(nil, d.validateSuggestionErr)
 			}
 
 			service := services.NewValidateImproveSuggestionService(repository, requestRepository, authClient)
-			err := service.Validate(context.Background(), d.tokenRaw, d.validated, d.id)
+			err := service.Validate(context.Background(), d.tokenRaw, d.validated, d.id, baseTime)
 
 			require.ErrorIs(t, err, d.expectErr)
 
@@ -3,29 +3,40 @@ package services
 import (
 	"context"
 	goerrors "errors"
+	"github.com/a-novel/bunovel"
 	"github.com/a-novel/forum-service/pkg/dao"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
+	"time"
 )
 
 type VoteImproveRequestService interface {
-	Vote(ctx context.Context, id, userID uuid.UUID, upVotes, downVotes int) error
+	Vote(ctx context.Context, id, userID uuid.UUID, vote int, now time.Time) error
 }
 
-func NewVoteImproveRequestService(repository dao.ImproveRequestRepository) VoteImproveRequestService {
+func NewVoteImproveRequestService(repository dao.ImproveRequestRepository, voteRepository dao.VoteRepository) VoteImproveRequestService {
 	return &voteImproveRequestServiceImpl{
-		repository: repository,
+		repository:     repository,
+		voteRepository: voteRepository,
 	}
 }
 
 type voteImproveRequestServiceImpl struct {
-	repository dao.ImproveRequestRepository
+	repository     dao.ImproveRequestRepository
+	voteRepository dao.VoteRepository
 }
 
-func (s *voteImproveRequestServiceImpl) Vote(ctx context.Context, id, userID uuid.UUID, upVotes, downVotes int) error {
+func (s *voteImproveRequestServiceImpl) Vote(ctx context.Context, id, userID uuid.UUID, vote int, now time.Time) error {
+	if vote < MinVote || vote > MaxVote {
+		return goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidVote)
+	}
+
 	request, err := s.repository.Get(ctx, id)
 	if err != nil {
-		return goerrors.Join(ErrGetImproveRequestRevision, err)
+		return goerrors.Join(ErrGetImproveRequest, err)
+	}
+	if !canView(request.Draft, request.UserID, &userID) {
+		return goerrors.Join(ErrGetImproveRequest, bunovel.ErrNotFound)
 	}
 
 	// User is not allowed to vote on its own post.
@@ -33,8 +44,8 @@ func (s *voteImproveRequestServiceImpl) Vote(ctx context.Context, id, userID uui
 		return goerrors.Join(goframework.ErrInvalidCredentials, ErrTheCreator)
 	}
 
-	if err := s.repository.UpdateVotes(ctx, id, upVotes, downVotes); err != nil {
-		return goerrors.Join(ErrUpdateImproveRequestRevision, err)
+	if err := s.voteRepository.Vote(ctx, dao.VoteTargetImproveRequest, id, userID, vote, now); err != nil {
+		return goerrors.Join(ErrVote, err)
 	}
 
 	return nil
@@ -3,38 +3,49 @@ package services
 import (
 	"context"
 	goerrors "errors"
+	"github.com/a-novel/bunovel"
 	"github.com/a-novel/forum-service/pkg/dao"
 	goframework "github.com/a-novel/go-framework"
 	"github.com/google/uuid"
+	"time"
 )
 
 type VoteImproveSuggestionService interface {
-	Vote(ctx context.Context, id, userID uuid.UUID, upVotes, downVotes int) error
+	Vote(ctx context.Context, id, userID uuid.UUID, vote int, now time.Time) error
 }
 
-func NewVoteImproveSuggestionService(repository dao.ImproveSuggestionRepository) VoteImproveSuggestionService {
+func NewVoteImproveSuggestionService(repository dao.ImproveSuggestionRepository, voteRepository dao.VoteRepository) VoteImproveSuggestionService {
 	return &voteImproveSuggestionServiceImpl{
-		repository: repository,
+		repository:     repository,
+		voteRepository: voteRepository,
 	}
 }
 
 type voteImproveSuggestionServiceImpl struct {
-	repository dao.ImproveSuggestionRepository
+	repository     dao.ImproveSuggestionRepository
+	voteRepository dao.VoteRepository
 }
 
-func (s *voteImproveSuggestionServiceImpl) Vote(ctx context.Context, id, userID uuid.UUID, upVotes, downVotes int) error {
+func (s *voteImproveSuggestionServiceImpl) Vote(ctx context.Context, id, userID uuid.UUID, vote int, now time.Time) error {
+	if vote < MinVote || vote > MaxVote {
+		return goerrors.Join(goframework.ErrInvalidEntity, ErrInvalidVote)
+	}
+
 	suggestion, err := s.repository.Get(ctx, id)
 	if err != nil {
 		return goerrors.Join(ErrGetImproveSuggestion, err)
 	}
+	if !canView(suggestion.Draft, suggestion.UserID, &userID) {
+		return goerrors.Join(ErrGetImproveSuggestion, bunovel.ErrNotFound)
+	}
 
 	// User is not allowed to vote on its own post.
 	if suggestion.UserID == userID {
 		return goerrors.Join(goframework.ErrInvalidCredentials, ErrTheCreator)
 	}
 
-	if err := s.repository.UpdateVotes(ctx, id, upVotes, downVotes); err != nil {
-		return goerrors.Join(ErrUpdateImproveSuggestion, err)
+	if err := s.voteRepository.Vote(ctx, dao.VoteTargetImproveSuggestion, id, userID, vote, now); err != nil {
+		return goerrors.Join(ErrVote, err)
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"context"
+	"github.com/a-novel/bunovel"
 	"github.com/a-novel/forum-service/pkg/dao"
 	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
 	"github.com/a-novel/forum-service/pkg/services"
@@ -9,87 +10,132 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestVoteImproveSuggestionService(t *testing.T) {
 	data := []struct {
 		name string
 
-		id        uuid.UUID
-		userID    uuid.UUID
-		upVotes   int
-		downVotes int
+		id     uuid.UUID
+		userID uuid.UUID
+		vote   int
+		now    time.Time
 
-		getRevision    *dao.ImproveSuggestionModel
-		getRevisionErr error
+		shouldCallGet    bool
+		getSuggestion    *dao.ImproveSuggestionModel
+		getSuggestionErr error
 
-		shouldCallUpdateVotes bool
-		updateVotesErr        error
+		shouldCallVote bool
+		voteErr        error
 
 		expectErr error
 	}{
 		{
-			name:      "Success",
-			id:        goframework.NumberUUID(1),
-			userID:    goframework.NumberUUID(100),
-			upVotes:   10,
-			downVotes: 5,
-			getRevision: &dao.ImproveSuggestionModel{
+			name:          "Success",
+			id:            goframework.NumberUUID(1),
+			userID:        goframework.NumberUUID(100),
+			vote:          1,
+			now:           baseTime,
+			shouldCallGet: true,
+			getSuggestion: &dao.ImproveSuggestionModel{
 				UserID: goframework.NumberUUID(200),
 			},
-			shouldCallUpdateVotes: true,
+			shouldCallVote: true,
 		},
 		{
-			name:      "Error/UpdateFailure",
-			id:        goframework.NumberUUID(1),
-			userID:    goframework.NumberUUID(100),
-			upVotes:   10,
-			downVotes: 5,
-			getRevision: &dao.ImproveSuggestionModel{
+			name:          "Success/Retract",
+			id:            goframework.NumberUUID(1),
+			userID:        goframework.NumberUUID(100),
+			vote:          0,
+			now:           baseTime,
+			shouldCallGet: true,
+			getSuggestion: &dao.ImproveSuggestionModel{
 				UserID: goframework.NumberUUID(200),
 			},
-			shouldCallUpdateVotes: true,
-			updateVotesErr:        fooErr,
-			expectErr:             fooErr,
+			shouldCallVote: true,
 		},
 		{
-			name:      "Error/IsTheCreator",
-			id:        goframework.NumberUUID(1),
-			userID:    goframework.NumberUUID(100),
-			upVotes:   10,
-			downVotes: 5,
-			getRevision: &dao.ImproveSuggestionModel{
+			name:          "Error/VoteFailure",
+			id:            goframework.NumberUUID(1),
+			userID:        goframework.NumberUUID(100),
+			vote:          -1,
+			now:           baseTime,
+			shouldCallGet: true,
+			getSuggestion: &dao.ImproveSuggestionModel{
+				UserID: goframework.NumberUUID(200),
+			},
+			shouldCallVote: true,
+			voteErr:        fooErr,
+			expectErr:      fooErr,
+		},
+		{
+			name:          "Error/IsTheCreator",
+			id:            goframework.NumberUUID(1),
+			userID:        goframework.NumberUUID(100),
+			vote:          1,
+			now:           baseTime,
+			shouldCallGet: true,
+			getSuggestion: &dao.ImproveSuggestionModel{
 				UserID: goframework.NumberUUID(100),
 			},
 			expectErr: goframework.ErrInvalidCredentials,
 		},
 		{
-			name:           "Error/GetRevisionFailure",
-			id:             goframework.NumberUUID(1),
-			userID:         goframework.NumberUUID(100),
-			upVotes:        10,
-			downVotes:      5,
-			getRevisionErr: fooErr,
-			expectErr:      fooErr,
+			name:          "Error/DraftOfAnotherUser",
+			id:            goframework.NumberUUID(1),
+			userID:        goframework.NumberUUID(100),
+			vote:          1,
+			now:           baseTime,
+			shouldCallGet: true,
+			getSuggestion: &dao.ImproveSuggestionModel{
+				UserID: goframework.NumberUUID(200),
+				Draft:  true,
+			},
+			expectErr: bunovel.ErrNotFound,
+		},
+		{
+			name:             "Error/GetFailure",
+			id:               goframework.NumberUUID(1),
+			userID:           goframework.NumberUUID(100),
+			vote:             1,
+			now:              baseTime,
+			shouldCallGet:    true,
+			getSuggestionErr: fooErr,
+			expectErr:        fooErr,
+		},
+		{
+			name:      "Error/InvalidVote",
+			id:        goframework.NumberUUID(1),
+			userID:    goframework.NumberUUID(100),
+			vote:      2,
+			now:       baseTime,
+			expectErr: goframework.ErrInvalidEntity,
 		},
 	}
 
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
 			repository := daomocks.NewImproveSuggestionRepository(t)
+			voteRepository := daomocks.NewVoteRepository(t)
 
-			repository.On("Get", context.Background(), d.id).Return(d.getRevision, d.getRevisionErr)
+			if d.shouldCallGet {
+				repository.On("Get", context.Background(), d.id).Return(d.getSuggestion, d.getSuggestionErr)
+			}
 
-			if d.shouldCallUpdateVotes {
-				repository.On("UpdateVotes", context.Background(), d.id, d.upVotes, d.downVotes).Return(d.updateVotesErr)
+			if d.shouldCallVote {
+				voteRepository.
+					On("Vote", context.Background(), dao.VoteTargetImproveSuggestion, d.id, d.userID, d.vote, d.now).
+					Return(d.voteErr)
 			}
 
-			service := services.NewVoteImproveSuggestionService(repository)
-			err := service.Vote(context.Background(), d.id, d.userID, d.upVotes, d.downVotes)
+			service := services.NewVoteImproveSuggestionService(repository, voteRepository)
+			err := service.Vote(context.Background(), d.id, d.userID, d.vote, d.now)
 
 			require.ErrorIs(t, err, d.expectErr)
 
 			repository.AssertExpectations(t)
+			voteRepository.AssertExpectations(t)
 		})
 	}
 }
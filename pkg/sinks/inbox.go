@@ -0,0 +1,32 @@
+package sinks
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/forum-service/pkg/dao"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+// NewInboxSink returns a sink that turns every event into notifications, in the inbox of the users concerned by the
+// event.
+func NewInboxSink(repository dao.NotificationRepository) Sink {
+	return &inboxSinkImpl{repository: repository}
+}
+
+type inboxSinkImpl struct {
+	repository dao.NotificationRepository
+}
+
+func (sink *inboxSinkImpl) Send(ctx context.Context, event *models.Event) error {
+	err := sink.repository.FanOut(ctx, event.ID, &dao.EventModelCore{
+		Type:     dao.EventType(event.Type),
+		UserID:   event.UserID,
+		TargetID: event.TargetID,
+		SourceID: event.SourceID,
+	}, event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to fan out notifications: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package sinks_test
+
+import (
+	"context"
+	"errors"
+	"github.com/a-novel/forum-service/pkg/dao"
+	daomocks "github.com/a-novel/forum-service/pkg/dao/mocks"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/sinks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestInboxSink(t *testing.T) {
+	createdAt := time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	fooErr := errors.New("it broken")
+
+	event := &models.Event{
+		ID:        1,
+		CreatedAt: createdAt,
+		Type:      models.EventTypeSuggestionCreated,
+		UserID:    goframework.NumberUUID(100),
+		TargetID:  goframework.NumberUUID(20),
+		SourceID:  goframework.NumberUUID(10),
+	}
+
+	data := []struct {
+		name string
+
+		fanOutErr error
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:      "Error/FanOutFailure",
+			fanOutErr: fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := daomocks.NewNotificationRepository(t)
+
+			repository.
+				On("FanOut", context.Background(), int64(1), &dao.EventModelCore{
+					Type:     dao.EventTypeSuggestionCreated,
+					UserID:   goframework.NumberUUID(100),
+					TargetID: goframework.NumberUUID(20),
+					SourceID: goframework.NumberUUID(10),
+				}, createdAt).
+				Return(d.fanOutErr)
+
+			sink := sinks.NewInboxSink(repository)
+			require.ErrorIs(t, sink.Send(context.Background(), event), d.expectErr)
+
+			repository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package sinks
+
+import (
+	"context"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/rs/zerolog"
+)
+
+// NewLogSink returns a sink that writes every event to the given logger.
+func NewLogSink(logger zerolog.Logger) Sink {
+	return &logSinkImpl{logger: logger}
+}
+
+type logSinkImpl struct {
+	logger zerolog.Logger
+}
+
+func (sink *logSinkImpl) Send(_ context.Context, event *models.Event) error {
+	sink.logger.Info().
+		Int64("id", event.ID).
+		Str("type", event.Type).
+		Str("userID", event.UserID.String()).
+		Str("targetID", event.TargetID.String()).
+		Str("sourceID", event.SourceID.String()).
+		Time("createdAt", event.CreatedAt).
+		Msg("event dispatched")
+
+	return nil
+}
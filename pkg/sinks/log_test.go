@@ -0,0 +1,41 @@
+package sinks_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/sinks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestLogSink(t *testing.T) {
+	output := new(bytes.Buffer)
+
+	err := sinks.NewLogSink(zerolog.New(output)).Send(context.Background(), &models.Event{
+		ID:        1,
+		CreatedAt: time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC),
+		Type:      models.EventTypeRequestRevised,
+		UserID:    goframework.NumberUUID(100),
+		TargetID:  goframework.NumberUUID(2),
+		SourceID:  goframework.NumberUUID(10),
+	})
+	require.NoError(t, err)
+
+	var line map[string]interface{}
+	require.NoError(t, json.Unmarshal(output.Bytes(), &line))
+	require.Equal(t, map[string]interface{}{
+		"level":     "info",
+		"id":        float64(1),
+		"type":      models.EventTypeRequestRevised,
+		"userID":    goframework.NumberUUID(100).String(),
+		"targetID":  goframework.NumberUUID(2).String(),
+		"sourceID":  goframework.NumberUUID(10).String(),
+		"createdAt": "2020-05-04T08:00:00Z",
+		"message":   "event dispatched",
+	}, line)
+}
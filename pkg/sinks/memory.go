@@ -0,0 +1,33 @@
+package sinks
+
+import (
+	"context"
+	"github.com/a-novel/forum-service/pkg/models"
+	"sync"
+)
+
+// MemorySink keeps the events it receives in memory. It is meant for tests.
+type MemorySink struct {
+	mu     sync.Mutex
+	events []*models.Event
+}
+
+func NewMemorySink() *MemorySink {
+	return new(MemorySink)
+}
+
+func (sink *MemorySink) Send(_ context.Context, event *models.Event) error {
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+
+	sink.events = append(sink.events, event)
+	return nil
+}
+
+// Events returns the events received so far, in the order they were sent.
+func (sink *MemorySink) Events() []*models.Event {
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+
+	return append([]*models.Event(nil), sink.events...)
+}
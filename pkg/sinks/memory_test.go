@@ -0,0 +1,27 @@
+package sinks_test
+
+import (
+	"context"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/sinks"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMemorySink(t *testing.T) {
+	sink := sinks.NewMemorySink()
+	require.Empty(t, sink.Events())
+
+	first := &models.Event{ID: 1, Type: models.EventTypeSuggestionCreated}
+	second := &models.Event{ID: 2, Type: models.EventTypeSuggestionValidated}
+
+	require.NoError(t, sink.Send(context.Background(), first))
+	require.NoError(t, sink.Send(context.Background(), second))
+
+	events := sink.Events()
+	require.Equal(t, []*models.Event{first, second}, events)
+
+	// The returned slice is a copy, so callers cannot alter the recorded events.
+	events[0] = nil
+	require.Equal(t, []*models.Event{first, second}, sink.Events())
+}
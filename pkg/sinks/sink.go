@@ -0,0 +1,13 @@
+// Package sinks contains the destinations forum events can be delivered to.
+package sinks
+
+import (
+	"context"
+	"github.com/a-novel/forum-service/pkg/models"
+)
+
+// Sink receives the events dispatched from the outbox. Delivery is at least once: an event may be sent again to a
+// sink that already received it, if the delivery to another sink failed.
+type Sink interface {
+	Send(ctx context.Context, event *models.Event) error
+}
@@ -0,0 +1,60 @@
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/a-novel/forum-service/pkg/models"
+	"io"
+	"net/http"
+)
+
+// EventTypeHeader is set on webhook requests, so receivers can route events without reading the body.
+const EventTypeHeader = "X-Event-Type"
+
+// maxDrainedBodySize bounds the part of a webhook response that is read, and discarded, before closing it.
+const maxDrainedBodySize = 64 << 10
+
+// NewWebhookSink returns a sink that posts every event, encoded as JSON, to the given URL. Any response with a
+// status other than 2xx is treated as a failed delivery.
+func NewWebhookSink(url string, client *http.Client) Sink {
+	return &webhookSinkImpl{url: url, client: client}
+}
+
+type webhookSinkImpl struct {
+	url    string
+	client *http.Client
+}
+
+func (sink *webhookSinkImpl) Send(ctx context.Context, event *models.Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, event.Type)
+
+	res, err := sink.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer func() {
+		// The connection is only reused once its body is fully read. Large bodies are not worth it: the connection is
+		// closed instead.
+		_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxDrainedBodySize))
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
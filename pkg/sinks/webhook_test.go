@@ -0,0 +1,78 @@
+package sinks_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/a-novel/forum-service/pkg/models"
+	"github.com/a-novel/forum-service/pkg/sinks"
+	goframework "github.com/a-novel/go-framework"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWebhookSink(t *testing.T) {
+	event := &models.Event{
+		ID:        1,
+		CreatedAt: time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC),
+		Type:      models.EventTypeSuggestionCreated,
+		UserID:    goframework.NumberUUID(100),
+		TargetID:  goframework.NumberUUID(20),
+		SourceID:  goframework.NumberUUID(10),
+	}
+
+	data := []struct {
+		name string
+
+		status int
+
+		expectErr bool
+	}{
+		{
+			name:   "Success",
+			status: http.StatusNoContent,
+		},
+		{
+			name:      "Error/BadStatus",
+			status:    http.StatusInternalServerError,
+			expectErr: true,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			var received *models.Event
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				require.Equal(t, models.EventTypeSuggestionCreated, r.Header.Get(sinks.EventTypeHeader))
+
+				received = new(models.Event)
+				require.NoError(t, json.NewDecoder(r.Body).Decode(received))
+
+				w.WriteHeader(d.status)
+			}))
+			defer server.Close()
+
+			err := sinks.NewWebhookSink(server.URL, server.Client()).Send(context.Background(), event)
+			if d.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, event, received)
+		})
+	}
+}
+
+func TestWebhookSink_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	err := sinks.NewWebhookSink(server.URL, server.Client()).Send(context.Background(), &models.Event{ID: 1})
+	require.Error(t, err)
+}